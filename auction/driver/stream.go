@@ -2,6 +2,7 @@ package driver
 
 import (
 	"io"
+	"mime"
 	"net/http"
 
 	streaming "github.com/cloudwego/kitex/pkg/streaming"
@@ -37,13 +38,28 @@ func (x *HttpStream) SendMsg(response interface{}) error {
 	if err != nil {
 		return err
 	}
-	x.w.Header().Set("Access-Control-Allow-Origin", "*")
-	x.w.Header().Set("Access-Control-Allow-Headers", "*")
-	x.w.Header().Set("Access-Control-Allow-Methods", "*")
-	x.w.Header().Set("Access-Control-Expose-Headers", "*")
-	x.w.Header().Set("Access-Control-Allow-Credentials", "true")
+	setCorsHeaders(x.w)
 	if _, err := x.w.Write(b); err != nil {
 		return err
 	}
 	return nil
+}
+
+// SendFile 以附件形式返回文件内容（用于导出）
+func SendFile(w http.ResponseWriter, fileName string, contentType string, content []byte) error {
+	setCorsHeaders(w)
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	if _, err := w.Write(content); err != nil {
+		return err
+	}
+	return nil
+}
+
+func setCorsHeaders(w http.ResponseWriter) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Headers", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "*")
+	w.Header().Set("Access-Control-Expose-Headers", "*")
+	w.Header().Set("Access-Control-Allow-Credentials", "true")
 }
\ No newline at end of file
@@ -2,17 +2,23 @@ package etcd
 
 import (
 	"sync"
+	"time"
 	"auction_module/config"
 
 	"github.com/cloudwego/kitex/pkg/discovery"
 	"github.com/cloudwego/kitex/pkg/registry"
 	_etcd "github.com/kitex-contrib/registry-etcd"
+	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 var (
 	syncOnce     sync.Once
 	etcdClient   registry.Registry
 	etcdResolver discovery.Resolver
+
+	kvOnce   sync.Once
+	kvClient *clientv3.Client
+	kvErr    error
 )
 
 func initEtcd() {
@@ -43,4 +49,21 @@ func GetEtcdClient() registry.Registry {
 func GetEtcdResolver() discovery.Resolver {
 	initEtcd()
 	return etcdResolver
-}
\ No newline at end of file
+}
+
+// GetEtcdKVClient 获取etcd原生客户端，用于租约、事务等注册中心以外的操作
+func GetEtcdKVClient() (*clientv3.Client, error) {
+	kvOnce.Do(func() {
+		addrs := make([]string, 0)
+		for _, addr := range config.Get("etcd.addrs").([]interface{}) {
+			addrs = append(addrs, addr.(string))
+		}
+		kvClient, kvErr = clientv3.New(clientv3.Config{
+			Endpoints:   addrs,
+			Username:    config.Get("etcd.username").(string),
+			Password:    config.Get("etcd.password").(string),
+			DialTimeout: 5 * time.Second,
+		})
+	})
+	return kvClient, kvErr
+}
@@ -23,6 +23,318 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 订单类型
+type OrderType int32
+
+const (
+	OrderType_LIMIT  OrderType = 0 // 限价单，未成交部分挂单等待撮合
+	OrderType_MARKET OrderType = 1 // 市价单，按盘口价格立即成交，未成交部分撤销
+	OrderType_IOC    OrderType = 2 // 立即成交剩余撤销（限价）
+	OrderType_FOK    OrderType = 3 // 全部成交否则全部撤销（限价）
+)
+
+// Enum value maps for OrderType.
+var (
+	OrderType_name = map[int32]string{
+		0: "LIMIT",
+		1: "MARKET",
+		2: "IOC",
+		3: "FOK",
+	}
+	OrderType_value = map[string]int32{
+		"LIMIT":  0,
+		"MARKET": 1,
+		"IOC":    2,
+		"FOK":    3,
+	}
+)
+
+func (x OrderType) Enum() *OrderType {
+	p := new(OrderType)
+	*p = x
+	return p
+}
+
+func (x OrderType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auction_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderType) Type() protoreflect.EnumType {
+	return &file_proto_auction_proto_enumTypes[0]
+}
+
+func (x OrderType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderType.Descriptor instead.
+func (OrderType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{0}
+}
+
+// 市场搜索排序字段
+type MarketSortBy int32
+
+const (
+	MarketSortBy_MARKET_SORT_BEST_ASK     MarketSortBy = 0 // 最低卖价（只包含有卖单的道具）
+	MarketSortBy_MARKET_SORT_BEST_BID     MarketSortBy = 1 // 最高买价（只包含有买单的道具）
+	MarketSortBy_MARKET_SORT_VOLUME_24H   MarketSortBy = 2 // 24小时成交量
+	MarketSortBy_MARKET_SORT_PRICE_CHANGE MarketSortBy = 3 // 24小时涨跌幅
+)
+
+// Enum value maps for MarketSortBy.
+var (
+	MarketSortBy_name = map[int32]string{
+		0: "MARKET_SORT_BEST_ASK",
+		1: "MARKET_SORT_BEST_BID",
+		2: "MARKET_SORT_VOLUME_24H",
+		3: "MARKET_SORT_PRICE_CHANGE",
+	}
+	MarketSortBy_value = map[string]int32{
+		"MARKET_SORT_BEST_ASK":     0,
+		"MARKET_SORT_BEST_BID":     1,
+		"MARKET_SORT_VOLUME_24H":   2,
+		"MARKET_SORT_PRICE_CHANGE": 3,
+	}
+)
+
+func (x MarketSortBy) Enum() *MarketSortBy {
+	p := new(MarketSortBy)
+	*p = x
+	return p
+}
+
+func (x MarketSortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarketSortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auction_proto_enumTypes[1].Descriptor()
+}
+
+func (MarketSortBy) Type() protoreflect.EnumType {
+	return &file_proto_auction_proto_enumTypes[1]
+}
+
+func (x MarketSortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarketSortBy.Descriptor instead.
+func (MarketSortBy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{1}
+}
+
+// K线周期
+type KlineInterval int32
+
+const (
+	KlineInterval_KLINE_1M KlineInterval = 0 // 1分钟
+	KlineInterval_KLINE_5M KlineInterval = 1 // 5分钟
+	KlineInterval_KLINE_1H KlineInterval = 2 // 1小时
+	KlineInterval_KLINE_1D KlineInterval = 3 // 1天
+)
+
+// Enum value maps for KlineInterval.
+var (
+	KlineInterval_name = map[int32]string{
+		0: "KLINE_1M",
+		1: "KLINE_5M",
+		2: "KLINE_1H",
+		3: "KLINE_1D",
+	}
+	KlineInterval_value = map[string]int32{
+		"KLINE_1M": 0,
+		"KLINE_5M": 1,
+		"KLINE_1H": 2,
+		"KLINE_1D": 3,
+	}
+)
+
+func (x KlineInterval) Enum() *KlineInterval {
+	p := new(KlineInterval)
+	*p = x
+	return p
+}
+
+func (x KlineInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KlineInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auction_proto_enumTypes[2].Descriptor()
+}
+
+func (KlineInterval) Type() protoreflect.EnumType {
+	return &file_proto_auction_proto_enumTypes[2]
+}
+
+func (x KlineInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KlineInterval.Descriptor instead.
+func (KlineInterval) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{2}
+}
+
+// 唯一道具挂单类型
+type UniqueListingType int32
+
+const (
+	UniqueListingType_UNIQUE_FIXED_PRICE UniqueListingType = 0 // 一口价，第一个按标价购买的玩家成交
+	UniqueListingType_UNIQUE_OFFER       UniqueListingType = 1 // 竞价，玩家出价不低于起拍价，卖家可随时接受最高出价，到期自动成交给最高出价者
+)
+
+// Enum value maps for UniqueListingType.
+var (
+	UniqueListingType_name = map[int32]string{
+		0: "UNIQUE_FIXED_PRICE",
+		1: "UNIQUE_OFFER",
+	}
+	UniqueListingType_value = map[string]int32{
+		"UNIQUE_FIXED_PRICE": 0,
+		"UNIQUE_OFFER":       1,
+	}
+)
+
+func (x UniqueListingType) Enum() *UniqueListingType {
+	p := new(UniqueListingType)
+	*p = x
+	return p
+}
+
+func (x UniqueListingType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UniqueListingType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auction_proto_enumTypes[3].Descriptor()
+}
+
+func (UniqueListingType) Type() protoreflect.EnumType {
+	return &file_proto_auction_proto_enumTypes[3]
+}
+
+func (x UniqueListingType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UniqueListingType.Descriptor instead.
+func (UniqueListingType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{3}
+}
+
+// 唯一道具挂单状态
+type UniqueListingStatus int32
+
+const (
+	UniqueListingStatus_UNIQUE_ACTIVE    UniqueListingStatus = 0 // 挂单中
+	UniqueListingStatus_UNIQUE_SOLD      UniqueListingStatus = 1 // 已成交
+	UniqueListingStatus_UNIQUE_CANCELLED UniqueListingStatus = 2 // 已取消
+	UniqueListingStatus_UNIQUE_EXPIRED   UniqueListingStatus = 3 // 到期流拍
+)
+
+// Enum value maps for UniqueListingStatus.
+var (
+	UniqueListingStatus_name = map[int32]string{
+		0: "UNIQUE_ACTIVE",
+		1: "UNIQUE_SOLD",
+		2: "UNIQUE_CANCELLED",
+		3: "UNIQUE_EXPIRED",
+	}
+	UniqueListingStatus_value = map[string]int32{
+		"UNIQUE_ACTIVE":    0,
+		"UNIQUE_SOLD":      1,
+		"UNIQUE_CANCELLED": 2,
+		"UNIQUE_EXPIRED":   3,
+	}
+)
+
+func (x UniqueListingStatus) Enum() *UniqueListingStatus {
+	p := new(UniqueListingStatus)
+	*p = x
+	return p
+}
+
+func (x UniqueListingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UniqueListingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auction_proto_enumTypes[4].Descriptor()
+}
+
+func (UniqueListingStatus) Type() protoreflect.EnumType {
+	return &file_proto_auction_proto_enumTypes[4]
+}
+
+func (x UniqueListingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UniqueListingStatus.Descriptor instead.
+func (UniqueListingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{4}
+}
+
+// 限时拍卖状态
+type TimedAuctionStatus int32
+
+const (
+	TimedAuctionStatus_TIMED_ACTIVE    TimedAuctionStatus = 0 // 竞拍中
+	TimedAuctionStatus_TIMED_SOLD      TimedAuctionStatus = 1 // 已成交（到期成交或一口价成交）
+	TimedAuctionStatus_TIMED_UNSOLD    TimedAuctionStatus = 2 // 到期无人出价，流拍
+	TimedAuctionStatus_TIMED_CANCELLED TimedAuctionStatus = 3 // 卖家在无人出价时取消
+)
+
+// Enum value maps for TimedAuctionStatus.
+var (
+	TimedAuctionStatus_name = map[int32]string{
+		0: "TIMED_ACTIVE",
+		1: "TIMED_SOLD",
+		2: "TIMED_UNSOLD",
+		3: "TIMED_CANCELLED",
+	}
+	TimedAuctionStatus_value = map[string]int32{
+		"TIMED_ACTIVE":    0,
+		"TIMED_SOLD":      1,
+		"TIMED_UNSOLD":    2,
+		"TIMED_CANCELLED": 3,
+	}
+)
+
+func (x TimedAuctionStatus) Enum() *TimedAuctionStatus {
+	p := new(TimedAuctionStatus)
+	*p = x
+	return p
+}
+
+func (x TimedAuctionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimedAuctionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_auction_proto_enumTypes[5].Descriptor()
+}
+
+func (TimedAuctionStatus) Type() protoreflect.EnumType {
+	return &file_proto_auction_proto_enumTypes[5]
+}
+
+func (x TimedAuctionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimedAuctionStatus.Descriptor instead.
+func (TimedAuctionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{5}
+}
+
 // 基础消息类型
 type PingReq struct {
 	state         protoimpl.MessageState
@@ -564,11 +876,13 @@ type SellReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ItemId       string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                   // 物品ID
-	Price        int64  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`                                  // 出售价格
-	Quantity     int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                            // 出售数量
-	ItemInfo     string `protobuf:"bytes,4,opt,name=item_info,json=itemInfo,proto3" json:"item_info,omitempty"`             // 道具信息（JSON字符串）
-	IdempotentId string `protobuf:"bytes,5,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"` // 幂等ID，用于防止重复请求
+	ItemId       string    `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                                  // 物品ID
+	Price        int64     `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`                                                 // 出售价格
+	Quantity     int32     `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                                           // 出售数量
+	ItemInfo     string    `protobuf:"bytes,4,opt,name=item_info,json=itemInfo,proto3" json:"item_info,omitempty"`                            // 道具信息（JSON字符串）
+	IdempotentId string    `protobuf:"bytes,5,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"`                // 幂等ID，用于防止重复请求
+	ExpireTime   int64     `protobuf:"varint,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`                     // 过期时间戳（秒），0表示使用服务端默认有效期
+	OrderType    OrderType `protobuf:"varint,7,opt,name=order_type,json=orderType,proto3,enum=auction.OrderType" json:"order_type,omitempty"` // 订单类型，市价单忽略price
 }
 
 func (x *SellReq) Reset() {
@@ -638,18 +952,34 @@ func (x *SellReq) GetIdempotentId() string {
 	return ""
 }
 
+func (x *SellReq) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *SellReq) GetOrderType() OrderType {
+	if x != nil {
+		return x.OrderType
+	}
+	return OrderType_LIMIT
+}
+
 // 出售响应数据结构 - 包含完整订单信息
 type SellData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId    string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`           // 订单ID
-	ItemId     string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`              // 道具ID
-	Quantity   int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                       // 道具数量
-	Price      int64  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`                             // 出售价格
-	ItemInfo   string `protobuf:"bytes,5,opt,name=item_info,json=itemInfo,proto3" json:"item_info,omitempty"`        // 道具信息（JSON字符串）
-	CreateTime int64  `protobuf:"varint,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // 创建时间
+	OrderId    string    `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                               // 订单ID
+	ItemId     string    `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                                  // 道具ID
+	Quantity   int32     `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                                           // 道具数量
+	Price      int64     `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`                                                 // 出售价格
+	ItemInfo   string    `protobuf:"bytes,5,opt,name=item_info,json=itemInfo,proto3" json:"item_info,omitempty"`                            // 道具信息（JSON字符串）
+	CreateTime int64     `protobuf:"varint,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                     // 创建时间
+	ExpireTime int64     `protobuf:"varint,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`                     // 过期时间
+	OrderType  OrderType `protobuf:"varint,8,opt,name=order_type,json=orderType,proto3,enum=auction.OrderType" json:"order_type,omitempty"` // 订单类型
 }
 
 func (x *SellData) Reset() {
@@ -726,6 +1056,20 @@ func (x *SellData) GetCreateTime() int64 {
 	return 0
 }
 
+func (x *SellData) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *SellData) GetOrderType() OrderType {
+	if x != nil {
+		return x.OrderType
+	}
+	return OrderType_LIMIT
+}
+
 type SellRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -795,10 +1139,12 @@ type BuyReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ItemId       string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                   // 物品ID
-	Price        int64  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`                                  // 求购价格
-	Quantity     int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                            // 求购数量
-	IdempotentId string `protobuf:"bytes,4,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"` // 幂等ID，用于防止重复请求
+	ItemId       string    `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                                  // 物品ID
+	Price        int64     `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`                                                 // 求购价格
+	Quantity     int32     `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                                           // 求购数量
+	IdempotentId string    `protobuf:"bytes,4,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"`                // 幂等ID，用于防止重复请求
+	ExpireTime   int64     `protobuf:"varint,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`                     // 过期时间戳（秒），0表示使用服务端默认有效期
+	OrderType    OrderType `protobuf:"varint,6,opt,name=order_type,json=orderType,proto3,enum=auction.OrderType" json:"order_type,omitempty"` // 订单类型，市价单忽略price
 }
 
 func (x *BuyReq) Reset() {
@@ -861,17 +1207,33 @@ func (x *BuyReq) GetIdempotentId() string {
 	return ""
 }
 
+func (x *BuyReq) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *BuyReq) GetOrderType() OrderType {
+	if x != nil {
+		return x.OrderType
+	}
+	return OrderType_LIMIT
+}
+
 // 求购响应数据结构 - 包含完整订单信息
 type BuyData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId    string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`           // 订单ID
-	ItemId     string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`              // 道具ID
-	Quantity   int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                       // 道具数量
-	Price      int64  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`                             // 求购价格
-	CreateTime int64  `protobuf:"varint,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // 创建时间
+	OrderId    string    `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                               // 订单ID
+	ItemId     string    `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                                  // 道具ID
+	Quantity   int32     `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                                           // 道具数量
+	Price      int64     `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`                                                 // 求购价格
+	CreateTime int64     `protobuf:"varint,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                     // 创建时间
+	ExpireTime int64     `protobuf:"varint,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`                     // 过期时间
+	OrderType  OrderType `protobuf:"varint,7,opt,name=order_type,json=orderType,proto3,enum=auction.OrderType" json:"order_type,omitempty"` // 订单类型
 }
 
 func (x *BuyData) Reset() {
@@ -941,6 +1303,20 @@ func (x *BuyData) GetCreateTime() int64 {
 	return 0
 }
 
+func (x *BuyData) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *BuyData) GetOrderType() OrderType {
+	if x != nil {
+		return x.OrderType
+	}
+	return OrderType_LIMIT
+}
+
 type BuyRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1004,6 +1380,94 @@ func (x *BuyRsp) GetData() *BuyData {
 	return nil
 }
 
+// 订单过期通知 - 订单到期自动下架后推送给订单所有者
+type AuctionOrderExpiredNtf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId        string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                      // 订单ID
+	ItemId         string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                         // 道具ID
+	TradeDirection string `protobuf:"bytes,3,opt,name=trade_direction,json=tradeDirection,proto3" json:"trade_direction,omitempty"` // 交易方向（buy/sell）
+	Quantity       int32  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`                                  // 未成交的剩余数量（已退还）
+	Price          int64  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`                                        // 订单价格
+	ExpireTime     int64  `protobuf:"varint,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`            // 过期时间
+}
+
+func (x *AuctionOrderExpiredNtf) Reset() {
+	*x = AuctionOrderExpiredNtf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionOrderExpiredNtf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionOrderExpiredNtf) ProtoMessage() {}
+
+func (x *AuctionOrderExpiredNtf) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionOrderExpiredNtf.ProtoReflect.Descriptor instead.
+func (*AuctionOrderExpiredNtf) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AuctionOrderExpiredNtf) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AuctionOrderExpiredNtf) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *AuctionOrderExpiredNtf) GetTradeDirection() string {
+	if x != nil {
+		return x.TradeDirection
+	}
+	return ""
+}
+
+func (x *AuctionOrderExpiredNtf) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AuctionOrderExpiredNtf) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AuctionOrderExpiredNtf) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
 // 取消出售协议 - 取消已发布的出售
 type CancelSellReq struct {
 	state         protoimpl.MessageState
@@ -1017,7 +1481,7 @@ type CancelSellReq struct {
 func (x *CancelSellReq) Reset() {
 	*x = CancelSellReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[13]
+		mi := &file_proto_auction_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1030,7 +1494,7 @@ func (x *CancelSellReq) String() string {
 func (*CancelSellReq) ProtoMessage() {}
 
 func (x *CancelSellReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[13]
+	mi := &file_proto_auction_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1043,7 +1507,7 @@ func (x *CancelSellReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelSellReq.ProtoReflect.Descriptor instead.
 func (*CancelSellReq) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{13}
+	return file_proto_auction_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CancelSellReq) GetOrderId() string {
@@ -1072,7 +1536,7 @@ type CancelSellData struct {
 func (x *CancelSellData) Reset() {
 	*x = CancelSellData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[14]
+		mi := &file_proto_auction_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1085,7 +1549,7 @@ func (x *CancelSellData) String() string {
 func (*CancelSellData) ProtoMessage() {}
 
 func (x *CancelSellData) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[14]
+	mi := &file_proto_auction_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1098,7 +1562,7 @@ func (x *CancelSellData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelSellData.ProtoReflect.Descriptor instead.
 func (*CancelSellData) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{14}
+	return file_proto_auction_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CancelSellData) GetSuccess() bool {
@@ -1121,7 +1585,7 @@ type CancelSellRsp struct {
 func (x *CancelSellRsp) Reset() {
 	*x = CancelSellRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[15]
+		mi := &file_proto_auction_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1134,7 +1598,7 @@ func (x *CancelSellRsp) String() string {
 func (*CancelSellRsp) ProtoMessage() {}
 
 func (x *CancelSellRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[15]
+	mi := &file_proto_auction_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,7 +1611,7 @@ func (x *CancelSellRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelSellRsp.ProtoReflect.Descriptor instead.
 func (*CancelSellRsp) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{15}
+	return file_proto_auction_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CancelSellRsp) GetCode() common.ErrorCode {
@@ -1184,7 +1648,7 @@ type CancelBuyReq struct {
 func (x *CancelBuyReq) Reset() {
 	*x = CancelBuyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[16]
+		mi := &file_proto_auction_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1197,7 +1661,7 @@ func (x *CancelBuyReq) String() string {
 func (*CancelBuyReq) ProtoMessage() {}
 
 func (x *CancelBuyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[16]
+	mi := &file_proto_auction_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,7 +1674,7 @@ func (x *CancelBuyReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelBuyReq.ProtoReflect.Descriptor instead.
 func (*CancelBuyReq) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{16}
+	return file_proto_auction_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CancelBuyReq) GetOrderId() string {
@@ -1239,7 +1703,7 @@ type CancelBuyData struct {
 func (x *CancelBuyData) Reset() {
 	*x = CancelBuyData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[17]
+		mi := &file_proto_auction_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1252,7 +1716,7 @@ func (x *CancelBuyData) String() string {
 func (*CancelBuyData) ProtoMessage() {}
 
 func (x *CancelBuyData) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[17]
+	mi := &file_proto_auction_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1265,7 +1729,7 @@ func (x *CancelBuyData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelBuyData.ProtoReflect.Descriptor instead.
 func (*CancelBuyData) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{17}
+	return file_proto_auction_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CancelBuyData) GetSuccess() bool {
@@ -1288,7 +1752,7 @@ type CancelBuyRsp struct {
 func (x *CancelBuyRsp) Reset() {
 	*x = CancelBuyRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[18]
+		mi := &file_proto_auction_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1301,7 +1765,7 @@ func (x *CancelBuyRsp) String() string {
 func (*CancelBuyRsp) ProtoMessage() {}
 
 func (x *CancelBuyRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[18]
+	mi := &file_proto_auction_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1314,7 +1778,7 @@ func (x *CancelBuyRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelBuyRsp.ProtoReflect.Descriptor instead.
 func (*CancelBuyRsp) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{18}
+	return file_proto_auction_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CancelBuyRsp) GetCode() common.ErrorCode {
@@ -1338,6 +1802,277 @@ func (x *CancelBuyRsp) GetData() *CancelBuyData {
 	return nil
 }
 
+// 修改出售协议 - 修改挂单中的出售价格或剩余数量
+// 只减少数量时保留排队顺序，修改价格时重新排队并可能立即成交
+type AmendSellReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId      string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                // 订单ID
+	Price        int64  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`                                  // 新价格，0表示不修改
+	Quantity     int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                            // 新的剩余数量（只能减少），0表示不修改
+	IdempotentId string `protobuf:"bytes,4,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"` // 幂等ID，用于防止重复修改
+}
+
+func (x *AmendSellReq) Reset() {
+	*x = AmendSellReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmendSellReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendSellReq) ProtoMessage() {}
+
+func (x *AmendSellReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendSellReq.ProtoReflect.Descriptor instead.
+func (*AmendSellReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AmendSellReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AmendSellReq) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AmendSellReq) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AmendSellReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+type AmendSellRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data *SellData        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                        // 修改后的订单信息
+}
+
+func (x *AmendSellRsp) Reset() {
+	*x = AmendSellRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmendSellRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendSellRsp) ProtoMessage() {}
+
+func (x *AmendSellRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendSellRsp.ProtoReflect.Descriptor instead.
+func (*AmendSellRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AmendSellRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *AmendSellRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *AmendSellRsp) GetData() *SellData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 修改求购协议 - 修改挂单中的求购价格或剩余数量，托管货币按新报价多退少补
+type AmendBuyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId      string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`                // 订单ID
+	Price        int64  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`                                  // 新价格，0表示不修改
+	Quantity     int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                            // 新的剩余数量（只能减少），0表示不修改
+	IdempotentId string `protobuf:"bytes,4,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"` // 幂等ID，用于防止重复修改
+}
+
+func (x *AmendBuyReq) Reset() {
+	*x = AmendBuyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmendBuyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendBuyReq) ProtoMessage() {}
+
+func (x *AmendBuyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendBuyReq.ProtoReflect.Descriptor instead.
+func (*AmendBuyReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AmendBuyReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AmendBuyReq) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AmendBuyReq) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AmendBuyReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+type AmendBuyRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data *BuyData         `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                        // 修改后的订单信息
+}
+
+func (x *AmendBuyRsp) Reset() {
+	*x = AmendBuyRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmendBuyRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendBuyRsp) ProtoMessage() {}
+
+func (x *AmendBuyRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendBuyRsp.ProtoReflect.Descriptor instead.
+func (*AmendBuyRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AmendBuyRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *AmendBuyRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *AmendBuyRsp) GetData() *BuyData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 // 查看自己所有出售道具协议
 type GetMySellsReq struct {
 	state         protoimpl.MessageState
@@ -1348,7 +2083,7 @@ type GetMySellsReq struct {
 func (x *GetMySellsReq) Reset() {
 	*x = GetMySellsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[19]
+		mi := &file_proto_auction_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1361,7 +2096,7 @@ func (x *GetMySellsReq) String() string {
 func (*GetMySellsReq) ProtoMessage() {}
 
 func (x *GetMySellsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[19]
+	mi := &file_proto_auction_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1374,7 +2109,7 @@ func (x *GetMySellsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMySellsReq.ProtoReflect.Descriptor instead.
 func (*GetMySellsReq) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{19}
+	return file_proto_auction_proto_rawDescGZIP(), []int{24}
 }
 
 // 查看出售道具响应数据
@@ -1391,7 +2126,7 @@ type GetMySellsRsp struct {
 func (x *GetMySellsRsp) Reset() {
 	*x = GetMySellsRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[20]
+		mi := &file_proto_auction_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1404,7 +2139,7 @@ func (x *GetMySellsRsp) String() string {
 func (*GetMySellsRsp) ProtoMessage() {}
 
 func (x *GetMySellsRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[20]
+	mi := &file_proto_auction_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1417,7 +2152,7 @@ func (x *GetMySellsRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMySellsRsp.ProtoReflect.Descriptor instead.
 func (*GetMySellsRsp) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{20}
+	return file_proto_auction_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetMySellsRsp) GetCode() common.ErrorCode {
@@ -1451,7 +2186,7 @@ type GetMyBuysReq struct {
 func (x *GetMyBuysReq) Reset() {
 	*x = GetMyBuysReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[21]
+		mi := &file_proto_auction_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1464,7 +2199,7 @@ func (x *GetMyBuysReq) String() string {
 func (*GetMyBuysReq) ProtoMessage() {}
 
 func (x *GetMyBuysReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[21]
+	mi := &file_proto_auction_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +2212,7 @@ func (x *GetMyBuysReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMyBuysReq.ProtoReflect.Descriptor instead.
 func (*GetMyBuysReq) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{21}
+	return file_proto_auction_proto_rawDescGZIP(), []int{26}
 }
 
 // 查看求购道具响应数据
@@ -1494,7 +2229,7 @@ type GetMyBuysRsp struct {
 func (x *GetMyBuysRsp) Reset() {
 	*x = GetMyBuysRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[22]
+		mi := &file_proto_auction_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1507,7 +2242,7 @@ func (x *GetMyBuysRsp) String() string {
 func (*GetMyBuysRsp) ProtoMessage() {}
 
 func (x *GetMyBuysRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[22]
+	mi := &file_proto_auction_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1520,7 +2255,7 @@ func (x *GetMyBuysRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMyBuysRsp.ProtoReflect.Descriptor instead.
 func (*GetMyBuysRsp) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{22}
+	return file_proto_auction_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetMyBuysRsp) GetCode() common.ErrorCode {
@@ -1556,7 +2291,7 @@ type GetItemAuctionInfoReq struct {
 func (x *GetItemAuctionInfoReq) Reset() {
 	*x = GetItemAuctionInfoReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[23]
+		mi := &file_proto_auction_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1569,7 +2304,7 @@ func (x *GetItemAuctionInfoReq) String() string {
 func (*GetItemAuctionInfoReq) ProtoMessage() {}
 
 func (x *GetItemAuctionInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[23]
+	mi := &file_proto_auction_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1582,7 +2317,7 @@ func (x *GetItemAuctionInfoReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetItemAuctionInfoReq.ProtoReflect.Descriptor instead.
 func (*GetItemAuctionInfoReq) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{23}
+	return file_proto_auction_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetItemAuctionInfoReq) GetItemIds() []string {
@@ -1606,7 +2341,7 @@ type ItemAuctionInfo struct {
 func (x *ItemAuctionInfo) Reset() {
 	*x = ItemAuctionInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[24]
+		mi := &file_proto_auction_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1619,7 +2354,7 @@ func (x *ItemAuctionInfo) String() string {
 func (*ItemAuctionInfo) ProtoMessage() {}
 
 func (x *ItemAuctionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[24]
+	mi := &file_proto_auction_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1632,7 +2367,7 @@ func (x *ItemAuctionInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ItemAuctionInfo.ProtoReflect.Descriptor instead.
 func (*ItemAuctionInfo) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{24}
+	return file_proto_auction_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ItemAuctionInfo) GetItemId() string {
@@ -1670,7 +2405,7 @@ type GetItemAuctionInfoRsp struct {
 func (x *GetItemAuctionInfoRsp) Reset() {
 	*x = GetItemAuctionInfoRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[25]
+		mi := &file_proto_auction_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1683,7 +2418,7 @@ func (x *GetItemAuctionInfoRsp) String() string {
 func (*GetItemAuctionInfoRsp) ProtoMessage() {}
 
 func (x *GetItemAuctionInfoRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[25]
+	mi := &file_proto_auction_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1696,7 +2431,7 @@ func (x *GetItemAuctionInfoRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetItemAuctionInfoRsp.ProtoReflect.Descriptor instead.
 func (*GetItemAuctionInfoRsp) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{25}
+	return file_proto_auction_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetItemAuctionInfoRsp) GetCode() common.ErrorCode {
@@ -1720,6 +2455,286 @@ func (x *GetItemAuctionInfoRsp) GetData() []*ItemAuctionInfo {
 	return nil
 }
 
+// 市场中有挂单的道具概况
+type MarketItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId      string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                 // 道具ID
+	Category    string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`                           // 道具分类（交易规则配置中的分类，未配置时为空）
+	BestAsk     int64  `protobuf:"varint,3,opt,name=best_ask,json=bestAsk,proto3" json:"best_ask,omitempty"`             // 最低卖价，0表示没有卖单
+	AskQuantity int32  `protobuf:"varint,4,opt,name=ask_quantity,json=askQuantity,proto3" json:"ask_quantity,omitempty"` // 最低卖价上的数量
+	BestBid     int64  `protobuf:"varint,5,opt,name=best_bid,json=bestBid,proto3" json:"best_bid,omitempty"`             // 最高买价，0表示没有买单
+	BidQuantity int32  `protobuf:"varint,6,opt,name=bid_quantity,json=bidQuantity,proto3" json:"bid_quantity,omitempty"` // 最高买价上的数量
+	LastPrice   int64  `protobuf:"varint,7,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`       // 最近成交价，0表示24小时内无成交
+	Volume_24H  int64  `protobuf:"varint,8,opt,name=volume_24h,json=volume24h,proto3" json:"volume_24h,omitempty"`       // 24小时成交量
+	PriceChange int32  `protobuf:"varint,9,opt,name=price_change,json=priceChange,proto3" json:"price_change,omitempty"` // 24小时涨跌幅（万分比）
+	UpdateTime  int64  `protobuf:"varint,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`   // 更新时间
+}
+
+func (x *MarketItem) Reset() {
+	*x = MarketItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketItem) ProtoMessage() {}
+
+func (x *MarketItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketItem.ProtoReflect.Descriptor instead.
+func (*MarketItem) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MarketItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *MarketItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *MarketItem) GetBestAsk() int64 {
+	if x != nil {
+		return x.BestAsk
+	}
+	return 0
+}
+
+func (x *MarketItem) GetAskQuantity() int32 {
+	if x != nil {
+		return x.AskQuantity
+	}
+	return 0
+}
+
+func (x *MarketItem) GetBestBid() int64 {
+	if x != nil {
+		return x.BestBid
+	}
+	return 0
+}
+
+func (x *MarketItem) GetBidQuantity() int32 {
+	if x != nil {
+		return x.BidQuantity
+	}
+	return 0
+}
+
+func (x *MarketItem) GetLastPrice() int64 {
+	if x != nil {
+		return x.LastPrice
+	}
+	return 0
+}
+
+func (x *MarketItem) GetVolume_24H() int64 {
+	if x != nil {
+		return x.Volume_24H
+	}
+	return 0
+}
+
+func (x *MarketItem) GetPriceChange() int32 {
+	if x != nil {
+		return x.PriceChange
+	}
+	return 0
+}
+
+func (x *MarketItem) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+// 搜索市场请求（浏览所有有挂单的道具）
+type SearchMarketReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category string       `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`                                      // 道具分类，为空表示全部
+	SortBy   MarketSortBy `protobuf:"varint,2,opt,name=sort_by,json=sortBy,proto3,enum=auction.MarketSortBy" json:"sort_by,omitempty"` // 排序字段
+	Desc     bool         `protobuf:"varint,3,opt,name=desc,proto3" json:"desc,omitempty"`                                             // 是否降序，默认升序
+	Cursor   string       `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                                          // 分页游标，首次查询传空
+	Limit    int32        `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                           // 每页数量，0表示默认20，最大100
+}
+
+func (x *SearchMarketReq) Reset() {
+	*x = SearchMarketReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMarketReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMarketReq) ProtoMessage() {}
+
+func (x *SearchMarketReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMarketReq.ProtoReflect.Descriptor instead.
+func (*SearchMarketReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SearchMarketReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchMarketReq) GetSortBy() MarketSortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return MarketSortBy_MARKET_SORT_BEST_ASK
+}
+
+func (x *SearchMarketReq) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *SearchMarketReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchMarketReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 搜索市场响应
+type SearchMarketRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"`        // 错误码
+	Msg        string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                                 // 错误信息
+	Data       []*MarketItem    `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`                               // 道具列表
+	NextCursor string           `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标
+	HasMore    bool             `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`         // 是否还有更多
+}
+
+func (x *SearchMarketRsp) Reset() {
+	*x = SearchMarketRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMarketRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMarketRsp) ProtoMessage() {}
+
+func (x *SearchMarketRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMarketRsp.ProtoReflect.Descriptor instead.
+func (*SearchMarketRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SearchMarketRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *SearchMarketRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SearchMarketRsp) GetData() []*MarketItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SearchMarketRsp) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *SearchMarketRsp) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 // 获取交易历史请求
 type GetTransactionHistoryReq struct {
 	state         protoimpl.MessageState
@@ -1732,7 +2747,7 @@ type GetTransactionHistoryReq struct {
 func (x *GetTransactionHistoryReq) Reset() {
 	*x = GetTransactionHistoryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[26]
+		mi := &file_proto_auction_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1745,7 +2760,7 @@ func (x *GetTransactionHistoryReq) String() string {
 func (*GetTransactionHistoryReq) ProtoMessage() {}
 
 func (x *GetTransactionHistoryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[26]
+	mi := &file_proto_auction_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1758,7 +2773,7 @@ func (x *GetTransactionHistoryReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionHistoryReq.ProtoReflect.Descriptor instead.
 func (*GetTransactionHistoryReq) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{26}
+	return file_proto_auction_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetTransactionHistoryReq) GetOrderId() string {
@@ -1782,7 +2797,7 @@ type GetTransactionHistoryRsp struct {
 func (x *GetTransactionHistoryRsp) Reset() {
 	*x = GetTransactionHistoryRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[27]
+		mi := &file_proto_auction_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1795,7 +2810,7 @@ func (x *GetTransactionHistoryRsp) String() string {
 func (*GetTransactionHistoryRsp) ProtoMessage() {}
 
 func (x *GetTransactionHistoryRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[27]
+	mi := &file_proto_auction_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,7 +2823,7 @@ func (x *GetTransactionHistoryRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionHistoryRsp.ProtoReflect.Descriptor instead.
 func (*GetTransactionHistoryRsp) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{27}
+	return file_proto_auction_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetTransactionHistoryRsp) GetCode() common.ErrorCode {
@@ -1847,7 +2862,7 @@ type GetTransactionsByTimeReq struct {
 func (x *GetTransactionsByTimeReq) Reset() {
 	*x = GetTransactionsByTimeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[28]
+		mi := &file_proto_auction_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1860,7 +2875,7 @@ func (x *GetTransactionsByTimeReq) String() string {
 func (*GetTransactionsByTimeReq) ProtoMessage() {}
 
 func (x *GetTransactionsByTimeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[28]
+	mi := &file_proto_auction_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1873,7 +2888,7 @@ func (x *GetTransactionsByTimeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionsByTimeReq.ProtoReflect.Descriptor instead.
 func (*GetTransactionsByTimeReq) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{28}
+	return file_proto_auction_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetTransactionsByTimeReq) GetStartTime() int64 {
@@ -1918,7 +2933,7 @@ type GetTransactionsByTimeRsp struct {
 func (x *GetTransactionsByTimeRsp) Reset() {
 	*x = GetTransactionsByTimeRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_auction_proto_msgTypes[29]
+		mi := &file_proto_auction_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1931,7 +2946,7 @@ func (x *GetTransactionsByTimeRsp) String() string {
 func (*GetTransactionsByTimeRsp) ProtoMessage() {}
 
 func (x *GetTransactionsByTimeRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auction_proto_msgTypes[29]
+	mi := &file_proto_auction_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1944,7 +2959,7 @@ func (x *GetTransactionsByTimeRsp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionsByTimeRsp.ProtoReflect.Descriptor instead.
 func (*GetTransactionsByTimeRsp) Descriptor() ([]byte, []int) {
-	return file_proto_auction_proto_rawDescGZIP(), []int{29}
+	return file_proto_auction_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetTransactionsByTimeRsp) GetCode() common.ErrorCode {
@@ -1968,319 +2983,4495 @@ func (x *GetTransactionsByTimeRsp) GetData() *TransactionsByTimeData {
 	return nil
 }
 
-var File_proto_auction_proto protoreflect.FileDescriptor
+// 对账单中单个道具的统计
+type StatementItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proto_auction_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x19,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x23, 0x0a,
-	0x07, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x42, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x56, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xa5,
-	0x02, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x62,
-	0x75, 0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x62, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a,
-	0x0d, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74,
-	0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
-	0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x78,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74, 0x61, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x85, 0x03, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a,
-	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74, 0x61,
-	0x78, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a,
-	0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25,
-	0x0a, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4e,
-	0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x99,
-	0x01, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
-	0x79, 0x54, 0x69, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x07, 0x53,
-	0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23,
-	0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69,
-	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74,
-	0x65, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x22, 0x69, 0x0a, 0x07, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x73, 0x70, 0x12,
-	0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0x78, 0x0a, 0x06, 0x42, 0x75, 0x79, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65,
-	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d,
-	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65,
-	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x07, 0x42, 0x75,
-	0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x67, 0x0a, 0x06,
-	0x42, 0x75, 0x79, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
-	0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4f, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53,
-	0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
-	0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2a, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x53, 0x65, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x22, 0x75, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x6c,
-	0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2b, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x6c, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0c, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x42, 0x75, 0x79, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65,
-	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x0d, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x42, 0x75, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x22, 0x73, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x75,
-	0x79, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2a, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x75, 0x79, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x4d, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x22, 0x6f, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x4d, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6d, 0x73, 0x67, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x6c,
-	0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x0e, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x4d, 0x79, 0x42, 0x75, 0x79, 0x73, 0x52, 0x65, 0x71, 0x22, 0x6d, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x4d, 0x79, 0x42, 0x75, 0x79, 0x73, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x79,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x32, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x22, 0x7c,
-	0x0a, 0x0f, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x65,
-	0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x73,
-	0x65, 0x6c, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x62, 0x75, 0x79, 0x73, 0x22, 0x7e, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2c,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x35, 0x0a, 0x18,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x73, 0x70,
-	0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x85,
-	0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e,
-	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e,
-	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65,
-	0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x33, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x42, 0x22, 0x5a, 0x20, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x2f, 0x6b, 0x69, 0x74, 0x65, 0x78, 0x5f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	ItemId       string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                      // 道具ID
+	BuyQuantity  int64  `protobuf:"varint,2,opt,name=buy_quantity,json=buyQuantity,proto3" json:"buy_quantity,omitempty"`      // 买入数量
+	BuyAmount    int64  `protobuf:"varint,3,opt,name=buy_amount,json=buyAmount,proto3" json:"buy_amount,omitempty"`            // 买入金额（不含手续费）
+	AvgBuyPrice  int64  `protobuf:"varint,4,opt,name=avg_buy_price,json=avgBuyPrice,proto3" json:"avg_buy_price,omitempty"`    // 平均买入价
+	SellQuantity int64  `protobuf:"varint,5,opt,name=sell_quantity,json=sellQuantity,proto3" json:"sell_quantity,omitempty"`   // 卖出数量
+	SellAmount   int64  `protobuf:"varint,6,opt,name=sell_amount,json=sellAmount,proto3" json:"sell_amount,omitempty"`         // 卖出金额（不含手续费）
+	AvgSellPrice int64  `protobuf:"varint,7,opt,name=avg_sell_price,json=avgSellPrice,proto3" json:"avg_sell_price,omitempty"` // 平均卖出价
+	Fee          int64  `protobuf:"varint,8,opt,name=fee,proto3" json:"fee,omitempty"`                                         // 支付的手续费（买卖双方合计）
+	RealizedPnl  int64  `protobuf:"varint,9,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`      // 已实现盈亏：卖出净收入减去卖出数量的平均买入成本（含买入手续费）
 }
 
-var (
-	file_proto_auction_proto_rawDescOnce sync.Once
-	file_proto_auction_proto_rawDescData = file_proto_auction_proto_rawDesc
-)
+func (x *StatementItem) Reset() {
+	*x = StatementItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_auction_proto_rawDescGZIP() []byte {
-	file_proto_auction_proto_rawDescOnce.Do(func() {
-		file_proto_auction_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_auction_proto_rawDescData)
-	})
-	return file_proto_auction_proto_rawDescData
+func (x *StatementItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_auction_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
-var file_proto_auction_proto_goTypes = []interface{}{
-	(*PingReq)(nil),                  // 0: auction.PingReq
-	(*PingRsp)(nil),                  // 1: auction.PingRsp
-	(*OrderInfo)(nil),                // 2: auction.OrderInfo
-	(*TransactionRecord)(nil),        // 3: auction.TransactionRecord
-	(*TimeTransactionRecord)(nil),    // 4: auction.TimeTransactionRecord
-	(*TransactionHistoryData)(nil),   // 5: auction.TransactionHistoryData
-	(*TransactionsByTimeData)(nil),   // 6: auction.TransactionsByTimeData
-	(*SellReq)(nil),                  // 7: auction.SellReq
-	(*SellData)(nil),                 // 8: auction.SellData
-	(*SellRsp)(nil),                  // 9: auction.SellRsp
-	(*BuyReq)(nil),                   // 10: auction.BuyReq
-	(*BuyData)(nil),                  // 11: auction.BuyData
-	(*BuyRsp)(nil),                   // 12: auction.BuyRsp
-	(*CancelSellReq)(nil),            // 13: auction.CancelSellReq
-	(*CancelSellData)(nil),           // 14: auction.CancelSellData
-	(*CancelSellRsp)(nil),            // 15: auction.CancelSellRsp
-	(*CancelBuyReq)(nil),             // 16: auction.CancelBuyReq
-	(*CancelBuyData)(nil),            // 17: auction.CancelBuyData
-	(*CancelBuyRsp)(nil),             // 18: auction.CancelBuyRsp
-	(*GetMySellsReq)(nil),            // 19: auction.GetMySellsReq
-	(*GetMySellsRsp)(nil),            // 20: auction.GetMySellsRsp
-	(*GetMyBuysReq)(nil),             // 21: auction.GetMyBuysReq
-	(*GetMyBuysRsp)(nil),             // 22: auction.GetMyBuysRsp
-	(*GetItemAuctionInfoReq)(nil),    // 23: auction.GetItemAuctionInfoReq
-	(*ItemAuctionInfo)(nil),          // 24: auction.ItemAuctionInfo
-	(*GetItemAuctionInfoRsp)(nil),    // 25: auction.GetItemAuctionInfoRsp
-	(*GetTransactionHistoryReq)(nil), // 26: auction.GetTransactionHistoryReq
-	(*GetTransactionHistoryRsp)(nil), // 27: auction.GetTransactionHistoryRsp
-	(*GetTransactionsByTimeReq)(nil), // 28: auction.GetTransactionsByTimeReq
-	(*GetTransactionsByTimeRsp)(nil), // 29: auction.GetTransactionsByTimeRsp
-	(common.ErrorCode)(0),            // 30: common.ErrorCode
+func (*StatementItem) ProtoMessage() {}
+
+func (x *StatementItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_auction_proto_depIdxs = []int32{
-	30, // 0: auction.PingRsp.code:type_name -> common.ErrorCode
-	3,  // 1: auction.TransactionHistoryData.records:type_name -> auction.TransactionRecord
-	4,  // 2: auction.TransactionsByTimeData.records:type_name -> auction.TimeTransactionRecord
-	30, // 3: auction.SellRsp.code:type_name -> common.ErrorCode
-	8,  // 4: auction.SellRsp.data:type_name -> auction.SellData
-	30, // 5: auction.BuyRsp.code:type_name -> common.ErrorCode
-	11, // 6: auction.BuyRsp.data:type_name -> auction.BuyData
-	30, // 7: auction.CancelSellRsp.code:type_name -> common.ErrorCode
-	14, // 8: auction.CancelSellRsp.data:type_name -> auction.CancelSellData
-	30, // 9: auction.CancelBuyRsp.code:type_name -> common.ErrorCode
-	17, // 10: auction.CancelBuyRsp.data:type_name -> auction.CancelBuyData
-	30, // 11: auction.GetMySellsRsp.code:type_name -> common.ErrorCode
-	8,  // 12: auction.GetMySellsRsp.data:type_name -> auction.SellData
-	30, // 13: auction.GetMyBuysRsp.code:type_name -> common.ErrorCode
-	11, // 14: auction.GetMyBuysRsp.data:type_name -> auction.BuyData
-	2,  // 15: auction.ItemAuctionInfo.sells:type_name -> auction.OrderInfo
-	2,  // 16: auction.ItemAuctionInfo.buys:type_name -> auction.OrderInfo
-	30, // 17: auction.GetItemAuctionInfoRsp.code:type_name -> common.ErrorCode
-	24, // 18: auction.GetItemAuctionInfoRsp.data:type_name -> auction.ItemAuctionInfo
-	30, // 19: auction.GetTransactionHistoryRsp.code:type_name -> common.ErrorCode
-	5,  // 20: auction.GetTransactionHistoryRsp.data:type_name -> auction.TransactionHistoryData
-	30, // 21: auction.GetTransactionsByTimeRsp.code:type_name -> common.ErrorCode
-	6,  // 22: auction.GetTransactionsByTimeRsp.data:type_name -> auction.TransactionsByTimeData
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+
+// Deprecated: Use StatementItem.ProtoReflect.Descriptor instead.
+func (*StatementItem) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{38}
 }
 
-func init() { file_proto_auction_proto_init() }
-func file_proto_auction_proto_init() {
-	if File_proto_auction_proto != nil {
-		return
+func (x *StatementItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_auction_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingReq); i {
+	return ""
+}
+
+func (x *StatementItem) GetBuyQuantity() int64 {
+	if x != nil {
+		return x.BuyQuantity
+	}
+	return 0
+}
+
+func (x *StatementItem) GetBuyAmount() int64 {
+	if x != nil {
+		return x.BuyAmount
+	}
+	return 0
+}
+
+func (x *StatementItem) GetAvgBuyPrice() int64 {
+	if x != nil {
+		return x.AvgBuyPrice
+	}
+	return 0
+}
+
+func (x *StatementItem) GetSellQuantity() int64 {
+	if x != nil {
+		return x.SellQuantity
+	}
+	return 0
+}
+
+func (x *StatementItem) GetSellAmount() int64 {
+	if x != nil {
+		return x.SellAmount
+	}
+	return 0
+}
+
+func (x *StatementItem) GetAvgSellPrice() int64 {
+	if x != nil {
+		return x.AvgSellPrice
+	}
+	return 0
+}
+
+func (x *StatementItem) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *StatementItem) GetRealizedPnl() int64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+// 个人拍卖对账单（只统计订单簿成交，按自然日汇总）
+type AuctionStatement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime        int64            `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`                        // 统计开始时间（所在自然日的0点）
+	EndTime          int64            `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                              // 统计结束时间
+	Items            []*StatementItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`                                                  // 各道具统计（按道具ID排序）
+	TotalBuyAmount   int64            `protobuf:"varint,4,opt,name=total_buy_amount,json=totalBuyAmount,proto3" json:"total_buy_amount,omitempty"`       // 买入总金额
+	TotalSellAmount  int64            `protobuf:"varint,5,opt,name=total_sell_amount,json=totalSellAmount,proto3" json:"total_sell_amount,omitempty"`    // 卖出总金额
+	TotalFee         int64            `protobuf:"varint,6,opt,name=total_fee,json=totalFee,proto3" json:"total_fee,omitempty"`                           // 手续费总额
+	TotalRealizedPnl int64            `protobuf:"varint,7,opt,name=total_realized_pnl,json=totalRealizedPnl,proto3" json:"total_realized_pnl,omitempty"` // 已实现盈亏总额
+}
+
+func (x *AuctionStatement) Reset() {
+	*x = AuctionStatement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionStatement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionStatement) ProtoMessage() {}
+
+func (x *AuctionStatement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionStatement.ProtoReflect.Descriptor instead.
+func (*AuctionStatement) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AuctionStatement) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *AuctionStatement) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *AuctionStatement) GetItems() []*StatementItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AuctionStatement) GetTotalBuyAmount() int64 {
+	if x != nil {
+		return x.TotalBuyAmount
+	}
+	return 0
+}
+
+func (x *AuctionStatement) GetTotalSellAmount() int64 {
+	if x != nil {
+		return x.TotalSellAmount
+	}
+	return 0
+}
+
+func (x *AuctionStatement) GetTotalFee() int64 {
+	if x != nil {
+		return x.TotalFee
+	}
+	return 0
+}
+
+func (x *AuctionStatement) GetTotalRealizedPnl() int64 {
+	if x != nil {
+		return x.TotalRealizedPnl
+	}
+	return 0
+}
+
+// 获取个人对账单请求
+type GetAuctionStatementReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 开始时间戳（秒），0表示结束时间前30天
+	EndTime   int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // 结束时间戳（秒），0表示当前时间
+}
+
+func (x *GetAuctionStatementReq) Reset() {
+	*x = GetAuctionStatementReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuctionStatementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuctionStatementReq) ProtoMessage() {}
+
+func (x *GetAuctionStatementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuctionStatementReq.ProtoReflect.Descriptor instead.
+func (*GetAuctionStatementReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetAuctionStatementReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetAuctionStatementReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// 获取个人对账单响应
+type GetAuctionStatementRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode  `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string            `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data *AuctionStatement `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                        // 对账单
+}
+
+func (x *GetAuctionStatementRsp) Reset() {
+	*x = GetAuctionStatementRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuctionStatementRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuctionStatementRsp) ProtoMessage() {}
+
+func (x *GetAuctionStatementRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuctionStatementRsp.ProtoReflect.Descriptor instead.
+func (*GetAuctionStatementRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetAuctionStatementRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *GetAuctionStatementRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetAuctionStatementRsp) GetData() *AuctionStatement {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 导出个人对账单请求
+type ExportAuctionStatementReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime int64  `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // 开始时间戳（秒），0表示结束时间前30天
+	EndTime   int64  `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // 结束时间戳（秒），0表示当前时间
+	Format    string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                         // 导出格式：csv或json，默认csv
+}
+
+func (x *ExportAuctionStatementReq) Reset() {
+	*x = ExportAuctionStatementReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAuctionStatementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuctionStatementReq) ProtoMessage() {}
+
+func (x *ExportAuctionStatementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuctionStatementReq.ProtoReflect.Descriptor instead.
+func (*ExportAuctionStatementReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ExportAuctionStatementReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ExportAuctionStatementReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ExportAuctionStatementReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// 导出个人对账单响应
+type ExportAuctionStatementRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code        common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"`           // 错误码
+	Msg         string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                                    // 错误信息
+	FileName    string           `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`          // 文件名
+	ContentType string           `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // 文件类型
+	Content     string           `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`                            // 文件内容
+}
+
+func (x *ExportAuctionStatementRsp) Reset() {
+	*x = ExportAuctionStatementRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAuctionStatementRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuctionStatementRsp) ProtoMessage() {}
+
+func (x *ExportAuctionStatementRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuctionStatementRsp.ProtoReflect.Descriptor instead.
+func (*ExportAuctionStatementRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExportAuctionStatementRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ExportAuctionStatementRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ExportAuctionStatementRsp) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportAuctionStatementRsp) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportAuctionStatementRsp) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// K线数据（OHLCV）
+type Kline struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenTime int64 `protobuf:"varint,1,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"` // 周期开始时间戳（秒）
+	Open     int64 `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`                         // 开盘价
+	High     int64 `protobuf:"varint,3,opt,name=high,proto3" json:"high,omitempty"`                         // 最高价
+	Low      int64 `protobuf:"varint,4,opt,name=low,proto3" json:"low,omitempty"`                           // 最低价
+	Close    int64 `protobuf:"varint,5,opt,name=close,proto3" json:"close,omitempty"`                       // 收盘价
+	Volume   int64 `protobuf:"varint,6,opt,name=volume,proto3" json:"volume,omitempty"`                     // 成交量
+	Turnover int64 `protobuf:"varint,7,opt,name=turnover,proto3" json:"turnover,omitempty"`                 // 成交额
+}
+
+func (x *Kline) Reset() {
+	*x = Kline{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Kline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Kline) ProtoMessage() {}
+
+func (x *Kline) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Kline.ProtoReflect.Descriptor instead.
+func (*Kline) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Kline) GetOpenTime() int64 {
+	if x != nil {
+		return x.OpenTime
+	}
+	return 0
+}
+
+func (x *Kline) GetOpen() int64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *Kline) GetHigh() int64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *Kline) GetLow() int64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *Kline) GetClose() int64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *Kline) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Kline) GetTurnover() int64 {
+	if x != nil {
+		return x.Turnover
+	}
+	return 0
+}
+
+// 获取道具K线请求
+type GetItemKlineReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId    string        `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                   // 道具ID
+	Interval  KlineInterval `protobuf:"varint,2,opt,name=interval,proto3,enum=auction.KlineInterval" json:"interval,omitempty"` // K线周期
+	StartTime int64         `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`         // 开始时间戳（秒），0表示按limit向前取
+	EndTime   int64         `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`               // 结束时间戳（秒），0表示当前时间
+	Limit     int32         `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                  // 最多返回条数，0表示默认值
+}
+
+func (x *GetItemKlineReq) Reset() {
+	*x = GetItemKlineReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemKlineReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemKlineReq) ProtoMessage() {}
+
+func (x *GetItemKlineReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemKlineReq.ProtoReflect.Descriptor instead.
+func (*GetItemKlineReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetItemKlineReq) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *GetItemKlineReq) GetInterval() KlineInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return KlineInterval_KLINE_1M
+}
+
+func (x *GetItemKlineReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetItemKlineReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *GetItemKlineReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// 获取道具K线响应
+type GetItemKlineRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data []*Kline         `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`                        // K线列表（按时间升序）
+}
+
+func (x *GetItemKlineRsp) Reset() {
+	*x = GetItemKlineRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemKlineRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemKlineRsp) ProtoMessage() {}
+
+func (x *GetItemKlineRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemKlineRsp.ProtoReflect.Descriptor instead.
+func (*GetItemKlineRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetItemKlineRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *GetItemKlineRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetItemKlineRsp) GetData() []*Kline {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 订阅道具行情请求（订阅后推送盘口变化和成交，需定期续订）
+type SubscribeItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // 道具ID
+}
+
+func (x *SubscribeItemReq) Reset() {
+	*x = SubscribeItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeItemReq) ProtoMessage() {}
+
+func (x *SubscribeItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeItemReq.ProtoReflect.Descriptor instead.
+func (*SubscribeItemReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SubscribeItemReq) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+// 订阅道具行情响应
+type SubscribeItemRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"`         // 错误码
+	Msg        string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                                  // 错误信息
+	Data       *ItemAuctionInfo `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                                // 当前买5卖5快照
+	Seq        int64            `protobuf:"varint,4,opt,name=seq,proto3" json:"seq,omitempty"`                                 // 快照对应的行情序号，推送从seq+1开始
+	ExpireTime int64            `protobuf:"varint,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"` // 订阅过期时间戳（秒），过期前需再次订阅续期
+}
+
+func (x *SubscribeItemRsp) Reset() {
+	*x = SubscribeItemRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeItemRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeItemRsp) ProtoMessage() {}
+
+func (x *SubscribeItemRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeItemRsp.ProtoReflect.Descriptor instead.
+func (*SubscribeItemRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SubscribeItemRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *SubscribeItemRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SubscribeItemRsp) GetData() *ItemAuctionInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SubscribeItemRsp) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *SubscribeItemRsp) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+// 取消订阅道具行情请求
+type UnsubscribeItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // 道具ID
+}
+
+func (x *UnsubscribeItemReq) Reset() {
+	*x = UnsubscribeItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubscribeItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeItemReq) ProtoMessage() {}
+
+func (x *UnsubscribeItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeItemReq.ProtoReflect.Descriptor instead.
+func (*UnsubscribeItemReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UnsubscribeItemReq) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+// 取消订阅道具行情响应
+type UnsubscribeItemRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+}
+
+func (x *UnsubscribeItemRsp) Reset() {
+	*x = UnsubscribeItemRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubscribeItemRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeItemRsp) ProtoMessage() {}
+
+func (x *UnsubscribeItemRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeItemRsp.ProtoReflect.Descriptor instead.
+func (*UnsubscribeItemRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UnsubscribeItemRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *UnsubscribeItemRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// 行情成交
+type MarketTrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price     int64 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`                          // 成交价格
+	Quantity  int32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                    // 成交数量
+	TradeTime int64 `protobuf:"varint,3,opt,name=trade_time,json=tradeTime,proto3" json:"trade_time,omitempty"` // 成交时间戳（秒）
+}
+
+func (x *MarketTrade) Reset() {
+	*x = MarketTrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketTrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketTrade) ProtoMessage() {}
+
+func (x *MarketTrade) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketTrade.ProtoReflect.Descriptor instead.
+func (*MarketTrade) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MarketTrade) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *MarketTrade) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *MarketTrade) GetTradeTime() int64 {
+	if x != nil {
+		return x.TradeTime
+	}
+	return 0
+}
+
+// 道具行情推送（增量）
+// 序号不连续时客户端应重新订阅获取快照
+type AuctionMarketNtf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId string         `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // 道具ID
+	Seq    int64          `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`                    // 行情序号
+	Sells  []*OrderInfo   `protobuf:"bytes,3,rep,name=sells,proto3" json:"sells,omitempty"`                 // 变化的卖盘价位（数量为0表示该价位移出买5卖5）
+	Buys   []*OrderInfo   `protobuf:"bytes,4,rep,name=buys,proto3" json:"buys,omitempty"`                   // 变化的买盘价位（数量为0表示该价位移出买5卖5）
+	Trades []*MarketTrade `protobuf:"bytes,5,rep,name=trades,proto3" json:"trades,omitempty"`               // 本次推送周期内的成交
+}
+
+func (x *AuctionMarketNtf) Reset() {
+	*x = AuctionMarketNtf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionMarketNtf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionMarketNtf) ProtoMessage() {}
+
+func (x *AuctionMarketNtf) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionMarketNtf.ProtoReflect.Descriptor instead.
+func (*AuctionMarketNtf) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AuctionMarketNtf) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *AuctionMarketNtf) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *AuctionMarketNtf) GetSells() []*OrderInfo {
+	if x != nil {
+		return x.Sells
+	}
+	return nil
+}
+
+func (x *AuctionMarketNtf) GetBuys() []*OrderInfo {
+	if x != nil {
+		return x.Buys
+	}
+	return nil
+}
+
+func (x *AuctionMarketNtf) GetTrades() []*MarketTrade {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+// 唯一道具挂单信息（引用具体的道具实例及其属性）
+type UniqueListing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListingId    string              `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`                                       // 挂单ID
+	SellerId     string              `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`                                          // 卖家ID
+	ItemId       string              `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                                                // 道具ID
+	ItemUniqueId string              `protobuf:"bytes,4,opt,name=item_unique_id,json=itemUniqueId,proto3" json:"item_unique_id,omitempty"`                            // 道具唯一id
+	ItemType     int32               `protobuf:"varint,5,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`                                         // 道具类型
+	Properties   string              `protobuf:"bytes,6,opt,name=properties,proto3" json:"properties,omitempty"`                                                      // 道具属性（json字符串）
+	ListingType  UniqueListingType   `protobuf:"varint,7,opt,name=listing_type,json=listingType,proto3,enum=auction.UniqueListingType" json:"listing_type,omitempty"` // 挂单类型
+	Price        int64               `protobuf:"varint,8,opt,name=price,proto3" json:"price,omitempty"`                                                               // 一口价或起拍价
+	BestOffer    int64               `protobuf:"varint,9,opt,name=best_offer,json=bestOffer,proto3" json:"best_offer,omitempty"`                                      // 当前最高出价，0表示暂无出价
+	CreateTime   int64               `protobuf:"varint,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                                  // 创建时间
+	ExpireTime   int64               `protobuf:"varint,11,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`                                  // 过期时间
+	Status       UniqueListingStatus `protobuf:"varint,12,opt,name=status,proto3,enum=auction.UniqueListingStatus" json:"status,omitempty"`                           // 挂单状态
+	BuyerId      string              `protobuf:"bytes,13,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`                                            // 成交买家ID
+	FinalPrice   int64               `protobuf:"varint,14,opt,name=final_price,json=finalPrice,proto3" json:"final_price,omitempty"`                                  // 成交价格
+}
+
+func (x *UniqueListing) Reset() {
+	*x = UniqueListing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UniqueListing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniqueListing) ProtoMessage() {}
+
+func (x *UniqueListing) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniqueListing.ProtoReflect.Descriptor instead.
+func (*UniqueListing) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UniqueListing) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *UniqueListing) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *UniqueListing) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *UniqueListing) GetItemUniqueId() string {
+	if x != nil {
+		return x.ItemUniqueId
+	}
+	return ""
+}
+
+func (x *UniqueListing) GetItemType() int32 {
+	if x != nil {
+		return x.ItemType
+	}
+	return 0
+}
+
+func (x *UniqueListing) GetProperties() string {
+	if x != nil {
+		return x.Properties
+	}
+	return ""
+}
+
+func (x *UniqueListing) GetListingType() UniqueListingType {
+	if x != nil {
+		return x.ListingType
+	}
+	return UniqueListingType_UNIQUE_FIXED_PRICE
+}
+
+func (x *UniqueListing) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UniqueListing) GetBestOffer() int64 {
+	if x != nil {
+		return x.BestOffer
+	}
+	return 0
+}
+
+func (x *UniqueListing) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *UniqueListing) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *UniqueListing) GetStatus() UniqueListingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UniqueListingStatus_UNIQUE_ACTIVE
+}
+
+func (x *UniqueListing) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *UniqueListing) GetFinalPrice() int64 {
+	if x != nil {
+		return x.FinalPrice
+	}
+	return 0
+}
+
+// 唯一道具挂单协议 - 按道具唯一id挂出一个具体实例
+type ListUniqueItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemUniqueId string            `protobuf:"bytes,1,opt,name=item_unique_id,json=itemUniqueId,proto3" json:"item_unique_id,omitempty"`                            // 道具唯一id
+	ListingType  UniqueListingType `protobuf:"varint,2,opt,name=listing_type,json=listingType,proto3,enum=auction.UniqueListingType" json:"listing_type,omitempty"` // 挂单类型
+	Price        int64             `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`                                                               // 一口价或起拍价
+	ExpireTime   int64             `protobuf:"varint,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`                                   // 过期时间戳（秒），0表示使用服务端默认有效期
+	IdempotentId string            `protobuf:"bytes,5,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"`                              // 幂等ID，用于防止重复请求
+}
+
+func (x *ListUniqueItemReq) Reset() {
+	*x = ListUniqueItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueItemReq) ProtoMessage() {}
+
+func (x *ListUniqueItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueItemReq.ProtoReflect.Descriptor instead.
+func (*ListUniqueItemReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListUniqueItemReq) GetItemUniqueId() string {
+	if x != nil {
+		return x.ItemUniqueId
+	}
+	return ""
+}
+
+func (x *ListUniqueItemReq) GetListingType() UniqueListingType {
+	if x != nil {
+		return x.ListingType
+	}
+	return UniqueListingType_UNIQUE_FIXED_PRICE
+}
+
+func (x *ListUniqueItemReq) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ListUniqueItemReq) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *ListUniqueItemReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+type ListUniqueItemRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data *UniqueListing   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                        // 挂单信息
+}
+
+func (x *ListUniqueItemRsp) Reset() {
+	*x = ListUniqueItemRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUniqueItemRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUniqueItemRsp) ProtoMessage() {}
+
+func (x *ListUniqueItemRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUniqueItemRsp.ProtoReflect.Descriptor instead.
+func (*ListUniqueItemRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListUniqueItemRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ListUniqueItemRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListUniqueItemRsp) GetData() *UniqueListing {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 取消唯一道具挂单协议 - 道具实例退回卖家，当前出价退还出价者
+type CancelUniqueListingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListingId    string `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`          // 挂单ID
+	IdempotentId string `protobuf:"bytes,2,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"` // 幂等ID
+}
+
+func (x *CancelUniqueListingReq) Reset() {
+	*x = CancelUniqueListingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelUniqueListingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelUniqueListingReq) ProtoMessage() {}
+
+func (x *CancelUniqueListingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelUniqueListingReq.ProtoReflect.Descriptor instead.
+func (*CancelUniqueListingReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CancelUniqueListingReq) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *CancelUniqueListingReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+type CancelUniqueListingRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data *UniqueListing   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                        // 挂单信息
+}
+
+func (x *CancelUniqueListingRsp) Reset() {
+	*x = CancelUniqueListingRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelUniqueListingRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelUniqueListingRsp) ProtoMessage() {}
+
+func (x *CancelUniqueListingRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelUniqueListingRsp.ProtoReflect.Descriptor instead.
+func (*CancelUniqueListingRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CancelUniqueListingRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *CancelUniqueListingRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CancelUniqueListingRsp) GetData() *UniqueListing {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 购买一口价唯一道具协议
+type BuyUniqueItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListingId    string `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`          // 挂单ID
+	Price        int64  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`                                  // 客户端看到的标价，与当前标价不一致时拒绝
+	IdempotentId string `protobuf:"bytes,3,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"` // 幂等ID
+}
+
+func (x *BuyUniqueItemReq) Reset() {
+	*x = BuyUniqueItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyUniqueItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyUniqueItemReq) ProtoMessage() {}
+
+func (x *BuyUniqueItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyUniqueItemReq.ProtoReflect.Descriptor instead.
+func (*BuyUniqueItemReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BuyUniqueItemReq) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *BuyUniqueItemReq) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *BuyUniqueItemReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+type BuyUniqueItemRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data *UniqueListing   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                        // 挂单信息
+}
+
+func (x *BuyUniqueItemRsp) Reset() {
+	*x = BuyUniqueItemRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyUniqueItemRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyUniqueItemRsp) ProtoMessage() {}
+
+func (x *BuyUniqueItemRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyUniqueItemRsp.ProtoReflect.Descriptor instead.
+func (*BuyUniqueItemRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *BuyUniqueItemRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *BuyUniqueItemRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *BuyUniqueItemRsp) GetData() *UniqueListing {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 竞价唯一道具出价协议 - 出价被托管，被更高出价超过时退还
+type OfferUniqueItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListingId    string `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`          // 挂单ID
+	Price        int64  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`                                  // 出价
+	IdempotentId string `protobuf:"bytes,3,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"` // 幂等ID
+}
+
+func (x *OfferUniqueItemReq) Reset() {
+	*x = OfferUniqueItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferUniqueItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferUniqueItemReq) ProtoMessage() {}
+
+func (x *OfferUniqueItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferUniqueItemReq.ProtoReflect.Descriptor instead.
+func (*OfferUniqueItemReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *OfferUniqueItemReq) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *OfferUniqueItemReq) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OfferUniqueItemReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+type OfferUniqueItemRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data *UniqueListing   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                        // 挂单信息
+}
+
+func (x *OfferUniqueItemRsp) Reset() {
+	*x = OfferUniqueItemRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferUniqueItemRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferUniqueItemRsp) ProtoMessage() {}
+
+func (x *OfferUniqueItemRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferUniqueItemRsp.ProtoReflect.Descriptor instead.
+func (*OfferUniqueItemRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *OfferUniqueItemRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *OfferUniqueItemRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *OfferUniqueItemRsp) GetData() *UniqueListing {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 卖家接受当前最高出价协议
+type AcceptUniqueOfferReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListingId    string `protobuf:"bytes,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`          // 挂单ID
+	IdempotentId string `protobuf:"bytes,2,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"` // 幂等ID
+}
+
+func (x *AcceptUniqueOfferReq) Reset() {
+	*x = AcceptUniqueOfferReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptUniqueOfferReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptUniqueOfferReq) ProtoMessage() {}
+
+func (x *AcceptUniqueOfferReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptUniqueOfferReq.ProtoReflect.Descriptor instead.
+func (*AcceptUniqueOfferReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AcceptUniqueOfferReq) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *AcceptUniqueOfferReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+type AcceptUniqueOfferRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data *UniqueListing   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                        // 挂单信息
+}
+
+func (x *AcceptUniqueOfferRsp) Reset() {
+	*x = AcceptUniqueOfferRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptUniqueOfferRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptUniqueOfferRsp) ProtoMessage() {}
+
+func (x *AcceptUniqueOfferRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptUniqueOfferRsp.ProtoReflect.Descriptor instead.
+func (*AcceptUniqueOfferRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AcceptUniqueOfferRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *AcceptUniqueOfferRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *AcceptUniqueOfferRsp) GetData() *UniqueListing {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 搜索唯一道具挂单协议
+type SearchUniqueListingsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId          string              `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                                                                                                                    // 道具ID
+	PropertyFilters map[string]string   `protobuf:"bytes,2,rep,name=property_filters,json=propertyFilters,proto3" json:"property_filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 属性过滤，按道具属性json顶层字段精确匹配
+	MinPrice        int64               `protobuf:"varint,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`                                                                                                             // 最低价格，0表示不限制
+	MaxPrice        int64               `protobuf:"varint,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`                                                                                                             // 最高价格，0表示不限制
+	ListingTypes    []UniqueListingType `protobuf:"varint,5,rep,packed,name=listing_types,json=listingTypes,proto3,enum=auction.UniqueListingType" json:"listing_types,omitempty"`                                                           // 挂单类型，为空表示全部
+	PriceDesc       bool                `protobuf:"varint,6,opt,name=price_desc,json=priceDesc,proto3" json:"price_desc,omitempty"`                                                                                                          // 是否按价格从高到低排序，默认从低到高
+	Cursor          int32               `protobuf:"varint,7,opt,name=cursor,proto3" json:"cursor,omitempty"`                                                                                                                                 // 分页游标，首次查询传0
+	Limit           int32               `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`                                                                                                                                   // 每页数量，0表示默认20，最大100
+}
+
+func (x *SearchUniqueListingsReq) Reset() {
+	*x = SearchUniqueListingsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUniqueListingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUniqueListingsReq) ProtoMessage() {}
+
+func (x *SearchUniqueListingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUniqueListingsReq.ProtoReflect.Descriptor instead.
+func (*SearchUniqueListingsReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SearchUniqueListingsReq) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *SearchUniqueListingsReq) GetPropertyFilters() map[string]string {
+	if x != nil {
+		return x.PropertyFilters
+	}
+	return nil
+}
+
+func (x *SearchUniqueListingsReq) GetMinPrice() int64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *SearchUniqueListingsReq) GetMaxPrice() int64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *SearchUniqueListingsReq) GetListingTypes() []UniqueListingType {
+	if x != nil {
+		return x.ListingTypes
+	}
+	return nil
+}
+
+func (x *SearchUniqueListingsReq) GetPriceDesc() bool {
+	if x != nil {
+		return x.PriceDesc
+	}
+	return false
+}
+
+func (x *SearchUniqueListingsReq) GetCursor() int32 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *SearchUniqueListingsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchUniqueListingsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"`         // 错误码
+	Msg        string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                                  // 错误信息
+	Data       []*UniqueListing `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`                                // 挂单列表
+	NextCursor int32            `protobuf:"varint,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标
+	HasMore    bool             `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`          // 是否还有更多
+}
+
+func (x *SearchUniqueListingsRsp) Reset() {
+	*x = SearchUniqueListingsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUniqueListingsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUniqueListingsRsp) ProtoMessage() {}
+
+func (x *SearchUniqueListingsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUniqueListingsRsp.ProtoReflect.Descriptor instead.
+func (*SearchUniqueListingsRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SearchUniqueListingsRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *SearchUniqueListingsRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SearchUniqueListingsRsp) GetData() []*UniqueListing {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SearchUniqueListingsRsp) GetNextCursor() int32 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *SearchUniqueListingsRsp) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// 查看自己的唯一道具挂单协议
+type GetMyUniqueListingsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMyUniqueListingsReq) Reset() {
+	*x = GetMyUniqueListingsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMyUniqueListingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyUniqueListingsReq) ProtoMessage() {}
+
+func (x *GetMyUniqueListingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyUniqueListingsReq.ProtoReflect.Descriptor instead.
+func (*GetMyUniqueListingsReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{66}
+}
+
+type GetMyUniqueListingsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data []*UniqueListing `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`                        // 挂单中的唯一道具列表
+}
+
+func (x *GetMyUniqueListingsRsp) Reset() {
+	*x = GetMyUniqueListingsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMyUniqueListingsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyUniqueListingsRsp) ProtoMessage() {}
+
+func (x *GetMyUniqueListingsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyUniqueListingsRsp.ProtoReflect.Descriptor instead.
+func (*GetMyUniqueListingsRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetMyUniqueListingsRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *GetMyUniqueListingsRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetMyUniqueListingsRsp) GetData() []*UniqueListing {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 唯一道具挂单事件推送
+type AuctionUniqueListingNtf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listing *UniqueListing `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"` // 挂单信息
+	Event   string         `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`     // 事件：sold 卖出，won 竞得，outbid 出价被超过，cancelled 挂单取消（出价已退还），expired 流拍
+}
+
+func (x *AuctionUniqueListingNtf) Reset() {
+	*x = AuctionUniqueListingNtf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionUniqueListingNtf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionUniqueListingNtf) ProtoMessage() {}
+
+func (x *AuctionUniqueListingNtf) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionUniqueListingNtf.ProtoReflect.Descriptor instead.
+func (*AuctionUniqueListingNtf) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AuctionUniqueListingNtf) GetListing() *UniqueListing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+func (x *AuctionUniqueListingNtf) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+// 限时拍卖（英式拍卖）信息，价格均为整批道具的总价
+type TimedAuction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuctionId     string             `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`              // 拍卖ID
+	SellerId      string             `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`                 // 卖家ID
+	ItemId        string             `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                       // 道具ID
+	Quantity      int32              `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`                                // 道具数量
+	ItemUniqueId  string             `protobuf:"bytes,5,opt,name=item_unique_id,json=itemUniqueId,proto3" json:"item_unique_id,omitempty"`   // 道具唯一id，拍卖唯一道具实例时有效
+	Properties    string             `protobuf:"bytes,6,opt,name=properties,proto3" json:"properties,omitempty"`                             // 道具属性（json字符串），拍卖唯一道具实例时有效
+	StartPrice    int64              `protobuf:"varint,7,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`          // 起拍价
+	MinIncrement  int64              `protobuf:"varint,8,opt,name=min_increment,json=minIncrement,proto3" json:"min_increment,omitempty"`    // 最小加价幅度
+	BuyoutPrice   int64              `protobuf:"varint,9,opt,name=buyout_price,json=buyoutPrice,proto3" json:"buyout_price,omitempty"`       // 一口价，0表示不支持
+	CreateTime    int64              `protobuf:"varint,10,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`         // 创建时间
+	EndTime       int64              `protobuf:"varint,11,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                  // 结束时间（可能因临近结束的出价而延长）
+	Status        TimedAuctionStatus `protobuf:"varint,12,opt,name=status,proto3,enum=auction.TimedAuctionStatus" json:"status,omitempty"`   // 拍卖状态
+	HighestBidder string             `protobuf:"bytes,13,opt,name=highest_bidder,json=highestBidder,proto3" json:"highest_bidder,omitempty"` // 当前最高出价者
+	HighestBid    int64              `protobuf:"varint,14,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid,omitempty"`         // 当前最高出价，0表示暂无出价
+	BidCount      int32              `protobuf:"varint,15,opt,name=bid_count,json=bidCount,proto3" json:"bid_count,omitempty"`               // 出价次数
+	WinnerId      string             `protobuf:"bytes,16,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`                // 成交买家ID
+	FinalPrice    int64              `protobuf:"varint,17,opt,name=final_price,json=finalPrice,proto3" json:"final_price,omitempty"`         // 成交价格
+}
+
+func (x *TimedAuction) Reset() {
+	*x = TimedAuction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimedAuction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimedAuction) ProtoMessage() {}
+
+func (x *TimedAuction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimedAuction.ProtoReflect.Descriptor instead.
+func (*TimedAuction) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *TimedAuction) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *TimedAuction) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *TimedAuction) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *TimedAuction) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TimedAuction) GetItemUniqueId() string {
+	if x != nil {
+		return x.ItemUniqueId
+	}
+	return ""
+}
+
+func (x *TimedAuction) GetProperties() string {
+	if x != nil {
+		return x.Properties
+	}
+	return ""
+}
+
+func (x *TimedAuction) GetStartPrice() int64 {
+	if x != nil {
+		return x.StartPrice
+	}
+	return 0
+}
+
+func (x *TimedAuction) GetMinIncrement() int64 {
+	if x != nil {
+		return x.MinIncrement
+	}
+	return 0
+}
+
+func (x *TimedAuction) GetBuyoutPrice() int64 {
+	if x != nil {
+		return x.BuyoutPrice
+	}
+	return 0
+}
+
+func (x *TimedAuction) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *TimedAuction) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *TimedAuction) GetStatus() TimedAuctionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TimedAuctionStatus_TIMED_ACTIVE
+}
+
+func (x *TimedAuction) GetHighestBidder() string {
+	if x != nil {
+		return x.HighestBidder
+	}
+	return ""
+}
+
+func (x *TimedAuction) GetHighestBid() int64 {
+	if x != nil {
+		return x.HighestBid
+	}
+	return 0
+}
+
+func (x *TimedAuction) GetBidCount() int32 {
+	if x != nil {
+		return x.BidCount
+	}
+	return 0
+}
+
+func (x *TimedAuction) GetWinnerId() string {
+	if x != nil {
+		return x.WinnerId
+	}
+	return ""
+}
+
+func (x *TimedAuction) GetFinalPrice() int64 {
+	if x != nil {
+		return x.FinalPrice
+	}
+	return 0
+}
+
+// 创建限时拍卖协议 - 托管道具（或指定的唯一道具实例）并开始竞拍
+type CreateTimedAuctionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId       string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`                     // 道具ID，拍卖唯一道具实例时可不填
+	Quantity     int32  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                              // 道具数量，拍卖唯一道具实例时可不填
+	ItemUniqueId string `protobuf:"bytes,3,opt,name=item_unique_id,json=itemUniqueId,proto3" json:"item_unique_id,omitempty"` // 道具唯一id，填写时拍卖该唯一道具实例
+	StartPrice   int64  `protobuf:"varint,4,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`        // 起拍价
+	MinIncrement int64  `protobuf:"varint,5,opt,name=min_increment,json=minIncrement,proto3" json:"min_increment,omitempty"`  // 最小加价幅度
+	BuyoutPrice  int64  `protobuf:"varint,6,opt,name=buyout_price,json=buyoutPrice,proto3" json:"buyout_price,omitempty"`     // 一口价，0表示不支持，必须高于起拍价
+	EndTime      int64  `protobuf:"varint,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                 // 结束时间戳（秒）
+	IdempotentId string `protobuf:"bytes,8,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"`   // 幂等ID
+}
+
+func (x *CreateTimedAuctionReq) Reset() {
+	*x = CreateTimedAuctionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTimedAuctionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimedAuctionReq) ProtoMessage() {}
+
+func (x *CreateTimedAuctionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTimedAuctionReq.ProtoReflect.Descriptor instead.
+func (*CreateTimedAuctionReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateTimedAuctionReq) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *CreateTimedAuctionReq) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateTimedAuctionReq) GetItemUniqueId() string {
+	if x != nil {
+		return x.ItemUniqueId
+	}
+	return ""
+}
+
+func (x *CreateTimedAuctionReq) GetStartPrice() int64 {
+	if x != nil {
+		return x.StartPrice
+	}
+	return 0
+}
+
+func (x *CreateTimedAuctionReq) GetMinIncrement() int64 {
+	if x != nil {
+		return x.MinIncrement
+	}
+	return 0
+}
+
+func (x *CreateTimedAuctionReq) GetBuyoutPrice() int64 {
+	if x != nil {
+		return x.BuyoutPrice
+	}
+	return 0
+}
+
+func (x *CreateTimedAuctionReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *CreateTimedAuctionReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+type CreateTimedAuctionRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data *TimedAuction    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                        // 拍卖信息
+}
+
+func (x *CreateTimedAuctionRsp) Reset() {
+	*x = CreateTimedAuctionRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTimedAuctionRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimedAuctionRsp) ProtoMessage() {}
+
+func (x *CreateTimedAuctionRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTimedAuctionRsp.ProtoReflect.Descriptor instead.
+func (*CreateTimedAuctionRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateTimedAuctionRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *CreateTimedAuctionRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CreateTimedAuctionRsp) GetData() *TimedAuction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 限时拍卖出价协议 - 出价被托管，被超过时退还；出价达到一口价时立即成交
+type BidTimedAuctionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuctionId    string `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`          // 拍卖ID
+	Price        int64  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`                                  // 出价
+	IdempotentId string `protobuf:"bytes,3,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"` // 幂等ID
+}
+
+func (x *BidTimedAuctionReq) Reset() {
+	*x = BidTimedAuctionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BidTimedAuctionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BidTimedAuctionReq) ProtoMessage() {}
+
+func (x *BidTimedAuctionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BidTimedAuctionReq.ProtoReflect.Descriptor instead.
+func (*BidTimedAuctionReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BidTimedAuctionReq) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *BidTimedAuctionReq) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *BidTimedAuctionReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+type BidTimedAuctionRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data *TimedAuction    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                        // 拍卖信息
+}
+
+func (x *BidTimedAuctionRsp) Reset() {
+	*x = BidTimedAuctionRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BidTimedAuctionRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BidTimedAuctionRsp) ProtoMessage() {}
+
+func (x *BidTimedAuctionRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BidTimedAuctionRsp.ProtoReflect.Descriptor instead.
+func (*BidTimedAuctionRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BidTimedAuctionRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *BidTimedAuctionRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *BidTimedAuctionRsp) GetData() *TimedAuction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 取消限时拍卖协议 - 只能在无人出价时取消，道具退回卖家
+type CancelTimedAuctionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuctionId    string `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`          // 拍卖ID
+	IdempotentId string `protobuf:"bytes,2,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"` // 幂等ID
+}
+
+func (x *CancelTimedAuctionReq) Reset() {
+	*x = CancelTimedAuctionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelTimedAuctionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTimedAuctionReq) ProtoMessage() {}
+
+func (x *CancelTimedAuctionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTimedAuctionReq.ProtoReflect.Descriptor instead.
+func (*CancelTimedAuctionReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CancelTimedAuctionReq) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+func (x *CancelTimedAuctionReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+type CancelTimedAuctionRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data *TimedAuction    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                        // 拍卖信息
+}
+
+func (x *CancelTimedAuctionRsp) Reset() {
+	*x = CancelTimedAuctionRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelTimedAuctionRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTimedAuctionRsp) ProtoMessage() {}
+
+func (x *CancelTimedAuctionRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTimedAuctionRsp.ProtoReflect.Descriptor instead.
+func (*CancelTimedAuctionRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CancelTimedAuctionRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *CancelTimedAuctionRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CancelTimedAuctionRsp) GetData() *TimedAuction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 查询单个限时拍卖协议
+type GetTimedAuctionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuctionId string `protobuf:"bytes,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"` // 拍卖ID
+}
+
+func (x *GetTimedAuctionReq) Reset() {
+	*x = GetTimedAuctionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimedAuctionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimedAuctionReq) ProtoMessage() {}
+
+func (x *GetTimedAuctionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimedAuctionReq.ProtoReflect.Descriptor instead.
+func (*GetTimedAuctionReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetTimedAuctionReq) GetAuctionId() string {
+	if x != nil {
+		return x.AuctionId
+	}
+	return ""
+}
+
+type GetTimedAuctionRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"` // 错误码
+	Msg  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                          // 错误信息
+	Data *TimedAuction    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                        // 拍卖信息
+}
+
+func (x *GetTimedAuctionRsp) Reset() {
+	*x = GetTimedAuctionRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimedAuctionRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimedAuctionRsp) ProtoMessage() {}
+
+func (x *GetTimedAuctionRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimedAuctionRsp.ProtoReflect.Descriptor instead.
+func (*GetTimedAuctionRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetTimedAuctionRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *GetTimedAuctionRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetTimedAuctionRsp) GetData() *TimedAuction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 按道具查询竞拍中的限时拍卖协议，按结束时间从近到远排序
+type GetTimedAuctionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // 道具ID
+	Cursor int32  `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`              // 分页游标，首次查询传0
+	Limit  int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                // 每页数量，0表示默认20，最大100
+}
+
+func (x *GetTimedAuctionsReq) Reset() {
+	*x = GetTimedAuctionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimedAuctionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimedAuctionsReq) ProtoMessage() {}
+
+func (x *GetTimedAuctionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimedAuctionsReq.ProtoReflect.Descriptor instead.
+func (*GetTimedAuctionsReq) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetTimedAuctionsReq) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *GetTimedAuctionsReq) GetCursor() int32 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *GetTimedAuctionsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTimedAuctionsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"`         // 错误码
+	Msg        string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`                                  // 错误信息
+	Data       []*TimedAuction  `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`                                // 拍卖列表
+	NextCursor int32            `protobuf:"varint,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标
+	HasMore    bool             `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`          // 是否还有更多
+}
+
+func (x *GetTimedAuctionsRsp) Reset() {
+	*x = GetTimedAuctionsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimedAuctionsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimedAuctionsRsp) ProtoMessage() {}
+
+func (x *GetTimedAuctionsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimedAuctionsRsp.ProtoReflect.Descriptor instead.
+func (*GetTimedAuctionsRsp) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetTimedAuctionsRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *GetTimedAuctionsRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetTimedAuctionsRsp) GetData() []*TimedAuction {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetTimedAuctionsRsp) GetNextCursor() int32 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetTimedAuctionsRsp) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// 限时拍卖事件推送
+type AuctionTimedNtf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auction *TimedAuction `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction,omitempty"` // 拍卖信息
+	Event   string        `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`     // 事件：outbid 出价被超过，won 竞得，sold 卖出，unsold 流拍
+}
+
+func (x *AuctionTimedNtf) Reset() {
+	*x = AuctionTimedNtf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_auction_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionTimedNtf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionTimedNtf) ProtoMessage() {}
+
+func (x *AuctionTimedNtf) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auction_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionTimedNtf.ProtoReflect.Descriptor instead.
+func (*AuctionTimedNtf) Descriptor() ([]byte, []int) {
+	return file_proto_auction_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AuctionTimedNtf) GetAuction() *TimedAuction {
+	if x != nil {
+		return x.Auction
+	}
+	return nil
+}
+
+func (x *AuctionTimedNtf) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+var File_proto_auction_proto protoreflect.FileDescriptor
+
+var file_proto_auction_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x19,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x23, 0x0a,
+	0x07, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x42, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x56, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xa5,
+	0x02, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x62,
+	0x75, 0x79, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x0d, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x78,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74, 0x61, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x85, 0x03, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x74, 0x61,
+	0x78, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4e,
+	0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x99,
+	0x01, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xea, 0x01, 0x0a, 0x07, 0x53,
+	0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x82, 0x02, 0x0a, 0x08, 0x53, 0x65, 0x6c, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x69, 0x0a, 0x07,
+	0x53, 0x65, 0x6c, 0x6c, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xcc, 0x01, 0x0a, 0x06, 0x42, 0x75, 0x79, 0x52,
+	0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x07, 0x42, 0x75, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x67, 0x0a,
+	0x06, 0x42, 0x75, 0x79, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc8, 0x01, 0x0a, 0x16, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x4e, 0x74,
+	0x66, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
+	0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x4f, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x2a, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x75,
+	0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x73, 0x70, 0x12,
+	0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42,
+	0x75, 0x79, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42,
+	0x75, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x22, 0x73, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x75, 0x79, 0x52, 0x73, 0x70,
+	0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x75, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x53,
+	0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x6e, 0x0a, 0x0c, 0x41, 0x6d, 0x65, 0x6e,
+	0x64, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x7f, 0x0a, 0x0b, 0x41, 0x6d, 0x65, 0x6e,
+	0x64, 0x42, 0x75, 0x79, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x0b, 0x41, 0x6d, 0x65,
+	0x6e, 0x64, 0x42, 0x75, 0x79, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x79, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x79,
+	0x53, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x22, 0x6f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d,
+	0x79, 0x53, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x4d, 0x79, 0x42, 0x75, 0x79, 0x73, 0x52, 0x65, 0x71, 0x22, 0x6d, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x4d, 0x79, 0x42, 0x75, 0x79, 0x73, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x32, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x22, 0x7c, 0x0a, 0x0f,
+	0x49, 0x74, 0x65, 0x6d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x73, 0x65, 0x6c,
+	0x6c, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x62, 0x75, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x62, 0x75, 0x79, 0x73, 0x22, 0x7e, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2c, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbf, 0x02, 0x0a, 0x0a, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x62, 0x65, 0x73, 0x74, 0x41, 0x73, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x6b,
+	0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x62, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x64, 0x5f, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62,
+	0x69, 0x64, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x5f, 0x32, 0x34, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x32, 0x34, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9f, 0x01, 0x0a,
+	0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x07,
+	0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x6f,
+	0x72, 0x74, 0x42, 0x79, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x65, 0x73, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xaf,
+	0x01, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
+	0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x27, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65,
+	0x22, 0x35, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x33, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x85, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xaf, 0x02, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x79, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x75, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x75, 0x79, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x76, 0x67, 0x5f, 0x62, 0x75, 0x79, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x76, 0x67, 0x42, 0x75,
+	0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73,
+	0x65, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x65, 0x6c, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e,
+	0x61, 0x76, 0x67, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x76, 0x67, 0x53, 0x65, 0x6c, 0x6c, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x66, 0x65, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x22, 0x9b, 0x02, 0x0a, 0x10, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x75,
+	0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x65, 0x6c, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x50, 0x6e, 0x6c, 0x22, 0x52, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2d, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6d, 0x0a, 0x19,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x19,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xa8, 0x01, 0x0a,
+	0x05, 0x4b, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6c,
+	0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74,
+	0x75, 0x72, 0x6e, 0x6f, 0x76, 0x65, 0x72, 0x22, 0xae, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x4b, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4b, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x4b, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x22, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4b, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2b, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
+	0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0xac, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x73, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x22, 0x5e, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x10, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x4e, 0x74, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73,
+	0x65, 0x71, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x73, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x04,
+	0x62, 0x75, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x62, 0x75, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x22, 0xef, 0x03, 0x0a, 0x0d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0c,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x65, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x79, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64,
+	0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70,
+	0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5c, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x7d, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x6c, 0x0a, 0x10, 0x42, 0x75, 0x79, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x77, 0x0a, 0x10, 0x42, 0x75, 0x79, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2a, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6e, 0x0a, 0x12, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x12, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x12,
+	0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x5a, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x7b, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa0, 0x03,
+	0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x12, 0x60, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3f,
+	0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x42, 0x0a, 0x14,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xba, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x18, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x22, 0x7d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x79,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x73,
+	0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x61, 0x0a, 0x17, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4e, 0x74,
+	0x66, 0x12, 0x30, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xc2, 0x04, 0x0a, 0x0c, 0x54, 0x69,
+	0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x49, 0x6e,
+	0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x79, 0x6f, 0x75,
+	0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
+	0x75, 0x79, 0x6f, 0x75, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x68,
+	0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x69, 0x64, 0x64,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x69,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74,
+	0x42, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x69, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x9b,
+	0x02, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a,
+	0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e,
+	0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x79,
+	0x6f, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x75, 0x79, 0x6f, 0x75, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x29,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6e, 0x0a, 0x12, 0x42, 0x69, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x12, 0x42, 0x69, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12,
+	0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x5b, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x22, 0x7b, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x64,
+	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x33, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x78, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5c, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x29, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d,
+	0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f,
+	0x72, 0x65, 0x22, 0x58, 0x0a, 0x0f, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x64, 0x4e, 0x74, 0x66, 0x12, 0x2f, 0x0a, 0x07, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a, 0x34, 0x0a, 0x09,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49, 0x4d,
+	0x49, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x01,
+	0x12, 0x07, 0x0a, 0x03, 0x49, 0x4f, 0x43, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4f, 0x4b,
+	0x10, 0x03, 0x2a, 0x7c, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x6f, 0x72, 0x74,
+	0x42, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x4f, 0x52,
+	0x54, 0x5f, 0x42, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x53, 0x4b, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14,
+	0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x45, 0x53, 0x54,
+	0x5f, 0x42, 0x49, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54,
+	0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x32, 0x34, 0x48,
+	0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x53, 0x4f, 0x52,
+	0x54, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x03,
+	0x2a, 0x47, 0x0a, 0x0d, 0x4b, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x0c, 0x0a, 0x08, 0x4b, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x31, 0x4d, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x4b, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x35, 0x4d, 0x10, 0x01, 0x12, 0x0c, 0x0a,
+	0x08, 0x4b, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x31, 0x48, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4b,
+	0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x31, 0x44, 0x10, 0x03, 0x2a, 0x3d, 0x0a, 0x11, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x12, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f, 0x50,
+	0x52, 0x49, 0x43, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45,
+	0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x10, 0x01, 0x2a, 0x63, 0x0a, 0x13, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
+	0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x53, 0x4f, 0x4c,
+	0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x5f, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x49,
+	0x51, 0x55, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x5d, 0x0a,
+	0x12, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f, 0x53,
+	0x4f, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f, 0x55,
+	0x4e, 0x53, 0x4f, 0x4c, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49, 0x4d, 0x45, 0x44,
+	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0x22, 0x5a, 0x20,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x6b,
+	0x69, 0x74, 0x65, 0x78, 0x5f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_auction_proto_rawDescOnce sync.Once
+	file_proto_auction_proto_rawDescData = file_proto_auction_proto_rawDesc
+)
+
+func file_proto_auction_proto_rawDescGZIP() []byte {
+	file_proto_auction_proto_rawDescOnce.Do(func() {
+		file_proto_auction_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_auction_proto_rawDescData)
+	})
+	return file_proto_auction_proto_rawDescData
+}
+
+var file_proto_auction_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_proto_auction_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
+var file_proto_auction_proto_goTypes = []interface{}{
+	(OrderType)(0),                    // 0: auction.OrderType
+	(MarketSortBy)(0),                 // 1: auction.MarketSortBy
+	(KlineInterval)(0),                // 2: auction.KlineInterval
+	(UniqueListingType)(0),            // 3: auction.UniqueListingType
+	(UniqueListingStatus)(0),          // 4: auction.UniqueListingStatus
+	(TimedAuctionStatus)(0),           // 5: auction.TimedAuctionStatus
+	(*PingReq)(nil),                   // 6: auction.PingReq
+	(*PingRsp)(nil),                   // 7: auction.PingRsp
+	(*OrderInfo)(nil),                 // 8: auction.OrderInfo
+	(*TransactionRecord)(nil),         // 9: auction.TransactionRecord
+	(*TimeTransactionRecord)(nil),     // 10: auction.TimeTransactionRecord
+	(*TransactionHistoryData)(nil),    // 11: auction.TransactionHistoryData
+	(*TransactionsByTimeData)(nil),    // 12: auction.TransactionsByTimeData
+	(*SellReq)(nil),                   // 13: auction.SellReq
+	(*SellData)(nil),                  // 14: auction.SellData
+	(*SellRsp)(nil),                   // 15: auction.SellRsp
+	(*BuyReq)(nil),                    // 16: auction.BuyReq
+	(*BuyData)(nil),                   // 17: auction.BuyData
+	(*BuyRsp)(nil),                    // 18: auction.BuyRsp
+	(*AuctionOrderExpiredNtf)(nil),    // 19: auction.AuctionOrderExpiredNtf
+	(*CancelSellReq)(nil),             // 20: auction.CancelSellReq
+	(*CancelSellData)(nil),            // 21: auction.CancelSellData
+	(*CancelSellRsp)(nil),             // 22: auction.CancelSellRsp
+	(*CancelBuyReq)(nil),              // 23: auction.CancelBuyReq
+	(*CancelBuyData)(nil),             // 24: auction.CancelBuyData
+	(*CancelBuyRsp)(nil),              // 25: auction.CancelBuyRsp
+	(*AmendSellReq)(nil),              // 26: auction.AmendSellReq
+	(*AmendSellRsp)(nil),              // 27: auction.AmendSellRsp
+	(*AmendBuyReq)(nil),               // 28: auction.AmendBuyReq
+	(*AmendBuyRsp)(nil),               // 29: auction.AmendBuyRsp
+	(*GetMySellsReq)(nil),             // 30: auction.GetMySellsReq
+	(*GetMySellsRsp)(nil),             // 31: auction.GetMySellsRsp
+	(*GetMyBuysReq)(nil),              // 32: auction.GetMyBuysReq
+	(*GetMyBuysRsp)(nil),              // 33: auction.GetMyBuysRsp
+	(*GetItemAuctionInfoReq)(nil),     // 34: auction.GetItemAuctionInfoReq
+	(*ItemAuctionInfo)(nil),           // 35: auction.ItemAuctionInfo
+	(*GetItemAuctionInfoRsp)(nil),     // 36: auction.GetItemAuctionInfoRsp
+	(*MarketItem)(nil),                // 37: auction.MarketItem
+	(*SearchMarketReq)(nil),           // 38: auction.SearchMarketReq
+	(*SearchMarketRsp)(nil),           // 39: auction.SearchMarketRsp
+	(*GetTransactionHistoryReq)(nil),  // 40: auction.GetTransactionHistoryReq
+	(*GetTransactionHistoryRsp)(nil),  // 41: auction.GetTransactionHistoryRsp
+	(*GetTransactionsByTimeReq)(nil),  // 42: auction.GetTransactionsByTimeReq
+	(*GetTransactionsByTimeRsp)(nil),  // 43: auction.GetTransactionsByTimeRsp
+	(*StatementItem)(nil),             // 44: auction.StatementItem
+	(*AuctionStatement)(nil),          // 45: auction.AuctionStatement
+	(*GetAuctionStatementReq)(nil),    // 46: auction.GetAuctionStatementReq
+	(*GetAuctionStatementRsp)(nil),    // 47: auction.GetAuctionStatementRsp
+	(*ExportAuctionStatementReq)(nil), // 48: auction.ExportAuctionStatementReq
+	(*ExportAuctionStatementRsp)(nil), // 49: auction.ExportAuctionStatementRsp
+	(*Kline)(nil),                     // 50: auction.Kline
+	(*GetItemKlineReq)(nil),           // 51: auction.GetItemKlineReq
+	(*GetItemKlineRsp)(nil),           // 52: auction.GetItemKlineRsp
+	(*SubscribeItemReq)(nil),          // 53: auction.SubscribeItemReq
+	(*SubscribeItemRsp)(nil),          // 54: auction.SubscribeItemRsp
+	(*UnsubscribeItemReq)(nil),        // 55: auction.UnsubscribeItemReq
+	(*UnsubscribeItemRsp)(nil),        // 56: auction.UnsubscribeItemRsp
+	(*MarketTrade)(nil),               // 57: auction.MarketTrade
+	(*AuctionMarketNtf)(nil),          // 58: auction.AuctionMarketNtf
+	(*UniqueListing)(nil),             // 59: auction.UniqueListing
+	(*ListUniqueItemReq)(nil),         // 60: auction.ListUniqueItemReq
+	(*ListUniqueItemRsp)(nil),         // 61: auction.ListUniqueItemRsp
+	(*CancelUniqueListingReq)(nil),    // 62: auction.CancelUniqueListingReq
+	(*CancelUniqueListingRsp)(nil),    // 63: auction.CancelUniqueListingRsp
+	(*BuyUniqueItemReq)(nil),          // 64: auction.BuyUniqueItemReq
+	(*BuyUniqueItemRsp)(nil),          // 65: auction.BuyUniqueItemRsp
+	(*OfferUniqueItemReq)(nil),        // 66: auction.OfferUniqueItemReq
+	(*OfferUniqueItemRsp)(nil),        // 67: auction.OfferUniqueItemRsp
+	(*AcceptUniqueOfferReq)(nil),      // 68: auction.AcceptUniqueOfferReq
+	(*AcceptUniqueOfferRsp)(nil),      // 69: auction.AcceptUniqueOfferRsp
+	(*SearchUniqueListingsReq)(nil),   // 70: auction.SearchUniqueListingsReq
+	(*SearchUniqueListingsRsp)(nil),   // 71: auction.SearchUniqueListingsRsp
+	(*GetMyUniqueListingsReq)(nil),    // 72: auction.GetMyUniqueListingsReq
+	(*GetMyUniqueListingsRsp)(nil),    // 73: auction.GetMyUniqueListingsRsp
+	(*AuctionUniqueListingNtf)(nil),   // 74: auction.AuctionUniqueListingNtf
+	(*TimedAuction)(nil),              // 75: auction.TimedAuction
+	(*CreateTimedAuctionReq)(nil),     // 76: auction.CreateTimedAuctionReq
+	(*CreateTimedAuctionRsp)(nil),     // 77: auction.CreateTimedAuctionRsp
+	(*BidTimedAuctionReq)(nil),        // 78: auction.BidTimedAuctionReq
+	(*BidTimedAuctionRsp)(nil),        // 79: auction.BidTimedAuctionRsp
+	(*CancelTimedAuctionReq)(nil),     // 80: auction.CancelTimedAuctionReq
+	(*CancelTimedAuctionRsp)(nil),     // 81: auction.CancelTimedAuctionRsp
+	(*GetTimedAuctionReq)(nil),        // 82: auction.GetTimedAuctionReq
+	(*GetTimedAuctionRsp)(nil),        // 83: auction.GetTimedAuctionRsp
+	(*GetTimedAuctionsReq)(nil),       // 84: auction.GetTimedAuctionsReq
+	(*GetTimedAuctionsRsp)(nil),       // 85: auction.GetTimedAuctionsRsp
+	(*AuctionTimedNtf)(nil),           // 86: auction.AuctionTimedNtf
+	nil,                               // 87: auction.SearchUniqueListingsReq.PropertyFiltersEntry
+	(common.ErrorCode)(0),             // 88: common.ErrorCode
+}
+var file_proto_auction_proto_depIdxs = []int32{
+	88, // 0: auction.PingRsp.code:type_name -> common.ErrorCode
+	9,  // 1: auction.TransactionHistoryData.records:type_name -> auction.TransactionRecord
+	10, // 2: auction.TransactionsByTimeData.records:type_name -> auction.TimeTransactionRecord
+	0,  // 3: auction.SellReq.order_type:type_name -> auction.OrderType
+	0,  // 4: auction.SellData.order_type:type_name -> auction.OrderType
+	88, // 5: auction.SellRsp.code:type_name -> common.ErrorCode
+	14, // 6: auction.SellRsp.data:type_name -> auction.SellData
+	0,  // 7: auction.BuyReq.order_type:type_name -> auction.OrderType
+	0,  // 8: auction.BuyData.order_type:type_name -> auction.OrderType
+	88, // 9: auction.BuyRsp.code:type_name -> common.ErrorCode
+	17, // 10: auction.BuyRsp.data:type_name -> auction.BuyData
+	88, // 11: auction.CancelSellRsp.code:type_name -> common.ErrorCode
+	21, // 12: auction.CancelSellRsp.data:type_name -> auction.CancelSellData
+	88, // 13: auction.CancelBuyRsp.code:type_name -> common.ErrorCode
+	24, // 14: auction.CancelBuyRsp.data:type_name -> auction.CancelBuyData
+	88, // 15: auction.AmendSellRsp.code:type_name -> common.ErrorCode
+	14, // 16: auction.AmendSellRsp.data:type_name -> auction.SellData
+	88, // 17: auction.AmendBuyRsp.code:type_name -> common.ErrorCode
+	17, // 18: auction.AmendBuyRsp.data:type_name -> auction.BuyData
+	88, // 19: auction.GetMySellsRsp.code:type_name -> common.ErrorCode
+	14, // 20: auction.GetMySellsRsp.data:type_name -> auction.SellData
+	88, // 21: auction.GetMyBuysRsp.code:type_name -> common.ErrorCode
+	17, // 22: auction.GetMyBuysRsp.data:type_name -> auction.BuyData
+	8,  // 23: auction.ItemAuctionInfo.sells:type_name -> auction.OrderInfo
+	8,  // 24: auction.ItemAuctionInfo.buys:type_name -> auction.OrderInfo
+	88, // 25: auction.GetItemAuctionInfoRsp.code:type_name -> common.ErrorCode
+	35, // 26: auction.GetItemAuctionInfoRsp.data:type_name -> auction.ItemAuctionInfo
+	1,  // 27: auction.SearchMarketReq.sort_by:type_name -> auction.MarketSortBy
+	88, // 28: auction.SearchMarketRsp.code:type_name -> common.ErrorCode
+	37, // 29: auction.SearchMarketRsp.data:type_name -> auction.MarketItem
+	88, // 30: auction.GetTransactionHistoryRsp.code:type_name -> common.ErrorCode
+	11, // 31: auction.GetTransactionHistoryRsp.data:type_name -> auction.TransactionHistoryData
+	88, // 32: auction.GetTransactionsByTimeRsp.code:type_name -> common.ErrorCode
+	12, // 33: auction.GetTransactionsByTimeRsp.data:type_name -> auction.TransactionsByTimeData
+	44, // 34: auction.AuctionStatement.items:type_name -> auction.StatementItem
+	88, // 35: auction.GetAuctionStatementRsp.code:type_name -> common.ErrorCode
+	45, // 36: auction.GetAuctionStatementRsp.data:type_name -> auction.AuctionStatement
+	88, // 37: auction.ExportAuctionStatementRsp.code:type_name -> common.ErrorCode
+	2,  // 38: auction.GetItemKlineReq.interval:type_name -> auction.KlineInterval
+	88, // 39: auction.GetItemKlineRsp.code:type_name -> common.ErrorCode
+	50, // 40: auction.GetItemKlineRsp.data:type_name -> auction.Kline
+	88, // 41: auction.SubscribeItemRsp.code:type_name -> common.ErrorCode
+	35, // 42: auction.SubscribeItemRsp.data:type_name -> auction.ItemAuctionInfo
+	88, // 43: auction.UnsubscribeItemRsp.code:type_name -> common.ErrorCode
+	8,  // 44: auction.AuctionMarketNtf.sells:type_name -> auction.OrderInfo
+	8,  // 45: auction.AuctionMarketNtf.buys:type_name -> auction.OrderInfo
+	57, // 46: auction.AuctionMarketNtf.trades:type_name -> auction.MarketTrade
+	3,  // 47: auction.UniqueListing.listing_type:type_name -> auction.UniqueListingType
+	4,  // 48: auction.UniqueListing.status:type_name -> auction.UniqueListingStatus
+	3,  // 49: auction.ListUniqueItemReq.listing_type:type_name -> auction.UniqueListingType
+	88, // 50: auction.ListUniqueItemRsp.code:type_name -> common.ErrorCode
+	59, // 51: auction.ListUniqueItemRsp.data:type_name -> auction.UniqueListing
+	88, // 52: auction.CancelUniqueListingRsp.code:type_name -> common.ErrorCode
+	59, // 53: auction.CancelUniqueListingRsp.data:type_name -> auction.UniqueListing
+	88, // 54: auction.BuyUniqueItemRsp.code:type_name -> common.ErrorCode
+	59, // 55: auction.BuyUniqueItemRsp.data:type_name -> auction.UniqueListing
+	88, // 56: auction.OfferUniqueItemRsp.code:type_name -> common.ErrorCode
+	59, // 57: auction.OfferUniqueItemRsp.data:type_name -> auction.UniqueListing
+	88, // 58: auction.AcceptUniqueOfferRsp.code:type_name -> common.ErrorCode
+	59, // 59: auction.AcceptUniqueOfferRsp.data:type_name -> auction.UniqueListing
+	87, // 60: auction.SearchUniqueListingsReq.property_filters:type_name -> auction.SearchUniqueListingsReq.PropertyFiltersEntry
+	3,  // 61: auction.SearchUniqueListingsReq.listing_types:type_name -> auction.UniqueListingType
+	88, // 62: auction.SearchUniqueListingsRsp.code:type_name -> common.ErrorCode
+	59, // 63: auction.SearchUniqueListingsRsp.data:type_name -> auction.UniqueListing
+	88, // 64: auction.GetMyUniqueListingsRsp.code:type_name -> common.ErrorCode
+	59, // 65: auction.GetMyUniqueListingsRsp.data:type_name -> auction.UniqueListing
+	59, // 66: auction.AuctionUniqueListingNtf.listing:type_name -> auction.UniqueListing
+	5,  // 67: auction.TimedAuction.status:type_name -> auction.TimedAuctionStatus
+	88, // 68: auction.CreateTimedAuctionRsp.code:type_name -> common.ErrorCode
+	75, // 69: auction.CreateTimedAuctionRsp.data:type_name -> auction.TimedAuction
+	88, // 70: auction.BidTimedAuctionRsp.code:type_name -> common.ErrorCode
+	75, // 71: auction.BidTimedAuctionRsp.data:type_name -> auction.TimedAuction
+	88, // 72: auction.CancelTimedAuctionRsp.code:type_name -> common.ErrorCode
+	75, // 73: auction.CancelTimedAuctionRsp.data:type_name -> auction.TimedAuction
+	88, // 74: auction.GetTimedAuctionRsp.code:type_name -> common.ErrorCode
+	75, // 75: auction.GetTimedAuctionRsp.data:type_name -> auction.TimedAuction
+	88, // 76: auction.GetTimedAuctionsRsp.code:type_name -> common.ErrorCode
+	75, // 77: auction.GetTimedAuctionsRsp.data:type_name -> auction.TimedAuction
+	75, // 78: auction.AuctionTimedNtf.auction:type_name -> auction.TimedAuction
+	79, // [79:79] is the sub-list for method output_type
+	79, // [79:79] is the sub-list for method input_type
+	79, // [79:79] is the sub-list for extension type_name
+	79, // [79:79] is the sub-list for extension extendee
+	0,  // [0:79] is the sub-list for field type_name
+}
+
+func init() { file_proto_auction_proto_init() }
+func file_proto_auction_proto_init() {
+	if File_proto_auction_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_auction_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeTransactionRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionHistoryData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionsByTimeData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SellReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SellData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SellRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionOrderExpiredNtf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSellReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSellData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelSellRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBuyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBuyData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBuyRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmendSellReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmendSellRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmendBuyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmendBuyRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMySellsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMySellsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyBuysReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyBuysRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemAuctionInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemAuctionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemAuctionInfoRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMarketReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2291,8 +7482,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingRsp); i {
+		file_proto_auction_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMarketRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2303,8 +7494,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderInfo); i {
+		file_proto_auction_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionHistoryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2315,8 +7506,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionRecord); i {
+		file_proto_auction_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionHistoryRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2327,8 +7518,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimeTransactionRecord); i {
+		file_proto_auction_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionsByTimeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2339,8 +7530,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionHistoryData); i {
+		file_proto_auction_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionsByTimeRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2351,8 +7542,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionsByTimeData); i {
+		file_proto_auction_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2363,8 +7554,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SellReq); i {
+		file_proto_auction_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionStatement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2375,8 +7566,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SellData); i {
+		file_proto_auction_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuctionStatementReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2387,8 +7578,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SellRsp); i {
+		file_proto_auction_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuctionStatementRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2399,8 +7590,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuyReq); i {
+		file_proto_auction_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAuctionStatementReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2411,8 +7602,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuyData); i {
+		file_proto_auction_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAuctionStatementRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2423,8 +7614,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuyRsp); i {
+		file_proto_auction_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Kline); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2435,8 +7626,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelSellReq); i {
+		file_proto_auction_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemKlineReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2447,8 +7638,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelSellData); i {
+		file_proto_auction_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemKlineRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2459,8 +7650,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelSellRsp); i {
+		file_proto_auction_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeItemReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2471,8 +7662,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelBuyReq); i {
+		file_proto_auction_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeItemRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2483,8 +7674,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelBuyData); i {
+		file_proto_auction_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeItemReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2495,8 +7686,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelBuyRsp); i {
+		file_proto_auction_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeItemRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2507,8 +7698,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMySellsReq); i {
+		file_proto_auction_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketTrade); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2519,8 +7710,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMySellsRsp); i {
+		file_proto_auction_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionMarketNtf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2531,8 +7722,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMyBuysReq); i {
+		file_proto_auction_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniqueListing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2543,8 +7734,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMyBuysRsp); i {
+		file_proto_auction_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueItemReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2555,8 +7746,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetItemAuctionInfoReq); i {
+		file_proto_auction_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUniqueItemRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2567,8 +7758,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ItemAuctionInfo); i {
+		file_proto_auction_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelUniqueListingReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2579,8 +7770,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetItemAuctionInfoRsp); i {
+		file_proto_auction_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelUniqueListingRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2591,8 +7782,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionHistoryReq); i {
+		file_proto_auction_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyUniqueItemReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2603,8 +7794,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionHistoryRsp); i {
+		file_proto_auction_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyUniqueItemRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2615,8 +7806,8 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionsByTimeReq); i {
+		file_proto_auction_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferUniqueItemReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2627,8 +7818,236 @@ func file_proto_auction_proto_init() {
 				return nil
 			}
 		}
-		file_proto_auction_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionsByTimeRsp); i {
+		file_proto_auction_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferUniqueItemRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptUniqueOfferReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptUniqueOfferRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUniqueListingsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUniqueListingsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyUniqueListingsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMyUniqueListingsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionUniqueListingNtf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimedAuction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTimedAuctionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTimedAuctionRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BidTimedAuctionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BidTimedAuctionRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTimedAuctionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTimedAuctionRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimedAuctionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimedAuctionRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimedAuctionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimedAuctionsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_auction_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionTimedNtf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2645,13 +8064,14 @@ func file_proto_auction_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_auction_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   30,
+			NumEnums:      6,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_auction_proto_goTypes,
 		DependencyIndexes: file_proto_auction_proto_depIdxs,
+		EnumInfos:         file_proto_auction_proto_enumTypes,
 		MessageInfos:      file_proto_auction_proto_msgTypes,
 	}.Build()
 	File_proto_auction_proto = out.File
@@ -28,7 +28,7 @@ var file_proto_auction_service_proto_rawDesc = []byte{
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x61,
 	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x13,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x32, 0xa5, 0x05, 0x0a, 0x0e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x6f, 0x74, 0x6f, 0x32, 0xe8, 0x11, 0x0a, 0x0e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x10,
 	0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
 	0x1a, 0x10, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52,
@@ -45,58 +45,199 @@ var file_proto_auction_service_proto_rawDesc = []byte{
 	0x5f, 0x62, 0x75, 0x79, 0x12, 0x15, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43,
 	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x75, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x61, 0x75,
 	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x75, 0x79, 0x52,
-	0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x79, 0x5f, 0x73, 0x65, 0x6c,
-	0x6c, 0x73, 0x12, 0x16, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x61, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x73, 0x52,
-	0x73, 0x70, 0x12, 0x3b, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x79, 0x5f, 0x62, 0x75, 0x79,
-	0x73, 0x12, 0x15, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x79, 0x42, 0x75, 0x79, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x42, 0x75, 0x79, 0x73, 0x52, 0x73, 0x70, 0x12,
-	0x57, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x61, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x73, 0x70, 0x12, 0x60, 0x0a, 0x18, 0x67, 0x65, 0x74,
-	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x62, 0x79,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
-	0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x73, 0x70, 0x42, 0x2a, 0x5a, 0x28, 0x61,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x6b, 0x69,
-	0x74, 0x65, 0x78, 0x5f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x6c, 0x6c,
+	0x12, 0x15, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x6d, 0x65, 0x6e, 0x64,
+	0x53, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x73, 0x70, 0x12, 0x37,
+	0x0a, 0x09, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x75, 0x79, 0x12, 0x14, 0x2e, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x79, 0x52, 0x65,
+	0x71, 0x1a, 0x14, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x6d, 0x65, 0x6e,
+	0x64, 0x42, 0x75, 0x79, 0x52, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x5f, 0x6d,
+	0x79, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x16, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x53,
+	0x65, 0x6c, 0x6c, 0x73, 0x52, 0x73, 0x70, 0x12, 0x3b, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x6d,
+	0x79, 0x5f, 0x62, 0x75, 0x79, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x42, 0x75, 0x79, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x42, 0x75, 0x79,
+	0x73, 0x52, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x41,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x41,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x73, 0x70, 0x12, 0x43, 0x0a,
+	0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x18,
+	0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
+	0x73, 0x70, 0x12, 0x5f, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x1a, 0x21, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x73, 0x70, 0x12, 0x60, 0x0a, 0x18, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x21, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f,
+	0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x1f, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70,
+	0x12, 0x62, 0x0a, 0x18, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x22, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0e, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x6b, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x4b, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x18, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x4b, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x2e, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x73, 0x70, 0x12, 0x4c, 0x0a, 0x10, 0x75, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x6e,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70,
+	0x12, 0x4a, 0x0a, 0x10, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
+	0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x15,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0f, 0x62, 0x75, 0x79, 0x5f, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x79, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x42, 0x75, 0x79, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70,
+	0x12, 0x4d, 0x0a, 0x11, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x12,
+	0x53, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x5f, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x52, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x16, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x20,
+	0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x20, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x73, 0x70, 0x12, 0x5a, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x79, 0x5f, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x79, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x73, 0x70, 0x12, 0x56,
+	0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x11, 0x62, 0x69, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x64, 0x5f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x42, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x42, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x69,
+	0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x69,
+	0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x4d, 0x0a,
+	0x11, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a,
+	0x1b, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x12,
+	0x67, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x70, 0x42, 0x2a,
+	0x5a, 0x28, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2f, 0x6b, 0x69, 0x74, 0x65, 0x78, 0x5f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var file_proto_auction_service_proto_goTypes = []interface{}{
-	(*auction.PingReq)(nil),                  // 0: auction.PingReq
-	(*auction.SellReq)(nil),                  // 1: auction.SellReq
-	(*auction.BuyReq)(nil),                   // 2: auction.BuyReq
-	(*auction.CancelSellReq)(nil),            // 3: auction.CancelSellReq
-	(*auction.CancelBuyReq)(nil),             // 4: auction.CancelBuyReq
-	(*auction.GetMySellsReq)(nil),            // 5: auction.GetMySellsReq
-	(*auction.GetMyBuysReq)(nil),             // 6: auction.GetMyBuysReq
-	(*auction.GetItemAuctionInfoReq)(nil),    // 7: auction.GetItemAuctionInfoReq
-	(*auction.GetTransactionHistoryReq)(nil), // 8: auction.GetTransactionHistoryReq
-	(*auction.GetTransactionsByTimeReq)(nil), // 9: auction.GetTransactionsByTimeReq
-	(*auction.PingRsp)(nil),                  // 10: auction.PingRsp
-	(*auction.SellRsp)(nil),                  // 11: auction.SellRsp
-	(*auction.BuyRsp)(nil),                   // 12: auction.BuyRsp
-	(*auction.CancelSellRsp)(nil),            // 13: auction.CancelSellRsp
-	(*auction.CancelBuyRsp)(nil),             // 14: auction.CancelBuyRsp
-	(*auction.GetMySellsRsp)(nil),            // 15: auction.GetMySellsRsp
-	(*auction.GetMyBuysRsp)(nil),             // 16: auction.GetMyBuysRsp
-	(*auction.GetItemAuctionInfoRsp)(nil),    // 17: auction.GetItemAuctionInfoRsp
-	(*auction.GetTransactionHistoryRsp)(nil), // 18: auction.GetTransactionHistoryRsp
-	(*auction.GetTransactionsByTimeRsp)(nil), // 19: auction.GetTransactionsByTimeRsp
+	(*auction.PingReq)(nil),                   // 0: auction.PingReq
+	(*auction.SellReq)(nil),                   // 1: auction.SellReq
+	(*auction.BuyReq)(nil),                    // 2: auction.BuyReq
+	(*auction.CancelSellReq)(nil),             // 3: auction.CancelSellReq
+	(*auction.CancelBuyReq)(nil),              // 4: auction.CancelBuyReq
+	(*auction.AmendSellReq)(nil),              // 5: auction.AmendSellReq
+	(*auction.AmendBuyReq)(nil),               // 6: auction.AmendBuyReq
+	(*auction.GetMySellsReq)(nil),             // 7: auction.GetMySellsReq
+	(*auction.GetMyBuysReq)(nil),              // 8: auction.GetMyBuysReq
+	(*auction.GetItemAuctionInfoReq)(nil),     // 9: auction.GetItemAuctionInfoReq
+	(*auction.SearchMarketReq)(nil),           // 10: auction.SearchMarketReq
+	(*auction.GetTransactionHistoryReq)(nil),  // 11: auction.GetTransactionHistoryReq
+	(*auction.GetTransactionsByTimeReq)(nil),  // 12: auction.GetTransactionsByTimeReq
+	(*auction.GetAuctionStatementReq)(nil),    // 13: auction.GetAuctionStatementReq
+	(*auction.ExportAuctionStatementReq)(nil), // 14: auction.ExportAuctionStatementReq
+	(*auction.GetItemKlineReq)(nil),           // 15: auction.GetItemKlineReq
+	(*auction.SubscribeItemReq)(nil),          // 16: auction.SubscribeItemReq
+	(*auction.UnsubscribeItemReq)(nil),        // 17: auction.UnsubscribeItemReq
+	(*auction.ListUniqueItemReq)(nil),         // 18: auction.ListUniqueItemReq
+	(*auction.CancelUniqueListingReq)(nil),    // 19: auction.CancelUniqueListingReq
+	(*auction.BuyUniqueItemReq)(nil),          // 20: auction.BuyUniqueItemReq
+	(*auction.OfferUniqueItemReq)(nil),        // 21: auction.OfferUniqueItemReq
+	(*auction.AcceptUniqueOfferReq)(nil),      // 22: auction.AcceptUniqueOfferReq
+	(*auction.SearchUniqueListingsReq)(nil),   // 23: auction.SearchUniqueListingsReq
+	(*auction.GetMyUniqueListingsReq)(nil),    // 24: auction.GetMyUniqueListingsReq
+	(*auction.CreateTimedAuctionReq)(nil),     // 25: auction.CreateTimedAuctionReq
+	(*auction.BidTimedAuctionReq)(nil),        // 26: auction.BidTimedAuctionReq
+	(*auction.CancelTimedAuctionReq)(nil),     // 27: auction.CancelTimedAuctionReq
+	(*auction.GetTimedAuctionReq)(nil),        // 28: auction.GetTimedAuctionReq
+	(*auction.GetTimedAuctionsReq)(nil),       // 29: auction.GetTimedAuctionsReq
+	(*auction.PingRsp)(nil),                   // 30: auction.PingRsp
+	(*auction.SellRsp)(nil),                   // 31: auction.SellRsp
+	(*auction.BuyRsp)(nil),                    // 32: auction.BuyRsp
+	(*auction.CancelSellRsp)(nil),             // 33: auction.CancelSellRsp
+	(*auction.CancelBuyRsp)(nil),              // 34: auction.CancelBuyRsp
+	(*auction.AmendSellRsp)(nil),              // 35: auction.AmendSellRsp
+	(*auction.AmendBuyRsp)(nil),               // 36: auction.AmendBuyRsp
+	(*auction.GetMySellsRsp)(nil),             // 37: auction.GetMySellsRsp
+	(*auction.GetMyBuysRsp)(nil),              // 38: auction.GetMyBuysRsp
+	(*auction.GetItemAuctionInfoRsp)(nil),     // 39: auction.GetItemAuctionInfoRsp
+	(*auction.SearchMarketRsp)(nil),           // 40: auction.SearchMarketRsp
+	(*auction.GetTransactionHistoryRsp)(nil),  // 41: auction.GetTransactionHistoryRsp
+	(*auction.GetTransactionsByTimeRsp)(nil),  // 42: auction.GetTransactionsByTimeRsp
+	(*auction.GetAuctionStatementRsp)(nil),    // 43: auction.GetAuctionStatementRsp
+	(*auction.ExportAuctionStatementRsp)(nil), // 44: auction.ExportAuctionStatementRsp
+	(*auction.GetItemKlineRsp)(nil),           // 45: auction.GetItemKlineRsp
+	(*auction.SubscribeItemRsp)(nil),          // 46: auction.SubscribeItemRsp
+	(*auction.UnsubscribeItemRsp)(nil),        // 47: auction.UnsubscribeItemRsp
+	(*auction.ListUniqueItemRsp)(nil),         // 48: auction.ListUniqueItemRsp
+	(*auction.CancelUniqueListingRsp)(nil),    // 49: auction.CancelUniqueListingRsp
+	(*auction.BuyUniqueItemRsp)(nil),          // 50: auction.BuyUniqueItemRsp
+	(*auction.OfferUniqueItemRsp)(nil),        // 51: auction.OfferUniqueItemRsp
+	(*auction.AcceptUniqueOfferRsp)(nil),      // 52: auction.AcceptUniqueOfferRsp
+	(*auction.SearchUniqueListingsRsp)(nil),   // 53: auction.SearchUniqueListingsRsp
+	(*auction.GetMyUniqueListingsRsp)(nil),    // 54: auction.GetMyUniqueListingsRsp
+	(*auction.CreateTimedAuctionRsp)(nil),     // 55: auction.CreateTimedAuctionRsp
+	(*auction.BidTimedAuctionRsp)(nil),        // 56: auction.BidTimedAuctionRsp
+	(*auction.CancelTimedAuctionRsp)(nil),     // 57: auction.CancelTimedAuctionRsp
+	(*auction.GetTimedAuctionRsp)(nil),        // 58: auction.GetTimedAuctionRsp
+	(*auction.GetTimedAuctionsRsp)(nil),       // 59: auction.GetTimedAuctionsRsp
 }
 var file_proto_auction_service_proto_depIdxs = []int32{
 	0,  // 0: auction_service.AuctionService.ping:input_type -> auction.PingReq
@@ -104,23 +245,63 @@ var file_proto_auction_service_proto_depIdxs = []int32{
 	2,  // 2: auction_service.AuctionService.buy:input_type -> auction.BuyReq
 	3,  // 3: auction_service.AuctionService.cancel_sell:input_type -> auction.CancelSellReq
 	4,  // 4: auction_service.AuctionService.cancel_buy:input_type -> auction.CancelBuyReq
-	5,  // 5: auction_service.AuctionService.get_my_sells:input_type -> auction.GetMySellsReq
-	6,  // 6: auction_service.AuctionService.get_my_buys:input_type -> auction.GetMyBuysReq
-	7,  // 7: auction_service.AuctionService.get_item_auction_info:input_type -> auction.GetItemAuctionInfoReq
-	8,  // 8: auction_service.AuctionService.get_transaction_history:input_type -> auction.GetTransactionHistoryReq
-	9,  // 9: auction_service.AuctionService.get_transactions_by_time:input_type -> auction.GetTransactionsByTimeReq
-	10, // 10: auction_service.AuctionService.ping:output_type -> auction.PingRsp
-	11, // 11: auction_service.AuctionService.sell:output_type -> auction.SellRsp
-	12, // 12: auction_service.AuctionService.buy:output_type -> auction.BuyRsp
-	13, // 13: auction_service.AuctionService.cancel_sell:output_type -> auction.CancelSellRsp
-	14, // 14: auction_service.AuctionService.cancel_buy:output_type -> auction.CancelBuyRsp
-	15, // 15: auction_service.AuctionService.get_my_sells:output_type -> auction.GetMySellsRsp
-	16, // 16: auction_service.AuctionService.get_my_buys:output_type -> auction.GetMyBuysRsp
-	17, // 17: auction_service.AuctionService.get_item_auction_info:output_type -> auction.GetItemAuctionInfoRsp
-	18, // 18: auction_service.AuctionService.get_transaction_history:output_type -> auction.GetTransactionHistoryRsp
-	19, // 19: auction_service.AuctionService.get_transactions_by_time:output_type -> auction.GetTransactionsByTimeRsp
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
+	5,  // 5: auction_service.AuctionService.amend_sell:input_type -> auction.AmendSellReq
+	6,  // 6: auction_service.AuctionService.amend_buy:input_type -> auction.AmendBuyReq
+	7,  // 7: auction_service.AuctionService.get_my_sells:input_type -> auction.GetMySellsReq
+	8,  // 8: auction_service.AuctionService.get_my_buys:input_type -> auction.GetMyBuysReq
+	9,  // 9: auction_service.AuctionService.get_item_auction_info:input_type -> auction.GetItemAuctionInfoReq
+	10, // 10: auction_service.AuctionService.search_market:input_type -> auction.SearchMarketReq
+	11, // 11: auction_service.AuctionService.get_transaction_history:input_type -> auction.GetTransactionHistoryReq
+	12, // 12: auction_service.AuctionService.get_transactions_by_time:input_type -> auction.GetTransactionsByTimeReq
+	13, // 13: auction_service.AuctionService.get_auction_statement:input_type -> auction.GetAuctionStatementReq
+	14, // 14: auction_service.AuctionService.export_auction_statement:input_type -> auction.ExportAuctionStatementReq
+	15, // 15: auction_service.AuctionService.get_item_kline:input_type -> auction.GetItemKlineReq
+	16, // 16: auction_service.AuctionService.subscribe_item:input_type -> auction.SubscribeItemReq
+	17, // 17: auction_service.AuctionService.unsubscribe_item:input_type -> auction.UnsubscribeItemReq
+	18, // 18: auction_service.AuctionService.list_unique_item:input_type -> auction.ListUniqueItemReq
+	19, // 19: auction_service.AuctionService.cancel_unique_listing:input_type -> auction.CancelUniqueListingReq
+	20, // 20: auction_service.AuctionService.buy_unique_item:input_type -> auction.BuyUniqueItemReq
+	21, // 21: auction_service.AuctionService.offer_unique_item:input_type -> auction.OfferUniqueItemReq
+	22, // 22: auction_service.AuctionService.accept_unique_offer:input_type -> auction.AcceptUniqueOfferReq
+	23, // 23: auction_service.AuctionService.search_unique_listings:input_type -> auction.SearchUniqueListingsReq
+	24, // 24: auction_service.AuctionService.get_my_unique_listings:input_type -> auction.GetMyUniqueListingsReq
+	25, // 25: auction_service.AuctionService.create_timed_auction:input_type -> auction.CreateTimedAuctionReq
+	26, // 26: auction_service.AuctionService.bid_timed_auction:input_type -> auction.BidTimedAuctionReq
+	27, // 27: auction_service.AuctionService.cancel_timed_auction:input_type -> auction.CancelTimedAuctionReq
+	28, // 28: auction_service.AuctionService.get_timed_auction:input_type -> auction.GetTimedAuctionReq
+	29, // 29: auction_service.AuctionService.get_timed_auctions:input_type -> auction.GetTimedAuctionsReq
+	30, // 30: auction_service.AuctionService.ping:output_type -> auction.PingRsp
+	31, // 31: auction_service.AuctionService.sell:output_type -> auction.SellRsp
+	32, // 32: auction_service.AuctionService.buy:output_type -> auction.BuyRsp
+	33, // 33: auction_service.AuctionService.cancel_sell:output_type -> auction.CancelSellRsp
+	34, // 34: auction_service.AuctionService.cancel_buy:output_type -> auction.CancelBuyRsp
+	35, // 35: auction_service.AuctionService.amend_sell:output_type -> auction.AmendSellRsp
+	36, // 36: auction_service.AuctionService.amend_buy:output_type -> auction.AmendBuyRsp
+	37, // 37: auction_service.AuctionService.get_my_sells:output_type -> auction.GetMySellsRsp
+	38, // 38: auction_service.AuctionService.get_my_buys:output_type -> auction.GetMyBuysRsp
+	39, // 39: auction_service.AuctionService.get_item_auction_info:output_type -> auction.GetItemAuctionInfoRsp
+	40, // 40: auction_service.AuctionService.search_market:output_type -> auction.SearchMarketRsp
+	41, // 41: auction_service.AuctionService.get_transaction_history:output_type -> auction.GetTransactionHistoryRsp
+	42, // 42: auction_service.AuctionService.get_transactions_by_time:output_type -> auction.GetTransactionsByTimeRsp
+	43, // 43: auction_service.AuctionService.get_auction_statement:output_type -> auction.GetAuctionStatementRsp
+	44, // 44: auction_service.AuctionService.export_auction_statement:output_type -> auction.ExportAuctionStatementRsp
+	45, // 45: auction_service.AuctionService.get_item_kline:output_type -> auction.GetItemKlineRsp
+	46, // 46: auction_service.AuctionService.subscribe_item:output_type -> auction.SubscribeItemRsp
+	47, // 47: auction_service.AuctionService.unsubscribe_item:output_type -> auction.UnsubscribeItemRsp
+	48, // 48: auction_service.AuctionService.list_unique_item:output_type -> auction.ListUniqueItemRsp
+	49, // 49: auction_service.AuctionService.cancel_unique_listing:output_type -> auction.CancelUniqueListingRsp
+	50, // 50: auction_service.AuctionService.buy_unique_item:output_type -> auction.BuyUniqueItemRsp
+	51, // 51: auction_service.AuctionService.offer_unique_item:output_type -> auction.OfferUniqueItemRsp
+	52, // 52: auction_service.AuctionService.accept_unique_offer:output_type -> auction.AcceptUniqueOfferRsp
+	53, // 53: auction_service.AuctionService.search_unique_listings:output_type -> auction.SearchUniqueListingsRsp
+	54, // 54: auction_service.AuctionService.get_my_unique_listings:output_type -> auction.GetMyUniqueListingsRsp
+	55, // 55: auction_service.AuctionService.create_timed_auction:output_type -> auction.CreateTimedAuctionRsp
+	56, // 56: auction_service.AuctionService.bid_timed_auction:output_type -> auction.BidTimedAuctionRsp
+	57, // 57: auction_service.AuctionService.cancel_timed_auction:output_type -> auction.CancelTimedAuctionRsp
+	58, // 58: auction_service.AuctionService.get_timed_auction:output_type -> auction.GetTimedAuctionRsp
+	59, // 59: auction_service.AuctionService.get_timed_auctions:output_type -> auction.GetTimedAuctionsRsp
+	30, // [30:60] is the sub-list for method output_type
+	0,  // [0:30] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -160,9 +341,29 @@ type AuctionService interface {
 	Buy(ctx context.Context, req *auction.BuyReq) (res *auction.BuyRsp, err error)
 	CancelSell(ctx context.Context, req *auction.CancelSellReq) (res *auction.CancelSellRsp, err error)
 	CancelBuy(ctx context.Context, req *auction.CancelBuyReq) (res *auction.CancelBuyRsp, err error)
+	AmendSell(ctx context.Context, req *auction.AmendSellReq) (res *auction.AmendSellRsp, err error)
+	AmendBuy(ctx context.Context, req *auction.AmendBuyReq) (res *auction.AmendBuyRsp, err error)
 	GetMySells(ctx context.Context, req *auction.GetMySellsReq) (res *auction.GetMySellsRsp, err error)
 	GetMyBuys(ctx context.Context, req *auction.GetMyBuysReq) (res *auction.GetMyBuysRsp, err error)
 	GetItemAuctionInfo(ctx context.Context, req *auction.GetItemAuctionInfoReq) (res *auction.GetItemAuctionInfoRsp, err error)
+	SearchMarket(ctx context.Context, req *auction.SearchMarketReq) (res *auction.SearchMarketRsp, err error)
 	GetTransactionHistory(ctx context.Context, req *auction.GetTransactionHistoryReq) (res *auction.GetTransactionHistoryRsp, err error)
 	GetTransactionsByTime(ctx context.Context, req *auction.GetTransactionsByTimeReq) (res *auction.GetTransactionsByTimeRsp, err error)
+	GetAuctionStatement(ctx context.Context, req *auction.GetAuctionStatementReq) (res *auction.GetAuctionStatementRsp, err error)
+	ExportAuctionStatement(ctx context.Context, req *auction.ExportAuctionStatementReq) (res *auction.ExportAuctionStatementRsp, err error)
+	GetItemKline(ctx context.Context, req *auction.GetItemKlineReq) (res *auction.GetItemKlineRsp, err error)
+	SubscribeItem(ctx context.Context, req *auction.SubscribeItemReq) (res *auction.SubscribeItemRsp, err error)
+	UnsubscribeItem(ctx context.Context, req *auction.UnsubscribeItemReq) (res *auction.UnsubscribeItemRsp, err error)
+	ListUniqueItem(ctx context.Context, req *auction.ListUniqueItemReq) (res *auction.ListUniqueItemRsp, err error)
+	CancelUniqueListing(ctx context.Context, req *auction.CancelUniqueListingReq) (res *auction.CancelUniqueListingRsp, err error)
+	BuyUniqueItem(ctx context.Context, req *auction.BuyUniqueItemReq) (res *auction.BuyUniqueItemRsp, err error)
+	OfferUniqueItem(ctx context.Context, req *auction.OfferUniqueItemReq) (res *auction.OfferUniqueItemRsp, err error)
+	AcceptUniqueOffer(ctx context.Context, req *auction.AcceptUniqueOfferReq) (res *auction.AcceptUniqueOfferRsp, err error)
+	SearchUniqueListings(ctx context.Context, req *auction.SearchUniqueListingsReq) (res *auction.SearchUniqueListingsRsp, err error)
+	GetMyUniqueListings(ctx context.Context, req *auction.GetMyUniqueListingsReq) (res *auction.GetMyUniqueListingsRsp, err error)
+	CreateTimedAuction(ctx context.Context, req *auction.CreateTimedAuctionReq) (res *auction.CreateTimedAuctionRsp, err error)
+	BidTimedAuction(ctx context.Context, req *auction.BidTimedAuctionReq) (res *auction.BidTimedAuctionRsp, err error)
+	CancelTimedAuction(ctx context.Context, req *auction.CancelTimedAuctionReq) (res *auction.CancelTimedAuctionRsp, err error)
+	GetTimedAuction(ctx context.Context, req *auction.GetTimedAuctionReq) (res *auction.GetTimedAuctionRsp, err error)
+	GetTimedAuctions(ctx context.Context, req *auction.GetTimedAuctionsReq) (res *auction.GetTimedAuctionsRsp, err error)
 }
@@ -0,0 +1,434 @@
+package manager
+
+import (
+	"auction_module/config"
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/auction_admin"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/google/btree"
+	goredis "github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	haltedItemsKey       = "auction:halted"      // 暂停交易的道具：field为道具ID，value为暂停信息JSON
+	adminAuditStreamKey  = "auction:admin:audit" // 运维操作审计流（Redis Stream）
+	defaultAdminAuditLen = 100000                // 未配置时审计流保留的最大条数（近似裁剪）
+)
+
+// haltInfo 道具暂停交易信息
+type haltInfo struct {
+	OperatorId string `json:"operator_id"`
+	Reason     string `json:"reason"`
+	HaltTime   int64  `json:"halt_time"`
+}
+
+// checkOperator 校验操作人，配置了auction_admin.operators时只允许名单内的操作人
+func checkOperator(operatorId string) error {
+	if operatorId == "" {
+		return fmt.Errorf("operator_id is empty")
+	}
+	operators, _ := config.Get("auction_admin.operators").([]interface{})
+	if len(operators) == 0 {
+		return nil
+	}
+	for _, op := range operators {
+		if fmt.Sprintf("%v", op) == operatorId {
+			return nil
+		}
+	}
+	return fmt.Errorf("operator %s is not allowed", operatorId)
+}
+
+// auditAdmin 记录运维操作到审计流
+func auditAdmin(ctx context.Context, operatorId string, action string, itemId string, userId string, detail map[string]interface{}) {
+	detailJson, _ := json.Marshal(detail)
+	klog.CtxInfof(ctx, "[AUCTION-ADMIN-AUDIT] operator=%s, action=%s, itemId=%s, userId=%s, detail=%s",
+		operatorId, action, itemId, userId, string(detailJson))
+
+	err := redis.GetRedis().XAdd(ctx, &goredis.XAddArgs{
+		Stream: adminAuditStreamKey,
+		MaxLen: int64(configInt("auction_admin.audit_max_len", defaultAdminAuditLen)),
+		Approx: true,
+		Values: map[string]interface{}{
+			"operator_id": operatorId,
+			"action":      action,
+			"item_id":     itemId,
+			"user_id":     userId,
+			"detail":      string(detailJson),
+			"time":        time.Now().Unix(),
+		},
+	}).Err()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-ADMIN-AUDIT] write audit error: operator=%s, action=%s, error: %s",
+			operatorId, action, err.Error())
+	}
+}
+
+// isItemHalted 查询道具是否被暂停交易（创建撮合单元时调用）
+func isItemHalted(ctx context.Context, itemId string) bool {
+	halted, err := redis.GetRedis().HExists(ctx, haltedItemsKey, itemId).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-ADMIN] get halt state error: itemId=%s, error: %s", itemId, err.Error())
+	}
+	return halted
+}
+
+// uncrossBook 恢复交易后处理暂停期间形成的交叉盘口（在撮合协程内调用）
+// 较早的订单作为挂单方，较晚的订单移出订单簿后重新作为主动方撮合
+func (mu *matchUnit) uncrossBook(ctx context.Context) {
+	if blockSelfMatch() {
+		mu.uncrossBookByTime(ctx)
+		return
+	}
+	for {
+		sellItem, buyItem := mu.sellOrders.Min(), mu.buyOrders.Min()
+		if sellItem == nil || buyItem == nil {
+			return
+		}
+		sell, buy := sellItem.(*SellOrderByPriceAsc), buyItem.(*BuyOrderByPriceDesc)
+		if buy.Price < sell.Price {
+			return
+		}
+
+		if sell.CreateTime > buy.CreateTime || (sell.CreateTime == buy.CreateTime && sell.OrderId > buy.OrderId) {
+			mu.sellOrders.Delete(sellItem)
+			mu.expireWheel.Remove(sell.OrderId)
+			mu.appendEvent(ctx, &matchEvent{Type: eventRequeue, Direction: "sell", OrderId: sell.OrderId})
+			mu.AddSellOrder(ctx, (*auction.SellData)(sell))
+		} else {
+			mu.buyOrders.Delete(buyItem)
+			mu.expireWheel.Remove(buy.OrderId)
+			mu.appendEvent(ctx, &matchEvent{Type: eventRequeue, Direction: "buy", OrderId: buy.OrderId})
+			mu.AddBuyOrder(ctx, (*auction.BuyData)(buy))
+		}
+	}
+}
+
+// runOp 在撮合协程内执行操作并等待完成，操作产生的成交落库后返回
+func (mu *matchUnit) runOp(op func()) {
+	settled := make(chan (<-chan struct{}), 1)
+	mu.opChannel <- func() {
+		op()
+		settled <- mu.settler.barrier()
+	}
+	<-<-settled
+}
+
+// AdminHaltItem 暂停道具交易：不再撮合，拒绝新订单，撤单和过期照常处理
+func (m *AuctionManager) AdminHaltItem(ctx context.Context, req *auction_admin.AdminHaltItemReq) (resp *auction_admin.AdminHaltItemRsp, err error) {
+	resp = &auction_admin.AdminHaltItemRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	if err = checkOperator(req.GetOperatorId()); err != nil {
+		resp.Code = common.ErrorCode_AUCTION_ADMIN_DENIED
+		resp.Msg = err.Error()
+		err = nil
+		return
+	}
+	if req.GetItemId() == "" {
+		resp.Msg = "item_id is empty"
+		return
+	}
+
+	info, _ := json.Marshal(&haltInfo{OperatorId: req.GetOperatorId(), Reason: req.GetReason(), HaltTime: time.Now().Unix()})
+	if err = redis.GetRedis().HSet(ctx, haltedItemsKey, req.GetItemId(), string(info)).Err(); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-ADMIN] halt item error: itemId=%s, error: %s", req.GetItemId(), err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "halt item error"
+		err = nil
+		return
+	}
+	mu, err := getMatchManager().GetMatchUnit(req.GetItemId())
+	if err != nil {
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
+	}
+	mu.runOp(func() { mu.halted.Store(true) })
+
+	auditAdmin(ctx, req.GetOperatorId(), "halt_item", req.GetItemId(), "", map[string]interface{}{"reason": req.GetReason()})
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	return
+}
+
+// AdminResumeItem 恢复道具交易，并撮合暂停期间形成的交叉盘口
+func (m *AuctionManager) AdminResumeItem(ctx context.Context, req *auction_admin.AdminResumeItemReq) (resp *auction_admin.AdminResumeItemRsp, err error) {
+	resp = &auction_admin.AdminResumeItemRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	if err = checkOperator(req.GetOperatorId()); err != nil {
+		resp.Code = common.ErrorCode_AUCTION_ADMIN_DENIED
+		resp.Msg = err.Error()
+		err = nil
+		return
+	}
+	if req.GetItemId() == "" {
+		resp.Msg = "item_id is empty"
+		return
+	}
+
+	if err = redis.GetRedis().HDel(ctx, haltedItemsKey, req.GetItemId()).Err(); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-ADMIN] resume item error: itemId=%s, error: %s", req.GetItemId(), err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "resume item error"
+		err = nil
+		return
+	}
+	mu, err := getMatchManager().GetMatchUnit(req.GetItemId())
+	if err != nil {
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
+	}
+	mu.runOp(func() {
+		mu.halted.Store(false)
+		mu.uncrossBook(ctx)
+	})
+
+	auditAdmin(ctx, req.GetOperatorId(), "resume_item", req.GetItemId(), "", nil)
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	return
+}
+
+// AdminUserOrderItems 获取用户挂单涉及的道具ID（按用户强制撤单时按道具分发到撮合单元所在实例）
+func (m *AuctionManager) AdminUserOrderItems(ctx context.Context, userId string) ([]string, error) {
+	orderKeys := make([]string, 0)
+	for _, direction := range []string{"sell", "buy"} {
+		keys, err := redis.GetRedis().SMembers(ctx, userOrdersKey(userId, direction)).Result()
+		if err != nil {
+			return nil, err
+		}
+		orderKeys = append(orderKeys, keys...)
+	}
+
+	itemIds, err := orderItemIds(ctx, orderKeys)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	items := make([]string, 0)
+	for _, itemId := range itemIds {
+		if itemId != "" && !seen[itemId] {
+			seen[itemId] = true
+			items = append(items, itemId)
+		}
+	}
+	return items, nil
+}
+
+// orderItemIds 批量获取订单的道具ID，订单不存在时为空字符串
+func orderItemIds(ctx context.Context, orderKeys []string) ([]string, error) {
+	pipe := redis.GetRedis().Pipeline()
+	cmds := make([]*goredis.StringCmd, 0, len(orderKeys))
+	for _, key := range orderKeys {
+		cmds = append(cmds, pipe.HGet(ctx, key, "item_id"))
+	}
+	if len(cmds) > 0 {
+		if _, err := pipe.Exec(ctx); err != nil && err != goredis.Nil {
+			return nil, err
+		}
+	}
+	itemIds := make([]string, 0, len(cmds))
+	for _, cmd := range cmds {
+		itemIds = append(itemIds, cmd.Val())
+	}
+	return itemIds, nil
+}
+
+// AdminCancelOrders 强制撤销道具上的订单（可限定用户），退还托管
+func (m *AuctionManager) AdminCancelOrders(ctx context.Context, req *auction_admin.AdminCancelOrdersReq) (resp *auction_admin.AdminCancelOrdersRsp, err error) {
+	resp = &auction_admin.AdminCancelOrdersRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	if err = checkOperator(req.GetOperatorId()); err != nil {
+		resp.Code = common.ErrorCode_AUCTION_ADMIN_DENIED
+		resp.Msg = err.Error()
+		err = nil
+		return
+	}
+	if req.GetItemId() == "" {
+		resp.Msg = "item_id is empty"
+		return
+	}
+
+	// 指定用户时从用户订单列表中查找，否则从全局订单列表中查找该道具的订单
+	orderKeys := make([]string, 0)
+	for _, direction := range []string{"sell", "buy"} {
+		setKey := ordersKey(direction)
+		if req.GetUserId() != "" {
+			setKey = userOrdersKey(req.GetUserId(), direction)
+		}
+		keys, err2 := redis.GetRedis().SMembers(ctx, setKey).Result()
+		if err2 != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-ADMIN] get orders error: setKey=%s, error: %s", setKey, err2.Error())
+			resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+			resp.Msg = "get orders error"
+			return
+		}
+		orderKeys = append(orderKeys, keys...)
+	}
+	itemIds, err := orderItemIds(ctx, orderKeys)
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-ADMIN] get order items error: %s", err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "get orders error"
+		err = nil
+		return
+	}
+
+	var cancelled int32
+	mu, err := getMatchManager().GetMatchUnit(req.GetItemId())
+	if err != nil {
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
+	}
+	mu.runOp(func() {
+		// 撤单按Redis中的剩余数量退还托管，先等待已撮合的成交落库
+		mu.settler.flush()
+		for i, key := range orderKeys {
+			if itemIds[i] != req.GetItemId() {
+				continue
+			}
+			direction, orderId, ok := parseOrderKey(key)
+			if !ok {
+				continue
+			}
+			if direction == "sell" {
+				mu.RemoveSellOrder(ctx, orderId)
+			} else {
+				mu.RemoveBuyOrder(ctx, orderId)
+			}
+			order, closeErr := closeOrder(ctx, direction, orderId, "取消",
+				"auction:admin_cancel:"+orderId, "auction_admin_cancel_"+direction, 0)
+			if closeErr != nil {
+				klog.CtxErrorf(ctx, "[AUCTION-ADMIN] cancel order error: orderId=%s, direction=%s, error: %s",
+					orderId, direction, closeErr.Error())
+				continue
+			}
+			if order != nil {
+				cancelled++
+			}
+		}
+	})
+
+	auditAdmin(ctx, req.GetOperatorId(), "cancel_orders", req.GetItemId(), req.GetUserId(), map[string]interface{}{
+		"reason":    req.GetReason(),
+		"cancelled": cancelled,
+	})
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	resp.Cancelled = cancelled
+	return
+}
+
+// AdminResetReferencePrice 重置道具参考价（小时均价），丢弃当前小时已累计的成交数据
+func (m *AuctionManager) AdminResetReferencePrice(ctx context.Context, req *auction_admin.AdminResetReferencePriceReq) (resp *auction_admin.AdminResetReferencePriceRsp, err error) {
+	resp = &auction_admin.AdminResetReferencePriceRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	if err = checkOperator(req.GetOperatorId()); err != nil {
+		resp.Code = common.ErrorCode_AUCTION_ADMIN_DENIED
+		resp.Msg = err.Error()
+		err = nil
+		return
+	}
+	if req.GetItemId() == "" {
+		resp.Msg = "item_id is empty"
+		return
+	}
+	if req.GetPrice() <= 0 {
+		resp.Msg = "price must be greater than 0"
+		return
+	}
+
+	var oldPrice int64
+	mu, err := getMatchManager().GetMatchUnit(req.GetItemId())
+	if err != nil {
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
+	}
+	mu.runOp(func() {
+		oldPrice = mu.hourlyAvgPrice
+		mu.hourlyAvgPrice = req.GetPrice()
+		mu.hourlyTotalPrice = 0
+		mu.hourlyTotalQty = 0
+		mu.appendEvent(ctx, mu.hourlyEvent(&matchEvent{Type: eventReprice}))
+	})
+	if err = redis.GetRedis().Set(ctx, "auction:hourly:price:"+req.GetItemId(), req.GetPrice(), 24*time.Hour*7).Err(); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-ADMIN] save reference price error: itemId=%s, error: %s", req.GetItemId(), err.Error())
+		err = nil
+	}
+
+	auditAdmin(ctx, req.GetOperatorId(), "reset_reference_price", req.GetItemId(), "", map[string]interface{}{
+		"old_price": oldPrice,
+		"new_price": req.GetPrice(),
+	})
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	resp.OldPrice = oldPrice
+	return
+}
+
+// AdminDumpBook 导出撮合单元的内存订单簿和状态
+func (m *AuctionManager) AdminDumpBook(ctx context.Context, req *auction_admin.AdminDumpBookReq) (resp *auction_admin.AdminDumpBookRsp, err error) {
+	resp = &auction_admin.AdminDumpBookRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	if err = checkOperator(req.GetOperatorId()); err != nil {
+		resp.Code = common.ErrorCode_AUCTION_ADMIN_DENIED
+		resp.Msg = err.Error()
+		err = nil
+		return
+	}
+	if req.GetItemId() == "" {
+		resp.Msg = "item_id is empty"
+		return
+	}
+
+	mu, err := getMatchManager().GetMatchUnit(req.GetItemId())
+	if err != nil {
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
+	}
+	mu.runOp(func() {
+		resp.Sells = make([]*auction.SellData, 0, mu.sellOrders.Len())
+		mu.sellOrders.Ascend(func(item btree.Item) bool {
+			resp.Sells = append(resp.Sells, proto.Clone((*auction.SellData)(item.(*SellOrderByPriceAsc))).(*auction.SellData))
+			return true
+		})
+		resp.Buys = make([]*auction.BuyData, 0, mu.buyOrders.Len())
+		mu.buyOrders.Ascend(func(item btree.Item) bool {
+			resp.Buys = append(resp.Buys, proto.Clone((*auction.BuyData)(item.(*BuyOrderByPriceDesc))).(*auction.BuyData))
+			return true
+		})
+		resp.Halted = mu.halted.Load()
+		resp.HourlyAvgPrice = mu.hourlyAvgPrice
+		resp.EventSeq = mu.eventSeq
+	})
+	if getMatchManager().ownership.Distributed() {
+		resp.Owner = advertiseAddr()
+	}
+
+	auditAdmin(ctx, req.GetOperatorId(), "dump_book", req.GetItemId(), "", nil)
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	return
+}
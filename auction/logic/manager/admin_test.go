@@ -0,0 +1,137 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/auction_admin"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 测试用例: 运维暂停/恢复交易、强制撤单、重置参考价和导出订单簿
+func TestAuctionManager_AdminControls(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	fake := newFakeInventory()
+	inventory = fake
+	defer func() { inventory = newFakeInventory() }()
+
+	itemId := "test_item_admin"
+	operatorId := "test_operator"
+	sellUserId := "test_user_admin_seller"
+	buyUserId := "test_user_admin_buyer"
+	sellCtx := context.WithValue(ctx, "userId", sellUserId)
+	buyCtx := context.WithValue(ctx, "userId", buyUserId)
+	currency := currencyItemId()
+	manager := GetAuctionManager()
+	idem := func() string { return "test_admin_" + strconv.FormatInt(time.Now().UnixNano(), 10) }
+
+	// 1. 未填写操作人时拒绝
+	haltResp, err := manager.AdminHaltItem(ctx, &auction_admin.AdminHaltItemReq{ItemId: itemId})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_ADMIN_DENIED, haltResp.Code)
+
+	// 2. 挂出不交叉的卖单和买单后暂停交易，新订单被拒绝，撤单照常
+	sellResp, err := manager.Sell(sellCtx, &auction.SellReq{ItemId: itemId, Quantity: 2, Price: 105, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, sellResp.Code)
+	time.Sleep(10 * time.Millisecond)
+	buyResp, err := manager.Buy(buyCtx, &auction.BuyReq{ItemId: itemId, Quantity: 2, Price: 95, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, buyResp.Code)
+
+	haltResp, err = manager.AdminHaltItem(ctx, &auction_admin.AdminHaltItemReq{OperatorId: operatorId, ItemId: itemId, Reason: "test"})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, haltResp.Code)
+	resp, err := manager.Buy(buyCtx, &auction.BuyReq{ItemId: itemId, Quantity: 1, Price: 105, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_TRADING_HALTED, resp.Code)
+	halted, err := redis.GetRedis().HExists(ctx, haltedItemsKey, itemId).Result()
+	assert.NoError(t, err)
+	assert.True(t, halted)
+
+	// 3. 暂停期间卖单价格被调整到与买单交叉（模拟暂停前已通过检查的订单），恢复后按时间顺序撮合
+	mu := testMatchUnit(itemId)
+	mu.runOp(func() {
+		item := mu.sellOrders.Min()
+		order := item.(*SellOrderByPriceAsc)
+		mu.sellOrders.Delete(item)
+		order.Price = 95
+		mu.sellOrders.ReplaceOrInsert(order)
+	})
+	redis.GetRedis().HSet(ctx, sellOrderKey(sellResp.Data.OrderId), "price", 95)
+
+	dumpResp, err := manager.AdminDumpBook(ctx, &auction_admin.AdminDumpBookReq{OperatorId: operatorId, ItemId: itemId})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, dumpResp.Code)
+	assert.True(t, dumpResp.Halted)
+	assert.Len(t, dumpResp.Sells, 1)
+	assert.Len(t, dumpResp.Buys, 1)
+
+	resumeResp, err := manager.AdminResumeItem(ctx, &auction_admin.AdminResumeItemReq{OperatorId: operatorId, ItemId: itemId})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, resumeResp.Code)
+	getMatchManager().settlePending(ctx)
+	assert.Equal(t, int64(2), fake.balance(buyUserId, itemId))
+	assert.Equal(t, int64(-190), fake.balance(buyUserId, currency))
+
+	dumpResp, err = manager.AdminDumpBook(ctx, &auction_admin.AdminDumpBookReq{OperatorId: operatorId, ItemId: itemId})
+	assert.NoError(t, err)
+	assert.False(t, dumpResp.Halted)
+	assert.Len(t, dumpResp.Sells, 0)
+	assert.Len(t, dumpResp.Buys, 0)
+
+	// 4. 强制撤销用户订单，退还托管
+	buyResp, err = manager.Buy(buyCtx, &auction.BuyReq{ItemId: itemId, Quantity: 3, Price: 100, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, buyResp.Code)
+	getMatchManager().settlePending(ctx)
+	assert.Equal(t, int64(-490), fake.balance(buyUserId, currency))
+
+	items, err := manager.AdminUserOrderItems(ctx, buyUserId)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{itemId}, items)
+
+	cancelResp, err := manager.AdminCancelOrders(ctx, &auction_admin.AdminCancelOrdersReq{
+		OperatorId: operatorId, UserId: buyUserId, ItemId: itemId, Reason: "test"})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, cancelResp.Code)
+	assert.Equal(t, int32(1), cancelResp.Cancelled)
+	getMatchManager().settlePending(ctx)
+	assert.Equal(t, int64(-190), fake.balance(buyUserId, currency))
+	status, err := redis.GetRedis().HGet(ctx, orderStatusKey(buyResp.Data.OrderId), "status").Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "取消", status)
+
+	// 5. 重置参考价
+	priceResp, err := manager.AdminResetReferencePrice(ctx, &auction_admin.AdminResetReferencePriceReq{
+		OperatorId: operatorId, ItemId: itemId, Price: 500})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, priceResp.Code)
+	dumpResp, err = manager.AdminDumpBook(ctx, &auction_admin.AdminDumpBookReq{OperatorId: operatorId, ItemId: itemId})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500), dumpResp.HourlyAvgPrice)
+	saved, err := redis.GetRedis().Get(ctx, "auction:hourly:price:"+itemId).Int64()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500), saved)
+
+	// 6. 每次成功的运维操作都写入审计流
+	audits, err := redis.GetRedis().XRange(ctx, adminAuditStreamKey, "-", "+").Result()
+	assert.NoError(t, err)
+	actions := make([]string, 0, len(audits))
+	for _, entry := range audits {
+		actions = append(actions, entry.Values["action"].(string))
+	}
+	assert.Equal(t, []string{"halt_item", "dump_book", "resume_item", "dump_book", "cancel_orders", "reset_reference_price", "dump_book"}, actions)
+}
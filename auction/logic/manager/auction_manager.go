@@ -4,14 +4,17 @@ import (
 	"auction_module/kitex_gen/auction"
 	"auction_module/kitex_gen/common"
 	"auction_module/redis"
+	"auction_module/redis/script"
 	"context"
 	"fmt"
+	"math"
 	"strconv"
 	"sync"
 	"time"
 
 	"github.com/bwmarrin/snowflake"
 	"github.com/cloudwego/kitex/pkg/klog"
+	goredis "github.com/redis/go-redis/v9"
 )
 
 type AuctionManager struct {
@@ -41,21 +44,8 @@ func (m *AuctionManager) releaseLock(ctx context.Context, userId string) error {
 
 // 检查幂等性，返回已存在的结果或插入"正在处理"状态
 func (m *AuctionManager) checkIdempotency(ctx context.Context, idempotentKey string, prefix string) (map[string]interface{}, error) {
-	// 使用Lua脚本实现原子操作：检查是否存在幂等键，如果不存在则插入"正在处理"状态
-	luaScript := `
-		local key = KEYS[1]
-		local exists = redis.call('EXISTS', key)
-		if exists == 1 then
-			local result = redis.call('HGETALL', key)
-			return result
-		else
-			redis.call('HMSET', key, 'code', 1306, 'msg', 'processing', 'timestamp', ARGV[1])
-			redis.call('EXPIRE', key, 2592000) -- 1个月过期
-			return {}
-		end
-	`
-
-	result, luaErr := redis.GetRedis().Eval(ctx, luaScript, []string{idempotentKey}, time.Now().Unix()).Result()
+	// 原子操作：检查是否存在幂等键，如果不存在则插入"正在处理"状态
+	result, luaErr := script.Run(ctx, redis.GetRedis(), script.CheckIdempotency, []string{idempotentKey}, time.Now().Unix()).Result()
 	if luaErr != nil {
 		klog.CtxErrorf(ctx, "%s Lua script error: %s", prefix, luaErr.Error())
 		return nil, luaErr
@@ -78,12 +68,76 @@ func (m *AuctionManager) checkIdempotency(ctx context.Context, idempotentKey str
 	return resultMap, nil
 }
 
+// withIdempotency 写操作的公共流程：用户锁、幂等检查、结果缓存
+// op返回结果对象ID（如挂单ID），重放请求时replay为true且只返回缓存的对象ID，由调用方重新读取对象当前状态
+func (m *AuctionManager) withIdempotency(ctx context.Context, logTag string, userId string, idempotentId string,
+	op func() (string, common.ErrorCode, string)) (resultId string, replay bool, code common.ErrorCode, msg string) {
+	if userId == "" {
+		return "", false, common.ErrorCode_AUCTION_USER_NOT_FOUND, "user_id is empty"
+	}
+
+	// 获取分布式锁（5秒超时）
+	lockAcquired, err := m.checkLock(ctx, userId)
+	if err != nil {
+		klog.CtxErrorf(ctx, "%s acquire lock error: %s", logTag, err.Error())
+		return "", false, common.ErrorCode_AUCTION_REDIS_ERROR, "acquire lock error"
+	}
+	if !lockAcquired {
+		klog.CtxInfof(ctx, "%s User %s is busy", logTag, userId)
+		return "", false, common.ErrorCode_AUCTION_PARAM_ERROR, "user is busy"
+	}
+	defer func() {
+		_ = m.releaseLock(ctx, userId)
+	}()
+
+	// 幂等性检查
+	if idempotentId == "" {
+		return "", false, common.ErrorCode_AUCTION_PARAM_ERROR, "idempotent_id is empty"
+	}
+	idempotentKey := "auction:idempotent:" + userId + ":" + idempotentId
+	resultMap, err := m.checkIdempotency(ctx, idempotentKey, logTag)
+	if err != nil {
+		klog.CtxErrorf(ctx, "%s Check idempotent error: %s", logTag, err.Error())
+		return "", false, common.ErrorCode_AUCTION_REDIS_ERROR, "check idempotent error"
+	}
+	if len(resultMap) > 0 {
+		codeValue, _ := strconv.ParseInt(fmt.Sprintf("%v", resultMap["code"]), 10, 32)
+		code, msg = common.ErrorCode(codeValue), fmt.Sprintf("%v", resultMap["msg"])
+		if id, ok := resultMap["result_id"]; ok {
+			resultId = fmt.Sprintf("%v", id)
+		}
+		klog.CtxWarnf(ctx, "%s Idempotent request detected, returning cached result: idempotentId=%s", logTag, idempotentId)
+		return resultId, true, code, msg
+	}
+
+	defer func() {
+		klog.CtxInfof(ctx, "%s result: userId: %s, resultId: %s, resp: %d", logTag, userId, resultId, code)
+
+		// 统一处理幂等性结果存储到Redis
+		data := map[string]interface{}{
+			"code":      int(code),
+			"msg":       msg,
+			"timestamp": time.Now().Unix(),
+		}
+		if code == common.ErrorCode_OK && resultId != "" {
+			data["result_id"] = resultId
+		}
+		if hmsetErr := redis.GetRedis().HMSet(ctx, idempotentKey, data).Err(); hmsetErr != nil {
+			klog.CtxErrorf(ctx, "%s Store idempotent result error: %s", logTag, hmsetErr.Error())
+		}
+		if expireErr, _ := redis.GetRedis().Expire(ctx, idempotentKey, 30*24*time.Hour).Result(); !expireErr {
+			klog.CtxErrorf(ctx, "%s Set idempotent key expire error", logTag)
+		}
+	}()
+
+	resultId, code, msg = op()
+	return resultId, false, code, msg
+}
+
 var (
 	auctionManager *AuctionManager
 	once           sync.Once
 	idClient       *snowflake.Node
-	maxSellCount   int32 = 8
-	maxBuyCount    int32 = 8
 )
 
 func GetAuctionManager() *AuctionManager {
@@ -110,6 +164,25 @@ func GetAuctionManager() *AuctionManager {
 	return auctionManager
 }
 
+// RouteItem 返回道具撮合单元所在实例，local为true时由本实例处理
+func (m *AuctionManager) RouteItem(ctx context.Context, itemId string) (owner string, local bool, err error) {
+	return getMatchManager().RouteItem(ctx, itemId)
+}
+
+// GetOrderItemId 获取订单对应的道具ID，direction为sell或buy，订单不存在时返回空字符串
+func (m *AuctionManager) GetOrderItemId(ctx context.Context, direction string, orderId string) (string, error) {
+	itemId, err := redis.GetRedis().HGet(ctx, orderKey(direction, orderId), "item_id").Result()
+	if err == goredis.Nil {
+		return "", nil
+	}
+	return itemId, err
+}
+
+// Close 交出本实例持有的所有撮合单元
+func (m *AuctionManager) Close(ctx context.Context) {
+	getMatchManager().Close(ctx)
+}
+
 func (m *AuctionManager) Ping(ctx context.Context, req *auction.PingReq) (resp *auction.PingRsp, err error) {
 	resp = &auction.PingRsp{
 		Code: common.ErrorCode_OK,
@@ -189,6 +262,8 @@ func (m *AuctionManager) Sell(ctx context.Context, req *auction.SellReq) (resp *
 				Price:      parseInt64(fmt.Sprintf("%v", resultMap["price"])),
 				ItemInfo:   fmt.Sprintf("%v", resultMap["item_info"]),
 				CreateTime: parseInt64(fmt.Sprintf("%v", resultMap["create_time"])),
+				ExpireTime: parseInt64(fmt.Sprintf("%v", resultMap["expire_time"])),
+				OrderType:  auction.OrderType(parseInt(fmt.Sprintf("%v", resultMap["order_type"]))),
 			}
 			resp.Data = sellData
 		}
@@ -220,6 +295,8 @@ func (m *AuctionManager) Sell(ctx context.Context, req *auction.SellReq) (resp *
 			data["price"] = sellData.Price
 			data["item_info"] = sellData.ItemInfo
 			data["create_time"] = sellData.CreateTime
+			data["expire_time"] = sellData.ExpireTime
+			data["order_type"] = int(sellData.OrderType)
 		}
 
 		hmsetErr := redis.GetRedis().HMSet(ctx, idempotentKey, data).Err()
@@ -246,7 +323,12 @@ func (m *AuctionManager) Sell(ctx context.Context, req *auction.SellReq) (resp *
 		klog.CtxInfof(ctx, "[AUCTION-MGR-SELL] User %s quantity must be greater than 0, cannot sell", userId)
 		return
 	}
-	if req.GetPrice() <= 0 {
+	if _, ok := auction.OrderType_name[int32(req.GetOrderType())]; !ok {
+		resp.Msg = "invalid order_type"
+		klog.CtxInfof(ctx, "[AUCTION-MGR-SELL] User %s invalid order_type %d, cannot sell", userId, req.GetOrderType())
+		return
+	}
+	if req.GetOrderType() != auction.OrderType_MARKET && req.GetPrice() <= 0 {
 		resp.Msg = "price must be greater than 0"
 		klog.CtxInfof(ctx, "[AUCTION-MGR-SELL] User %s price must be greater than 0, cannot sell", userId)
 		return
@@ -257,9 +339,25 @@ func (m *AuctionManager) Sell(ctx context.Context, req *auction.SellReq) (resp *
 		klog.CtxInfof(ctx, "[AUCTION-MGR-SELL] User %s user_id is empty, cannot sell", userId)
 		return
 	}
+	createTime := time.Now().Unix()
+	expireTime, expireErr := resolveExpireTime(req.GetExpireTime(), createTime)
+	if expireErr != nil {
+		resp.Msg = expireErr.Error()
+		klog.CtxInfof(ctx, "[AUCTION-MGR-SELL] User %s invalid expire_time %d: %s", userId, req.GetExpireTime(), expireErr.Error())
+		return
+	}
 
-	// 销售限制检查：确保单个用户在拍卖系统中最多只能销售maxSellCount单商品
-	userSellsKey = "user:" + userId + ":sells"
+	// 按道具交易规则检查数量
+	rule := getItemRule(req.GetItemId())
+	if req.GetQuantity() < rule.MinQuantity {
+		klog.CtxInfof(ctx, "[AUCTION-MGR-SELL] Quantity too small, userId: %s, itemId: %s, quantity: %d, minQuantity: %d", userId, req.GetItemId(), req.GetQuantity(), rule.MinQuantity)
+		resp.Code = common.ErrorCode_AUCTION_QUANTITY_TOO_SMALL
+		resp.Msg = "quantity too small"
+		return
+	}
+
+	// 销售限制检查：确保单个用户在拍卖系统中最多只能持有规则允许数量的出售挂单
+	userSellsKey = userOrdersKey(userId, "sell")
 	sellCount, err := redis.GetRedis().SCard(ctx, userSellsKey).Result()
 	if err != nil {
 		klog.CtxErrorf(ctx, "[AUCTION-MGR-SELL] Get user sells count error: %s", err.Error())
@@ -267,90 +365,92 @@ func (m *AuctionManager) Sell(ctx context.Context, req *auction.SellReq) (resp *
 		resp.Msg = "get user sells count error"
 		return
 	}
-	if int32(sellCount) >= maxSellCount {
-		klog.CtxErrorf(ctx, "[AUCTION-MGR-SELL] Sell count exceeds limit, userId: %s, currentCount: %d, maxLimit: %d", userId, sellCount, maxSellCount)
-		resp.Code = common.ErrorCode_AUCTION_PARAM_ERROR
+	if int32(sellCount) >= rule.MaxSellOrders {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-SELL] Sell count exceeds limit, userId: %s, currentCount: %d, maxLimit: %d", userId, sellCount, rule.MaxSellOrders)
+		resp.Code = common.ErrorCode_AUCTION_ORDER_LIMIT_EXCEEDED
 		resp.Msg = "sell count exceeds limit"
 		return
 	}
 
-	// 价格限制检查：所有买卖价格必须控制在matchunit.hourlyAvgPrice的±10%范围内
-	mu = getMatchManager().GetMatchUnit(req.GetItemId())
+	// 道具归属已转移到其他实例时拒绝，道具被运维暂停交易时拒绝新订单
+	if mu, err = getMatchManager().GetMatchUnit(req.GetItemId()); err != nil {
+		klog.CtxWarnf(ctx, "[AUCTION-MGR-SELL] Get match unit error, userId: %s, itemId: %s, error: %s", userId, req.GetItemId(), err.Error())
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
+	}
+	if mu.halted.Load() {
+		klog.CtxInfof(ctx, "[AUCTION-MGR-SELL] Trading halted, userId: %s, itemId: %s", userId, req.GetItemId())
+		resp.Code = common.ErrorCode_AUCTION_TRADING_HALTED
+		resp.Msg = "trading halted"
+		return
+	}
+	// 撮合队列已满时在托管前拒绝
+	if mu.busy() {
+		klog.CtxWarnf(ctx, "[AUCTION-MGR-SELL] Market busy, userId: %s, itemId: %s", userId, req.GetItemId())
+		resp.Code, resp.Msg = queueErrorCode(errMarketBusy)
+		return
+	}
+
+	// 价格限制检查：价格必须在规则根据matchunit.hourlyAvgPrice计算的价格区间内，且为最小价格变动单位的整数倍
 	avgPrice := mu.hourlyAvgPrice
-	minPrice := int64(float64(avgPrice) * 0.9)
-	maxPrice := int64(float64(avgPrice) * 1.1)
-	if req.GetPrice() < minPrice || req.GetPrice() > maxPrice {
+	minPrice, maxPrice := rule.priceRange(avgPrice)
+	price := req.GetPrice()
+	if req.GetOrderType() == auction.OrderType_MARKET {
+		// 市价卖单以价格区间下限作为保护价，可与区间内任意买单成交
+		price = rule.tickUp(minPrice)
+		if price <= 0 || price > maxPrice {
+			klog.CtxErrorf(ctx, "[AUCTION-MGR-SELL] Market price unavailable, userId: %s, itemId: %s, avgPrice: %d", userId, req.GetItemId(), avgPrice)
+			resp.Code = common.ErrorCode_AUCTION_PARAM_ERROR
+			resp.Msg = "market price unavailable"
+			return
+		}
+	} else if price < minPrice || price > maxPrice {
 		klog.CtxErrorf(ctx, "[AUCTION-MGR-SELL] Price exceeds limit, userId: %s, itemId: %s, price: %d, minPrice: %d, maxPrice: %d, avgPrice: %d", userId, req.GetItemId(), req.GetPrice(), minPrice, maxPrice, avgPrice)
-		resp.Code = common.ErrorCode_AUCTION_PARAM_ERROR
+		resp.Code = common.ErrorCode_AUCTION_PRICE_OUT_OF_BAND
 		resp.Msg = "price exceeds limit"
 		return
+	} else if !rule.onTick(price) {
+		klog.CtxInfof(ctx, "[AUCTION-MGR-SELL] Price not on tick, userId: %s, itemId: %s, price: %d, tickSize: %d", userId, req.GetItemId(), price, rule.TickSize)
+		resp.Code = common.ErrorCode_AUCTION_PRICE_TICK_INVALID
+		resp.Msg = "price is not a multiple of tick size"
+		return
 	}
 
 	// 使用雪花算法生成order_id
 	orderId := idClient.Generate().String()
 
+	// 托管出售道具：以订单ID作为幂等ID从卖家背包中扣除
+	if err = inventory.EscrowItem(ctx, userId, req.GetItemId(), int64(req.GetQuantity()), "auction_sell", "auction:sell:"+orderId); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-SELL] Escrow item error, userId: %s, itemId: %s, quantity: %d, error: %s", userId, req.GetItemId(), req.GetQuantity(), err.Error())
+		resp.Code, resp.Msg = escrowItemError(err)
+		err = nil
+		return
+	}
+
 	// 创建SellData结构体
 	sellData := &auction.SellData{
 		OrderId:    orderId,
 		ItemId:     req.GetItemId(),
 		Quantity:   req.GetQuantity(),
-		Price:      req.GetPrice(),
+		Price:      price,
 		ItemInfo:   req.GetItemInfo(),
-		CreateTime: time.Now().Unix(),
+		CreateTime: createTime,
+		ExpireTime: expireTime,
+		OrderType:  req.GetOrderType(),
 	}
 
 	// 计算各种key
-	key := "auction:sell:" + orderId
-	statusKey := "auction:order:" + orderId + ":status"
-	userTimeKey := "user:" + userId + ":transactions:time"
-	userSellsKey = "user:" + userId + ":sells"
+	key := orderKey("sell", orderId)
+	statusKey := orderStatusKey(orderId)
+	userSellsKey = userOrdersKey(userId, "sell")
 
 	// 使用Lua脚本将数据存入Redis：
 	// 1. 将订单信息存入 auction:sell:{orderId}（包含order_id字段）
-	// 2. 将订单ID添加到用户的出售列表中
-	// 3. 将订单ID添加到全局出售列表中
-	// 4. 记录订单状态到新的Redis结构
-	var luaScript string
-	luaScript = ` 
-		-- 将订单信息存入hash表（包含order_id字段）
-		redis.call('HMSET', KEYS[1], 
-			'order_id', ARGV[7],
-			'item_id', ARGV[1],
-			'quantity', ARGV[2],
-			'price', ARGV[3],
-			'item_info', ARGV[4],
-			'create_time', ARGV[5],
-			'user_id', ARGV[6]
-		)
-		
-		-- 将订单ID添加到用户的出售列表
-		redis.call('SADD', ARGV[8], KEYS[1])
-		
-		-- 将订单ID添加到全局出售列表
-		redis.call('SADD', 'auction:sells', KEYS[1])
-		
-		-- 记录订单状态到新的Redis结构
-		redis.call('HMSET', KEYS[2], 
-			'order_id', ARGV[7],
-			'trade_direction', 'sell',
-			'status', '卖',
-			'quantity', ARGV[2],
-			'price', ARGV[3],
-			'final_price', 0,
-			'final_quantity', 0,
-			'item_id', ARGV[1],
-			'tax', 0,
-			'create_time', ARGV[5],
-			'user_id', ARGV[6]
-		)
-		
-		-- 添加到用户交易时间排序集合（按用户维度的时间排序）
-		redis.call('ZADD', KEYS[3], ARGV[5], ARGV[7])
-		
-		return 1
-	`
-
-	if _, err = redis.GetRedis().Eval(ctx, luaScript, []string{key, statusKey, userTimeKey},
+	// 2. 记录订单状态到新的Redis结构
+	// 3. 用户出售列表、全局出售列表、道具挂单索引和用户时间索引位于其他slot，写入订单outbox
+
+	if _, err = runWithOutbox(ctx, script.PlaceSellOrder, []string{key, statusKey, orderOutboxKey(orderId)},
 		sellData.ItemId,
 		sellData.Quantity,
 		sellData.Price,
@@ -359,20 +459,38 @@ func (m *AuctionManager) Sell(ctx context.Context, req *auction.SellReq) (resp *
 		userId,
 		orderId,
 		userSellsKey,
+		sellData.ExpireTime,
+		int(sellData.OrderType),
+		sellOrdersKey,
+		userTransactionsTimeKey(userId),
+		itemOrdersKey(sellData.ItemId, "sell"),
+		orderItemsKey,
 	).Result(); err != nil {
 		klog.CtxErrorf(ctx, "[AUCTION-MGR-SELL] redis eval error: %s", err.Error())
 		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
 		resp.Msg = "save to redis error"
+		// 订单未落地，退还已托管的道具
+		if refundErr := inventory.AddItem(ctx, userId, sellData.ItemId, int64(sellData.Quantity), "auction_sell_rollback", "auction:sell:"+orderId+":rollback"); refundErr != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-MGR-SELL] Rollback escrow error, userId: %s, orderId: %s, error: %s", userId, orderId, refundErr.Error())
+		}
 		return
 	}
 
-	mu = getMatchManager().GetMatchUnit(sellData.ItemId)
-	r := make(chan bool, 0)
-	mu.opChannel <- func() {
-		mu.AddSellOrder(ctx, sellData)
-		r <- true
+	// 托管和写入Redis期间道具归属可能已转移，撮合单元不在本实例时关闭订单并退还托管
+	if mu, err = getMatchManager().GetMatchUnit(sellData.ItemId); err != nil {
+		rejectOrder(ctx, sellData.ItemId, "sell", orderId, err)
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
+	}
+	if err = mu.placeOrder(ctx, "sell", orderId, func() {
+		mu.bindParty("sell", orderId, userId, 0)
+		mu.PlaceSellOrder(ctx, sellData)
+	}); err != nil {
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
 	}
-	<-r
 	// 更新响应为成功状态
 	resp.Code = common.ErrorCode_OK
 	resp.Msg = "success"
@@ -381,9 +499,11 @@ func (m *AuctionManager) Sell(ctx context.Context, req *auction.SellReq) (resp *
 		OrderId:    orderId,
 		ItemId:     req.GetItemId(),
 		Quantity:   req.GetQuantity(),
-		Price:      req.GetPrice(),
+		Price:      price,
 		ItemInfo:   req.GetItemInfo(),
-		CreateTime: sellData.CreateTime,
+		CreateTime: createTime,
+		ExpireTime: expireTime,
+		OrderType:  req.GetOrderType(),
 	}
 
 	return
@@ -459,6 +579,8 @@ func (m *AuctionManager) Buy(ctx context.Context, req *auction.BuyReq) (resp *au
 				Quantity:   int32(parseInt(fmt.Sprintf("%v", resultMap["quantity"]))),
 				Price:      parseInt64(fmt.Sprintf("%v", resultMap["price"])),
 				CreateTime: parseInt64(fmt.Sprintf("%v", resultMap["create_time"])),
+				ExpireTime: parseInt64(fmt.Sprintf("%v", resultMap["expire_time"])),
+				OrderType:  auction.OrderType(parseInt(fmt.Sprintf("%v", resultMap["order_type"]))),
 			}
 			resp.Data = buyData
 		}
@@ -489,6 +611,8 @@ func (m *AuctionManager) Buy(ctx context.Context, req *auction.BuyReq) (resp *au
 			data["quantity"] = buyData.Quantity
 			data["price"] = buyData.Price
 			data["create_time"] = buyData.CreateTime
+			data["expire_time"] = buyData.ExpireTime
+			data["order_type"] = int(buyData.OrderType)
 		}
 
 		if hmsetErr := redis.GetRedis().HMSet(ctx, idempotentKey, data).Err(); hmsetErr != nil {
@@ -513,7 +637,12 @@ func (m *AuctionManager) Buy(ctx context.Context, req *auction.BuyReq) (resp *au
 		klog.CtxInfof(ctx, "[AUCTION-MGR-BUY] User %s quantity must be greater than 0, cannot buy", userId)
 		return
 	}
-	if req.GetPrice() <= 0 {
+	if _, ok := auction.OrderType_name[int32(req.GetOrderType())]; !ok {
+		resp.Msg = "invalid order_type"
+		klog.CtxInfof(ctx, "[AUCTION-MGR-BUY] User %s invalid order_type %d, cannot buy", userId, req.GetOrderType())
+		return
+	}
+	if req.GetOrderType() != auction.OrderType_MARKET && req.GetPrice() <= 0 {
 		resp.Msg = "price must be greater than 0"
 		klog.CtxInfof(ctx, "[AUCTION-MGR-BUY] User %s price must be greater than 0, cannot buy", userId)
 		return
@@ -524,96 +653,131 @@ func (m *AuctionManager) Buy(ctx context.Context, req *auction.BuyReq) (resp *au
 		klog.CtxInfof(ctx, "[AUCTION-MGR-BUY] User %s user_id is empty, cannot buy", userId)
 		return
 	}
+	createTime := time.Now().Unix()
+	expireTime, expireErr := resolveExpireTime(req.GetExpireTime(), createTime)
+	if expireErr != nil {
+		resp.Msg = expireErr.Error()
+		klog.CtxInfof(ctx, "[AUCTION-MGR-BUY] User %s invalid expire_time %d: %s", userId, req.GetExpireTime(), expireErr.Error())
+		return
+	}
+
+	// 按道具交易规则检查数量
+	rule := getItemRule(req.GetItemId())
+	if req.GetQuantity() < rule.MinQuantity {
+		klog.CtxInfof(ctx, "[AUCTION-MGR-BUY] Quantity too small, userId: %s, itemId: %s, quantity: %d, minQuantity: %d", userId, req.GetItemId(), req.GetQuantity(), rule.MinQuantity)
+		resp.Code = common.ErrorCode_AUCTION_QUANTITY_TOO_SMALL
+		resp.Msg = "quantity too small"
+		return
+	}
 
-	// 购买限制检查：确保单个用户在拍卖系统中最多只能购买maxBuyCount单商品
-	userBuysKey = "user:" + userId + ":buys"
+	// 购买限制检查：确保单个用户在拍卖系统中最多只能持有规则允许数量的求购挂单
+	userBuysKey = userOrdersKey(userId, "buy")
 	if buyCount, err2 := redis.GetRedis().SCard(ctx, userBuysKey).Result(); err2 != nil {
 		klog.CtxErrorf(ctx, "[AUCTION-MGR-BUY] Get user buys count error: %s", err2.Error())
 		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
 		resp.Msg = "get user buys count error"
 		return
-	} else if int32(buyCount) >= maxBuyCount {
-		klog.CtxErrorf(ctx, "[AUCTION-MGR-BUY] Buy count exceeds limit, userId: %s, currentCount: %d, maxLimit: %d", userId, buyCount, maxBuyCount)
-		resp.Code = common.ErrorCode_AUCTION_PARAM_ERROR
+	} else if int32(buyCount) >= rule.MaxBuyOrders {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-BUY] Buy count exceeds limit, userId: %s, currentCount: %d, maxLimit: %d", userId, buyCount, rule.MaxBuyOrders)
+		resp.Code = common.ErrorCode_AUCTION_ORDER_LIMIT_EXCEEDED
 		resp.Msg = "buy count exceeds limit"
 		return
 	}
 
-	// 价格限制检查：所有买卖价格必须控制在matchunit.hourlyAvgPrice的±10%范围内
-	mu = getMatchManager().GetMatchUnit(req.GetItemId())
+	// 道具归属已转移到其他实例时拒绝，道具被运维暂停交易时拒绝新订单
+	if mu, err = getMatchManager().GetMatchUnit(req.GetItemId()); err != nil {
+		klog.CtxWarnf(ctx, "[AUCTION-MGR-BUY] Get match unit error, userId: %s, itemId: %s, error: %s", userId, req.GetItemId(), err.Error())
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
+	}
+	if mu.halted.Load() {
+		klog.CtxInfof(ctx, "[AUCTION-MGR-BUY] Trading halted, userId: %s, itemId: %s", userId, req.GetItemId())
+		resp.Code = common.ErrorCode_AUCTION_TRADING_HALTED
+		resp.Msg = "trading halted"
+		return
+	}
+	// 撮合队列已满时在托管前拒绝
+	if mu.busy() {
+		klog.CtxWarnf(ctx, "[AUCTION-MGR-BUY] Market busy, userId: %s, itemId: %s", userId, req.GetItemId())
+		resp.Code, resp.Msg = queueErrorCode(errMarketBusy)
+		return
+	}
+
+	// 价格限制检查：价格必须在规则根据matchunit.hourlyAvgPrice计算的价格区间内，且为最小价格变动单位的整数倍
 	avgPrice := mu.hourlyAvgPrice
-	minPrice := int64(float64(avgPrice) * 0.9)
-	maxPrice := int64(float64(avgPrice) * 1.1)
-	if req.GetPrice() < minPrice || req.GetPrice() > maxPrice {
+	minPrice, maxPrice := rule.priceRange(avgPrice)
+	price := req.GetPrice()
+	if req.GetOrderType() == auction.OrderType_MARKET {
+		// 市价买单以价格区间上限作为保护价并按上限托管，成交价与保护价的差额在成交时退还
+		price = rule.tickDown(maxPrice)
+		if maxPrice == math.MaxInt64 || price <= 0 || price < minPrice {
+			klog.CtxErrorf(ctx, "[AUCTION-MGR-BUY] Market price unavailable, userId: %s, itemId: %s, avgPrice: %d", userId, req.GetItemId(), avgPrice)
+			resp.Code = common.ErrorCode_AUCTION_PARAM_ERROR
+			resp.Msg = "market price unavailable"
+			return
+		}
+	} else if price < minPrice || price > maxPrice {
 		klog.CtxErrorf(ctx, "[AUCTION-MGR-BUY] Price exceeds limit, userId: %s, itemId: %s, price: %d, minPrice: %d, maxPrice: %d, avgPrice: %d", userId, req.GetItemId(), req.GetPrice(), minPrice, maxPrice, avgPrice)
-		resp.Code = common.ErrorCode_AUCTION_PARAM_ERROR
+		resp.Code = common.ErrorCode_AUCTION_PRICE_OUT_OF_BAND
 		resp.Msg = "price exceeds limit"
 		return
+	} else if !rule.onTick(price) {
+		klog.CtxInfof(ctx, "[AUCTION-MGR-BUY] Price not on tick, userId: %s, itemId: %s, price: %d, tickSize: %d", userId, req.GetItemId(), price, rule.TickSize)
+		resp.Code = common.ErrorCode_AUCTION_PRICE_TICK_INVALID
+		resp.Msg = "price is not a multiple of tick size"
+		return
+	}
+
+	// 托管金额不能超过单次道具操作的上限
+	// 手续费由买家支付时，按报价预留手续费一并托管，成交时从预留中扣除，剩余部分随订单结束退还
+	escrowAmount := price * int64(req.GetQuantity())
+	var feeReserve int64
+	if rule.FeePayer == feePayerBuyer && escrowAmount <= math.MaxInt32 {
+		feeReserve = rule.fee(escrowAmount)
+		escrowAmount += feeReserve
+	}
+	if escrowAmount > math.MaxInt32 {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-BUY] Escrow amount exceeds limit, userId: %s, itemId: %s, amount: %d", userId, req.GetItemId(), escrowAmount)
+		resp.Code = common.ErrorCode_AUCTION_PARAM_ERROR
+		resp.Msg = "amount exceeds limit"
+		return
 	}
 
 	// 使用雪花算法生成order_id
 	orderId := idClient.Generate().String()
 
+	// 托管求购货币：按报价*数量以订单ID作为幂等ID从买家背包中扣除
+	if err = inventory.DeleteItem(ctx, userId, currencyItemId(), escrowAmount, "auction_buy", "auction:buy:"+orderId); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-BUY] Escrow currency error, userId: %s, amount: %d, error: %s", userId, escrowAmount, err.Error())
+		resp.Code = common.ErrorCode_AUCTION_ESCROW_FAILED
+		resp.Msg = "escrow currency failed"
+		err = nil
+		return
+	}
+
 	// 创建BuyData结构体
 	buyData := &auction.BuyData{
 		OrderId:    orderId,
 		ItemId:     req.GetItemId(),
 		Quantity:   req.GetQuantity(),
-		Price:      req.GetPrice(),
-		CreateTime: time.Now().Unix(),
+		Price:      price,
+		CreateTime: createTime,
+		ExpireTime: expireTime,
+		OrderType:  req.GetOrderType(),
 	}
 
 	// 计算各种key
-	key := "auction:buy:" + orderId
-	statusKey := "auction:order:" + orderId + ":status"
-	userTimeKey := "user:" + userId + ":transactions:time"
-	userBuysKey = "user:" + userId + ":buys"
+	key := orderKey("buy", orderId)
+	statusKey := orderStatusKey(orderId)
+	userBuysKey = userOrdersKey(userId, "buy")
 
 	// 使用Lua脚本将数据存入Redis：
 	// 1. 将订单信息存入 auction:buy:{orderId}（包含order_id字段）
-	// 2. 将订单ID添加到用户的求购列表中
-	// 3. 将订单ID添加到全局求购列表中
-	// 4. 记录订单状态到新的Redis结构
-	var luaScript string
-	luaScript = ` 
-		-- 将订单信息存入hash表（包含order_id字段）
-		redis.call('HMSET', KEYS[1], 
-			'order_id', ARGV[6],
-			'item_id', ARGV[1],
-			'quantity', ARGV[2],
-			'price', ARGV[3],
-			'create_time', ARGV[4],
-			'user_id', ARGV[5]
-		)
-		
-		-- 将订单ID添加到用户的求购列表
-		redis.call('SADD', ARGV[7], KEYS[1])
-		
-		-- 将订单ID添加到全局求购列表
-		redis.call('SADD', 'auction:buys', KEYS[1])
-		
-		-- 记录订单状态到新的Redis结构
-		redis.call('HMSET', KEYS[2], 
-			'order_id', ARGV[6],
-			'trade_direction', 'buy',
-			'status', '买',
-			'price', ARGV[3],
-			'quantity', ARGV[2],
-			'final_price', 0,
-			'final_quantity', 0,
-			'item_id', ARGV[1],
-			'tax', 0,
-			'create_time', ARGV[4],
-			'user_id', ARGV[5]
-		)
-		
-		-- 添加到用户交易时间排序集合（按用户维度的时间排序）
-		redis.call('ZADD', KEYS[3], ARGV[4], ARGV[6])
-		
-		return 1
-	`
-
-	if _, err = redis.GetRedis().Eval(ctx, luaScript, []string{key, statusKey, userTimeKey},
+	// 2. 记录订单状态到新的Redis结构
+	// 3. 用户求购列表、全局求购列表、道具挂单索引和用户时间索引位于其他slot，写入订单outbox
+
+	if _, err = runWithOutbox(ctx, script.PlaceBuyOrder, []string{key, statusKey, orderOutboxKey(orderId)},
 		buyData.ItemId,
 		buyData.Quantity,
 		buyData.Price,
@@ -621,20 +785,39 @@ func (m *AuctionManager) Buy(ctx context.Context, req *auction.BuyReq) (resp *au
 		userId,
 		orderId,
 		userBuysKey,
+		buyData.ExpireTime,
+		int(buyData.OrderType),
+		feeReserve,
+		buyOrdersKey,
+		userTransactionsTimeKey(userId),
+		itemOrdersKey(buyData.ItemId, "buy"),
+		orderItemsKey,
 	).Result(); err != nil {
 		klog.CtxErrorf(ctx, "[AUCTION-MGR-BUY] redis eval error: %s", err.Error())
 		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
 		resp.Msg = "save to redis error"
+		// 订单未落地，退还已托管的货币
+		if refundErr := inventory.AddItem(ctx, userId, currencyItemId(), escrowAmount, "auction_buy_rollback", "auction:buy:"+orderId+":rollback"); refundErr != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-MGR-BUY] Rollback escrow error, userId: %s, orderId: %s, error: %s", userId, orderId, refundErr.Error())
+		}
 		return
 	}
 
-	mu = getMatchManager().GetMatchUnit(buyData.ItemId)
-	r := make(chan bool, 0)
-	mu.opChannel <- func() {
-		mu.AddBuyOrder(ctx, buyData)
-		r <- true
+	// 托管和写入Redis期间道具归属可能已转移，撮合单元不在本实例时关闭订单并退还托管
+	if mu, err = getMatchManager().GetMatchUnit(buyData.ItemId); err != nil {
+		rejectOrder(ctx, buyData.ItemId, "buy", orderId, err)
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
+	}
+	if err = mu.placeOrder(ctx, "buy", orderId, func() {
+		mu.bindParty("buy", orderId, userId, feeReserve)
+		mu.PlaceBuyOrder(ctx, buyData)
+	}); err != nil {
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
 	}
-	<-r
 	// 更新响应为成功状态
 	resp.Code = common.ErrorCode_OK
 	resp.Msg = "success"
@@ -643,8 +826,10 @@ func (m *AuctionManager) Buy(ctx context.Context, req *auction.BuyReq) (resp *au
 		OrderId:    orderId,
 		ItemId:     req.GetItemId(),
 		Quantity:   req.GetQuantity(),
-		Price:      req.GetPrice(),
-		CreateTime: buyData.CreateTime,
+		Price:      price,
+		CreateTime: createTime,
+		ExpireTime: expireTime,
+		OrderType:  req.GetOrderType(),
 	}
 
 	return
@@ -764,7 +949,7 @@ func (m *AuctionManager) CancelSell(ctx context.Context, req *auction.CancelSell
 	}
 
 	// 先查询订单信息，获取道具ID
-	orderKey := "auction:sell:" + req.GetOrderId()
+	orderKey := sellOrderKey(req.GetOrderId())
 
 	// 先检查订单是否存在
 	exists, err := redis.GetRedis().Exists(ctx, orderKey).Result()
@@ -791,69 +976,33 @@ func (m *AuctionManager) CancelSell(ctx context.Context, req *auction.CancelSell
 	}
 
 	// 从matchUnit中移除订单（通过opChannel保证线程安全）
-	mu := getMatchManager().GetMatchUnit(itemId)
-	r := make(chan bool, 0)
-	mu.opChannel <- func() {
+	// 等待该订单已撮合的成交落库后再修改Redis，保证退还的是成交后的剩余数量
+	mu, err := getMatchManager().GetMatchUnit(itemId)
+	if err != nil {
+		klog.CtxWarnf(ctx, "[AUCTION-MGR-CANCEL-SELL] get match unit error: orderId=%s, error: %s", req.GetOrderId(), err.Error())
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
+	}
+	if err = mu.callSettled(ctx, func() {
 		if mu.RemoveSellOrder(ctx, req.GetOrderId()) {
 			klog.CtxInfof(ctx, "[AUCTION-MGR-CANCEL-SELL] remove sell order success: %s", req.GetOrderId())
 		}
-		r <- true
+	}); err != nil {
+		klog.CtxWarnf(ctx, "[AUCTION-MGR-CANCEL-SELL] remove sell order error: orderId=%s, error: %s", req.GetOrderId(), err.Error())
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
 	}
-
-	<-r
 	// 使用Lua脚本原子性地删除Redis中的卖单数据
-	var luaScript string
 	var result interface{}
-	luaScript = ` 
-		-- 参数：orderId, userId
-		local orderId = ARGV[1]
-		local userId = ARGV[2]
-		local orderKey = 'auction:sell:' .. orderId
-		
-		-- 检查订单是否存在
-		local exists = redis.call('EXISTS', orderKey)
-		if exists == 0 then
-			return {'err', 'order_not_found'}
-		end
-		
-		-- 检查订单是否属于该用户
-		local orderUserId = redis.call('HGET', orderKey, 'user_id')
-		if orderUserId ~= userId then
-			return {'err', 'order_not_belong_to_user'}
-		end
-		
-		-- 获取订单信息
-		local itemId = redis.call('HGET', orderKey, 'item_id')
-		local createTime = redis.call('HGET', orderKey, 'create_time')
-		
-		-- 更新订单状态到新的Redis结构
-		local statusKey = 'auction:order:' .. orderId .. ':status'
-		redis.call('HMSET', statusKey, 
-			'order_id', orderId,
-			'trade_direction', 'sell',
-			'status', '取消',
-			'item_id', itemId,
-			'tax', 0,
-			'create_time', createTime,
-			'user_id', userId
-		)
-		
-		-- 删除订单
-		redis.call('DEL', orderKey)
-		
-		-- 从用户出售列表中移除
-		redis.call('SREM', 'user:' .. userId .. ':sells', orderKey)
-		
-		-- 从全局出售列表中移除
-		redis.call('SREM', 'auction:sells', orderKey)
-		
-		return {'success', 'true'}
-	`
 
 	// 执行Lua脚本
-	result, err = redis.GetRedis().Eval(ctx, luaScript, []string{},
+	result, err = runWithOutbox(ctx, script.CancelSellOrder, []string{orderKey, orderStatusKey(req.GetOrderId()), orderOutboxKey(req.GetOrderId())},
 		req.GetOrderId(),
 		userId,
+		userOrdersKey(userId, "sell"),
+		sellOrdersKey,
 	).Result()
 
 	if err != nil {
@@ -889,6 +1038,9 @@ func (m *AuctionManager) CancelSell(ctx context.Context, req *auction.CancelSell
 		}
 	}
 
+	// 唤醒结算协程退还托管
+	getMatchManager().wakeSettlement()
+
 	// 更新响应为成功状态
 	resp.Code = common.ErrorCode_OK
 	resp.Msg = "success"
@@ -1011,7 +1163,7 @@ func (m *AuctionManager) CancelBuy(ctx context.Context, req *auction.CancelBuyRe
 	}
 
 	// 先查询订单信息，获取道具ID
-	orderKey := "auction:buy:" + req.GetOrderId()
+	orderKey := buyOrderKey(req.GetOrderId())
 
 	// 先检查订单是否存在
 	exists, err := redis.GetRedis().Exists(ctx, orderKey).Result()
@@ -1038,69 +1190,34 @@ func (m *AuctionManager) CancelBuy(ctx context.Context, req *auction.CancelBuyRe
 	}
 
 	// 从matchUnit中移除订单（通过opChannel保证线程安全）
-	mu := getMatchManager().GetMatchUnit(itemId)
-	r := make(chan bool, 0)
-	mu.opChannel <- func() {
+	// 等待该订单已撮合的成交落库后再修改Redis，保证退还的是成交后的剩余数量
+	mu, err := getMatchManager().GetMatchUnit(itemId)
+	if err != nil {
+		klog.CtxWarnf(ctx, "[AUCTION-MGR-CANCEL-BUY] get match unit error: orderId=%s, error: %s", req.GetOrderId(), err.Error())
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
+	}
+	if err = mu.callSettled(ctx, func() {
 		if mu.RemoveBuyOrder(ctx, req.GetOrderId()) {
 			klog.CtxInfof(ctx, "[AUCTION-MGR-CANCEL-BUY] remove buy order success: %s", req.GetOrderId())
 		}
-		r <- true
+	}); err != nil {
+		klog.CtxWarnf(ctx, "[AUCTION-MGR-CANCEL-BUY] remove buy order error: orderId=%s, error: %s", req.GetOrderId(), err.Error())
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
 	}
-
-	<-r
 	// 使用Lua脚本原子性地删除Redis中的买单数据
-	var luaScript string
 	var result interface{}
-	luaScript = ` 
-		-- 参数：orderId, userId
-		local orderId = ARGV[1]
-		local userId = ARGV[2]
-		local orderKey = 'auction:buy:' .. orderId
-		
-		-- 检查订单是否存在
-		local exists = redis.call('EXISTS', orderKey)
-		if exists == 0 then
-			return {'err', 'order_not_found'}
-		end
-		
-		-- 检查订单是否属于该用户
-		local orderUserId = redis.call('HGET', orderKey, 'user_id')
-		if orderUserId ~= userId then
-			return {'err', 'order_not_belong_to_user'}
-		end
-		
-		-- 获取订单信息
-		local itemId = redis.call('HGET', orderKey, 'item_id')
-		local createTime = redis.call('HGET', orderKey, 'create_time')
-		
-		-- 更新订单状态到新的Redis结构
-		local statusKey = 'auction:order:' .. orderId .. ':status'
-		redis.call('HMSET', statusKey, 
-			'order_id', orderId,
-			'trade_direction', 'buy',
-			'status', '取消',
-			'item_id', itemId,
-			'tax', 0,
-			'create_time', createTime,
-			'user_id', userId
-		)
-		
-		-- 删除订单
-		redis.call('DEL', orderKey)
-		
-		-- 从用户求购列表中移除
-		redis.call('SREM', 'user:' .. userId .. ':buys', orderKey)
-		
-		-- 从全局求购列表中移除
-		redis.call('SREM', 'auction:buys', orderKey)
-		
-		return {'success', 'true'}
-	`
 
 	// 执行Lua脚本
-	result, err = redis.GetRedis().Eval(ctx, luaScript, []string{},
+	result, err = runWithOutbox(ctx, script.CancelBuyOrder, []string{orderKey, orderStatusKey(req.GetOrderId()), orderOutboxKey(req.GetOrderId())},
 		req.GetOrderId(),
 		userId,
+		currencyItemId(),
+		userOrdersKey(userId, "buy"),
+		buyOrdersKey,
 	).Result()
 
 	if err != nil {
@@ -1136,6 +1253,9 @@ func (m *AuctionManager) CancelBuy(ctx context.Context, req *auction.CancelBuyRe
 		}
 	}
 
+	// 唤醒结算协程退还托管
+	getMatchManager().wakeSettlement()
+
 	// 更新响应为成功状态
 	resp.Code = common.ErrorCode_OK
 	resp.Msg = "success"
@@ -1144,6 +1264,39 @@ func (m *AuctionManager) CancelBuy(ctx context.Context, req *auction.CancelBuyRe
 	return
 }
 
+// getUserOrders 获取用户的挂单：订单hash与用户列表位于不同的slot，先读取列表再批量读取订单
+// 订单删除后列表由outbox异步移除，期间已删除的订单直接跳过
+func getUserOrders(ctx context.Context, userId string, direction string) ([]map[string]string, error) {
+	orderKeys, err := redis.GetRedis().SMembers(ctx, userOrdersKey(userId, direction)).Result()
+	if err != nil {
+		return nil, err
+	}
+	return getHashes(ctx, orderKeys)
+}
+
+// getHashes 使用pipeline批量读取hash（各key可能位于不同的slot），跳过不存在的key
+func getHashes(ctx context.Context, keys []string) ([]map[string]string, error) {
+	if len(keys) == 0 {
+		return []map[string]string{}, nil
+	}
+	pipe := redis.GetRedis().Pipeline()
+	cmds := make([]*goredis.MapStringStringCmd, 0, len(keys))
+	for _, key := range keys {
+		cmds = append(cmds, pipe.HGetAll(ctx, key))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]string, 0, len(cmds))
+	for _, cmd := range cmds {
+		if data := cmd.Val(); len(data) > 0 {
+			result = append(result, data)
+		}
+	}
+	return result, nil
+}
+
 // 查看自己所有出售道具协议
 func (m *AuctionManager) GetMySells(ctx context.Context, req *auction.GetMySellsReq) (resp *auction.GetMySellsRsp, err error) {
 	// 初始化resp
@@ -1167,68 +1320,40 @@ func (m *AuctionManager) GetMySells(ctx context.Context, req *auction.GetMySells
 		return
 	}
 
-	// 使用Lua脚本获取用户的所有出售道具
-	luaScript := `
-		local userSellsKey = 'user:' .. ARGV[1] .. ':sells'
-		local sellOrderKeys = redis.call('SMEMBERS', userSellsKey)
-		local result = {}
-		
-		for _, key in ipairs(sellOrderKeys) do
-			local sellData = redis.call('HGETALL', key)
-			if sellData then
-				table.insert(result, sellData)
-			end
-		end
-		
-		return result
-	`
-
-	// 执行Lua脚本
-	result, err := redis.GetRedis().Eval(ctx, luaScript, []string{}, userId).Result()
+	// 获取用户的所有出售道具
+	orders, err := getUserOrders(ctx, userId, "sell")
 	if err != nil {
-		klog.CtxErrorf(ctx, "[AUCTION-MGR-GET-MY-SELLS] Execute Lua script error: %s", err.Error())
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-GET-MY-SELLS] Get user orders error: %s", err.Error())
 		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
 		resp.Msg = "get user sells error"
 		return
 	}
 
-	// 处理Lua脚本返回的结果
-	sellDataList := make([]*auction.SellData, 0)
-	if resultList, ok := result.([]interface{}); ok {
-		for _, item := range resultList {
-			if sellDataMap, ok := item.([]interface{}); ok {
-				// 将[]interface{}转换为map[string]string
-				dataMap := make(map[string]string)
-				for i := 0; i < len(sellDataMap); i += 2 {
-					if key, ok := sellDataMap[i].(string); ok {
-						if value, ok := sellDataMap[i+1].(string); ok {
-							dataMap[key] = value
-						}
-					}
-				}
-
-				// 构造SellData
-				sellData := &auction.SellData{
-					OrderId:  dataMap["order_id"],
-					ItemId:   dataMap["item_id"],
-					ItemInfo: dataMap["item_info"],
-				}
-
-				// 解析数值字段
-				if quantity, err := strconv.ParseInt(dataMap["quantity"], 10, 32); err == nil {
-					sellData.Quantity = int32(quantity)
-				}
-				if price, err := strconv.ParseInt(dataMap["price"], 10, 64); err == nil {
-					sellData.Price = price
-				}
-				if createTime, err := strconv.ParseInt(dataMap["create_time"], 10, 64); err == nil {
-					sellData.CreateTime = createTime
-				}
+	sellDataList := make([]*auction.SellData, 0, len(orders))
+	for _, dataMap := range orders {
+		// 构造SellData
+		sellData := &auction.SellData{
+			OrderId:  dataMap["order_id"],
+			ItemId:   dataMap["item_id"],
+			ItemInfo: dataMap["item_info"],
+		}
 
-				// 添加到列表
-				sellDataList = append(sellDataList, sellData)
-			}
+		// 解析数值字段
+		if quantity, err := strconv.ParseInt(dataMap["quantity"], 10, 32); err == nil {
+			sellData.Quantity = int32(quantity)
+		}
+		if price, err := strconv.ParseInt(dataMap["price"], 10, 64); err == nil {
+			sellData.Price = price
+		}
+		if createTime, err := strconv.ParseInt(dataMap["create_time"], 10, 64); err == nil {
+			sellData.CreateTime = createTime
 		}
+		if expireTime, err := strconv.ParseInt(dataMap["expire_time"], 10, 64); err == nil {
+			sellData.ExpireTime = expireTime
+		}
+
+		// 添加到列表
+		sellDataList = append(sellDataList, sellData)
 	}
 
 	// 更新响应
@@ -1262,67 +1387,39 @@ func (m *AuctionManager) GetMyBuys(ctx context.Context, req *auction.GetMyBuysRe
 		return
 	}
 
-	// 使用Lua脚本获取用户的所有求购道具
-	luaScript := `
-		local userBuysKey = 'user:' .. ARGV[1] .. ':buys'
-		local buyOrderKeys = redis.call('SMEMBERS', userBuysKey)
-		local result = {}
-		
-		for _, key in ipairs(buyOrderKeys) do
-			local buyData = redis.call('HGETALL', key)
-			if buyData then
-				table.insert(result, buyData)
-			end
-		end
-		
-		return result
-	`
-
-	// 执行Lua脚本
-	result, err := redis.GetRedis().Eval(ctx, luaScript, []string{}, userId).Result()
+	// 获取用户的所有求购道具
+	orders, err := getUserOrders(ctx, userId, "buy")
 	if err != nil {
-		klog.CtxErrorf(ctx, "[AUCTION-MGR-GET-MY-BUYS] Execute Lua script error: %s", err.Error())
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-GET-MY-BUYS] Get user orders error: %s", err.Error())
 		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
 		resp.Msg = "get user buys error"
 		return
 	}
 
-	// 处理Lua脚本返回的结果
-	buyDataList := make([]*auction.BuyData, 0)
-	if resultList, ok := result.([]interface{}); ok {
-		for _, item := range resultList {
-			if buyDataMap, ok := item.([]interface{}); ok {
-				// 将[]interface{}转换为map[string]string
-				dataMap := make(map[string]string)
-				for i := 0; i < len(buyDataMap); i += 2 {
-					if key, ok := buyDataMap[i].(string); ok {
-						if value, ok := buyDataMap[i+1].(string); ok {
-							dataMap[key] = value
-						}
-					}
-				}
-
-				// 构造BuyData
-				buyData := &auction.BuyData{
-					OrderId: dataMap["order_id"],
-					ItemId:  dataMap["item_id"],
-				}
-
-				// 解析数值字段
-				if quantity, err := strconv.ParseInt(dataMap["quantity"], 10, 32); err == nil {
-					buyData.Quantity = int32(quantity)
-				}
-				if price, err := strconv.ParseInt(dataMap["price"], 10, 64); err == nil {
-					buyData.Price = price
-				}
-				if createTime, err := strconv.ParseInt(dataMap["create_time"], 10, 64); err == nil {
-					buyData.CreateTime = createTime
-				}
+	buyDataList := make([]*auction.BuyData, 0, len(orders))
+	for _, dataMap := range orders {
+		// 构造BuyData
+		buyData := &auction.BuyData{
+			OrderId: dataMap["order_id"],
+			ItemId:  dataMap["item_id"],
+		}
 
-				// 添加到列表
-				buyDataList = append(buyDataList, buyData)
-			}
+		// 解析数值字段
+		if quantity, err := strconv.ParseInt(dataMap["quantity"], 10, 32); err == nil {
+			buyData.Quantity = int32(quantity)
+		}
+		if price, err := strconv.ParseInt(dataMap["price"], 10, 64); err == nil {
+			buyData.Price = price
+		}
+		if createTime, err := strconv.ParseInt(dataMap["create_time"], 10, 64); err == nil {
+			buyData.CreateTime = createTime
+		}
+		if expireTime, err := strconv.ParseInt(dataMap["expire_time"], 10, 64); err == nil {
+			buyData.ExpireTime = expireTime
 		}
+
+		// 添加到列表
+		buyDataList = append(buyDataList, buyData)
 	}
 
 	// 更新响应
@@ -1355,17 +1452,24 @@ func (m *AuctionManager) GetItemAuctionInfo(ctx context.Context, req *auction.Ge
 	// 遍历道具ID列表，获取每个道具的拍卖信息
 	itemInfoList := make([]*auction.ItemAuctionInfo, 0, len(req.GetItemIds()))
 	for _, itemId := range req.GetItemIds() {
-		// 获取或创建matchUnit
-		matchUnit := matchMgr.GetMatchUnit(itemId)
-		// matchUnit必定存在
+		// 获取matchUnit，道具已迁移到其他实例时由客户端重试
+		var matchUnit *matchUnit
+		var info *auction.ItemAuctionInfo
+		if matchUnit, err = matchMgr.GetMatchUnit(itemId); err != nil {
+			klog.CtxWarnf(ctx, "[AUCTION-MGR-GET-INFO] get match unit error: itemId=%s, error: %s", itemId, err.Error())
+			resp.Code, resp.Msg = queueErrorCode(err)
+			err = nil
+			return
+		}
 		// 直接通过opChannel执行getAuctionInfo，确保线程安全
-		resultChan := make(chan *auction.ItemAuctionInfo, 1)
-		matchUnit.opChannel <- func() {
-			info := matchUnit.getAuctionInfo(ctx)
-			resultChan <- info
+		if err = matchUnit.call(ctx, func() {
+			info = matchUnit.getAuctionInfo(ctx)
+		}); err != nil {
+			klog.CtxWarnf(ctx, "[AUCTION-MGR-GET-INFO] get auction info error: itemId=%s, error: %s", itemId, err.Error())
+			resp.Code, resp.Msg = queueErrorCode(err)
+			err = nil
+			return
 		}
-		// 等待结果
-		info := <-resultChan
 		itemInfoList = append(itemInfoList, info)
 	}
 
@@ -1415,7 +1519,7 @@ func (m *AuctionManager) GetTransactionsByTime(ctx context.Context, req *auction
 	}
 
 	// 构建用户交易时间排序集合键
-	transactionsTimeKey := "user:" + userId + ":transactions:time"
+	transactionsTimeKey := userTransactionsTimeKey(userId)
 
 	// 计算时间范围
 	startTime := req.GetStartTime()
@@ -1424,113 +1528,81 @@ func (m *AuctionManager) GetTransactionsByTime(ctx context.Context, req *auction
 		endTime = time.Now().Unix()
 	}
 
-	// 使用Lua脚本获取交易记录
-	luaScript := `
-		local transactionsTimeKey = ARGV[1]
-		local startTime = tonumber(ARGV[2])
-		local endTime = tonumber(ARGV[3])
-		local offset = tonumber(ARGV[4])
-		local count = tonumber(ARGV[5])
-		
-		-- 1. 通过'user:{user_id}:transactions:time'获取订单ID（按时间降序）
-		local orderIds = redis.call('ZREVRANGEBYSCORE', transactionsTimeKey, endTime, startTime, 'LIMIT', offset, count)
-		
-		-- 获取总记录数
-		local total = redis.call('ZCOUNT', transactionsTimeKey, startTime, endTime)
-		
-		-- 2. 使用获取到的订单ID，查询对应的订单详细信息
-		local transactions = {}
-		for _, orderId in ipairs(orderIds) do
-			-- 通过'auction:order:{order_id}:status'查询订单详细信息
-			local statusKey = 'auction:order:' .. orderId .. ':status'
-			local orderStatus = redis.call('HGETALL', statusKey)
-			if orderStatus then
-				-- 将订单状态添加到结果中
-				table.insert(transactions, orderStatus)
-			end
-		end
-		
-		return {total, transactions}
-	`
-
 	// 计算偏移量
 	offset := (req.GetPage() - 1) * req.GetPageSize()
 
-	// 执行Lua脚本
-	result, err := redis.GetRedis().Eval(ctx, luaScript, []string{},
-		transactionsTimeKey,
-		startTime,
-		endTime,
-		offset,
-		req.GetPageSize(),
-	).Result()
+	// 1. 通过用户时间索引获取订单ID（按时间降序）和总记录数
+	rangeBy := &goredis.ZRangeBy{
+		Min:    strconv.FormatInt(startTime, 10),
+		Max:    strconv.FormatInt(endTime, 10),
+		Offset: int64(offset),
+		Count:  int64(req.GetPageSize()),
+	}
+	orderIds, err := redis.GetRedis().ZRevRangeByScore(ctx, transactionsTimeKey, rangeBy).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-GET-TRANSACTIONS-BY-TIME] Get order ids error: %s", err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "get transactions error"
+		return
+	}
+	total, err := redis.GetRedis().ZCount(ctx, transactionsTimeKey, rangeBy.Min, rangeBy.Max).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-GET-TRANSACTIONS-BY-TIME] Count order ids error: %s", err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "get transactions error"
+		return
+	}
+	resp.Data.Total = int32(total)
 
+	// 2. 使用获取到的订单ID，查询对应的订单状态
+	statusKeys := make([]string, 0, len(orderIds))
+	for _, orderId := range orderIds {
+		statusKeys = append(statusKeys, orderStatusKey(orderId))
+	}
+	orders, err := getHashes(ctx, statusKeys)
 	if err != nil {
-		klog.CtxErrorf(ctx, "[AUCTION-MGR-GET-TRANSACTIONS-BY-TIME] Execute Lua script error: %s", err.Error())
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-GET-TRANSACTIONS-BY-TIME] Get order status error: %s", err.Error())
 		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
 		resp.Msg = "get transactions error"
 		return
 	}
 
-	// 处理Lua脚本返回的结果
-	if resultList, ok := result.([]interface{}); ok && len(resultList) == 2 {
-		// 获取总记录数
-		if totalInterface, ok := resultList[0].(int64); ok {
-			resp.Data.Total = int32(totalInterface)
+	records := make([]*auction.TimeTransactionRecord, 0, len(orders))
+	for _, dataMap := range orders {
+		// 构造TimeTransactionRecord
+		record := &auction.TimeTransactionRecord{
+			TransactionId:  dataMap["order_id"],
+			ItemId:         dataMap["item_id"],
+			ItemInfo:       dataMap["item_info"],
+			TradeDirection: dataMap["trade_direction"],
+			Status:         dataMap["status"],
+			UserId:         dataMap["user_id"],
 		}
 
-		// 获取交易记录
-		if transactionsInterface, ok := resultList[1].([]interface{}); ok {
-			records := make([]*auction.TimeTransactionRecord, 0, len(transactionsInterface))
-			for _, transactionInterface := range transactionsInterface {
-				if orderStatusMap, ok := transactionInterface.([]interface{}); ok {
-					// 将[]interface{}转换为map[string]string
-					dataMap := make(map[string]string)
-					for i := 0; i < len(orderStatusMap); i += 2 {
-						if key, ok := orderStatusMap[i].(string); ok {
-							if value, ok := orderStatusMap[i+1].(string); ok {
-								dataMap[key] = value
-							}
-						}
-					}
-
-					// 构造TimeTransactionRecord
-					record := &auction.TimeTransactionRecord{
-						TransactionId:  dataMap["order_id"],
-						ItemId:         dataMap["item_id"],
-						ItemInfo:       dataMap["item_info"],
-						TradeDirection: dataMap["trade_direction"],
-						Status:         dataMap["status"],
-						UserId:         dataMap["user_id"],
-					}
-
-					// 解析数值字段
-					if quantity, err := strconv.ParseInt(dataMap["quantity"], 10, 32); err == nil {
-						record.Quantity = int32(quantity)
-					}
-					if price, err := strconv.ParseInt(dataMap["price"], 10, 64); err == nil {
-						record.Price = price
-					}
-					if tax, err := strconv.ParseInt(dataMap["tax"], 10, 64); err == nil {
-						record.Tax = tax
-					}
-					if createTime, err := strconv.ParseInt(dataMap["create_time"], 10, 64); err == nil {
-						record.TransactionTime = createTime
-					}
-					if finalPrice, err := strconv.ParseInt(dataMap["final_price"], 10, 64); err == nil {
-						record.FinalPrice = finalPrice
-					}
-					if finalQuantity, err := strconv.ParseInt(dataMap["final_quantity"], 10, 32); err == nil {
-						record.FinalQuantity = int32(finalQuantity)
-					}
-
-					// 添加到记录列表
-					records = append(records, record)
-				}
-			}
-			resp.Data.Records = records
+		// 解析数值字段
+		if quantity, err := strconv.ParseInt(dataMap["quantity"], 10, 32); err == nil {
+			record.Quantity = int32(quantity)
+		}
+		if price, err := strconv.ParseInt(dataMap["price"], 10, 64); err == nil {
+			record.Price = price
+		}
+		if tax, err := strconv.ParseInt(dataMap["tax"], 10, 64); err == nil {
+			record.Tax = tax
 		}
+		if createTime, err := strconv.ParseInt(dataMap["create_time"], 10, 64); err == nil {
+			record.TransactionTime = createTime
+		}
+		if finalPrice, err := strconv.ParseInt(dataMap["final_price"], 10, 64); err == nil {
+			record.FinalPrice = finalPrice
+		}
+		if finalQuantity, err := strconv.ParseInt(dataMap["final_quantity"], 10, 32); err == nil {
+			record.FinalQuantity = int32(finalQuantity)
+		}
+
+		// 添加到记录列表
+		records = append(records, record)
 	}
+	resp.Data.Records = records
 
 	// 更新响应
 	resp.Code = common.ErrorCode_OK
@@ -1559,80 +1631,57 @@ func (m *AuctionManager) GetTransactionHistory(ctx context.Context, req *auction
 		return
 	}
 
-	// 使用Lua脚本获取交易记录
-	luaScript := `
-		local orderId = ARGV[1]
-		
-		-- 1. 从"auction:order:{order_id}:transactions" 获取交易id
-		local transactionsKey = 'auction:order:' .. orderId .. ':transactions'
-		local transactionIds = redis.call('SMEMBERS', transactionsKey)
-		
-		-- 2. 遍历每个交易id，从"auction:transaction:{transactionId}" 获取交易明细
-		local transactions = {}
-		for _, transactionId in ipairs(transactionIds) do
-			local transactionKey = 'auction:transaction:' .. transactionId
-			local transaction = redis.call('HGETALL', transactionKey)
-			if transaction then
-				table.insert(transactions, transaction)
-			end
-		end
-		
-		return transactions
-	`
-
-	// 执行Lua脚本
-	result, err := redis.GetRedis().Eval(ctx, luaScript, []string{}, orderId).Result()
+	// 1. 获取订单的成交ID
+	transactionIds, err := redis.GetRedis().SMembers(ctx, orderTransactionsKey(orderId)).Result()
 	if err != nil {
-		klog.CtxErrorf(ctx, "[AUCTION-MGR-GET-TRANSACTION-HISTORY] Execute Lua script error: %s", err.Error())
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-GET-TRANSACTION-HISTORY] Get transaction ids error: %s", err.Error())
 		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
 		resp.Msg = "get transaction history error"
 		return
 	}
 
-	// 处理Lua脚本返回的结果
-	if transactionsInterface, ok := result.([]interface{}); ok {
-		records := make([]*auction.TransactionRecord, 0, len(transactionsInterface))
-		for _, transactionInterface := range transactionsInterface {
-			if transactionMap, ok := transactionInterface.([]interface{}); ok {
-				// 将[]interface{}转换为map[string]string
-				dataMap := make(map[string]string)
-				for i := 0; i < len(transactionMap); i += 2 {
-					if key, ok := transactionMap[i].(string); ok {
-						if value, ok := transactionMap[i+1].(string); ok {
-							dataMap[key] = value
-						}
-					}
-				}
-
-				// 构造TransactionRecord
-				record := &auction.TransactionRecord{
-					TransactionId: dataMap["transaction_id"],
-					BuyOrderId:    dataMap["buy_order_id"],
-					SellOrderId:   dataMap["sell_order_id"],
-					ItemId:        dataMap["item_id"],
-					ItemInfo:      dataMap["item_info"],
-				}
+	// 2. 批量读取成交明细
+	transactionKeys := make([]string, 0, len(transactionIds))
+	for _, transactionId := range transactionIds {
+		transactionKeys = append(transactionKeys, transactionKey(transactionId))
+	}
+	transactions, err := getHashes(ctx, transactionKeys)
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-GET-TRANSACTION-HISTORY] Get transactions error: %s", err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "get transaction history error"
+		return
+	}
 
-				// 解析数值字段
-				if quantity, err := strconv.ParseInt(dataMap["quantity"], 10, 32); err == nil {
-					record.Quantity = int32(quantity)
-				}
-				if price, err := strconv.ParseInt(dataMap["price"], 10, 64); err == nil {
-					record.Price = price
-				}
-				if tax, err := strconv.ParseInt(dataMap["tax"], 10, 64); err == nil {
-					record.Tax = tax
-				}
-				if transactionTime, err := strconv.ParseInt(dataMap["transaction_time"], 10, 64); err == nil {
-					record.TransactionTime = transactionTime
-				}
+	records := make([]*auction.TransactionRecord, 0, len(transactions))
+	for _, dataMap := range transactions {
+		// 构造TransactionRecord
+		record := &auction.TransactionRecord{
+			TransactionId: dataMap["transaction_id"],
+			BuyOrderId:    dataMap["buy_order_id"],
+			SellOrderId:   dataMap["sell_order_id"],
+			ItemId:        dataMap["item_id"],
+			ItemInfo:      dataMap["item_info"],
+		}
 
-				// 添加到记录列表
-				records = append(records, record)
-			}
+		// 解析数值字段
+		if quantity, err := strconv.ParseInt(dataMap["quantity"], 10, 32); err == nil {
+			record.Quantity = int32(quantity)
+		}
+		if price, err := strconv.ParseInt(dataMap["price"], 10, 64); err == nil {
+			record.Price = price
 		}
-		resp.Data.Records = records
+		if tax, err := strconv.ParseInt(dataMap["tax"], 10, 64); err == nil {
+			record.Tax = tax
+		}
+		if transactionTime, err := strconv.ParseInt(dataMap["transaction_time"], 10, 64); err == nil {
+			record.TransactionTime = transactionTime
+		}
+
+		// 添加到记录列表
+		records = append(records, record)
 	}
+	resp.Data.Records = records
 
 	// 更新响应
 	resp.Code = common.ErrorCode_OK
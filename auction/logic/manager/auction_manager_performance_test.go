@@ -2,6 +2,7 @@ package manager
 
 import (
 	"context"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -9,11 +10,15 @@ import (
 	"auction_module/kitex_gen/auction"
 	"auction_module/kitex_gen/common"
 	"auction_module/redis"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/stretchr/testify/assert"
 )
 
 // 性能测试用例: 并发创建出售订单
 func TestAuctionManager_Performance_Sell(t *testing.T) {
 	setupTest()
+	defer teardownTest()
 
 	// 检查Redis连接是否正常
 	ctx := context.Background()
@@ -42,7 +47,8 @@ func TestAuctionManager_Performance_Sell(t *testing.T) {
 			userID := "test_user_performance_" + string(rune(userIdx+'0'))
 			testCtx := context.WithValue(ctx, "userId", userID)
 			for j := 0; j < ordersPerUser; j++ {
-				itemID := "test_item_perf_" + string(rune(userIdx+'0')) + "_" + string(rune(j+'0'))
+				// 所有用户挂在相同的几个道具上，测试并发挂单而不是创建大量撮合单元
+				itemID := "test_item_perf_" + strconv.Itoa(j)
 				req := &auction.SellReq{
 					ItemId:       itemID,
 					Quantity:     1,
@@ -82,6 +88,7 @@ func TestAuctionManager_Performance_Sell(t *testing.T) {
 // 性能测试用例: 并发创建购买订单
 func TestAuctionManager_Performance_Buy(t *testing.T) {
 	setupTest()
+	defer teardownTest()
 
 	// 检查Redis连接是否正常
 	ctx := context.Background()
@@ -194,7 +201,7 @@ func TestAuctionManager_Performance_GetTransactionHistory(t *testing.T) {
 			historyTestCtx := context.WithValue(ctx, "userId", historyUserID)
 
 			itemID := "test_item_perf_history_" + string(rune(i+'0'))
-			price := getMatchManager().GetMatchUnit(itemID).hourlyAvgPrice
+			price := testMatchUnit(itemID).hourlyAvgPrice
 
 			// 创建出售订单
 			sellReq := &auction.SellReq{
@@ -312,3 +319,113 @@ func TestAuctionManager_Performance_GetTransactionHistory(t *testing.T) {
 	t.Logf("成功率: %.2f%%", float64(totalQueries-errors)/float64(totalQueries)*100)
 	t.Logf("每秒处理查询数: %.2f", float64(totalQueries)/duration.Seconds())
 }
+
+// newBenchMatchUnit 创建使用内存持久化的撮合单元，只启动成交落库协程，由基准测试直接调用
+func newBenchMatchUnit(b *testing.B) (*matchUnit, *memoryMatchStore) {
+	if err := initLocalIdClient(); err != nil {
+		b.Fatal(err)
+	}
+	klog.SetLevel(klog.LevelWarn)
+	b.Cleanup(func() { klog.SetLevel(klog.LevelInfo) })
+	store := newMemoryMatchStore()
+	mu := newMatchUnit("bench_item", store)
+	ctx, cancel := context.WithCancel(context.Background())
+	go mu.settler.run(ctx)
+	b.Cleanup(func() {
+		mu.settler.flush()
+		cancel()
+	})
+	return mu, store
+}
+
+// 基准测试: 挂单不成交（订单簿持续增长）
+func BenchmarkMatchUnit_AddOrder(b *testing.B) {
+	ctx := context.Background()
+	mu, store := newBenchMatchUnit(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		orderId := strconv.Itoa(i)
+		store.PutOrder("sell", orderId, "bench_seller", mu.itemId, 1, int64(1000+i%100), 0)
+		mu.bindParty("sell", orderId, "bench_seller", 0)
+		mu.AddSellOrder(ctx, &auction.SellData{OrderId: orderId, ItemId: mu.itemId, Quantity: 1, Price: int64(1000 + i%100)})
+	}
+}
+
+// 基准测试: 每次下单与一笔挂单完全成交（含成交落库、结算任务构造和事件记录）
+func BenchmarkMatchUnit_Fill(b *testing.B) {
+	ctx := context.Background()
+	mu, store := newBenchMatchUnit(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sellId, buyId := "s"+strconv.Itoa(i), "b"+strconv.Itoa(i)
+		store.PutOrder("sell", sellId, "bench_seller", mu.itemId, 1, 1000, 0)
+		mu.bindParty("sell", sellId, "bench_seller", 0)
+		mu.AddSellOrder(ctx, &auction.SellData{OrderId: sellId, ItemId: mu.itemId, Quantity: 1, Price: 1000})
+		store.PutOrder("buy", buyId, "bench_buyer", mu.itemId, 1, 1000, 0)
+		mu.bindParty("buy", buyId, "bench_buyer", 0)
+		mu.AddBuyOrder(ctx, &auction.BuyData{OrderId: buyId, ItemId: mu.itemId, Quantity: 1, Price: 1000})
+	}
+	mu.settler.flush()
+	b.StopTimer()
+	if store.fills.Load() != int64(b.N) {
+		b.Fatalf("fills=%d, want %d", store.fills.Load(), b.N)
+	}
+}
+
+// 基准测试: 在100档深度的订单簿上，每次买单吃掉10档卖单后补齐
+func BenchmarkMatchUnit_Sweep(b *testing.B) {
+	ctx := context.Background()
+	mu, store := newBenchMatchUnit(b)
+	addSell := func(orderId string, price int64) {
+		store.PutOrder("sell", orderId, "bench_seller", mu.itemId, 1, price, 0)
+		mu.bindParty("sell", orderId, "bench_seller", 0)
+		mu.AddSellOrder(ctx, &auction.SellData{OrderId: orderId, ItemId: mu.itemId, Quantity: 1, Price: price})
+	}
+	for level := 0; level < 100; level++ {
+		addSell("init_"+strconv.Itoa(level), int64(1000+level))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buyId := "b" + strconv.Itoa(i)
+		store.PutOrder("buy", buyId, "bench_buyer", mu.itemId, 10, 1009, 0)
+		mu.bindParty("buy", buyId, "bench_buyer", 0)
+		mu.AddBuyOrder(ctx, &auction.BuyData{OrderId: buyId, ItemId: mu.itemId, Quantity: 10, Price: 1009})
+		for level := 0; level < 10; level++ {
+			addSell("s"+strconv.Itoa(i)+"_"+strconv.Itoa(level), int64(1000+level))
+		}
+	}
+}
+
+// 测试用例: 压测场景（内存持久化，不依赖Redis）产生成交并输出延迟和积压统计
+func TestMatchUnit_LoadScenario(t *testing.T) {
+	klog.SetLevel(klog.LevelWarn)
+	defer klog.SetLevel(klog.LevelInfo)
+
+	scenario := &LoadScenario{
+		Items:       4,
+		OrderRate:   4000,
+		Duration:    500 * time.Millisecond,
+		BasePrice:   1000,
+		PriceSpread: 20,
+		PriceDist:   PriceDistNormal,
+		MaxQuantity: 5,
+		CancelRatio: 0.2,
+		Seed:        1,
+	}
+	report, err := RunLoadScenario(context.Background(), scenario)
+	if !assert.NoError(t, err) {
+		return
+	}
+	t.Log(report)
+	assert.InDelta(t, 2000, report.Orders, 100)
+	assert.Greater(t, report.Fills, int64(0))
+	assert.Greater(t, report.Cancels, int64(0))
+	assert.Greater(t, report.FillsPerSec, float64(0))
+	assert.LessOrEqual(t, report.LatencyP50, report.LatencyP99)
+	assert.LessOrEqual(t, report.LatencyP99, report.LatencyMax)
+	assert.GreaterOrEqual(t, report.BacklogMax, 0)
+
+	// 非法场景
+	_, err = RunLoadScenario(context.Background(), &LoadScenario{Items: 1, OrderRate: 1, Duration: time.Second, BasePrice: 10, PriceSpread: 1, PriceDist: "zipf", MaxQuantity: 1})
+	assert.Error(t, err)
+}
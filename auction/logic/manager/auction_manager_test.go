@@ -4,35 +4,62 @@ import (
 	"auction_module/config"
 	"auction_module/kitex_gen/auction"
 	"auction_module/kitex_gen/common"
+	"auction_module/kitex_gen/item"
 	"auction_module/redis"
+	"auction_module/redis/clustertest"
 	"context"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/btree"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 // TestMain 用于在测试开始前初始化测试环境
 func TestMain(m *testing.M) {
-	// 初始化测试环境
-	defer func() {
-		if r := recover(); r != nil {
-			fmt.Println("Recovered from panic:", r)
-			fmt.Println("Redis connection failed, skipping all tests")
-			os.Exit(0)
-		}
-	}()
-
-	// 设置配置文件路径环境变量（使用绝对路径）
-	os.Setenv("CONF_PATH", "e:\\tank\\auction\\etc")
-	os.Setenv("CONF_FILE", "server-test.yaml")
+	// 未指定配置文件时使用仓库内的测试配置
+	if os.Getenv(config.CONF_ENV_PATH) == "" {
+		os.Setenv(config.CONF_ENV_PATH, "../../etc")
+	}
+	if os.Getenv(config.CONF_ENV_FILE) == "" {
+		os.Setenv(config.CONF_ENV_FILE, "server-test.yaml")
+	}
 
 	// 加载配置
 	config.LoadConfig()
 
+	// 默认连接进程内的miniredis，不依赖外部Redis；AUCTION_TEST_REDIS=1 时连接配置中的Redis
+	// AUCTION_TEST_CLUSTER=1 时连接Redis Cluster替身，校验每条命令、每个脚本访问的key都在同一个slot
+	var cluster *clustertest.Cluster
+	switch {
+	case os.Getenv("AUCTION_TEST_CLUSTER") == "1":
+		var err error
+		if cluster, err = clustertest.Run(); err != nil {
+			panic(err)
+		}
+		viper.Set("redis.addrs", []interface{}{cluster.Addr()})
+		viper.Set("redis.cluster", true)
+	case os.Getenv("AUCTION_TEST_REDIS") != "1":
+		mr, err := miniredis.Run()
+		if err != nil {
+			panic(err)
+		}
+		viper.Set("redis.addrs", []interface{}{mr.Addr()})
+		viper.Set("redis.password", "")
+		viper.Set("redis.cluster", false)
+	}
+
+	// 使用内存背包替代item_manager
+	inventory = newFakeInventory()
+
 	setupTest()
 
 	getMatchManager()
@@ -42,6 +69,12 @@ func TestMain(m *testing.M) {
 
 	// 运行测试
 	code := m.Run()
+	if cluster != nil {
+		if violations := cluster.Violations(); len(violations) > 0 {
+			fmt.Println("Cross slot commands:", strings.Join(violations, "\n"))
+			code = 1
+		}
+	}
 
 	// 清理测试环境
 	teardownTest()
@@ -50,6 +83,123 @@ func TestMain(m *testing.M) {
 	os.Exit(code)
 }
 
+// fakeInventory 内存背包，记录每个用户每种道具的变化量
+type fakeInventory struct {
+	mu         sync.Mutex
+	balances   map[string]int64
+	idempotent map[string]bool
+	instances  map[string]*item.ItemInfo // 唯一道具实例，key为 userId:itemUniqueId
+	escrows    map[string]*item.ItemInfo // 托管中的唯一道具实例，key为 托管用户Id:itemUniqueId
+	failDelete bool
+}
+
+func newFakeInventory() *fakeInventory {
+	return &fakeInventory{
+		balances:   make(map[string]int64),
+		idempotent: make(map[string]bool),
+		instances:  make(map[string]*item.ItemInfo),
+		escrows:    make(map[string]*item.ItemInfo),
+	}
+}
+
+func (f *fakeInventory) DeleteItem(ctx context.Context, userId string, itemUniqueId string, count int64, reason string, idempotentId string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failDelete {
+		return fmt.Errorf("delete count exceeds available count")
+	}
+	if f.idempotent[userId+":"+idempotentId] {
+		return nil
+	}
+	if _, ok := f.instances[userId+":"+itemUniqueId]; ok {
+		delete(f.instances, userId+":"+itemUniqueId)
+	} else {
+		f.balances[userId+":"+itemUniqueId] -= count
+	}
+	f.idempotent[userId+":"+idempotentId] = true
+	return nil
+}
+
+// EscrowItem 与item_manager一致，实例属性中bindable为true的道具拒绝托管
+func (f *fakeInventory) EscrowItem(ctx context.Context, userId string, itemUniqueId string, count int64, reason string, idempotentId string) error {
+	f.mu.Lock()
+	info := f.instances[userId+":"+itemUniqueId]
+	f.mu.Unlock()
+	if info != nil && strings.Contains(info.GetProperties(), `"bindable":true`) {
+		return errItemNotTradable
+	}
+	if err := f.DeleteItem(ctx, userId, itemUniqueId, count, reason, idempotentId); err != nil {
+		return err
+	}
+	if info != nil {
+		f.mu.Lock()
+		f.escrows[userId+":"+itemUniqueId] = info
+		f.mu.Unlock()
+	}
+	return nil
+}
+
+func (f *fakeInventory) AddItem(ctx context.Context, userId string, itemId string, count int64, reason string, idempotentId string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.idempotent[userId+":"+idempotentId] {
+		return nil
+	}
+	f.idempotent[userId+":"+idempotentId] = true
+	f.balances[userId+":"+itemId] += count
+	return nil
+}
+
+func (f *fakeInventory) GetItem(ctx context.Context, userId string, itemUniqueId string) (*item.ItemInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	info, ok := f.instances[userId+":"+itemUniqueId]
+	if !ok {
+		return nil, fmt.Errorf("item not found")
+	}
+	return proto.Clone(info).(*item.ItemInfo), nil
+}
+
+// RestoreItem 与item_manager一致，只能写回托管记录中的实例，写回的是托管时的属性
+func (f *fakeInventory) RestoreItem(ctx context.Context, userId string, escrowUserId string, info *item.ItemInfo, reason string, idempotentId string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.idempotent[userId+":"+idempotentId] {
+		return nil
+	}
+	escrowed, ok := f.escrows[escrowUserId+":"+info.ItemUniqueId]
+	if !ok || escrowed.GetItemId() != info.GetItemId() {
+		return fmt.Errorf("escrow mismatch")
+	}
+	if _, ok := f.instances[userId+":"+info.ItemUniqueId]; ok {
+		return fmt.Errorf("item already exists")
+	}
+	f.idempotent[userId+":"+idempotentId] = true
+	delete(f.escrows, escrowUserId+":"+info.ItemUniqueId)
+	f.instances[userId+":"+info.ItemUniqueId] = proto.Clone(escrowed).(*item.ItemInfo)
+	return nil
+}
+
+// grantInstance 直接向用户背包放入一个唯一道具实例
+func (f *fakeInventory) grantInstance(userId string, info *item.ItemInfo) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.instances[userId+":"+info.ItemUniqueId] = info
+}
+
+// instance 查询用户背包中的唯一道具实例
+func (f *fakeInventory) instance(userId string, itemUniqueId string) *item.ItemInfo {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.instances[userId+":"+itemUniqueId]
+}
+
+func (f *fakeInventory) balance(userId string, itemId string) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.balances[userId+":"+itemId]
+}
+
 // 测试环境设置
 func setupTest() {
 	// 初始化Redis连接
@@ -97,7 +247,7 @@ func TestAuctionManager_Sell(t *testing.T) {
 
 	// 获取当前市场的平均价格数据
 	manager := GetAuctionManager()
-	mu := getMatchManager().GetMatchUnit("test_item_001")
+	mu := testMatchUnit("test_item_001")
 	avgPrice := mu.hourlyAvgPrice
 	// 预设交易价格
 	originalPrice := int64(100)
@@ -146,11 +296,11 @@ func TestAuctionManager_Sell(t *testing.T) {
 
 	// 清理测试数据
 	orderId := resp.Data.OrderId
-	redis.GetRedis().Del(ctx, "auction:sell:"+orderId)
-	redis.GetRedis().Del(ctx, "auction:order:"+orderId+":status")
-	redis.GetRedis().SRem(ctx, "user:test_user_001:sells", "auction:sell:"+orderId)
-	redis.GetRedis().SRem(ctx, "auction:sells", "auction:sell:"+orderId)
-	redis.GetRedis().ZRem(ctx, "user:test_user_001:transactions:time", orderId)
+	redis.GetRedis().Del(ctx, sellOrderKey(orderId))
+	redis.GetRedis().Del(ctx, orderStatusKey(orderId))
+	redis.GetRedis().SRem(ctx, userOrdersKey("test_user_001", "sell"), sellOrderKey(orderId))
+	redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(orderId))
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey("test_user_001"), orderId)
 }
 
 // 测试用例: 出售数量为0的情况
@@ -300,7 +450,7 @@ func TestAuctionManager_Buy(t *testing.T) {
 
 	// 获取当前市场的平均价格数据
 	manager := GetAuctionManager()
-	mu := getMatchManager().GetMatchUnit("test_item_001")
+	mu := testMatchUnit("test_item_001")
 	avgPrice := mu.hourlyAvgPrice
 	// 预设交易价格
 	originalPrice := int64(100)
@@ -347,11 +497,11 @@ func TestAuctionManager_Buy(t *testing.T) {
 
 	// 清理测试数据
 	orderId := resp.Data.OrderId
-	redis.GetRedis().Del(ctx, "auction:buy:"+orderId)
-	redis.GetRedis().Del(ctx, "auction:order:"+orderId+":status")
-	redis.GetRedis().SRem(ctx, "user:test_user_002:buys", "auction:buy:"+orderId)
-	redis.GetRedis().SRem(ctx, "auction:buys", "auction:buy:"+orderId)
-	redis.GetRedis().ZRem(ctx, "user:test_user_002:transactions:time", orderId)
+	redis.GetRedis().Del(ctx, buyOrderKey(orderId))
+	redis.GetRedis().Del(ctx, orderStatusKey(orderId))
+	redis.GetRedis().SRem(ctx, userOrdersKey("test_user_002", "buy"), buyOrderKey(orderId))
+	redis.GetRedis().SRem(ctx, buyOrdersKey, buyOrderKey(orderId))
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey("test_user_002"), orderId)
 }
 
 // 测试用例: 购买数量为0的情况
@@ -497,7 +647,7 @@ func TestAuctionManager_CancelSell(t *testing.T) {
 
 	manager := GetAuctionManager()
 	// 获取当前市场的平均价格数据
-	mu := getMatchManager().GetMatchUnit("test_item_001")
+	mu := testMatchUnit("test_item_001")
 	avgPrice := mu.hourlyAvgPrice
 	// 预设交易价格
 	originalPrice := int64(100)
@@ -549,7 +699,7 @@ func TestAuctionManager_CancelSell(t *testing.T) {
 	assert.Equal(t, "test_item_001", infoResp.Data[0].ItemId)
 
 	// 验证订单是否被删除
-	exists, err := redis.GetRedis().Exists(ctx, "auction:sell:"+sellResp.Data.OrderId).Result()
+	exists, err := redis.GetRedis().Exists(ctx, sellOrderKey(sellResp.Data.OrderId)).Result()
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), exists)
 }
@@ -599,7 +749,7 @@ func TestAuctionManager_CancelBuy(t *testing.T) {
 
 	manager := GetAuctionManager()
 	// 获取当前市场的平均价格数据
-	mu := getMatchManager().GetMatchUnit("test_item_001")
+	mu := testMatchUnit("test_item_001")
 	avgPrice := mu.hourlyAvgPrice
 	// 预设交易价格
 	originalPrice := int64(100)
@@ -650,7 +800,7 @@ func TestAuctionManager_CancelBuy(t *testing.T) {
 	assert.Equal(t, "test_item_001", infoResp.Data[0].ItemId)
 
 	// 验证订单是否被删除
-	exists, err := redis.GetRedis().Exists(ctx, "auction:buy:"+buyResp.Data.OrderId).Result()
+	exists, err := redis.GetRedis().Exists(ctx, buyOrderKey(buyResp.Data.OrderId)).Result()
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), exists)
 }
@@ -738,15 +888,15 @@ func TestAuctionManager_GetMySells(t *testing.T) {
 	assert.Len(t, getResp.Data, 2)
 
 	// 清理测试数据
-	redis.GetRedis().Del(ctx, "auction:sell:"+sellResp1.Data.OrderId)
-	redis.GetRedis().Del(ctx, "auction:order:"+sellResp1.Data.OrderId+":status")
-	redis.GetRedis().Del(ctx, "auction:sell:"+sellResp2.Data.OrderId)
-	redis.GetRedis().Del(ctx, "auction:order:"+sellResp2.Data.OrderId+":status")
-	redis.GetRedis().Del(ctx, "user:test_user_001:sells")
-	redis.GetRedis().SRem(ctx, "auction:sells", "auction:sell:"+sellResp1.Data.OrderId)
-	redis.GetRedis().SRem(ctx, "auction:sells", "auction:sell:"+sellResp2.Data.OrderId)
-	redis.GetRedis().ZRem(ctx, "user:test_user_001:transactions:time", sellResp1.Data.OrderId)
-	redis.GetRedis().ZRem(ctx, "user:test_user_001:transactions:time", sellResp2.Data.OrderId)
+	redis.GetRedis().Del(ctx, sellOrderKey(sellResp1.Data.OrderId))
+	redis.GetRedis().Del(ctx, orderStatusKey(sellResp1.Data.OrderId))
+	redis.GetRedis().Del(ctx, sellOrderKey(sellResp2.Data.OrderId))
+	redis.GetRedis().Del(ctx, orderStatusKey(sellResp2.Data.OrderId))
+	redis.GetRedis().Del(ctx, userOrdersKey("test_user_001", "sell"))
+	redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(sellResp1.Data.OrderId))
+	redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(sellResp2.Data.OrderId))
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey("test_user_001"), sellResp1.Data.OrderId)
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey("test_user_001"), sellResp2.Data.OrderId)
 }
 
 // 测试用例: 获取我的购买订单
@@ -801,15 +951,15 @@ func TestAuctionManager_GetMyBuys(t *testing.T) {
 	assert.Len(t, getResp.Data, 2)
 
 	// 清理测试数据
-	redis.GetRedis().Del(ctx, "auction:buy:"+buyResp1.Data.OrderId)
-	redis.GetRedis().Del(ctx, "auction:order:"+buyResp1.Data.OrderId+":status")
-	redis.GetRedis().Del(ctx, "auction:buy:"+buyResp2.Data.OrderId)
-	redis.GetRedis().Del(ctx, "auction:order:"+buyResp2.Data.OrderId+":status")
-	redis.GetRedis().Del(ctx, "user:test_user_002:buys")
-	redis.GetRedis().SRem(ctx, "auction:buys", "auction:buy:"+buyResp1.Data.OrderId)
-	redis.GetRedis().SRem(ctx, "auction:buys", "auction:buy:"+buyResp2.Data.OrderId)
-	redis.GetRedis().ZRem(ctx, "user:test_user_002:transactions:time", buyResp1.Data.OrderId)
-	redis.GetRedis().ZRem(ctx, "user:test_user_002:transactions:time", buyResp2.Data.OrderId)
+	redis.GetRedis().Del(ctx, buyOrderKey(buyResp1.Data.OrderId))
+	redis.GetRedis().Del(ctx, orderStatusKey(buyResp1.Data.OrderId))
+	redis.GetRedis().Del(ctx, buyOrderKey(buyResp2.Data.OrderId))
+	redis.GetRedis().Del(ctx, orderStatusKey(buyResp2.Data.OrderId))
+	redis.GetRedis().Del(ctx, userOrdersKey("test_user_002", "buy"))
+	redis.GetRedis().SRem(ctx, buyOrdersKey, buyOrderKey(buyResp1.Data.OrderId))
+	redis.GetRedis().SRem(ctx, buyOrdersKey, buyOrderKey(buyResp2.Data.OrderId))
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey("test_user_002"), buyResp1.Data.OrderId)
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey("test_user_002"), buyResp2.Data.OrderId)
 }
 
 // 测试用例: 获取物品拍卖信息
@@ -824,8 +974,8 @@ func TestAuctionManager_GetItemAuctionInfo(t *testing.T) {
 	}
 
 	// 清理所有现有的订单，避免影响测试
-	redis.GetRedis().Del(ctx, "auction:sells").Result()
-	redis.GetRedis().Del(ctx, "auction:buys").Result()
+	redis.GetRedis().Del(ctx, sellOrdersKey).Result()
+	redis.GetRedis().Del(ctx, buyOrdersKey).Result()
 
 	// 创建测试上下文
 	ctx = context.WithValue(ctx, "userId", "test_user_auction_info")
@@ -967,55 +1117,55 @@ func TestAuctionManager_GetItemAuctionInfo(t *testing.T) {
 	// 清理测试数据
 	// 清理道具1
 	if sellResp1.Data != nil {
-		redis.GetRedis().Del(ctx, "auction:sell:"+sellResp1.Data.OrderId)
-		redis.GetRedis().Del(ctx, "auction:order:"+sellResp1.Data.OrderId+":status")
-		redis.GetRedis().SRem(ctx, "auction:sells", "auction:sell:"+sellResp1.Data.OrderId)
-		redis.GetRedis().ZRem(ctx, "user:test_user_auction_info:transactions:time", sellResp1.Data.OrderId)
+		redis.GetRedis().Del(ctx, sellOrderKey(sellResp1.Data.OrderId))
+		redis.GetRedis().Del(ctx, orderStatusKey(sellResp1.Data.OrderId))
+		redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(sellResp1.Data.OrderId))
+		redis.GetRedis().ZRem(ctx, userTransactionsTimeKey("test_user_auction_info"), sellResp1.Data.OrderId)
 	}
 	if buyResp1.Data != nil {
-		redis.GetRedis().Del(ctx, "auction:buy:"+buyResp1.Data.OrderId)
-		redis.GetRedis().Del(ctx, "auction:order:"+buyResp1.Data.OrderId+":status")
-		redis.GetRedis().SRem(ctx, "auction:buys", "auction:buy:"+buyResp1.Data.OrderId)
-		redis.GetRedis().ZRem(ctx, "user:test_user_auction_info:transactions:time", buyResp1.Data.OrderId)
+		redis.GetRedis().Del(ctx, buyOrderKey(buyResp1.Data.OrderId))
+		redis.GetRedis().Del(ctx, orderStatusKey(buyResp1.Data.OrderId))
+		redis.GetRedis().SRem(ctx, buyOrdersKey, buyOrderKey(buyResp1.Data.OrderId))
+		redis.GetRedis().ZRem(ctx, userTransactionsTimeKey("test_user_auction_info"), buyResp1.Data.OrderId)
 	}
 
 	// 清理道具2
 	if sellResp2.Data != nil {
-		redis.GetRedis().Del(ctx, "auction:sell:"+sellResp2.Data.OrderId)
-		redis.GetRedis().Del(ctx, "auction:order:"+sellResp2.Data.OrderId+":status")
-		redis.GetRedis().SRem(ctx, "auction:sells", "auction:sell:"+sellResp2.Data.OrderId)
+		redis.GetRedis().Del(ctx, sellOrderKey(sellResp2.Data.OrderId))
+		redis.GetRedis().Del(ctx, orderStatusKey(sellResp2.Data.OrderId))
+		redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(sellResp2.Data.OrderId))
 	}
 
 	// 清理道具3
 	if buyResp3.Data != nil {
-		redis.GetRedis().Del(ctx, "auction:buy:"+buyResp3.Data.OrderId)
-		redis.GetRedis().Del(ctx, "auction:order:"+buyResp3.Data.OrderId+":status")
-		redis.GetRedis().SRem(ctx, "auction:buys", "auction:buy:"+buyResp3.Data.OrderId)
+		redis.GetRedis().Del(ctx, buyOrderKey(buyResp3.Data.OrderId))
+		redis.GetRedis().Del(ctx, orderStatusKey(buyResp3.Data.OrderId))
+		redis.GetRedis().SRem(ctx, buyOrdersKey, buyOrderKey(buyResp3.Data.OrderId))
 	}
 
 	// 清理道具5
 	for _, sellResp5 := range sellResps5 {
 		if sellResp5.Data != nil {
-			redis.GetRedis().Del(ctx, "auction:sell:"+sellResp5.Data.OrderId)
-			redis.GetRedis().Del(ctx, "auction:order:"+sellResp5.Data.OrderId+":status")
-			redis.GetRedis().SRem(ctx, "auction:sells", "auction:sell:"+sellResp5.Data.OrderId)
-			redis.GetRedis().ZRem(ctx, "user:test_user_auction_info:transactions:time", sellResp5.Data.OrderId)
+			redis.GetRedis().Del(ctx, sellOrderKey(sellResp5.Data.OrderId))
+			redis.GetRedis().Del(ctx, orderStatusKey(sellResp5.Data.OrderId))
+			redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(sellResp5.Data.OrderId))
+			redis.GetRedis().ZRem(ctx, userTransactionsTimeKey("test_user_auction_info"), sellResp5.Data.OrderId)
 		}
 	}
 
 	// 清理道具6
 	for _, buyResp6 := range buyResps6 {
 		if buyResp6.Data != nil {
-			redis.GetRedis().Del(ctx, "auction:buy:"+buyResp6.Data.OrderId)
-			redis.GetRedis().Del(ctx, "auction:order:"+buyResp6.Data.OrderId+":status")
-			redis.GetRedis().SRem(ctx, "auction:buys", "auction:buy:"+buyResp6.Data.OrderId)
-			redis.GetRedis().ZRem(ctx, "user:test_user_auction_info:transactions:time", buyResp6.Data.OrderId)
+			redis.GetRedis().Del(ctx, buyOrderKey(buyResp6.Data.OrderId))
+			redis.GetRedis().Del(ctx, orderStatusKey(buyResp6.Data.OrderId))
+			redis.GetRedis().SRem(ctx, buyOrdersKey, buyOrderKey(buyResp6.Data.OrderId))
+			redis.GetRedis().ZRem(ctx, userTransactionsTimeKey("test_user_auction_info"), buyResp6.Data.OrderId)
 		}
 	}
 
 	// 清理用户相关数据
-	redis.GetRedis().Del(ctx, "user:test_user_auction_info:sells")
-	redis.GetRedis().Del(ctx, "user:test_user_auction_info:buys")
+	redis.GetRedis().Del(ctx, userOrdersKey("test_user_auction_info", "sell"))
+	redis.GetRedis().Del(ctx, userOrdersKey("test_user_auction_info", "buy"))
 }
 
 // 测试用例: 按时间获取交易记录
@@ -1105,31 +1255,31 @@ func TestAuctionManager_GetTransactionsByTime(t *testing.T) {
 	// 清理测试数据
 	// 清理第一个出售订单
 	orderId1 := sellResp1.Data.OrderId
-	redis.GetRedis().Del(ctx, "auction:sell:"+orderId1)
-	redis.GetRedis().Del(ctx, "auction:order:"+orderId1+":status")
-	redis.GetRedis().SRem(ctx, "user:"+sellUserId+":sells", "auction:sell:"+orderId1)
-	redis.GetRedis().SRem(ctx, "auction:sells", "auction:sell:"+orderId1)
-	redis.GetRedis().ZRem(ctx, "user:"+sellUserId+":transactions:time", orderId1)
+	redis.GetRedis().Del(ctx, sellOrderKey(orderId1))
+	redis.GetRedis().Del(ctx, orderStatusKey(orderId1))
+	redis.GetRedis().SRem(ctx, userOrdersKey(sellUserId, "sell"), sellOrderKey(orderId1))
+	redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(orderId1))
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey(sellUserId), orderId1)
 
 	// 清理第二个出售订单和购买订单（已成交）
 	orderId2 := sellResp2.Data.OrderId
 	buyOrderId := buyResp.Data.OrderId
-	redis.GetRedis().Del(ctx, "auction:sell:"+orderId2)
-	redis.GetRedis().Del(ctx, "auction:order:"+orderId2+":status")
-	redis.GetRedis().Del(ctx, "auction:buy:"+buyOrderId)
-	redis.GetRedis().Del(ctx, "auction:order:"+buyOrderId+":status")
-	redis.GetRedis().SRem(ctx, "user:"+sellUserId+":sells", "auction:sell:"+orderId2)
-	redis.GetRedis().SRem(ctx, "user:"+buyUserId+":buys", "auction:buy:"+buyOrderId)
-	redis.GetRedis().SRem(ctx, "auction:sells", "auction:sell:"+orderId2)
-	redis.GetRedis().SRem(ctx, "auction:buys", "auction:buy:"+buyOrderId)
-	redis.GetRedis().ZRem(ctx, "user:"+sellUserId+":transactions:time", orderId2)
-	redis.GetRedis().ZRem(ctx, "user:"+buyUserId+":transactions:time", buyOrderId)
+	redis.GetRedis().Del(ctx, sellOrderKey(orderId2))
+	redis.GetRedis().Del(ctx, orderStatusKey(orderId2))
+	redis.GetRedis().Del(ctx, buyOrderKey(buyOrderId))
+	redis.GetRedis().Del(ctx, orderStatusKey(buyOrderId))
+	redis.GetRedis().SRem(ctx, userOrdersKey(sellUserId, "sell"), sellOrderKey(orderId2))
+	redis.GetRedis().SRem(ctx, userOrdersKey(buyUserId, "buy"), buyOrderKey(buyOrderId))
+	redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(orderId2))
+	redis.GetRedis().SRem(ctx, buyOrdersKey, buyOrderKey(buyOrderId))
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey(sellUserId), orderId2)
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey(buyUserId), buyOrderId)
 
 	// 清理用户相关数据
-	redis.GetRedis().Del(ctx, "user:"+sellUserId+":sells")
-	redis.GetRedis().Del(ctx, "user:"+buyUserId+":buys")
-	redis.GetRedis().Del(ctx, "user:"+sellUserId+":transactions:time")
-	redis.GetRedis().Del(ctx, "user:"+buyUserId+":transactions:time")
+	redis.GetRedis().Del(ctx, userOrdersKey(sellUserId, "sell"))
+	redis.GetRedis().Del(ctx, userOrdersKey(buyUserId, "buy"))
+	redis.GetRedis().Del(ctx, userTransactionsTimeKey(sellUserId))
+	redis.GetRedis().Del(ctx, userTransactionsTimeKey(buyUserId))
 }
 
 // 测试用例: 先挂买单后挂卖单并能成功交易（覆盖matchSellOrder函数的所有分支）
@@ -1152,7 +1302,7 @@ func TestAuctionManager_Trade_BuyFirstThenSell(t *testing.T) {
 	manager := GetAuctionManager()
 
 	// 获取当前市场的平均价格数据
-	mu := getMatchManager().GetMatchUnit("test_item_trade")
+	mu := testMatchUnit("test_item_trade")
 	avgPrice := mu.hourlyAvgPrice
 	t.Logf("Average price: %d", avgPrice)
 
@@ -1226,13 +1376,13 @@ func TestAuctionManager_Trade_BuyFirstThenSell(t *testing.T) {
 
 	// 4. 检查交易记录
 	// 获取买家的交易记录
-	buyTransactions, err := redis.GetRedis().ZRange(ctx, "user:"+buyUserId+":transactions:time", 0, -1).Result()
+	buyTransactions, err := redis.GetRedis().ZRange(ctx, userTransactionsTimeKey(buyUserId), 0, -1).Result()
 	assert.NoError(t, err)
 	assert.Greater(t, len(buyTransactions), 0)
 	t.Logf("Buyer transaction count: %d", len(buyTransactions))
 
 	// 获取卖家的交易记录
-	sellTransactions, err := redis.GetRedis().ZRange(ctx, "user:"+sellUserId+":transactions:time", 0, -1).Result()
+	sellTransactions, err := redis.GetRedis().ZRange(ctx, userTransactionsTimeKey(sellUserId), 0, -1).Result()
 	assert.NoError(t, err)
 	assert.Greater(t, len(sellTransactions), 0)
 	t.Logf("Seller transaction count: %d", len(sellTransactions))
@@ -1258,7 +1408,7 @@ func TestAuctionManager_Trade_BuyFirstThenSell_Simple(t *testing.T) {
 	manager := GetAuctionManager()
 
 	// 获取当前市场的平均价格数据
-	mu := getMatchManager().GetMatchUnit("test_item_trade")
+	mu := testMatchUnit("test_item_trade")
 	avgPrice := mu.hourlyAvgPrice
 	t.Logf("Average price: %d", avgPrice)
 
@@ -1304,13 +1454,13 @@ func TestAuctionManager_Trade_BuyFirstThenSell_Simple(t *testing.T) {
 
 	// 4. 检查交易记录
 	// 获取买家的交易记录
-	buyTransactions, err := redis.GetRedis().ZRange(ctx, "user:"+buyUserId+":transactions:time", 0, -1).Result()
+	buyTransactions, err := redis.GetRedis().ZRange(ctx, userTransactionsTimeKey(buyUserId), 0, -1).Result()
 	assert.NoError(t, err)
 	assert.Greater(t, len(buyTransactions), 0)
 	t.Logf("Buyer transaction count: %d", len(buyTransactions))
 
 	// 获取卖家的交易记录
-	sellTransactions, err := redis.GetRedis().ZRange(ctx, "user:"+sellUserId+":transactions:time", 0, -1).Result()
+	sellTransactions, err := redis.GetRedis().ZRange(ctx, userTransactionsTimeKey(sellUserId), 0, -1).Result()
 	assert.NoError(t, err)
 	assert.Greater(t, len(sellTransactions), 0)
 	t.Logf("Seller transaction count: %d", len(sellTransactions))
@@ -1411,31 +1561,31 @@ func TestAuctionManager_GetTransactionHistory(t *testing.T) {
 	// 清理测试数据
 	// 清理第一个出售订单
 	orderId1 := sellResp1.Data.OrderId
-	redis.GetRedis().Del(ctx, "auction:sell:"+orderId1)
-	redis.GetRedis().Del(ctx, "auction:order:"+orderId1+":status")
-	redis.GetRedis().SRem(ctx, "user:"+sellUserId+":sells", "auction:sell:"+orderId1)
-	redis.GetRedis().SRem(ctx, "auction:sells", "auction:sell:"+orderId1)
-	redis.GetRedis().ZRem(ctx, "user:"+sellUserId+":transactions:time", orderId1)
+	redis.GetRedis().Del(ctx, sellOrderKey(orderId1))
+	redis.GetRedis().Del(ctx, orderStatusKey(orderId1))
+	redis.GetRedis().SRem(ctx, userOrdersKey(sellUserId, "sell"), sellOrderKey(orderId1))
+	redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(orderId1))
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey(sellUserId), orderId1)
 
 	// 清理第二个出售订单和购买订单（已成交）
 	orderId2 := sellResp2.Data.OrderId
 	buyOrderId := buyResp.Data.OrderId
-	redis.GetRedis().Del(ctx, "auction:sell:"+orderId2)
-	redis.GetRedis().Del(ctx, "auction:order:"+orderId2+":status")
-	redis.GetRedis().Del(ctx, "auction:buy:"+buyOrderId)
-	redis.GetRedis().Del(ctx, "auction:order:"+buyOrderId+":status")
-	redis.GetRedis().SRem(ctx, "user:"+sellUserId+":sells", "auction:sell:"+orderId2)
-	redis.GetRedis().SRem(ctx, "user:"+buyUserId+":buys", "auction:buy:"+buyOrderId)
-	redis.GetRedis().SRem(ctx, "auction:sells", "auction:sell:"+orderId2)
-	redis.GetRedis().SRem(ctx, "auction:buys", "auction:buy:"+buyOrderId)
-	redis.GetRedis().ZRem(ctx, "user:"+sellUserId+":transactions:time", orderId2)
-	redis.GetRedis().ZRem(ctx, "user:"+buyUserId+":transactions:time", buyOrderId)
+	redis.GetRedis().Del(ctx, sellOrderKey(orderId2))
+	redis.GetRedis().Del(ctx, orderStatusKey(orderId2))
+	redis.GetRedis().Del(ctx, buyOrderKey(buyOrderId))
+	redis.GetRedis().Del(ctx, orderStatusKey(buyOrderId))
+	redis.GetRedis().SRem(ctx, userOrdersKey(sellUserId, "sell"), sellOrderKey(orderId2))
+	redis.GetRedis().SRem(ctx, userOrdersKey(buyUserId, "buy"), buyOrderKey(buyOrderId))
+	redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(orderId2))
+	redis.GetRedis().SRem(ctx, buyOrdersKey, buyOrderKey(buyOrderId))
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey(sellUserId), orderId2)
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey(buyUserId), buyOrderId)
 
 	// 清理用户相关数据
-	redis.GetRedis().Del(ctx, "user:"+sellUserId+":sells")
-	redis.GetRedis().Del(ctx, "user:"+buyUserId+":buys")
-	redis.GetRedis().Del(ctx, "user:"+sellUserId+":transactions:time")
-	redis.GetRedis().Del(ctx, "user:"+buyUserId+":transactions:time")
+	redis.GetRedis().Del(ctx, userOrdersKey(sellUserId, "sell"))
+	redis.GetRedis().Del(ctx, userOrdersKey(buyUserId, "buy"))
+	redis.GetRedis().Del(ctx, userTransactionsTimeKey(sellUserId))
+	redis.GetRedis().Del(ctx, userTransactionsTimeKey(buyUserId))
 }
 
 // 测试用例: 销售限制 - 超过最大销售数量
@@ -1455,7 +1605,7 @@ func TestAuctionManager_Sell_ExceedLimit(t *testing.T) {
 	manager := GetAuctionManager()
 
 	// 清理之前的测试数据
-	redis.GetRedis().Del(ctx, "user:test_user_limit:sells")
+	redis.GetRedis().Del(ctx, userOrdersKey("test_user_limit", "sell"))
 
 	// 创建8个出售订单（达到限制）
 	var orderIds []string
@@ -1485,18 +1635,18 @@ func TestAuctionManager_Sell_ExceedLimit(t *testing.T) {
 	}
 	sellResp, err := manager.Sell(ctx, sellReq)
 	assert.NoError(t, err)
-	assert.Equal(t, common.ErrorCode_AUCTION_PARAM_ERROR, sellResp.Code)
+	assert.Equal(t, common.ErrorCode_AUCTION_ORDER_LIMIT_EXCEEDED, sellResp.Code)
 	assert.Equal(t, "sell count exceeds limit", sellResp.Msg)
 
 	// 清理测试数据
 	for _, orderId := range orderIds {
-		redis.GetRedis().Del(ctx, "auction:sell:"+orderId)
-		redis.GetRedis().Del(ctx, "auction:order:"+orderId+":status")
-		redis.GetRedis().SRem(ctx, "user:test_user_limit:sells", "auction:sell:"+orderId)
-		redis.GetRedis().SRem(ctx, "auction:sells", "auction:sell:"+orderId)
-		redis.GetRedis().ZRem(ctx, "user:test_user_limit:transactions:time", orderId)
+		redis.GetRedis().Del(ctx, sellOrderKey(orderId))
+		redis.GetRedis().Del(ctx, orderStatusKey(orderId))
+		redis.GetRedis().SRem(ctx, userOrdersKey("test_user_limit", "sell"), sellOrderKey(orderId))
+		redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(orderId))
+		redis.GetRedis().ZRem(ctx, userTransactionsTimeKey("test_user_limit"), orderId)
 	}
-	redis.GetRedis().Del(ctx, "user:test_user_limit:sells")
+	redis.GetRedis().Del(ctx, userOrdersKey("test_user_limit", "sell"))
 }
 
 // 测试用例: 购买限制 - 超过最大购买数量
@@ -1516,7 +1666,7 @@ func TestAuctionManager_Buy_ExceedLimit(t *testing.T) {
 	manager := GetAuctionManager()
 
 	// 清理之前的测试数据
-	redis.GetRedis().Del(ctx, "user:test_user_limit:buys")
+	redis.GetRedis().Del(ctx, userOrdersKey("test_user_limit", "buy"))
 
 	// 创建8个购买订单（达到限制）
 	var orderIds []string
@@ -1544,18 +1694,18 @@ func TestAuctionManager_Buy_ExceedLimit(t *testing.T) {
 	}
 	buyResp, err := manager.Buy(ctx, buyReq)
 	assert.NoError(t, err)
-	assert.Equal(t, common.ErrorCode_AUCTION_PARAM_ERROR, buyResp.Code)
+	assert.Equal(t, common.ErrorCode_AUCTION_ORDER_LIMIT_EXCEEDED, buyResp.Code)
 	assert.Equal(t, "buy count exceeds limit", buyResp.Msg)
 
 	// 清理测试数据
 	for _, orderId := range orderIds {
-		redis.GetRedis().Del(ctx, "auction:buy:"+orderId)
-		redis.GetRedis().Del(ctx, "auction:order:"+orderId+":status")
-		redis.GetRedis().SRem(ctx, "user:test_user_limit:buys", "auction:buy:"+orderId)
-		redis.GetRedis().SRem(ctx, "auction:buys", "auction:buy:"+orderId)
-		redis.GetRedis().ZRem(ctx, "user:test_user_limit:transactions:time", orderId)
+		redis.GetRedis().Del(ctx, buyOrderKey(orderId))
+		redis.GetRedis().Del(ctx, orderStatusKey(orderId))
+		redis.GetRedis().SRem(ctx, userOrdersKey("test_user_limit", "buy"), buyOrderKey(orderId))
+		redis.GetRedis().SRem(ctx, buyOrdersKey, buyOrderKey(orderId))
+		redis.GetRedis().ZRem(ctx, userTransactionsTimeKey("test_user_limit"), orderId)
 	}
-	redis.GetRedis().Del(ctx, "user:test_user_limit:buys")
+	redis.GetRedis().Del(ctx, userOrdersKey("test_user_limit", "buy"))
 }
 
 // 测试用例: 价格限制 - 低于最低价格
@@ -1648,8 +1798,8 @@ func TestAuctionManager_GetMySells_PriceDisplay(t *testing.T) {
 	ctx = context.WithValue(ctx, "userId", userId)
 
 	// 清理该用户的所有相关数据
-	redis.GetRedis().Del(ctx, "user:"+userId+":sells")
-	redis.GetRedis().Del(ctx, "user:"+userId+":transactions:time")
+	redis.GetRedis().Del(ctx, userOrdersKey(userId, "sell"))
+	redis.GetRedis().Del(ctx, userTransactionsTimeKey(userId))
 
 	manager := GetAuctionManager()
 
@@ -1705,15 +1855,15 @@ func TestAuctionManager_GetMySells_PriceDisplay(t *testing.T) {
 	assert.Equal(t, int64(110), priceMap["test_item_price_2"])
 
 	// 清理测试数据
-	redis.GetRedis().Del(ctx, "auction:sell:"+sellResp1.Data.OrderId)
-	redis.GetRedis().Del(ctx, "auction:order:"+sellResp1.Data.OrderId+":status")
-	redis.GetRedis().Del(ctx, "auction:sell:"+sellResp2.Data.OrderId)
-	redis.GetRedis().Del(ctx, "auction:order:"+sellResp2.Data.OrderId+":status")
-	redis.GetRedis().Del(ctx, "user:"+userId+":sells")
-	redis.GetRedis().SRem(ctx, "auction:sells", "auction:sell:"+sellResp1.Data.OrderId)
-	redis.GetRedis().SRem(ctx, "auction:sells", "auction:sell:"+sellResp2.Data.OrderId)
-	redis.GetRedis().ZRem(ctx, "user:"+userId+":transactions:time", sellResp1.Data.OrderId)
-	redis.GetRedis().ZRem(ctx, "user:"+userId+":transactions:time", sellResp2.Data.OrderId)
+	redis.GetRedis().Del(ctx, sellOrderKey(sellResp1.Data.OrderId))
+	redis.GetRedis().Del(ctx, orderStatusKey(sellResp1.Data.OrderId))
+	redis.GetRedis().Del(ctx, sellOrderKey(sellResp2.Data.OrderId))
+	redis.GetRedis().Del(ctx, orderStatusKey(sellResp2.Data.OrderId))
+	redis.GetRedis().Del(ctx, userOrdersKey(userId, "sell"))
+	redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(sellResp1.Data.OrderId))
+	redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(sellResp2.Data.OrderId))
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey(userId), sellResp1.Data.OrderId)
+	redis.GetRedis().ZRem(ctx, userTransactionsTimeKey(userId), sellResp2.Data.OrderId)
 }
 
 // 测试Sell接口的幂等功能
@@ -1730,7 +1880,7 @@ func TestAuctionManager_SellIdempotency(t *testing.T) {
 	ctx = context.WithValue(ctx, "userId", "test_user_001")
 
 	// 获取当前市场的平均价格数据
-	mu := getMatchManager().GetMatchUnit("test_item_idempotent")
+	mu := testMatchUnit("test_item_idempotent")
 	avgPrice := mu.hourlyAvgPrice
 	// 预设交易价格
 	originalPrice := int64(100)
@@ -1820,7 +1970,7 @@ func TestAuctionManager_BuyIdempotency(t *testing.T) {
 	ctx = context.WithValue(ctx, "userId", "test_user_001")
 
 	// 获取当前市场的平均价格数据
-	mu := getMatchManager().GetMatchUnit("test_item_buy_idempotent")
+	mu := testMatchUnit("test_item_buy_idempotent")
 	avgPrice := mu.hourlyAvgPrice
 	// 预设交易价格
 	originalPrice := int64(100)
@@ -1917,7 +2067,7 @@ func TestAuctionManager_CancelSellIdempotency(t *testing.T) {
 	ctx = context.WithValue(ctx, "userId", "test_user_001")
 
 	// 获取当前市场的平均价格数据
-	mu := getMatchManager().GetMatchUnit("test_item_cancel_sell_idempotent")
+	mu := testMatchUnit("test_item_cancel_sell_idempotent")
 	avgPrice := mu.hourlyAvgPrice
 	// 预设交易价格
 	originalPrice := int64(100)
@@ -2006,7 +2156,7 @@ func TestAuctionManager_CancelBuyIdempotency(t *testing.T) {
 	ctx = context.WithValue(ctx, "userId", "test_user_001")
 
 	// 获取当前市场的平均价格数据
-	mu := getMatchManager().GetMatchUnit("test_item_cancel_buy_idempotent")
+	mu := testMatchUnit("test_item_cancel_buy_idempotent")
 	avgPrice := mu.hourlyAvgPrice
 	// 预设交易价格
 	originalPrice := int64(100)
@@ -2091,8 +2241,8 @@ func TestAuctionManager_CancelBuyIdempotency(t *testing.T) {
 	assert.Equal(t, "idempotent_id is empty", resp4.Msg)
 }
 
-// 测试 loadOrdersFromRedis 函数
-func TestLoadOrdersFromRedis(t *testing.T) {
+// 测试 loadItemOrders 函数：按道具挂单索引读取订单，不读取其他道具的订单，索引中已删除的订单跳过
+func TestLoadItemOrders(t *testing.T) {
 	// 初始化测试环境
 	setupTest()
 	defer teardownTest()
@@ -2100,85 +2250,95 @@ func TestLoadOrdersFromRedis(t *testing.T) {
 	// 创建测试上下文
 	ctx := context.Background()
 
-	// 1. 测试正常情况下的订单加载
-	t.Run("NormalCase", func(t *testing.T) {
-		// 清除之前的测试数据
-		redis.GetRedis().Del(ctx, "auction:sells").Result()
-		redis.GetRedis().Del(ctx, "auction:buys").Result()
-
-		// 先初始化 idClient
-		GetAuctionManager()
-
-		// 先初始化 matchMgr
-		getMatchManager()
-
-		// 添加测试数据到 Redis
-		sellOrderKey := "auction:sell:test_sell_order_1"
-		redis.GetRedis().HMSet(ctx, sellOrderKey, map[string]interface{}{
-			"order_id":    "test_sell_order_1",
-			"item_id":     "test_item_1",
-			"quantity":    "10",
-			"price":       "100",
-			"item_info":   "Test Item 1",
-			"create_time": "1234567890",
-		}).Result()
-		redis.GetRedis().SAdd(ctx, "auction:sells", sellOrderKey).Result()
-
-		buyOrderKey := "auction:buy:test_buy_order_1"
-		redis.GetRedis().HMSet(ctx, buyOrderKey, map[string]interface{}{
-			"order_id":    "test_buy_order_1",
-			"item_id":     "test_item_1",
-			"quantity":    "5",
-			"price":       "100",
-			"create_time": "1234567890",
-		}).Result()
-		redis.GetRedis().SAdd(ctx, "auction:buys", buyOrderKey).Result()
-
-		// 调用 loadOrdersFromRedis 函数
-		loadOrdersFromRedis(ctx)
-
-		// 由于 matchUnit 内部使用 channel 处理订单，需要等待处理完成
-		time.Sleep(100 * time.Millisecond)
-
-		// 验证订单是否被正确加载（这里可以根据 matchUnit 的内部结构进行验证）
-		t.Log("Orders loaded successfully")
+	// 添加测试数据到 Redis
+	sellKey := sellOrderKey("test_sell_order_1")
+	redis.GetRedis().HMSet(ctx, sellKey, map[string]interface{}{
+		"order_id":    "test_sell_order_1",
+		"item_id":     "test_item_1",
+		"quantity":    "10",
+		"price":       "100",
+		"item_info":   "Test Item 1",
+		"create_time": "1234567890",
+		"user_id":     "test_user_load_seller",
+	})
+	redis.GetRedis().SAdd(ctx, itemOrdersKey("test_item_1", "sell"), sellKey, sellOrderKey("test_sell_order_deleted"))
+
+	buyKey := buyOrderKey("test_buy_order_1")
+	redis.GetRedis().HMSet(ctx, buyKey, map[string]interface{}{
+		"order_id":    "test_buy_order_1",
+		"item_id":     "test_item_1",
+		"quantity":    "5",
+		"price":       "100",
+		"create_time": "1234567890",
+		"user_id":     "test_user_load_buyer",
+		"fee_reserve": "3",
+	})
+	redis.GetRedis().SAdd(ctx, itemOrdersKey("test_item_1", "buy"), buyKey)
+
+	otherKey := sellOrderKey("test_sell_order_2")
+	redis.GetRedis().HMSet(ctx, otherKey, map[string]interface{}{
+		"order_id": "test_sell_order_2",
+		"item_id":  "test_item_2",
+		"quantity": "1",
 	})
+	redis.GetRedis().SAdd(ctx, itemOrdersKey("test_item_2", "sell"), otherKey)
 
-	// 2. 测试 Redis 错误情况下的处理
-	t.Run("RedisError", func(t *testing.T) {
-		// 清除之前的测试数据
-		redis.GetRedis().Del(ctx, "auction:sells").Result()
-		redis.GetRedis().Del(ctx, "auction:buys").Result()
+	orders, err := loadItemOrders(ctx, "test_item_1")
+	assert.NoError(t, err)
+	if assert.Len(t, orders.sells, 1) && assert.Len(t, orders.buys, 1) {
+		assert.Equal(t, "test_sell_order_1", orders.sells[0].OrderId)
+		assert.Equal(t, int32(10), orders.sells[0].Quantity)
+		assert.Equal(t, "test_buy_order_1", orders.buys[0].OrderId)
+		assert.Equal(t, int32(5), orders.buys[0].Quantity)
+	}
+	assert.Equal(t, "test_user_load_seller", orders.parties[partyKey("sell", "test_sell_order_1")].userId)
+	assert.Equal(t, int64(3), orders.parties[partyKey("buy", "test_buy_order_1")].feeReserve)
 
-		// 调用 loadOrdersFromRedis 函数
-		loadOrdersFromRedis(ctx)
+	// 没有挂单的道具
+	orders, err = loadItemOrders(ctx, "test_item_none")
+	assert.NoError(t, err)
+	assert.Empty(t, orders.sells)
+	assert.Empty(t, orders.buys)
+}
 
-		// 验证函数能够正常执行，不会因为 Redis 错误而崩溃
-		t.Log("Function executed successfully with Redis error")
-	})
+// fakeNotifier 记录推送给用户的消息
+type fakeNotifier struct {
+	mu   sync.Mutex
+	msgs map[string][]proto.Message
+}
 
-	// 3. 测试订单数据解析错误的处理
-	t.Run("ParseError", func(t *testing.T) {
-		// 清除之前的测试数据
-		redis.GetRedis().Del(ctx, "auction:sells").Result()
-		redis.GetRedis().Del(ctx, "auction:buys").Result()
-
-		// 添加格式错误的测试数据到 Redis
-		invalidOrderKey := "auction:sell:invalid_order"
-		redis.GetRedis().HMSet(ctx, invalidOrderKey, map[string]interface{}{
-			"order_id":    "invalid_order",
-			"item_id":     "test_item_1",
-			"quantity":    "invalid", // 无效的数量
-			"price":       "100",
-			"item_info":   "Invalid Item",
-			"create_time": "1234567890",
-		}).Result()
-		redis.GetRedis().SAdd(ctx, "auction:sells", invalidOrderKey).Result()
-
-		// 调用 loadOrdersFromRedis 函数
-		loadOrdersFromRedis(ctx)
-
-		// 验证函数能够正常执行，不会因为数据解析错误而崩溃
-		t.Log("Function executed successfully with parse error")
-	})
+func (n *fakeNotifier) Notify(ctx context.Context, userId string, msg proto.Message) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.msgs[userId] = append(n.msgs[userId], msg)
+	return nil
+}
+
+// testMatchUnit 获取道具的撮合单元，本实例不持有该道具时panic，多实例测试需先通过RouteItem获得归属
+func testMatchUnit(itemId string) *matchUnit {
+	mu, err := getMatchManager().GetMatchUnit(itemId)
+	if err != nil {
+		panic(err)
+	}
+	return mu
+}
+
+// dumpBook 在撮合协程内导出订单簿（方向:订单ID:剩余数量，按撮合优先级排列）
+func dumpBook(mu *matchUnit) []string {
+	r := make(chan []string, 1)
+	mu.opChannel <- func() {
+		book := make([]string, 0)
+		mu.sellOrders.Ascend(func(item btree.Item) bool {
+			order := item.(*SellOrderByPriceAsc)
+			book = append(book, fmt.Sprintf("sell:%s:%d", order.OrderId, order.Quantity))
+			return true
+		})
+		mu.buyOrders.Ascend(func(item btree.Item) bool {
+			order := item.(*BuyOrderByPriceDesc)
+			book = append(book, fmt.Sprintf("buy:%s:%d", order.OrderId, order.Quantity))
+			return true
+		})
+		r <- book
+	}
+	return <-r
 }
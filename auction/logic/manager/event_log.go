@@ -0,0 +1,489 @@
+package manager
+
+import (
+	"auction_module/config"
+	"auction_module/kitex_gen/auction"
+	"auction_module/redis"
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/google/btree"
+)
+
+const (
+	eventStreamKeyPrefix    = "auction:events:"   // 撮合单元事件流（Redis Stream），按道具划分
+	snapshotKeyPrefix       = "auction:snapshot:" // 撮合单元订单簿快照，按道具划分
+	defaultSnapshotInterval = 1000                // 未配置时每追加多少条事件保存一次快照
+	defaultEventLogMaxLen   = 100000              // 未配置时事件流保留的最大条数（近似裁剪）
+)
+
+// 撮合单元事件类型，只记录对订单簿和小时数据产生影响的操作
+const (
+	eventAdd        = "add"         // 订单挂入订单簿（撮合后的剩余部分）
+	eventFill       = "fill"        // 订单簿中的订单被成交
+	eventCancel     = "cancel"      // 订单被取消
+	eventExpire     = "expire"      // 订单过期下架
+	eventHourlyRoll = "hourly_roll" // 小时数据滚动
+	eventRequeue    = "requeue"     // 订单移出订单簿重新撮合（恢复交易时处理交叉盘口），剩余部分以add事件重新挂入
+	eventReprice    = "reprice"     // 运维重置参考价
+	eventAmend      = "amend"       // 订单减少剩余数量（保留排队顺序）
+)
+
+// bookOrder 订单簿中的挂单
+type bookOrder struct {
+	OrderId    string `json:"order_id"`
+	ItemId     string `json:"item_id"`
+	ItemInfo   string `json:"item_info,omitempty"`
+	Quantity   int32  `json:"quantity"`
+	Price      int64  `json:"price"`
+	CreateTime int64  `json:"create_time"`
+	ExpireTime int64  `json:"expire_time,omitempty"`
+	UserId     string `json:"user_id,omitempty"`     // 订单所有者
+	FeeReserve int64  `json:"fee_reserve,omitempty"` // 买单剩余的手续费预留
+}
+
+// matchEvent 撮合单元事件
+type matchEvent struct {
+	Seq          int64      `json:"seq"`                      // 单元内递增序号
+	Type         string     `json:"type"`                     // 事件类型
+	Direction    string     `json:"direction,omitempty"`      // 订单方向（buy/sell），成交事件为被动方方向
+	OrderId      string     `json:"order_id,omitempty"`       // 订单ID，成交事件为被动方订单
+	Order        *bookOrder `json:"order,omitempty"`          // 挂单事件的订单数据
+	TakerOrderId string     `json:"taker_order_id,omitempty"` // 成交事件的主动方订单
+	Quantity     int32      `json:"quantity,omitempty"`       // 成交数量
+	Price        int64      `json:"price,omitempty"`          // 成交价格
+	FeeReserve   int64      `json:"fee_reserve,omitempty"`    // 买单剩余的手续费预留（买单被成交、修改时记录）
+	CurrentHour  int64      `json:"current_hour,omitempty"`   // 事件发生后的小时数据
+	HourlyPrice  int64      `json:"hourly_price,omitempty"`
+	HourlyQty    int32      `json:"hourly_qty,omitempty"`
+	HourlyAvg    int64      `json:"hourly_avg,omitempty"`
+	Time         int64      `json:"time"` // 事件时间
+}
+
+func sellBookOrder(order *auction.SellData) *bookOrder {
+	return &bookOrder{
+		OrderId:    order.OrderId,
+		ItemId:     order.ItemId,
+		ItemInfo:   order.ItemInfo,
+		Quantity:   order.Quantity,
+		Price:      order.Price,
+		CreateTime: order.CreateTime,
+		ExpireTime: order.ExpireTime,
+	}
+}
+
+func buyBookOrder(order *auction.BuyData) *bookOrder {
+	return &bookOrder{
+		OrderId:    order.OrderId,
+		ItemId:     order.ItemId,
+		Quantity:   order.Quantity,
+		Price:      order.Price,
+		CreateTime: order.CreateTime,
+		ExpireTime: order.ExpireTime,
+	}
+}
+
+func (o *bookOrder) sellItem() *SellOrderByPriceAsc {
+	return &SellOrderByPriceAsc{
+		OrderId:    o.OrderId,
+		ItemId:     o.ItemId,
+		ItemInfo:   o.ItemInfo,
+		Quantity:   o.Quantity,
+		Price:      o.Price,
+		CreateTime: o.CreateTime,
+		ExpireTime: o.ExpireTime,
+	}
+}
+
+func (o *bookOrder) buyItem() *BuyOrderByPriceDesc {
+	return &BuyOrderByPriceDesc{
+		OrderId:    o.OrderId,
+		ItemId:     o.ItemId,
+		Quantity:   o.Quantity,
+		Price:      o.Price,
+		CreateTime: o.CreateTime,
+		ExpireTime: o.ExpireTime,
+	}
+}
+
+// withParty 填充挂单的所有者，回放时据此恢复撮合单元内的订单所有者
+func (mu *matchUnit) withParty(direction string, o *bookOrder) *bookOrder {
+	party := mu.party(direction, o.OrderId)
+	o.UserId, o.FeeReserve = party.userId, party.feeReserve
+	return o
+}
+
+// restoreParty 回放挂单时恢复订单所有者，旧事件中没有所有者时留待loadOrdersFromRedis校正
+func (mu *matchUnit) restoreParty(direction string, o *bookOrder) {
+	if o.UserId != "" {
+		mu.bindParty(direction, o.OrderId, o.UserId, o.FeeReserve)
+	}
+}
+
+// configInt 读取整数配置，未配置或非法时返回默认值
+func configInt(key string, def int) int {
+	if v, ok := config.Get(key).(int); ok && v > 0 {
+		return v
+	}
+	return def
+}
+
+// appendEvent 追加撮合单元事件（在撮合协程内调用），达到快照间隔时保存快照
+// 事件交给落库协程与成交一起分批写入事件流，进程在写入前退出时丢失的尾部事件由loadOrdersFromRedis以Redis订单数据校正
+func (mu *matchUnit) appendEvent(ctx context.Context, ev *matchEvent) {
+	mu.eventSeq++
+	ev.Seq = mu.eventSeq
+	ev.Time = time.Now().Unix()
+	mu.settler.submitEvent(ev)
+
+	mu.eventsSinceSnapshot++
+	if mu.eventsSinceSnapshot >= configInt("auction.snapshot_interval", defaultSnapshotInterval) {
+		mu.saveSnapshot(ctx)
+	}
+}
+
+// hourlyEvent 填充事件发生后的小时数据
+func (mu *matchUnit) hourlyEvent(ev *matchEvent) *matchEvent {
+	ev.CurrentHour = mu.currentHour
+	ev.HourlyPrice = mu.hourlyTotalPrice
+	ev.HourlyQty = mu.hourlyTotalQty
+	ev.HourlyAvg = mu.hourlyAvgPrice
+	return ev
+}
+
+// saveSnapshot 保存订单簿快照（在撮合协程内调用），恢复时从快照对应的事件之后开始回放
+// 快照在撮合协程内生成，由落库协程在此前的事件写入后保存，并记录最后一条事件在事件流中的ID
+func (mu *matchUnit) saveSnapshot(ctx context.Context) {
+	sells := make([]*bookOrder, 0, mu.sellOrders.Len())
+	mu.sellOrders.Ascend(func(item btree.Item) bool {
+		sells = append(sells, mu.withParty("sell", sellBookOrder((*auction.SellData)(item.(*SellOrderByPriceAsc)))))
+		return true
+	})
+	buys := make([]*bookOrder, 0, mu.buyOrders.Len())
+	mu.buyOrders.Ascend(func(item btree.Item) bool {
+		buys = append(buys, mu.withParty("buy", buyBookOrder((*auction.BuyData)(item.(*BuyOrderByPriceDesc)))))
+		return true
+	})
+	sellsData, _ := json.Marshal(sells)
+	buysData, _ := json.Marshal(buys)
+
+	mu.settler.submitSnapshot(map[string]interface{}{
+		"seq":                mu.eventSeq,
+		"sells":              string(sellsData),
+		"buys":               string(buysData),
+		"current_hour":       mu.currentHour,
+		"hourly_total_price": mu.hourlyTotalPrice,
+		"hourly_total_qty":   mu.hourlyTotalQty,
+		"hourly_avg_price":   mu.hourlyAvgPrice,
+		"snapshot_time":      time.Now().Unix(),
+	})
+	mu.eventsSinceSnapshot = 0
+}
+
+// restoreFromEventLog 从快照和事件流恢复订单簿（撮合协程启动前调用），回放过程不触发撮合
+func (mu *matchUnit) restoreFromEventLog(ctx context.Context) {
+	// 回放得到的小时数据不属于当前小时时，保留创建撮合单元时从Redis读取的数据
+	currentHour, hourlyTotalPrice, hourlyTotalQty, hourlyAvgPrice := mu.currentHour, mu.hourlyTotalPrice, mu.hourlyTotalQty, mu.hourlyAvgPrice
+	defer func() {
+		if mu.currentHour != currentHour {
+			mu.currentHour, mu.hourlyTotalPrice, mu.hourlyTotalQty, mu.hourlyAvgPrice = currentHour, hourlyTotalPrice, hourlyTotalQty, hourlyAvgPrice
+		}
+	}()
+
+	start := "-"
+	snapshot, err := redis.GetRedis().HGetAll(ctx, snapshotKeyPrefix+mu.itemId).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-EVENT-LOG] load snapshot error: itemId=%s, error: %s", mu.itemId, err.Error())
+		return
+	}
+	if len(snapshot) > 0 {
+		var sells, buys []*bookOrder
+		if err := json.Unmarshal([]byte(snapshot["sells"]), &sells); err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-EVENT-LOG] invalid snapshot sells: itemId=%s, error: %s", mu.itemId, err.Error())
+		}
+		if err := json.Unmarshal([]byte(snapshot["buys"]), &buys); err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-EVENT-LOG] invalid snapshot buys: itemId=%s, error: %s", mu.itemId, err.Error())
+		}
+		for _, order := range sells {
+			mu.sellOrders.ReplaceOrInsert(order.sellItem())
+			mu.expireWheel.Add(order.OrderId, "sell", order.ExpireTime)
+			mu.restoreParty("sell", order)
+		}
+		for _, order := range buys {
+			mu.buyOrders.ReplaceOrInsert(order.buyItem())
+			mu.expireWheel.Add(order.OrderId, "buy", order.ExpireTime)
+			mu.restoreParty("buy", order)
+		}
+		mu.currentHour = parseInt64(snapshot["current_hour"])
+		mu.hourlyTotalPrice = parseInt64(snapshot["hourly_total_price"])
+		mu.hourlyTotalQty = int32(parseInt(snapshot["hourly_total_qty"]))
+		mu.hourlyAvgPrice = parseInt64(snapshot["hourly_avg_price"])
+		mu.eventSeq = parseInt64(snapshot["seq"])
+		mu.settler.lastEventId = snapshot["event_id"]
+		if mu.settler.lastEventId != "" {
+			start = mu.settler.lastEventId
+		}
+	}
+
+	messages, err := redis.GetRedis().XRange(ctx, eventStreamKeyPrefix+mu.itemId, start, "+").Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-EVENT-LOG] load events error: itemId=%s, error: %s", mu.itemId, err.Error())
+		return
+	}
+	replayed := 0
+	for _, msg := range messages {
+		ev := &matchEvent{}
+		if data, ok := msg.Values["data"].(string); !ok || json.Unmarshal([]byte(data), ev) != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-EVENT-LOG] invalid event: itemId=%s, id=%s", mu.itemId, msg.ID)
+			continue
+		}
+		if ev.Seq <= mu.eventSeq {
+			continue
+		}
+		if ev.Seq != mu.eventSeq+1 {
+			// 事件缺失（被裁剪），后续由loadOrdersFromRedis以Redis订单数据校正
+			klog.CtxWarnf(ctx, "[AUCTION-EVENT-LOG] event gap: itemId=%s, expected seq=%d, got=%d", mu.itemId, mu.eventSeq+1, ev.Seq)
+		}
+		mu.applyEvent(ev)
+		mu.eventSeq = ev.Seq
+		mu.settler.lastEventId = msg.ID
+		replayed++
+	}
+	mu.eventsSinceSnapshot = replayed
+
+	if len(snapshot) > 0 || replayed > 0 {
+		klog.CtxInfof(ctx, "[AUCTION-EVENT-LOG] Order book restored: itemId=%s, seq=%d, replayed=%d, sells=%d, buys=%d",
+			mu.itemId, mu.eventSeq, replayed, mu.sellOrders.Len(), mu.buyOrders.Len())
+	}
+}
+
+// applyEvent 回放单条事件，直接修改订单簿，不撮合也不写Redis
+func (mu *matchUnit) applyEvent(ev *matchEvent) {
+	switch ev.Type {
+	case eventAdd:
+		if ev.Order == nil {
+			return
+		}
+		if ev.Direction == "sell" {
+			mu.sellOrders.ReplaceOrInsert(ev.Order.sellItem())
+		} else {
+			mu.buyOrders.ReplaceOrInsert(ev.Order.buyItem())
+		}
+		mu.expireWheel.Add(ev.Order.OrderId, ev.Direction, ev.Order.ExpireTime)
+		mu.restoreParty(ev.Direction, ev.Order)
+	case eventFill:
+		if ev.Direction == "sell" {
+			if order := mu.findSellOrder(ev.OrderId); order != nil {
+				if order.Quantity > ev.Quantity {
+					order.Quantity -= ev.Quantity
+				} else {
+					mu.sellOrders.Delete(order)
+					mu.expireWheel.Remove(ev.OrderId)
+					mu.releaseParty("sell", ev.OrderId)
+				}
+			}
+		} else {
+			if order := mu.findBuyOrder(ev.OrderId); order != nil {
+				if order.Quantity > ev.Quantity {
+					order.Quantity -= ev.Quantity
+					mu.party("buy", ev.OrderId).feeReserve = ev.FeeReserve
+				} else {
+					mu.buyOrders.Delete(order)
+					mu.expireWheel.Remove(ev.OrderId)
+					mu.releaseParty("buy", ev.OrderId)
+				}
+			}
+		}
+		mu.applyHourly(ev)
+	case eventCancel, eventExpire, eventRequeue:
+		if ev.Direction == "sell" {
+			if order := mu.findSellOrder(ev.OrderId); order != nil {
+				mu.sellOrders.Delete(order)
+			}
+		} else {
+			if order := mu.findBuyOrder(ev.OrderId); order != nil {
+				mu.buyOrders.Delete(order)
+			}
+		}
+		mu.expireWheel.Remove(ev.OrderId)
+		mu.releaseParty(ev.Direction, ev.OrderId)
+	case eventAmend:
+		if ev.Direction == "sell" {
+			if order := mu.findSellOrder(ev.OrderId); order != nil {
+				order.Quantity = ev.Quantity
+			}
+		} else {
+			if order := mu.findBuyOrder(ev.OrderId); order != nil {
+				order.Quantity = ev.Quantity
+				mu.party("buy", ev.OrderId).feeReserve = ev.FeeReserve
+			}
+		}
+	case eventHourlyRoll, eventReprice:
+		mu.applyHourly(ev)
+	}
+}
+
+func (mu *matchUnit) applyHourly(ev *matchEvent) {
+	mu.currentHour = ev.CurrentHour
+	mu.hourlyTotalPrice = ev.HourlyPrice
+	mu.hourlyTotalQty = ev.HourlyQty
+	mu.hourlyAvgPrice = ev.HourlyAvg
+}
+
+// findSellOrder 按订单ID查找订单簿中的卖单，不存在时返回nil；只能原地修改不参与排序的字段
+func (mu *matchUnit) findSellOrder(orderId string) *SellOrderByPriceAsc {
+	var found *SellOrderByPriceAsc
+	mu.sellOrders.Ascend(func(item btree.Item) bool {
+		order := item.(*SellOrderByPriceAsc)
+		if order.OrderId == orderId {
+			found = order
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// findBuyOrder 按订单ID查找订单簿中的买单，不存在时返回nil；只能原地修改不参与排序的字段
+func (mu *matchUnit) findBuyOrder(orderId string) *BuyOrderByPriceDesc {
+	var found *BuyOrderByPriceDesc
+	mu.buyOrders.Ascend(func(item btree.Item) bool {
+		order := item.(*BuyOrderByPriceDesc)
+		if order.OrderId == orderId {
+			found = order
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// pendingOrder 存在于Redis但未进入订单簿的订单（下单后撮合前中断）
+type pendingOrder struct {
+	sell *auction.SellData
+	buy  *auction.BuyData
+}
+
+func (p *pendingOrder) key() (int64, string) {
+	if p.sell != nil {
+		return p.sell.CreateTime, p.sell.OrderId
+	}
+	return p.buy.CreateTime, p.buy.OrderId
+}
+
+// reconcileOrders 以Redis中的订单数据为准校正回放得到的订单簿（在撮合协程内调用）：
+// 1. 订单簿中已不存在于Redis的订单移除
+// 2. 剩余数量不一致的以Redis为准
+// 3. Redis中存在但不在订单簿中的订单按创建时间、订单ID的顺序重新执行
+// 订单所有者和手续费预留同样以Redis为准（parties按direction:orderId索引）
+func (mu *matchUnit) reconcileOrders(ctx context.Context, sells []*auction.SellData, buys []*auction.BuyData, parties map[string]*bookParty) {
+	for key, party := range parties {
+		mu.parties[key] = party
+	}
+
+	redisSells := make(map[string]*auction.SellData, len(sells))
+	for _, order := range sells {
+		redisSells[order.OrderId] = order
+	}
+	redisBuys := make(map[string]*auction.BuyData, len(buys))
+	for _, order := range buys {
+		redisBuys[order.OrderId] = order
+	}
+
+	// 遍历时不能修改BTree，先收集需要校正的订单
+	var staleSells []btree.Item
+	inBookSells := make(map[string]bool)
+	mu.sellOrders.Ascend(func(item btree.Item) bool {
+		order := item.(*SellOrderByPriceAsc)
+		if data, ok := redisSells[order.OrderId]; !ok || data.Quantity != order.Quantity {
+			staleSells = append(staleSells, item)
+		}
+		inBookSells[order.OrderId] = true
+		return true
+	})
+	for _, item := range staleSells {
+		order := item.(*SellOrderByPriceAsc)
+		data, ok := redisSells[order.OrderId]
+		if !ok || data.Quantity <= 0 {
+			mu.sellOrders.Delete(item)
+			mu.expireWheel.Remove(order.OrderId)
+			mu.releaseParty("sell", order.OrderId)
+			mu.appendEvent(ctx, &matchEvent{Type: eventCancel, Direction: "sell", OrderId: order.OrderId})
+			continue
+		}
+		order.Quantity = data.Quantity
+		mu.appendEvent(ctx, &matchEvent{Type: eventAdd, Direction: "sell", Order: mu.withParty("sell", sellBookOrder((*auction.SellData)(order)))})
+	}
+
+	var staleBuys []btree.Item
+	inBookBuys := make(map[string]bool)
+	mu.buyOrders.Ascend(func(item btree.Item) bool {
+		order := item.(*BuyOrderByPriceDesc)
+		if data, ok := redisBuys[order.OrderId]; !ok || data.Quantity != order.Quantity {
+			staleBuys = append(staleBuys, item)
+		}
+		inBookBuys[order.OrderId] = true
+		return true
+	})
+	for _, item := range staleBuys {
+		order := item.(*BuyOrderByPriceDesc)
+		data, ok := redisBuys[order.OrderId]
+		if !ok || data.Quantity <= 0 {
+			mu.buyOrders.Delete(item)
+			mu.expireWheel.Remove(order.OrderId)
+			mu.releaseParty("buy", order.OrderId)
+			mu.appendEvent(ctx, &matchEvent{Type: eventCancel, Direction: "buy", OrderId: order.OrderId})
+			continue
+		}
+		order.Quantity = data.Quantity
+		mu.appendEvent(ctx, &matchEvent{Type: eventAdd, Direction: "buy", Order: mu.withParty("buy", buyBookOrder((*auction.BuyData)(order)))})
+	}
+
+	// 未进入订单簿的订单按下单顺序重新执行
+	pending := make([]*pendingOrder, 0)
+	for _, order := range sells {
+		if !inBookSells[order.OrderId] {
+			pending = append(pending, &pendingOrder{sell: order})
+		}
+	}
+	for _, order := range buys {
+		if !inBookBuys[order.OrderId] {
+			pending = append(pending, &pendingOrder{buy: order})
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		ti, idi := pending[i].key()
+		tj, idj := pending[j].key()
+		if ti != tj {
+			return ti < tj
+		}
+		return idi < idj
+	})
+	for _, p := range pending {
+		if p.sell != nil {
+			// 即时订单在撮合前中断，撤销剩余部分而不是挂单
+			if p.sell.OrderType != auction.OrderType_LIMIT {
+				mu.cancelRemainder(ctx, "sell", p.sell.OrderId, p.sell.OrderType)
+				mu.releaseParty("sell", p.sell.OrderId)
+				continue
+			}
+			mu.AddSellOrder(ctx, p.sell)
+		} else {
+			if p.buy.OrderType != auction.OrderType_LIMIT {
+				mu.cancelRemainder(ctx, "buy", p.buy.OrderId, p.buy.OrderType)
+				mu.releaseParty("buy", p.buy.OrderId)
+				continue
+			}
+			mu.AddBuyOrder(ctx, p.buy)
+		}
+	}
+
+	if len(staleSells) > 0 || len(staleBuys) > 0 || len(pending) > 0 {
+		klog.CtxInfof(ctx, "[AUCTION-EVENT-LOG] Order book reconciled: itemId=%s, staleSells=%d, staleBuys=%d, pending=%d",
+			mu.itemId, len(staleSells), len(staleBuys), len(pending))
+		mu.saveSnapshot(ctx)
+	}
+}
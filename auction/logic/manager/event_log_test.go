@@ -0,0 +1,121 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// crashUnit 模拟进程崩溃：直接丢弃撮合单元，不保存快照
+func crashUnit(mgr *matchManager, itemId string) {
+	mgr.mu.Lock()
+	unit := mgr.matchUnits[itemId]
+	delete(mgr.matchUnits, itemId)
+	mgr.mu.Unlock()
+	if unit != nil && unit.stop != nil {
+		// 模拟写入协程已把投递的事件写入事件流后崩溃
+		done := make(chan bool)
+		unit.opChannel <- func() {
+			unit.settler.flush()
+			done <- true
+		}
+		<-done
+		unit.stop()
+	}
+}
+
+// 测试用例: 崩溃后从快照和事件流恢复出完全一致的订单簿，并以Redis订单数据校正
+func TestMatchUnit_EventLogReplay(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	// 检查Redis连接是否正常
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	sellCtx := context.WithValue(ctx, "userId", "test_user_replay_seller")
+	buyCtx := context.WithValue(ctx, "userId", "test_user_replay_buyer")
+	itemId := "test_item_replay"
+	manager := GetAuctionManager()
+	mgr := getMatchManager()
+	avgPrice := testMatchUnit(itemId).hourlyAvgPrice
+	seq := 0
+	idem := func() string {
+		seq++
+		return fmt.Sprintf("test_replay_%d_%d", time.Now().UnixNano(), seq)
+	}
+
+	// 1. 挂单、成交、取消
+	sell1, _ := manager.Sell(sellCtx, &auction.SellReq{ItemId: itemId, Quantity: 3, Price: avgPrice, IdempotentId: idem()})
+	sell2, _ := manager.Sell(sellCtx, &auction.SellReq{ItemId: itemId, Quantity: 2, Price: avgPrice + 1, IdempotentId: idem()})
+	sell3, _ := manager.Sell(sellCtx, &auction.SellReq{ItemId: itemId, Quantity: 5, Price: avgPrice + 2, IdempotentId: idem()})
+	buy1, _ := manager.Buy(buyCtx, &auction.BuyReq{ItemId: itemId, Quantity: 4, Price: avgPrice - 1, IdempotentId: idem()})
+	buy2, _ := manager.Buy(buyCtx, &auction.BuyReq{ItemId: itemId, Quantity: 2, Price: avgPrice, IdempotentId: idem()})
+	_, err = manager.CancelSell(sellCtx, &auction.CancelSellReq{OrderId: sell2.Data.OrderId, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, buy2.Code)
+
+	expected := []string{
+		"sell:" + sell1.Data.OrderId + ":1",
+		"sell:" + sell3.Data.OrderId + ":5",
+		"buy:" + buy1.Data.OrderId + ":4",
+	}
+	assert.Equal(t, expected, dumpBook(testMatchUnit(itemId)))
+	hourlyQty := testMatchUnit(itemId).hourlyTotalQty
+
+	// 2. 崩溃后仅通过事件流恢复
+	crashUnit(mgr, itemId)
+	restored := testMatchUnit(itemId)
+	assert.Equal(t, expected, dumpBook(restored))
+	assert.Equal(t, hourlyQty, restored.hourlyTotalQty)
+
+	// 3. 保存快照后继续操作，崩溃后从快照+后续事件恢复
+	done := make(chan bool)
+	restored.opChannel <- func() {
+		restored.saveSnapshot(ctx)
+		done <- true
+	}
+	<-done
+	_, err = manager.CancelBuy(buyCtx, &auction.CancelBuyReq{OrderId: buy1.Data.OrderId, IdempotentId: idem()})
+	assert.NoError(t, err)
+	expected = expected[:2]
+	lastSeq := restored.eventSeq
+	crashUnit(mgr, itemId)
+	restored = testMatchUnit(itemId)
+	assert.Equal(t, expected, dumpBook(restored))
+	assert.Equal(t, lastSeq, restored.eventSeq)
+
+	// 4. 以Redis为准校正：已删除的订单移除，撮合前中断的订单重新执行
+	redis.GetRedis().Del(ctx, sellOrderKey(sell3.Data.OrderId))
+	redis.GetRedis().SRem(ctx, sellOrdersKey, sellOrderKey(sell3.Data.OrderId))
+	redis.GetRedis().SRem(ctx, itemOrdersKey(itemId, "sell"), sellOrderKey(sell3.Data.OrderId))
+	pendingKey := buyOrderKey("test_replay_pending")
+	redis.GetRedis().HMSet(ctx, pendingKey, map[string]interface{}{
+		"order_id":    "test_replay_pending",
+		"item_id":     itemId,
+		"quantity":    "3",
+		"price":       strconv.FormatInt(avgPrice-2, 10),
+		"create_time": strconv.FormatInt(time.Now().Unix(), 10),
+		"user_id":     "test_user_replay_buyer",
+	})
+	redis.GetRedis().SAdd(ctx, buyOrdersKey, pendingKey)
+	redis.GetRedis().SAdd(ctx, itemOrdersKey(itemId, "buy"), pendingKey)
+	orders, err := loadItemOrders(ctx, itemId)
+	assert.NoError(t, err)
+	assert.NoError(t, restored.call(ctx, func() {
+		restored.reconcileOrders(ctx, orders.sells, orders.buys, orders.parties)
+	}))
+	assert.Equal(t, []string{
+		"sell:" + sell1.Data.OrderId + ":1",
+		"buy:test_replay_pending:3",
+	}, dumpBook(restored))
+}
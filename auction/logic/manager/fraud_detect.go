@@ -0,0 +1,338 @@
+package manager
+
+import (
+	"auction_module/config"
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/auction_admin"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"auction_module/redis/script"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/google/btree"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// 成交风控：撮合成交后分析自成交、同一对手方反复成交、环形交易和偏离参考价的异常成交，
+// 命中的交易写入风控标记供运维查询，不影响成交本身
+
+const (
+	// 风控数据使用相同的hash tag，环形交易检测的脚本和标记写入的事务只访问一个slot
+	fraudFlagsKey        = "auction:{fraud}:flags" // 全部风控标记（ZSET，score为标记时间，member为标记JSON）
+	fraudUserFlagsPrefix = "auction:{fraud}:user:" // 用户相关的风控标记（ZSET）
+	fraudPairPrefix      = "auction:{fraud}:pair:" // 同一对手方在同一道具上的成交次数（按窗口过期）
+	fraudFlowPrefix      = "auction:{fraud}:flow:" // 用户卖出道具的下游用户（ZSET，score为最近成交时间）
+
+	defaultFraudPairWindow    = 3600   // 同一对手方成交计数窗口（秒）
+	defaultFraudPairThreshold = 5      // 窗口内同一对手方成交达到该次数时标记
+	defaultFraudFlowWindow    = 86400  // 环形交易检测窗口（秒）
+	defaultFraudPriceImpact   = 9      // 成交价偏离参考价达到该百分比时标记
+	defaultFraudFlagMaxLen    = 100000 // 全部风控标记保留的最大条数
+	defaultFraudUserFlagLen   = 1000   // 单个用户保留的最大标记条数
+	defaultFraudUserFlagTTL   = 30 * 86400
+	fraudFlowFanout           = 50 // 环形交易检测时每个用户最多展开的下游用户数
+
+	defaultFraudQueryLimit = 100
+	maxFraudQueryLimit     = 1000
+)
+
+// 风控标记类型
+const (
+	fraudSelfTrade    = "self_trade"    // 自成交：买卖双方为同一用户
+	fraudRepeatedPair = "repeated_pair" // 窗口内同一对手方反复成交
+	fraudCircularFlow = "circular_flow" // 环形交易：道具经两到三个用户流转后回到卖家
+	fraudPriceImpact  = "price_impact"  // 成交价大幅偏离参考价
+)
+
+// fraudFill 风控分析用的成交信息
+type fraudFill struct {
+	TransactionId string
+	ItemId        string
+	SellerId      string
+	BuyerId       string
+	Price         int64
+	Quantity      int32
+	RefPrice      int64 // 成交前的参考价（小时均价）
+	Time          int64
+}
+
+// fraudFlag 风控标记
+type fraudFlag struct {
+	FlagId        string   `json:"flag_id"`
+	Type          string   `json:"type"`
+	ItemId        string   `json:"item_id"`
+	UserIds       []string `json:"user_ids"`
+	TransactionId string   `json:"transaction_id"`
+	Price         int64    `json:"price"`
+	Quantity      int32    `json:"quantity"`
+	Detail        string   `json:"detail"`
+	CreateTime    int64    `json:"create_time"`
+}
+
+func (f *fraudFlag) toProto() *auction_admin.FraudFlag {
+	return &auction_admin.FraudFlag{
+		FlagId:        f.FlagId,
+		FlagType:      f.Type,
+		ItemId:        f.ItemId,
+		UserIds:       f.UserIds,
+		TransactionId: f.TransactionId,
+		Price:         f.Price,
+		Quantity:      f.Quantity,
+		Detail:        f.Detail,
+		CreateTime:    f.CreateTime,
+	}
+}
+
+// blockSelfMatch 是否拦截自成交（同一用户的买卖单不撮合）
+func blockSelfMatch() bool {
+	block, _ := config.Get("auction_fraud.block_self_match").(bool)
+	return block
+}
+
+// detectFraud 分析一笔成交并写入风控标记（撮合协程内调用），返回命中的标记
+func detectFraud(ctx context.Context, fill *fraudFill) []*fraudFlag {
+	if fill.SellerId == "" || fill.BuyerId == "" {
+		return nil
+	}
+	newFlag := func(flagType string, userIds []string, detail string) *fraudFlag {
+		return &fraudFlag{
+			FlagId:        idClient.Generate().String(),
+			Type:          flagType,
+			ItemId:        fill.ItemId,
+			UserIds:       userIds,
+			TransactionId: fill.TransactionId,
+			Price:         fill.Price,
+			Quantity:      fill.Quantity,
+			Detail:        detail,
+			CreateTime:    fill.Time,
+		}
+	}
+
+	parties := []string{fill.SellerId, fill.BuyerId}
+	flags := make([]*fraudFlag, 0)
+	if fill.SellerId == fill.BuyerId {
+		parties = parties[:1]
+		flags = append(flags, newFlag(fraudSelfTrade, parties, ""))
+	} else {
+		pairWindow := configSeconds("auction_fraud.pair_window", defaultFraudPairWindow)
+		first, second := fill.SellerId, fill.BuyerId
+		if first > second {
+			first, second = second, first
+		}
+		pairKey := fraudPairPrefix + fill.ItemId + ":" + first + ":" + second
+		res, err := script.Run(ctx, redis.GetRedis(), script.FraudFlow, []string{pairKey, fraudFlowPrefix + fill.SellerId},
+			fill.SellerId,
+			fill.BuyerId,
+			fill.Time,
+			pairWindow,
+			configSeconds("auction_fraud.flow_window", defaultFraudFlowWindow),
+			fraudFlowFanout,
+			fraudFlowPrefix,
+		).Slice()
+		if err != nil || len(res) < 3 {
+			klog.CtxErrorf(ctx, "[AUCTION-FRAUD] update trade flow error: transactionId=%s, error: %v", fill.TransactionId, err)
+		} else {
+			pairCount, _ := res[0].(int64)
+			cycleLen, _ := res[1].(int64)
+			via, _ := res[2].(string)
+			// 每个窗口内只在达到阈值时标记一次
+			if threshold := configInt("auction_fraud.pair_threshold", defaultFraudPairThreshold); pairCount == int64(threshold) {
+				flags = append(flags, newFlag(fraudRepeatedPair, parties,
+					fmt.Sprintf("%d trades within %ds", pairCount, pairWindow)))
+			}
+			switch cycleLen {
+			case 2:
+				flags = append(flags, newFlag(fraudCircularFlow, parties,
+					fmt.Sprintf("%s -> %s -> %s", fill.SellerId, fill.BuyerId, fill.SellerId)))
+			case 3:
+				flags = append(flags, newFlag(fraudCircularFlow, []string{fill.SellerId, fill.BuyerId, via},
+					fmt.Sprintf("%s -> %s -> %s -> %s", fill.SellerId, fill.BuyerId, via, fill.SellerId)))
+			}
+		}
+	}
+
+	if fill.RefPrice > 0 {
+		deviation := fill.Price - fill.RefPrice
+		if deviation < 0 {
+			deviation = -deviation
+		}
+		if percent := configInt("auction_fraud.price_impact_percent", defaultFraudPriceImpact); deviation*100 >= fill.RefPrice*int64(percent) {
+			flags = append(flags, newFlag(fraudPriceImpact, parties,
+				fmt.Sprintf("reference price %d, deviation %d%%", fill.RefPrice, deviation*100/fill.RefPrice)))
+		}
+	}
+
+	if len(flags) > 0 {
+		saveFraudFlags(ctx, flags)
+	}
+	return flags
+}
+
+// saveFraudFlags 写入风控标记及用户索引
+func saveFraudFlags(ctx context.Context, flags []*fraudFlag) {
+	maxLen := int64(configInt("auction_fraud.flag_max_len", defaultFraudFlagMaxLen))
+	userTTL := time.Duration(configSeconds("auction_fraud.user_flag_ttl", defaultFraudUserFlagTTL)) * time.Second
+	_, err := redis.GetRedis().TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		for _, flag := range flags {
+			klog.CtxWarnf(ctx, "[AUCTION-FRAUD] Flag %s: itemId=%s, users=%v, transactionId=%s, price=%d, quantity=%d, detail=%s",
+				flag.Type, flag.ItemId, flag.UserIds, flag.TransactionId, flag.Price, flag.Quantity, flag.Detail)
+			data, _ := json.Marshal(flag)
+			member := goredis.Z{Score: float64(flag.CreateTime), Member: string(data)}
+			pipe.ZAdd(ctx, fraudFlagsKey, member)
+			for _, userId := range flag.UserIds {
+				userKey := fraudUserFlagsPrefix + userId
+				pipe.ZAdd(ctx, userKey, member)
+				pipe.ZRemRangeByRank(ctx, userKey, 0, -defaultFraudUserFlagLen-1)
+				pipe.Expire(ctx, userKey, userTTL)
+			}
+		}
+		pipe.ZRemRangeByRank(ctx, fraudFlagsKey, 0, -maxLen-1)
+		return nil
+	})
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-FRAUD] save fraud flags error: %s", err.Error())
+	}
+}
+
+// uncrossBookByTime 开启自成交拦截时处理交叉盘口：同一用户的买卖单交叉时无法逐对消除，
+// 将交叉区间内的订单全部移出订单簿，按下单时间先后重新撮合（在撮合协程内调用）
+func (mu *matchUnit) uncrossBookByTime(ctx context.Context) {
+	sellItem, buyItem := mu.sellOrders.Min(), mu.buyOrders.Min()
+	if sellItem == nil || buyItem == nil {
+		return
+	}
+	bestSell, bestBuy := sellItem.(*SellOrderByPriceAsc).Price, buyItem.(*BuyOrderByPriceDesc).Price
+	if bestBuy < bestSell {
+		return
+	}
+
+	type requeued struct {
+		sell *auction.SellData
+		buy  *auction.BuyData
+	}
+	orders := make([]requeued, 0)
+	mu.sellOrders.AscendLessThan(&SellOrderByPriceAsc{Price: bestBuy + 1}, func(item btree.Item) bool {
+		orders = append(orders, requeued{sell: (*auction.SellData)(item.(*SellOrderByPriceAsc))})
+		return true
+	})
+	mu.buyOrders.AscendLessThan(&BuyOrderByPriceDesc{Price: bestSell - 1}, func(item btree.Item) bool {
+		orders = append(orders, requeued{buy: (*auction.BuyData)(item.(*BuyOrderByPriceDesc))})
+		return true
+	})
+	for _, order := range orders {
+		if order.sell != nil {
+			mu.sellOrders.Delete((*SellOrderByPriceAsc)(order.sell))
+			mu.expireWheel.Remove(order.sell.OrderId)
+			mu.appendEvent(ctx, &matchEvent{Type: eventRequeue, Direction: "sell", OrderId: order.sell.OrderId})
+		} else {
+			mu.buyOrders.Delete((*BuyOrderByPriceDesc)(order.buy))
+			mu.expireWheel.Remove(order.buy.OrderId)
+			mu.appendEvent(ctx, &matchEvent{Type: eventRequeue, Direction: "buy", OrderId: order.buy.OrderId})
+		}
+	}
+
+	createdAt := func(o requeued) (int64, string) {
+		if o.sell != nil {
+			return o.sell.CreateTime, o.sell.OrderId
+		}
+		return o.buy.CreateTime, o.buy.OrderId
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		ti, idi := createdAt(orders[i])
+		tj, idj := createdAt(orders[j])
+		if ti != tj {
+			return ti < tj
+		}
+		return idi < idj
+	})
+	for _, order := range orders {
+		if order.sell != nil {
+			mu.AddSellOrder(ctx, order.sell)
+		} else {
+			mu.AddBuyOrder(ctx, order.buy)
+		}
+	}
+}
+
+// AdminGetFraudFlags 查询风控标记，可按用户、道具、标记类型和时间范围筛选，按时间倒序返回
+func (m *AuctionManager) AdminGetFraudFlags(ctx context.Context, req *auction_admin.AdminGetFraudFlagsReq) (resp *auction_admin.AdminGetFraudFlagsRsp, err error) {
+	resp = &auction_admin.AdminGetFraudFlagsRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	if err = checkOperator(req.GetOperatorId()); err != nil {
+		resp.Code = common.ErrorCode_AUCTION_ADMIN_DENIED
+		resp.Msg = err.Error()
+		err = nil
+		return
+	}
+	if req.GetEndTime() > 0 && req.GetStartTime() > req.GetEndTime() {
+		resp.Msg = "start_time is later than end_time"
+		return
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultFraudQueryLimit
+	}
+	if limit > maxFraudQueryLimit {
+		limit = maxFraudQueryLimit
+	}
+
+	key := fraudFlagsKey
+	if req.GetUserId() != "" {
+		key = fraudUserFlagsPrefix + req.GetUserId()
+	}
+	rangeBy := &goredis.ZRangeBy{Min: "-inf", Max: "+inf", Count: int64(limit)}
+	if req.GetStartTime() > 0 {
+		rangeBy.Min = strconv.FormatInt(req.GetStartTime(), 10)
+	}
+	if req.GetEndTime() > 0 {
+		rangeBy.Max = strconv.FormatInt(req.GetEndTime(), 10)
+	}
+
+	resp.Flags = make([]*auction_admin.FraudFlag, 0)
+	for len(resp.Flags) < limit {
+		members, err := redis.GetRedis().ZRevRangeByScore(ctx, key, rangeBy).Result()
+		if err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-FRAUD] get fraud flags error: key=%s, error: %s", key, err.Error())
+			resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+			resp.Msg = "get fraud flags error"
+			resp.Flags = nil
+			return resp, nil
+		}
+		for _, member := range members {
+			flag := &fraudFlag{}
+			if err := json.Unmarshal([]byte(member), flag); err != nil {
+				continue
+			}
+			if req.GetItemId() != "" && flag.ItemId != req.GetItemId() {
+				continue
+			}
+			if req.GetFlagType() != "" && flag.Type != req.GetFlagType() {
+				continue
+			}
+			resp.Flags = append(resp.Flags, flag.toProto())
+			if len(resp.Flags) >= limit {
+				break
+			}
+		}
+		if len(members) < int(rangeBy.Count) {
+			break
+		}
+		rangeBy.Offset += rangeBy.Count
+	}
+
+	auditAdmin(ctx, req.GetOperatorId(), "get_fraud_flags", req.GetItemId(), req.GetUserId(), map[string]interface{}{
+		"flag_type": req.GetFlagType(),
+		"count":     len(resp.Flags),
+	})
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	return
+}
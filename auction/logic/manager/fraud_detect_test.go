@@ -0,0 +1,145 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/auction_admin"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuctionManager_FraudDetection(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	fake := newFakeInventory()
+	inventory = fake
+	defer func() { inventory = newFakeInventory() }()
+
+	manager := GetAuctionManager()
+	operatorId := "test_operator"
+	userCtx := func(userId string) context.Context { return context.WithValue(ctx, "userId", userId) }
+	seq := 0
+	idem := func() string {
+		seq++
+		return fmt.Sprintf("test_fraud_%d_%d", time.Now().UnixNano(), seq)
+	}
+	flagsOf := func(req *auction_admin.AdminGetFraudFlagsReq) []*auction_admin.FraudFlag {
+		req.OperatorId = operatorId
+		resp, err := manager.AdminGetFraudFlags(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, common.ErrorCode_OK, resp.Code, resp.Msg)
+		return resp.Flags
+	}
+
+	// 1. 未开启拦截时同一用户的买卖单照常成交，并标记自成交
+	itemId := "test_item_fraud"
+	sellResp, err := manager.Sell(userCtx("test_fraud_a"), &auction.SellReq{ItemId: itemId, Quantity: 1, Price: 100, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, sellResp.Code)
+	time.Sleep(10 * time.Millisecond)
+	buyResp, err := manager.Buy(userCtx("test_fraud_a"), &auction.BuyReq{ItemId: itemId, Quantity: 1, Price: 100, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, buyResp.Code)
+	assert.Empty(t, dumpBook(testMatchUnit(itemId)))
+
+	flags := flagsOf(&auction_admin.AdminGetFraudFlagsReq{UserId: "test_fraud_a", FlagType: fraudSelfTrade})
+	if assert.Len(t, flags, 1) {
+		assert.Equal(t, itemId, flags[0].ItemId)
+		assert.Equal(t, []string{"test_fraud_a"}, flags[0].UserIds)
+		assert.NotEmpty(t, flags[0].TransactionId)
+	}
+
+	// 2. 开启拦截后同一用户的订单互不撮合，其他用户的订单照常成交
+	viper.Set("auction_fraud.block_self_match", true)
+	defer viper.Set("auction_fraud.block_self_match", false)
+	blockItemId := "test_item_fraud_block"
+	sellResp, err = manager.Sell(userCtx("test_fraud_a"), &auction.SellReq{ItemId: blockItemId, Quantity: 1, Price: 100, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, sellResp.Code)
+	time.Sleep(10 * time.Millisecond)
+	buyResp, err = manager.Buy(userCtx("test_fraud_a"), &auction.BuyReq{ItemId: blockItemId, Quantity: 2, Price: 101, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, buyResp.Code)
+	mu := testMatchUnit(blockItemId)
+	assert.Equal(t, []string{"sell:" + sellResp.Data.OrderId + ":1", "buy:" + buyResp.Data.OrderId + ":2"}, dumpBook(mu))
+
+	otherResp, err := manager.Sell(userCtx("test_fraud_b"), &auction.SellReq{ItemId: blockItemId, Quantity: 1, Price: 100, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, otherResp.Code)
+	assert.Equal(t, []string{"sell:" + sellResp.Data.OrderId + ":1", "buy:" + buyResp.Data.OrderId + ":1"}, dumpBook(mu))
+	assert.Empty(t, flagsOf(&auction_admin.AdminGetFraudFlagsReq{ItemId: blockItemId, FlagType: fraudSelfTrade}))
+
+	// 3. 暂停期间形成的交叉盘口恢复后按时间顺序重新撮合，同一用户的订单仍不成交
+	_, err = manager.AdminHaltItem(ctx, &auction_admin.AdminHaltItemReq{OperatorId: operatorId, ItemId: blockItemId})
+	assert.NoError(t, err)
+	mu.runOp(func() {
+		order := &SellOrderByPriceAsc{OrderId: "test_fraud_cross", ItemId: blockItemId, Quantity: 1, Price: 99, CreateTime: time.Now().Unix() + 1}
+		mu.sellOrders.ReplaceOrInsert(order)
+	})
+	redis.GetRedis().HSet(ctx, sellOrderKey("test_fraud_cross"), "user_id", "test_fraud_c", "quantity", 1, "create_time", time.Now().Unix())
+	_, err = manager.AdminResumeItem(ctx, &auction_admin.AdminResumeItemReq{OperatorId: operatorId, ItemId: blockItemId})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"sell:" + sellResp.Data.OrderId + ":1"}, dumpBook(mu))
+
+	// 4. 同一对手方反复成交达到阈值时标记一次，道具流转回卖家时标记环形交易
+	now := time.Now().Unix()
+	fill := func(seller, buyer string, price int64) []*fraudFlag {
+		return detectFraud(ctx, &fraudFill{
+			TransactionId: idClient.Generate().String(),
+			ItemId:        "test_item_fraud_flow",
+			SellerId:      seller,
+			BuyerId:       buyer,
+			Price:         price,
+			Quantity:      1,
+			RefPrice:      100,
+			Time:          now,
+		})
+	}
+	flagTypes := func(flags []*fraudFlag) []string {
+		types := make([]string, 0, len(flags))
+		for _, flag := range flags {
+			types = append(types, flag.Type)
+		}
+		return types
+	}
+	for i := 1; i < defaultFraudPairThreshold; i++ {
+		assert.Empty(t, fill("test_fraud_x", "test_fraud_y", 100))
+	}
+	assert.Equal(t, []string{fraudRepeatedPair}, flagTypes(fill("test_fraud_x", "test_fraud_y", 100)))
+	assert.Empty(t, fill("test_fraud_x", "test_fraud_y", 100))
+
+	flags2 := fill("test_fraud_y", "test_fraud_x", 100)
+	assert.Equal(t, []string{fraudCircularFlow}, flagTypes(flags2))
+
+	assert.Empty(t, fill("test_fraud_p", "test_fraud_q", 100))
+	assert.Empty(t, fill("test_fraud_q", "test_fraud_r", 100))
+	flags3 := fill("test_fraud_r", "test_fraud_p", 100)
+	if assert.Equal(t, []string{fraudCircularFlow}, flagTypes(flags3)) {
+		assert.Equal(t, []string{"test_fraud_r", "test_fraud_p", "test_fraud_q"}, flags3[0].UserIds)
+	}
+
+	// 5. 成交价偏离参考价达到阈值时标记
+	assert.Empty(t, fill("test_fraud_m", "test_fraud_n", 108))
+	assert.Equal(t, []string{fraudPriceImpact}, flagTypes(fill("test_fraud_n", "test_fraud_o", 91)))
+
+	// 6. 查询：需要操作人，按道具、类型和条数筛选
+	denied, err := manager.AdminGetFraudFlags(ctx, &auction_admin.AdminGetFraudFlagsReq{})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_ADMIN_DENIED, denied.Code)
+	assert.Len(t, flagsOf(&auction_admin.AdminGetFraudFlagsReq{ItemId: "test_item_fraud_flow", FlagType: fraudCircularFlow}), 2)
+	assert.Len(t, flagsOf(&auction_admin.AdminGetFraudFlagsReq{UserId: "test_fraud_q"}), 1)
+	assert.Len(t, flagsOf(&auction_admin.AdminGetFraudFlagsReq{ItemId: "test_item_fraud_flow", Limit: 2}), 2)
+	assert.Empty(t, flagsOf(&auction_admin.AdminGetFraudFlagsReq{ItemId: "test_item_fraud_flow", EndTime: now - 1}))
+}
@@ -0,0 +1,294 @@
+package manager
+
+import (
+	"auction_module/config"
+	"fmt"
+	"math"
+	"path"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultRulesFile = "auction_rules.yaml" // 未配置时的交易规则文件名（位于配置目录下）
+	feePayerSeller   = "seller"             // 手续费从卖家货款中扣除
+	feePayerBuyer    = "buyer"              // 手续费由买家额外支付，下单时一并托管
+)
+
+// itemRule 道具交易规则（已合并默认、分类和道具配置）
+type itemRule struct {
+	FeePercent    float64 // 手续费比例（百分比）
+	MinFee        int64   // 每笔成交最低手续费
+	FeePayer      string  // 手续费支付方
+	BandPercent   float64 // 价格区间：参考价上下浮动百分比，0表示不限制
+	PriceFloor    int64   // 绝对最低价，0表示不限制
+	PriceCeiling  int64   // 绝对最高价，0表示不限制
+	TickSize      int64   // 最小价格变动单位
+	MinQuantity   int32   // 单笔最小数量
+	MaxSellOrders int32   // 出售该道具时用户允许持有的出售挂单总数
+	MaxBuyOrders  int32   // 求购该道具时用户允许持有的求购挂单总数
+	DefaultPrice  int64   // 无成交记录时的参考价，也是无成交时参考价回落的下限
+	Category      string  // 所属分类，未配置分类时为空
+}
+
+// builtinRule 规则文件缺失时使用的内置规则
+var builtinRule = itemRule{
+	FeePercent:    1,
+	MinFee:        0,
+	FeePayer:      feePayerSeller,
+	BandPercent:   10,
+	TickSize:      1,
+	MinQuantity:   1,
+	MaxSellOrders: 8,
+	MaxBuyOrders:  8,
+	DefaultPrice:  100,
+}
+
+// ruleOverride 规则文件中的一级配置，未配置的字段为nil，沿用上一级
+type ruleOverride struct {
+	FeePercent    *float64 `mapstructure:"fee_percent"`
+	MinFee        *int64   `mapstructure:"min_fee"`
+	FeePayer      *string  `mapstructure:"fee_payer"`
+	BandPercent   *float64 `mapstructure:"band_percent"`
+	PriceFloor    *int64   `mapstructure:"price_floor"`
+	PriceCeiling  *int64   `mapstructure:"price_ceiling"`
+	TickSize      *int64   `mapstructure:"tick_size"`
+	MinQuantity   *int32   `mapstructure:"min_quantity"`
+	MaxSellOrders *int32   `mapstructure:"max_sell_orders"`
+	MaxBuyOrders  *int32   `mapstructure:"max_buy_orders"`
+	DefaultPrice  *int64   `mapstructure:"default_price"`
+}
+
+// categoryOverride 分类规则，items为属于该分类的道具ID
+type categoryOverride struct {
+	ruleOverride `mapstructure:",squash"`
+	Items        []string `mapstructure:"items"`
+}
+
+// rulesConfig 规则文件结构
+type rulesConfig struct {
+	Default    ruleOverride                `mapstructure:"default"`
+	Categories map[string]categoryOverride `mapstructure:"categories"`
+	Items      map[string]ruleOverride     `mapstructure:"items"`
+}
+
+// ruleSet 解析后的规则，加载后只读，热加载时整体替换
+type ruleSet struct {
+	defaultRule itemRule
+	itemRules   map[string]*itemRule // 配置了分类或道具规则的道具
+}
+
+var (
+	currentRules atomic.Pointer[ruleSet]
+	rulesOnce    sync.Once
+)
+
+// apply 用配置中已设置的字段覆盖规则
+func (o *ruleOverride) apply(rule *itemRule) {
+	if o.FeePercent != nil {
+		rule.FeePercent = *o.FeePercent
+	}
+	if o.MinFee != nil {
+		rule.MinFee = *o.MinFee
+	}
+	if o.FeePayer != nil {
+		rule.FeePayer = *o.FeePayer
+	}
+	if o.BandPercent != nil {
+		rule.BandPercent = *o.BandPercent
+	}
+	if o.PriceFloor != nil {
+		rule.PriceFloor = *o.PriceFloor
+	}
+	if o.PriceCeiling != nil {
+		rule.PriceCeiling = *o.PriceCeiling
+	}
+	if o.TickSize != nil {
+		rule.TickSize = *o.TickSize
+	}
+	if o.MinQuantity != nil {
+		rule.MinQuantity = *o.MinQuantity
+	}
+	if o.MaxSellOrders != nil {
+		rule.MaxSellOrders = *o.MaxSellOrders
+	}
+	if o.MaxBuyOrders != nil {
+		rule.MaxBuyOrders = *o.MaxBuyOrders
+	}
+	if o.DefaultPrice != nil {
+		rule.DefaultPrice = *o.DefaultPrice
+	}
+}
+
+// validate 检查规则取值是否合法
+func (r *itemRule) validate() error {
+	if r.FeePercent < 0 || r.FeePercent >= 100 {
+		return fmt.Errorf("fee_percent must be in [0, 100)")
+	}
+	if r.MinFee < 0 {
+		return fmt.Errorf("min_fee must not be negative")
+	}
+	if r.FeePayer != feePayerSeller && r.FeePayer != feePayerBuyer {
+		return fmt.Errorf("fee_payer must be %s or %s", feePayerSeller, feePayerBuyer)
+	}
+	if r.BandPercent < 0 || r.BandPercent >= 100 {
+		return fmt.Errorf("band_percent must be in [0, 100)")
+	}
+	if r.PriceFloor < 0 || r.PriceCeiling < 0 || (r.PriceCeiling > 0 && r.PriceCeiling < r.PriceFloor) {
+		return fmt.Errorf("invalid price_floor/price_ceiling")
+	}
+	if r.TickSize <= 0 || r.MinQuantity <= 0 || r.MaxSellOrders <= 0 || r.MaxBuyOrders <= 0 || r.DefaultPrice <= 0 {
+		return fmt.Errorf("tick_size, min_quantity, max_sell_orders, max_buy_orders and default_price must be greater than 0")
+	}
+	return nil
+}
+
+// buildRuleSet 按默认→分类→道具的顺序合并规则，任一规则非法时整体失败
+func buildRuleSet(cfg *rulesConfig) (*ruleSet, error) {
+	set := &ruleSet{defaultRule: builtinRule, itemRules: make(map[string]*itemRule)}
+	cfg.Default.apply(&set.defaultRule)
+	if err := set.defaultRule.validate(); err != nil {
+		return nil, fmt.Errorf("default: %w", err)
+	}
+
+	for name, category := range cfg.Categories {
+		for _, itemId := range category.Items {
+			if _, ok := set.itemRules[itemId]; ok {
+				return nil, fmt.Errorf("item %s belongs to more than one category", itemId)
+			}
+			rule := set.defaultRule
+			category.apply(&rule)
+			rule.Category = name
+			if err := rule.validate(); err != nil {
+				return nil, fmt.Errorf("category %s: %w", name, err)
+			}
+			set.itemRules[itemId] = &rule
+		}
+	}
+
+	for itemId, override := range cfg.Items {
+		rule := set.defaultRule
+		if categoryRule, ok := set.itemRules[itemId]; ok {
+			rule = *categoryRule
+		}
+		override.apply(&rule)
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("item %s: %w", itemId, err)
+		}
+		set.itemRules[itemId] = &rule
+	}
+	return set, nil
+}
+
+// loadRules 读取规则文件并替换当前规则，失败时保留原规则
+func loadRules(v *viper.Viper) error {
+	cfg := &rulesConfig{}
+	if err := v.Unmarshal(cfg); err != nil {
+		return err
+	}
+	set, err := buildRuleSet(cfg)
+	if err != nil {
+		return err
+	}
+	currentRules.Store(set)
+	return nil
+}
+
+// initRules 首次使用时加载规则文件并监听变更，文件修改后自动热加载
+func initRules() {
+	currentRules.Store(&ruleSet{defaultRule: builtinRule, itemRules: make(map[string]*itemRule)})
+
+	file := defaultRulesFile
+	if v, ok := config.Get("auction.rules_file").(string); ok && v != "" {
+		file = v
+	}
+	v := viper.New()
+	v.SetConfigFile(path.Join(config.GetConfPath(), file))
+	if err := v.ReadInConfig(); err != nil {
+		klog.Warnf("[AUCTION-RULES] read rules file %s error, using builtin rules: %s", v.ConfigFileUsed(), err.Error())
+		return
+	}
+	if err := loadRules(v); err != nil {
+		klog.Errorf("[AUCTION-RULES] invalid rules file %s, using builtin rules: %s", v.ConfigFileUsed(), err.Error())
+	} else {
+		klog.Infof("[AUCTION-RULES] rules loaded from %s", v.ConfigFileUsed())
+	}
+
+	v.OnConfigChange(func(e fsnotify.Event) {
+		if err := loadRules(v); err != nil {
+			klog.Errorf("[AUCTION-RULES] reload rules file %s error, keeping previous rules: %s", e.Name, err.Error())
+			return
+		}
+		klog.Infof("[AUCTION-RULES] rules reloaded from %s", e.Name)
+	})
+	v.WatchConfig()
+}
+
+// getItemRule 获取道具当前生效的交易规则
+func getItemRule(itemId string) *itemRule {
+	rulesOnce.Do(initRules)
+	set := currentRules.Load()
+	if rule, ok := set.itemRules[itemId]; ok {
+		return rule
+	}
+	return &set.defaultRule
+}
+
+// fee 计算成交额对应的手续费（不超过成交额）
+func (r *itemRule) fee(amount int64) int64 {
+	fee := int64(math.Floor(float64(amount) * r.FeePercent / 100))
+	if fee < r.MinFee {
+		fee = r.MinFee
+	}
+	if fee > amount {
+		fee = amount
+	}
+	return fee
+}
+
+// tradeAmounts 按手续费支付方计算单笔成交总价对应的买家托管金额与卖家实收金额
+func (r *itemRule) tradeAmounts(price int64) (escrow int64, proceeds int64) {
+	fee := r.fee(price)
+	if r.FeePayer == feePayerBuyer {
+		return price + fee, price
+	}
+	return price, price - fee
+}
+
+// priceRange 根据参考价计算允许的价格区间，未限制上限时maxPrice为math.MaxInt64
+func (r *itemRule) priceRange(avgPrice int64) (minPrice int64, maxPrice int64) {
+	minPrice, maxPrice = 0, math.MaxInt64
+	if r.BandPercent > 0 {
+		minPrice = int64(float64(avgPrice) * (1 - r.BandPercent/100))
+		maxPrice = int64(float64(avgPrice) * (1 + r.BandPercent/100))
+	}
+	if r.PriceFloor > 0 && minPrice < r.PriceFloor {
+		minPrice = r.PriceFloor
+	}
+	if r.PriceCeiling > 0 && maxPrice > r.PriceCeiling {
+		maxPrice = r.PriceCeiling
+	}
+	return
+}
+
+// onTick 判断价格是否为最小价格变动单位的整数倍
+func (r *itemRule) onTick(price int64) bool {
+	return price%r.TickSize == 0
+}
+
+// tickUp 向上取整到最小价格变动单位
+func (r *itemRule) tickUp(price int64) int64 {
+	if rem := price % r.TickSize; rem != 0 {
+		price += r.TickSize - rem
+	}
+	return price
+}
+
+// tickDown 向下取整到最小价格变动单位
+func (r *itemRule) tickDown(price int64) int64 {
+	return price - price%r.TickSize
+}
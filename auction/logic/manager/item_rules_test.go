@@ -0,0 +1,170 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// setTestRules 替换当前交易规则，测试结束后恢复
+func setTestRules(t *testing.T, cfg *rulesConfig) {
+	getItemRule("")
+	old := currentRules.Load()
+	set, err := buildRuleSet(cfg)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	currentRules.Store(set)
+	t.Cleanup(func() { currentRules.Store(old) })
+}
+
+// 测试用例: 交易规则按默认→分类→道具合并，非法配置整体拒绝
+func TestItemRules_Build(t *testing.T) {
+	set, err := buildRuleSet(&rulesConfig{
+		Default: ruleOverride{FeePercent: ptr(2.0)},
+		Categories: map[string]categoryOverride{
+			"equipment": {ruleOverride: ruleOverride{TickSize: ptr(int64(10)), MinFee: ptr(int64(5))}, Items: []string{"a", "b"}},
+		},
+		Items: map[string]ruleOverride{
+			"b": {MinFee: ptr(int64(7)), FeePayer: ptr(feePayerBuyer)},
+			"c": {BandPercent: ptr(0.0), PriceFloor: ptr(int64(50)), PriceCeiling: ptr(int64(80))},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2.0, set.defaultRule.FeePercent)
+	assert.Equal(t, int64(1), set.defaultRule.TickSize)
+	assert.Equal(t, int64(10), set.itemRules["a"].TickSize)
+	assert.Equal(t, int64(5), set.itemRules["a"].MinFee)
+	assert.Equal(t, int64(10), set.itemRules["b"].TickSize)
+	assert.Equal(t, int64(7), set.itemRules["b"].MinFee)
+	assert.Equal(t, feePayerBuyer, set.itemRules["b"].FeePayer)
+
+	// 价格区间、手续费与最小价格变动单位
+	minPrice, maxPrice := set.defaultRule.priceRange(100)
+	assert.Equal(t, []int64{90, 110}, []int64{minPrice, maxPrice})
+	minPrice, maxPrice = set.itemRules["c"].priceRange(100)
+	assert.Equal(t, []int64{50, 80}, []int64{minPrice, maxPrice})
+	assert.Equal(t, int64(20), set.defaultRule.fee(1000))
+	assert.Equal(t, int64(5), set.itemRules["a"].fee(100))
+	assert.Equal(t, int64(3), set.itemRules["a"].fee(3))
+	assert.Equal(t, int64(30), set.itemRules["a"].tickUp(21))
+	assert.Equal(t, int64(20), set.itemRules["a"].tickDown(29))
+
+	// 非法配置
+	_, err = buildRuleSet(&rulesConfig{Items: map[string]ruleOverride{"x": {FeePayer: ptr("nobody")}}})
+	assert.Error(t, err)
+	_, err = buildRuleSet(&rulesConfig{Categories: map[string]categoryOverride{
+		"a": {Items: []string{"x"}},
+		"b": {Items: []string{"x"}},
+	}})
+	assert.Error(t, err)
+
+	// 热加载：非法的规则文件不替换当前规则
+	dir := t.TempDir()
+	file := dir + "/rules.yaml"
+	assert.NoError(t, os.WriteFile(file, []byte("items:\n  \"r1\":\n    tick_size: 5\n"), 0644))
+	v := viper.New()
+	v.SetConfigFile(file)
+	assert.NoError(t, v.ReadInConfig())
+	getItemRule("")
+	old := currentRules.Load()
+	defer currentRules.Store(old)
+	assert.NoError(t, loadRules(v))
+	assert.Equal(t, int64(5), getItemRule("r1").TickSize)
+	assert.NoError(t, os.WriteFile(file, []byte("items:\n  \"r1\":\n    tick_size: 0\n"), 0644))
+	assert.NoError(t, v.ReadInConfig())
+	assert.Error(t, loadRules(v))
+	assert.Equal(t, int64(5), getItemRule("r1").TickSize)
+}
+
+// 测试用例: 下单按道具规则校验，手续费由买家支付时预留并在订单结束后退还剩余部分
+func TestAuctionManager_ItemRules(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	fake := newFakeInventory()
+	inventory = fake
+	defer func() { inventory = newFakeInventory() }()
+
+	itemId := "test_item_rules"
+	setTestRules(t, &rulesConfig{Items: map[string]ruleOverride{
+		itemId: {
+			FeePercent:    ptr(5.0),
+			MinFee:        ptr(int64(10)),
+			FeePayer:      ptr(feePayerBuyer),
+			BandPercent:   ptr(0.0),
+			PriceFloor:    ptr(int64(50)),
+			PriceCeiling:  ptr(int64(200)),
+			TickSize:      ptr(int64(5)),
+			MinQuantity:   ptr(int32(2)),
+			MaxSellOrders: ptr(int32(1)),
+		},
+	}})
+
+	sellUserId := "test_user_rules_seller"
+	buyUserId := "test_user_rules_buyer"
+	sellCtx := context.WithValue(ctx, "userId", sellUserId)
+	buyCtx := context.WithValue(ctx, "userId", buyUserId)
+	currency := currencyItemId()
+	manager := GetAuctionManager()
+	idem := func() string { return "test_rules_" + strconv.FormatInt(time.Now().UnixNano(), 10) }
+	sell := func(quantity int32, price int64) *auction.SellRsp {
+		resp, err := manager.Sell(sellCtx, &auction.SellReq{ItemId: itemId, Quantity: quantity, Price: price, IdempotentId: idem()})
+		assert.NoError(t, err)
+		return resp
+	}
+
+	// 1. 各项规则校验返回不同的错误码
+	assert.Equal(t, common.ErrorCode_AUCTION_QUANTITY_TOO_SMALL, sell(1, 100).Code)
+	assert.Equal(t, common.ErrorCode_AUCTION_PRICE_OUT_OF_BAND, sell(2, 205).Code)
+	assert.Equal(t, common.ErrorCode_AUCTION_PRICE_OUT_OF_BAND, sell(2, 45).Code)
+	assert.Equal(t, common.ErrorCode_AUCTION_PRICE_TICK_INVALID, sell(2, 103).Code)
+	assert.Equal(t, common.ErrorCode_OK, sell(2, 200).Code)
+	assert.Equal(t, common.ErrorCode_AUCTION_ORDER_LIMIT_EXCEEDED, sell(2, 200).Code)
+
+	// 2. 买单托管报价金额+手续费预留（4*200=800，手续费5%=40）
+	buyResp, err := manager.Buy(buyCtx, &auction.BuyReq{ItemId: itemId, Quantity: 4, Price: 200, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, buyResp.Code)
+	getMatchManager().settlePending(ctx)
+	assert.Equal(t, int64(-840), fake.balance(buyUserId, currency))
+	assert.Equal(t, int64(2), fake.balance(buyUserId, itemId))
+	// 成交2个（400），手续费20由买家预留支付，卖家全额收款
+	assert.Equal(t, int64(400), fake.balance(sellUserId, currency))
+	tax, err := redis.GetRedis().HGet(ctx, orderStatusKey(buyResp.Data.OrderId), "tax").Int64()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), tax)
+
+	// 3. 撤销买单退还剩余报价金额和剩余手续费预留（400+20）
+	cancelResp, err := manager.CancelBuy(buyCtx, &auction.CancelBuyReq{OrderId: buyResp.Data.OrderId, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, cancelResp.Code)
+	getMatchManager().settlePending(ctx)
+	assert.Equal(t, int64(-420), fake.balance(buyUserId, currency))
+
+	// 4. 最低手续费：成交额100*2=200按5%为10，与最低手续费相同；买单全部成交后无剩余预留
+	assert.Equal(t, common.ErrorCode_OK, sell(2, 100).Code)
+	buyResp, err = manager.Buy(buyCtx, &auction.BuyReq{ItemId: itemId, Quantity: 2, Price: 100, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, buyResp.Code)
+	getMatchManager().settlePending(ctx)
+	assert.Equal(t, int64(-630), fake.balance(buyUserId, currency))
+	assert.Equal(t, int64(600), fake.balance(sellUserId, currency))
+}
@@ -0,0 +1,360 @@
+package manager
+
+import (
+	"auction_module/redis"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// 旧key布局迁移
+// 按实体划分hash tag（见keys.go）之前的key没有hash tag：auction:sell:<orderId>、auction:order:<orderId>:status、
+// user:<userId>:sells、auction:settlement:pending等。启动时一次性把旧key搬到新key，全局和用户挂单列表中的
+// member（旧订单key）改写为新订单key，完成后写入布局版本，之后的启动直接跳过。
+// 多个实例同时启动时只有取得迁移锁的实例执行迁移，其他实例等待迁移完成后再启动。
+// 旧版本实例仍按旧key读写，升级前需停止所有旧版本实例，否则迁移后旧版本写入的key不会再被迁移。
+// 版本3增加道具挂单索引（见keys.go），按全局挂单列表中的订单补齐。
+
+const (
+	keyLayoutVersionKey = "auction:key_layout:version" // 当前key布局版本
+	keyLayoutLockKey    = "auction:key_layout:lock"    // 迁移锁
+	keyLayoutVersion    = 3                            // 按实体划分hash tag、增加道具挂单索引的布局
+	keyLayoutLockTTL    = 10 * time.Minute             // 迁移锁过期时间，执行迁移的实例异常退出后由其他实例接手
+	keyLayoutWait       = time.Second                  // 等待其他实例迁移完成的轮询间隔
+	keyLayoutScanCount  = 500                          // 每次SCAN的数量
+)
+
+// legacyKeyRule 旧key的匹配模式和到新key的映射，映射返回空字符串表示不是旧key（已是新布局或其他功能的key）
+type legacyKeyRule struct {
+	pattern string
+	rename  func(key string) string
+}
+
+var legacyKeyRules = []legacyKeyRule{
+	{"auction:sell:*", legacyEntityKey("auction:sell:", sellOrderKey)},
+	{"auction:buy:*", legacyEntityKey("auction:buy:", buyOrderKey)},
+	{"auction:order:*", legacyOrderDataKey},
+	{"auction:timed:*", legacyEntityKey(timedAuctionKeyPrefix, timedAuctionKey)},
+	{"auction:unique:*", legacyEntityKey(uniqueListingKeyPrefix, uniqueListingKey)},
+	{"auction:kline:*", legacyKlineKey},
+	{"user:*", legacyUserKey},
+	{"auction:settlement:*", legacyPrefixKey("auction:settlement:", "auction:{settlement}:")},
+	{"auction:fraud:*", legacyPrefixKey("auction:fraud:", "auction:{fraud}:")},
+	{"auction:market:entries", legacyPrefixKey("auction:market:entries", marketEntriesKey)},
+	{"auction:market:idx:*", legacyPrefixKey("auction:market:idx:", marketIndexKeyPrefix)},
+}
+
+// legacyUserSuffixes 拍卖行使用的用户key后缀，其他服务的user:前缀key不迁移
+var legacyUserSuffixes = []string{"sells", "buys", "transactions:time", "market_subs", "timed_auctions", "unique_listings", "statement:"}
+
+// legacyEntityKey <prefix><id> -> newKey(id)，id中含有:或{的是新布局或其他key（如索引auction:timed:item:<itemId>）
+func legacyEntityKey(prefix string, newKey func(id string) string) func(string) string {
+	return func(key string) string {
+		id, found := strings.CutPrefix(key, prefix)
+		if !found || id == "" || strings.ContainsAny(id, ":{") {
+			return ""
+		}
+		return newKey(id)
+	}
+}
+
+// legacyOrderDataKey auction:order:<orderId>:status|transactions
+func legacyOrderDataKey(key string) string {
+	rest, _ := strings.CutPrefix(key, "auction:order:")
+	orderId, suffix, found := strings.Cut(rest, ":")
+	if !found || orderId == "" || strings.Contains(orderId, "{") {
+		return ""
+	}
+	switch suffix {
+	case "status":
+		return orderStatusKey(orderId)
+	case "transactions":
+		return orderTransactionsKey(orderId)
+	}
+	return ""
+}
+
+// legacyKlineKey auction:kline:<itemId>:<周期>[:<开始时间>]
+func legacyKlineKey(key string) string {
+	rest, _ := strings.CutPrefix(key, klineKeyPrefix)
+	itemId, suffix, found := strings.Cut(rest, ":")
+	if !found || itemId == "" || strings.Contains(itemId, "{") {
+		return ""
+	}
+	return klineKeyPrefix + "{" + itemId + "}:" + suffix
+}
+
+// legacyUserKey user:<userId>:<suffix>
+func legacyUserKey(key string) string {
+	rest, _ := strings.CutPrefix(key, "user:")
+	userId, suffix, found := strings.Cut(rest, ":")
+	if !found || userId == "" || strings.Contains(userId, "{") {
+		return ""
+	}
+	for _, s := range legacyUserSuffixes {
+		if suffix == s || (strings.HasSuffix(s, ":") && strings.HasPrefix(suffix, s)) {
+			return userKey(userId, suffix)
+		}
+	}
+	return ""
+}
+
+// legacyPrefixKey 只替换前缀的key（结算队列、风控、市场概况），新前缀带hash tag
+func legacyPrefixKey(oldPrefix string, newPrefix string) func(string) string {
+	return func(key string) string {
+		rest, found := strings.CutPrefix(key, oldPrefix)
+		if !found {
+			return ""
+		}
+		return newPrefix + rest
+	}
+}
+
+// legacyOrderMember 挂单列表中的旧订单key改写为新订单key，不是旧订单key时返回空字符串
+func legacyOrderMember(member string) string {
+	for _, direction := range []string{"sell", "buy"} {
+		if newKey := legacyEntityKey("auction:"+direction+":", func(id string) string { return orderKey(direction, id) })(member); newKey != "" {
+			return newKey
+		}
+	}
+	return ""
+}
+
+// MigrateKeyLayout 启动时把旧布局的key迁移到当前布局，需在开始处理请求、创建撮合管理器之前调用
+func MigrateKeyLayout(ctx context.Context) error {
+	rdb := redis.GetRedis()
+	for {
+		version, err := rdb.Get(ctx, keyLayoutVersionKey).Int()
+		if err != nil && !errors.Is(err, goredis.Nil) {
+			return err
+		}
+		if version >= keyLayoutVersion {
+			return nil
+		}
+		locked, err := rdb.SetNX(ctx, keyLayoutLockKey, time.Now().UnixMilli(), keyLayoutLockTTL).Result()
+		if err != nil {
+			return err
+		}
+		if !locked {
+			klog.CtxInfof(ctx, "[AUCTION-KEY-MIGRATION] Waiting for another instance to migrate keys")
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(keyLayoutWait):
+			}
+			continue
+		}
+
+		start := time.Now()
+		moved, err := migrateLegacyKeys(ctx)
+		if err == nil {
+			err = rdb.Set(ctx, keyLayoutVersionKey, keyLayoutVersion, 0).Err()
+		}
+		rdb.Del(ctx, keyLayoutLockKey)
+		if err != nil {
+			return err
+		}
+		klog.CtxInfof(ctx, "[AUCTION-KEY-MIGRATION] Key layout migrated to version %d: moved=%d, cost=%s",
+			keyLayoutVersion, moved, time.Since(start))
+		return nil
+	}
+}
+
+// migrateLegacyKeys 迁移所有旧key、改写挂单列表的member并补齐道具挂单索引，返回迁移的key数量。迁移可以重复执行：
+// 已迁移的key不再匹配旧模式，中途失败后重新执行会继续迁移剩余的key
+func migrateLegacyKeys(ctx context.Context) (int64, error) {
+	var moved atomic.Int64
+	for _, rule := range legacyKeyRules {
+		err := scanKeys(ctx, rule.pattern, func(key string) error {
+			newKey := rule.rename(key)
+			if newKey == "" {
+				return nil
+			}
+			if err := moveKey(ctx, key, newKey); err != nil {
+				klog.CtxErrorf(ctx, "[AUCTION-KEY-MIGRATION] move key error: %s -> %s, error: %s", key, newKey, err.Error())
+				return err
+			}
+			moved.Add(1)
+			return nil
+		})
+		if err != nil {
+			return moved.Load(), err
+		}
+	}
+
+	// 全局和用户挂单列表的member为订单key，需要改写为新订单key
+	if err := rewriteOrderMembers(ctx, sellOrdersKey); err != nil {
+		return moved.Load(), err
+	}
+	if err := rewriteOrderMembers(ctx, buyOrdersKey); err != nil {
+		return moved.Load(), err
+	}
+	for _, pattern := range []string{"user:{*}:sells", "user:{*}:buys"} {
+		if err := scanKeys(ctx, pattern, func(key string) error {
+			return rewriteOrderMembers(ctx, key)
+		}); err != nil {
+			return moved.Load(), err
+		}
+	}
+
+	// 全局挂单列表改写完成后补齐道具挂单索引
+	for _, direction := range []string{"sell", "buy"} {
+		if err := indexItemOrders(ctx, direction); err != nil {
+			return moved.Load(), err
+		}
+	}
+	return moved.Load(), nil
+}
+
+// indexItemOrders 把全局挂单列表中的订单加入所属道具的挂单索引，已删除的订单跳过
+func indexItemOrders(ctx context.Context, direction string) error {
+	rdb := redis.GetRedis()
+	members, err := rdb.SMembers(ctx, ordersKey(direction)).Result()
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		itemId, err := rdb.HGet(ctx, member, "item_id").Result()
+		if errors.Is(err, goredis.Nil) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := rdb.SAdd(ctx, itemOrdersKey(itemId, direction), member).Err(); err != nil {
+			return err
+		}
+		if err := rdb.SAdd(ctx, orderItemsKey, itemId).Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanKeys 遍历匹配pattern的key，集群模式下遍历每个主节点（各主节点并发执行，fn需要并发安全）
+func scanKeys(ctx context.Context, pattern string, fn func(key string) error) error {
+	scan := func(ctx context.Context, node goredis.Cmdable) error {
+		iter := node.Scan(ctx, 0, pattern, keyLayoutScanCount).Iterator()
+		for iter.Next(ctx) {
+			if err := fn(iter.Val()); err != nil {
+				return err
+			}
+		}
+		return iter.Err()
+	}
+	if cluster, ok := redis.GetRedis().(*goredis.ClusterClient); ok {
+		return cluster.ForEachMaster(ctx, func(ctx context.Context, node *goredis.Client) error {
+			return scan(ctx, node)
+		})
+	}
+	return scan(ctx, redis.GetRedis())
+}
+
+// moveKey 把旧key搬到新key。新旧key可能位于不同slot，不能使用RENAME，按类型复制后删除旧key：
+// 新key已存在（迁移前已有新版本写入）时合并，新key中已有的字段优先；新key不存在时保留旧key的过期时间
+func moveKey(ctx context.Context, oldKey string, newKey string) error {
+	rdb := redis.GetRedis()
+	exists, err := rdb.Exists(ctx, newKey).Result()
+	if err != nil {
+		return err
+	}
+	ttl, err := rdb.PTTL(ctx, oldKey).Result()
+	if err != nil {
+		return err
+	}
+	if err := copyKey(ctx, oldKey, newKey); err != nil {
+		return err
+	}
+	if exists == 0 && ttl > 0 {
+		if err := rdb.PExpire(ctx, newKey, ttl).Err(); err != nil {
+			return err
+		}
+	}
+	return rdb.Del(ctx, oldKey).Err()
+}
+
+// copyKey 按类型把旧key的内容写入新key，不覆盖新key中已有的字段
+func copyKey(ctx context.Context, oldKey string, newKey string) error {
+	rdb := redis.GetRedis()
+	keyType, err := rdb.Type(ctx, oldKey).Result()
+	if err != nil {
+		return err
+	}
+	switch keyType {
+	case "string":
+		value, err := rdb.Get(ctx, oldKey).Result()
+		if err != nil {
+			return err
+		}
+		return rdb.SetNX(ctx, newKey, value, 0).Err()
+	case "hash":
+		fields, err := rdb.HGetAll(ctx, oldKey).Result()
+		if err != nil {
+			return err
+		}
+		pipe := rdb.Pipeline()
+		for field, value := range fields {
+			pipe.HSetNX(ctx, newKey, field, value)
+		}
+		_, err = pipe.Exec(ctx)
+		return err
+	case "set":
+		members, err := rdb.SMembers(ctx, oldKey).Result()
+		if err != nil || len(members) == 0 {
+			return err
+		}
+		return rdb.SAdd(ctx, newKey, toInterfaces(members)...).Err()
+	case "zset":
+		members, err := rdb.ZRangeWithScores(ctx, oldKey, 0, -1).Result()
+		if err != nil || len(members) == 0 {
+			return err
+		}
+		return rdb.ZAddNX(ctx, newKey, members...).Err()
+	case "list":
+		values, err := rdb.LRange(ctx, oldKey, 0, -1).Result()
+		if err != nil || len(values) == 0 {
+			return err
+		}
+		return rdb.RPush(ctx, newKey, toInterfaces(values)...).Err()
+	case "none":
+		return nil
+	}
+	return fmt.Errorf("unsupported key type %s", keyType)
+}
+
+// rewriteOrderMembers 把挂单列表中的旧订单key改写为新订单key
+func rewriteOrderMembers(ctx context.Context, setKey string) error {
+	rdb := redis.GetRedis()
+	members, err := rdb.SMembers(ctx, setKey).Result()
+	if err != nil {
+		return err
+	}
+	pipe := rdb.TxPipeline()
+	count := 0
+	for _, member := range members {
+		if newMember := legacyOrderMember(member); newMember != "" {
+			pipe.SAdd(ctx, setKey, newMember)
+			pipe.SRem(ctx, setKey, member)
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func toInterfaces(values []string) []interface{} {
+	args := make([]interface{}, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	return args
+}
@@ -0,0 +1,89 @@
+package manager
+
+import (
+	"auction_module/redis"
+	"context"
+	"testing"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// TestKeyMigration_LegacyKeys 旧布局的订单、用户列表、K线和结算队列迁移到新key，挂单列表member改写并补齐道具挂单索引，其他服务的key不受影响
+func TestKeyMigration_LegacyKeys(t *testing.T) {
+	ctx := context.Background()
+	rdb := redis.GetRedis()
+	const orderId, userId, itemId = "migtest_order", "migtest_user", "migtest_item"
+	legacyOrder := "auction:sell:" + orderId
+	cleanup := func() {
+		// 新旧key位于不同的slot，逐个删除
+		for _, key := range []string{legacyOrder, sellOrderKey(orderId), "auction:order:" + orderId + ":status", orderStatusKey(orderId),
+			"user:" + userId + ":sells", userOrdersKey(userId, "sell"), "user:" + userId + ":profile",
+			"user:" + userId + ":statement:20260101", statementDayKey(userId, "20260101"),
+			"auction:kline:" + itemId + ":1m", "auction:kline:{" + itemId + "}:1m", itemOrdersKey(itemId, "sell")} {
+			rdb.Del(ctx, key)
+		}
+		rdb.SRem(ctx, sellOrdersKey, legacyOrder, sellOrderKey(orderId))
+		rdb.SRem(ctx, orderItemsKey, itemId)
+	}
+	cleanup()
+	defer cleanup()
+
+	rdb.HSet(ctx, legacyOrder, "user_id", userId, "item_id", itemId, "quantity", 3)
+	rdb.Expire(ctx, legacyOrder, time.Hour)
+	rdb.HSet(ctx, "auction:order:"+orderId+":status", "status", "0")
+	rdb.SAdd(ctx, "user:"+userId+":sells", legacyOrder)
+	rdb.SAdd(ctx, sellOrdersKey, legacyOrder)
+	rdb.ZAdd(ctx, "auction:kline:"+itemId+":1m", goredis.Z{Score: 1, Member: "1700000000"})
+	rdb.Set(ctx, "user:"+userId+":profile", "other service", 0)
+	// 迁移前新版本已写入的key与旧key合并，新key已有的字段优先
+	rdb.HSet(ctx, "user:"+userId+":statement:20260101", "sell_count", 1, "buy_count", 2)
+	rdb.HSet(ctx, statementDayKey(userId, "20260101"), "sell_count", 5)
+
+	if _, err := migrateLegacyKeys(ctx); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	for _, key := range []string{legacyOrder, "auction:order:" + orderId + ":status", "user:" + userId + ":sells", "auction:kline:" + itemId + ":1m"} {
+		if rdb.Exists(ctx, key).Val() != 0 {
+			t.Fatalf("legacy key left: %s", key)
+		}
+	}
+	if qty := rdb.HGet(ctx, sellOrderKey(orderId), "quantity").Val(); qty != "3" {
+		t.Fatalf("order quantity = %q", qty)
+	}
+	if ttl := rdb.TTL(ctx, sellOrderKey(orderId)).Val(); ttl <= 0 || ttl > time.Hour {
+		t.Fatalf("order ttl = %s", ttl)
+	}
+	if status := rdb.HGet(ctx, orderStatusKey(orderId), "status").Val(); status != "0" {
+		t.Fatalf("order status = %q", status)
+	}
+	if !rdb.SIsMember(ctx, userOrdersKey(userId, "sell"), sellOrderKey(orderId)).Val() ||
+		rdb.SIsMember(ctx, userOrdersKey(userId, "sell"), legacyOrder).Val() {
+		t.Fatalf("user sells = %v", rdb.SMembers(ctx, userOrdersKey(userId, "sell")).Val())
+	}
+	if !rdb.SIsMember(ctx, sellOrdersKey, sellOrderKey(orderId)).Val() || rdb.SIsMember(ctx, sellOrdersKey, legacyOrder).Val() {
+		t.Fatal("global sells not rewritten")
+	}
+	if members := rdb.SMembers(ctx, itemOrdersKey(itemId, "sell")).Val(); len(members) != 1 || members[0] != sellOrderKey(orderId) {
+		t.Fatalf("item sells index = %v", members)
+	}
+	if !rdb.SIsMember(ctx, orderItemsKey, itemId).Val() {
+		t.Fatal("order item not indexed")
+	}
+	if n := rdb.ZCard(ctx, "auction:kline:{"+itemId+"}:1m").Val(); n != 1 {
+		t.Fatalf("kline index size = %d", n)
+	}
+	day := rdb.HGetAll(ctx, statementDayKey(userId, "20260101")).Val()
+	if day["sell_count"] != "5" || day["buy_count"] != "2" {
+		t.Fatalf("merged statement = %v", day)
+	}
+	if rdb.Get(ctx, "user:"+userId+":profile").Val() != "other service" {
+		t.Fatal("unrelated user key migrated")
+	}
+
+	// 重复执行不再迁移
+	if moved, err := migrateLegacyKeys(ctx); err != nil || moved != 0 {
+		t.Fatalf("second run moved=%d, err=%v", moved, err)
+	}
+}
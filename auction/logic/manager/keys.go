@@ -0,0 +1,105 @@
+package manager
+
+import "strings"
+
+// Redis key布局
+// 同一实体的key使用相同的hash tag（{}中的部分），Redis Cluster中落在同一个slot，Lua脚本只访问一个slot：
+//   - 订单：auction:sell:{orderId}、auction:buy:{orderId}、auction:order:{orderId}:status|transactions|outbox
+//   - 道具挂单索引：auction:item:{itemId}:sells|buys
+//   - 用户：user:{userId}:...
+//   - 限时拍卖、唯一道具挂单：auction:timed:{auctionId}、auction:unique:{listingId} 及其outbox
+//   - 其他按功能划分：auction:kline:{itemId}:...、auction:{market}:...、auction:{fraud}:...、auction:{settlement}:...
+// 脚本需要修改其他实体的key（用户列表、全局列表、索引、结算队列）时，写入本实体的outbox，由outbox投递（见outbox.go）
+
+const (
+	sellOrdersKey = "auction:sells"       // 全局出售列表，member为卖单key
+	buyOrdersKey  = "auction:buys"        // 全局求购列表，member为买单key
+	orderItemsKey = "auction:order_items" // 有过挂单的道具ID集合，接管道具时按道具挂单索引加载订单。道具ID数量有限，不做清理
+)
+
+// orderKey 订单hash，direction为sell或buy
+func orderKey(direction string, orderId string) string {
+	return "auction:" + direction + ":{" + orderId + "}"
+}
+
+func sellOrderKey(orderId string) string {
+	return orderKey("sell", orderId)
+}
+
+func buyOrderKey(orderId string) string {
+	return orderKey("buy", orderId)
+}
+
+// parseOrderKey 从订单key中解析方向和订单ID
+func parseOrderKey(key string) (direction string, orderId string, ok bool) {
+	rest, found := strings.CutPrefix(key, "auction:")
+	if !found {
+		return "", "", false
+	}
+	direction, tagged, found := strings.Cut(rest, ":")
+	if !found || (direction != "sell" && direction != "buy") {
+		return "", "", false
+	}
+	orderId, found = strings.CutPrefix(tagged, "{")
+	if !found {
+		return "", "", false
+	}
+	orderId, found = strings.CutSuffix(orderId, "}")
+	if !found || orderId == "" {
+		return "", "", false
+	}
+	return direction, orderId, true
+}
+
+// orderStatusKey 订单状态hash（订单成交、撤销后保留）
+func orderStatusKey(orderId string) string {
+	return "auction:order:{" + orderId + "}:status"
+}
+
+// orderTransactionsKey 订单的成交ID集合
+func orderTransactionsKey(orderId string) string {
+	return "auction:order:{" + orderId + "}:transactions"
+}
+
+// orderOutboxKey 订单的outbox
+func orderOutboxKey(orderId string) string {
+	return "auction:order:{" + orderId + "}:outbox"
+}
+
+// ordersKey 全局订单列表
+func ordersKey(direction string) string {
+	if direction == "sell" {
+		return sellOrdersKey
+	}
+	return buyOrdersKey
+}
+
+// itemOrdersKey 道具挂单索引，member为该道具未成交的订单key，direction为sell或buy
+func itemOrdersKey(itemId string, direction string) string {
+	return "auction:item:{" + itemId + "}:" + direction + "s"
+}
+
+// transactionKey 成交记录hash
+func transactionKey(transactionId string) string {
+	return "auction:transaction:" + transactionId
+}
+
+// hourlyPriceKey 道具最近一个小时的平均成交价
+func hourlyPriceKey(itemId string) string {
+	return "auction:hourly:price:" + itemId
+}
+
+// userKey 用户维度的key：user:{userId}:<suffix>
+func userKey(userId string, suffix string) string {
+	return "user:{" + userId + "}:" + suffix
+}
+
+// userOrdersKey 用户的挂单列表，member为订单key
+func userOrdersKey(userId string, direction string) string {
+	return userKey(userId, direction+"s")
+}
+
+// userTransactionsTimeKey 用户的订单时间索引（有序集合，member为订单ID，score为下单时间）
+func userTransactionsTimeKey(userId string) string {
+	return userKey(userId, "transactions:time")
+}
@@ -0,0 +1,29 @@
+package manager
+
+import (
+	"auction_module/redis/script"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 测试用例: 订单key使用订单ID作为hash tag，可以解析回方向和订单ID
+func TestOrderKey(t *testing.T) {
+	assert.Equal(t, "auction:sell:{123}", sellOrderKey("123"))
+	assert.Equal(t, "auction:order:{123}:outbox", orderOutboxKey("123"))
+
+	direction, orderId, ok := parseOrderKey(buyOrderKey("456"))
+	assert.True(t, ok)
+	assert.Equal(t, "buy", direction)
+	assert.Equal(t, "456", orderId)
+
+	for _, key := range []string{"auction:sell:123", "auction:order:{123}", "auction:buy:{}", "user:{1}:sells"} {
+		_, _, ok = parseOrderKey(key)
+		assert.False(t, ok, key)
+	}
+}
+
+// 测试用例: 脚本中写死的key与Go中的定义一致
+func TestScriptKeys(t *testing.T) {
+	assert.Contains(t, script.GetRegistry().Get(script.CloseOrder).Source, "'"+settlementPendingKey+"'")
+}
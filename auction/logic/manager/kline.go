@@ -0,0 +1,159 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"auction_module/redis/script"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const (
+	klineKeyPrefix    = "auction:kline:" // K线索引key前缀：auction:kline:{<itemId>}:<周期>，单根K线：索引key:<开始时间>
+	defaultKlineLimit = 200              // 未指定条数时默认返回的K线数量
+	maxKlineLimit     = 1000             // 单次最多返回的K线数量
+)
+
+// klineSpec K线周期定义，周期越短保留时间越短，长期历史只保留粗粒度K线
+type klineSpec struct {
+	name      string // key中的周期名
+	seconds   int64  // 周期长度（秒）
+	retention int64  // 保留时长（秒）
+}
+
+var (
+	// klineIntervals 每笔成交需要更新的K线周期（有序）
+	klineIntervals = []auction.KlineInterval{
+		auction.KlineInterval_KLINE_1M,
+		auction.KlineInterval_KLINE_5M,
+		auction.KlineInterval_KLINE_1H,
+		auction.KlineInterval_KLINE_1D,
+	}
+	klineSpecs = map[auction.KlineInterval]klineSpec{
+		auction.KlineInterval_KLINE_1M: {name: "1m", seconds: 60, retention: 2 * 24 * 3600},          // 保留2天
+		auction.KlineInterval_KLINE_5M: {name: "5m", seconds: 300, retention: 14 * 24 * 3600},        // 保留14天
+		auction.KlineInterval_KLINE_1H: {name: "1h", seconds: 3600, retention: 180 * 24 * 3600},      // 保留180天
+		auction.KlineInterval_KLINE_1D: {name: "1d", seconds: 86400, retention: 5 * 365 * 24 * 3600}, // 保留5年
+	}
+)
+
+// klineIndexKey K线索引key（有序集合，score为K线开始时间）
+func klineIndexKey(itemId string, spec klineSpec) string {
+	return klineKeyPrefix + "{" + itemId + "}:" + spec.name
+}
+
+// recordKline 将一笔成交计入各周期K线（在撮合协程内调用，同一道具的成交按顺序写入）
+func recordKline(ctx context.Context, itemId string, price int64, quantity int32, tradeTime int64) {
+	// 使用Lua脚本原子性地更新所有周期的K线：
+	// 1. K线不存在时以本次成交价作为开高低收创建，并加入索引
+	// 2. K线已存在时更新最高/最低/收盘价并累加成交量和成交额
+	// 3. K线按周期的保留时长过期，索引中移除超出保留时长的记录
+	// KEYS为各周期的索引key，同一道具的K线key使用相同的hash tag
+
+	keys := make([]string, 0, len(klineIntervals))
+	args := []interface{}{price, quantity, tradeTime}
+	for _, interval := range klineIntervals {
+		spec := klineSpecs[interval]
+		keys = append(keys, klineIndexKey(itemId, spec))
+		args = append(args, spec.seconds, spec.retention)
+	}
+	if err := script.Run(ctx, redis.GetRedis(), script.RecordKline, keys, args...).Err(); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-KLINE] record kline error: itemId=%s, price=%d, quantity=%d, error: %s",
+			itemId, price, quantity, err.Error())
+	}
+}
+
+// GetItemKline 获取道具K线（按开始时间升序）
+func (m *AuctionManager) GetItemKline(ctx context.Context, req *auction.GetItemKlineReq) (resp *auction.GetItemKlineRsp, err error) {
+	resp = &auction.GetItemKlineRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+
+	if req.GetItemId() == "" {
+		resp.Msg = "item_id is empty"
+		return
+	}
+	spec, ok := klineSpecs[req.GetInterval()]
+	if !ok {
+		resp.Msg = "invalid interval"
+		return
+	}
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultKlineLimit
+	}
+	if limit > maxKlineLimit {
+		limit = maxKlineLimit
+	}
+	endTime := req.GetEndTime()
+	if endTime <= 0 {
+		endTime = time.Now().Unix()
+	}
+	startTime := req.GetStartTime()
+	if startTime <= 0 {
+		startTime = endTime - endTime%spec.seconds - (limit-1)*spec.seconds
+	}
+	if startTime > endTime {
+		resp.Msg = "start_time must not be later than end_time"
+		return
+	}
+
+	// 从索引中取时间范围内的K线开始时间（最近的limit条）
+	openTimes, err := redis.GetRedis().ZRevRangeByScore(ctx, klineIndexKey(req.GetItemId(), spec), &goredis.ZRangeBy{
+		Min:   fmt.Sprintf("%d", startTime-startTime%spec.seconds),
+		Max:   fmt.Sprintf("%d", endTime),
+		Count: limit,
+	}).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-KLINE] get kline index error: itemId=%s, interval=%s, error: %s",
+			req.GetItemId(), spec.name, err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "get kline error"
+		err = nil
+		return
+	}
+
+	pipe := redis.GetRedis().Pipeline()
+	cmds := make([]*goredis.MapStringStringCmd, 0, len(openTimes))
+	for i := len(openTimes) - 1; i >= 0; i-- {
+		cmds = append(cmds, pipe.HGetAll(ctx, klineIndexKey(req.GetItemId(), spec)+":"+openTimes[i]))
+	}
+	if _, err = pipe.Exec(ctx); err != nil && err != goredis.Nil {
+		klog.CtxErrorf(ctx, "[AUCTION-KLINE] get kline data error: itemId=%s, interval=%s, error: %s",
+			req.GetItemId(), spec.name, err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "get kline error"
+		err = nil
+		return
+	}
+	err = nil
+
+	data := make([]*auction.Kline, 0, len(cmds))
+	for _, cmd := range cmds {
+		bar := cmd.Val()
+		if len(bar) == 0 {
+			// 已过期但索引尚未清理
+			continue
+		}
+		data = append(data, &auction.Kline{
+			OpenTime: parseInt64(bar["open_time"]),
+			Open:     parseInt64(bar["open"]),
+			High:     parseInt64(bar["high"]),
+			Low:      parseInt64(bar["low"]),
+			Close:    parseInt64(bar["close"]),
+			Volume:   parseInt64(bar["volume"]),
+			Turnover: parseInt64(bar["turnover"]),
+		})
+	}
+
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	resp.Data = data
+	return
+}
@@ -0,0 +1,87 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAuctionManager_GetItemKline 测试K线记录与查询
+func TestAuctionManager_GetItemKline(t *testing.T) {
+	setupTest()
+	ctx := context.Background()
+	manager := GetAuctionManager()
+	itemId := "test_kline_item"
+
+	// 清理各周期的K线索引和K线
+	cleanup := func() {
+		for _, spec := range klineSpecs {
+			indexKey := klineIndexKey(itemId, spec)
+			keys, _ := redis.GetRedis().Keys(ctx, indexKey+":*").Result()
+			redis.GetRedis().Del(ctx, append(keys, indexKey)...)
+		}
+	}
+	cleanup()
+	defer cleanup()
+
+	// 以当前分钟为基准，前一分钟2笔、当前分钟3笔成交
+	now := time.Now().Unix()
+	minute := now - now%60 - 60
+	recordKline(ctx, itemId, 100, 2, minute+1)
+	recordKline(ctx, itemId, 90, 1, minute+30)
+	recordKline(ctx, itemId, 110, 3, minute+60)
+	recordKline(ctx, itemId, 120, 1, minute+70)
+	recordKline(ctx, itemId, 105, 4, minute+80)
+
+	// 1. 1分钟K线
+	resp, err := manager.GetItemKline(ctx, &auction.GetItemKlineReq{
+		ItemId:   itemId,
+		Interval: auction.KlineInterval_KLINE_1M,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, resp.Code)
+	if assert.Len(t, resp.Data, 2) {
+		assert.Equal(t, &auction.Kline{OpenTime: minute, Open: 100, High: 100, Low: 90, Close: 90, Volume: 3, Turnover: 290},
+			resp.Data[0])
+		assert.Equal(t, &auction.Kline{OpenTime: minute + 60, Open: 110, High: 120, Low: 105, Close: 105, Volume: 8, Turnover: 870},
+			resp.Data[1])
+	}
+
+	// 2. limit只返回最近的K线
+	resp, err = manager.GetItemKline(ctx, &auction.GetItemKlineReq{
+		ItemId:   itemId,
+		Interval: auction.KlineInterval_KLINE_1M,
+		Limit:    1,
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Data, 1) {
+		assert.Equal(t, minute+60, resp.Data[0].OpenTime)
+	}
+
+	// 3. 日K线汇总所有成交（两分钟跨日时分属两根）
+	resp, err = manager.GetItemKline(ctx, &auction.GetItemKlineReq{
+		ItemId:   itemId,
+		Interval: auction.KlineInterval_KLINE_1D,
+	})
+	assert.NoError(t, err)
+	var volume, turnover int64
+	for _, bar := range resp.Data {
+		volume += bar.Volume
+		turnover += bar.Turnover
+	}
+	assert.Equal(t, int64(11), volume)
+	assert.Equal(t, int64(1160), turnover)
+
+	// 4. 参数错误
+	resp, err = manager.GetItemKline(ctx, &auction.GetItemKlineReq{ItemId: itemId, Interval: auction.KlineInterval(99)})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_PARAM_ERROR, resp.Code)
+	resp, err = manager.GetItemKline(ctx, &auction.GetItemKlineReq{Interval: auction.KlineInterval_KLINE_1M})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_PARAM_ERROR, resp.Code)
+}
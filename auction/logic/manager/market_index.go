@@ -0,0 +1,336 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"auction_module/redis/script"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/google/btree"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// 市场索引：各撮合单元把自己道具的盘口概况和24小时统计写入Redis，
+// 按排序字段维护有序集合（全部道具一份，每个分类一份），搜索时直接按有序集合分页，不扫描订单
+
+const (
+	marketEntriesKey          = "auction:{market}:entries" // 道具概况：field为道具ID，value为MarketItem JSON
+	marketIndexKeyPrefix      = "auction:{market}:idx:"    // 排序索引：auction:{market}:idx:<排序字段>:all 或 auction:{market}:idx:<排序字段>:cat:<分类>
+	defaultMarketIndexRefresh = 60                         // 未配置时概况的定时刷新周期（秒），用于滚动24小时统计
+	marketStatsWindow         = 24 * 3600                  // 成交量和涨跌幅统计窗口（秒）
+	marketSearchLimit         = 20                         // 未指定时每页数量
+	marketSearchMaxLimit      = 100                        // 每页最大数量
+	marketSearchMaxScan       = 1000                       // 单次搜索最多检查的索引条目数
+)
+
+// marketSortFields 排序方式对应的索引字段
+var marketSortFields = map[auction.MarketSortBy]string{
+	auction.MarketSortBy_MARKET_SORT_BEST_ASK:     "ask",
+	auction.MarketSortBy_MARKET_SORT_BEST_BID:     "bid",
+	auction.MarketSortBy_MARKET_SORT_VOLUME_24H:   "volume",
+	auction.MarketSortBy_MARKET_SORT_PRICE_CHANGE: "change",
+}
+
+// marketIndexKey 排序索引key，分类为空时为全部道具的索引
+func marketIndexKey(field string, category string) string {
+	if category == "" {
+		return marketIndexKeyPrefix + field + ":all"
+	}
+	return marketIndexKeyPrefix + field + ":cat:" + category
+}
+
+// marketIndexRefreshSeconds 概况定时刷新周期
+func marketIndexRefreshSeconds() int64 {
+	return configSeconds("auction.market_index_refresh", defaultMarketIndexRefresh)
+}
+
+// writeMarketEntry 写入道具概况，entry为nil时移除
+func writeMarketEntry(ctx context.Context, itemId string, category string, oldCategory string, entry *auction.MarketItem) error {
+	args := []interface{}{marketIndexKeyPrefix, itemId, category, oldCategory}
+	if entry == nil {
+		args = append(args, "", "", "", "", "")
+	} else {
+		data, _ := json.Marshal(entry)
+		args = append(args, string(data))
+		// 没有卖单（买单）的道具不进入按最低卖价（最高买价）排序的索引
+		for _, price := range []int64{entry.BestAsk, entry.BestBid} {
+			if price > 0 {
+				args = append(args, price)
+			} else {
+				args = append(args, "")
+			}
+		}
+		args = append(args, entry.Volume_24H, entry.PriceChange)
+	}
+	return script.Run(ctx, redis.GetRedis(), script.UpdateMarketIndex, []string{marketEntriesKey}, args...).Err()
+}
+
+// marketStats 按小时K线统计道具24小时内的最近成交价、成交量和涨跌幅（万分比）
+func marketStats(ctx context.Context, itemId string, now int64) (lastPrice int64, volume int64, change int32, err error) {
+	indexKey := klineIndexKey(itemId, klineSpecs[auction.KlineInterval_KLINE_1H])
+	openTimes, err := redis.GetRedis().ZRangeByScore(ctx, indexKey, &goredis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(now-marketStatsWindow, 10),
+		Max: strconv.FormatInt(now, 10),
+	}).Result()
+	if err != nil || len(openTimes) == 0 {
+		return 0, 0, 0, err
+	}
+
+	pipe := redis.GetRedis().Pipeline()
+	cmds := make([]*goredis.SliceCmd, 0, len(openTimes))
+	for _, openTime := range openTimes {
+		cmds = append(cmds, pipe.HMGet(ctx, indexKey+":"+openTime, "open", "close", "volume"))
+	}
+	if _, err = pipe.Exec(ctx); err != nil && err != goredis.Nil {
+		return 0, 0, 0, err
+	}
+
+	var firstOpen int64
+	for _, cmd := range cmds {
+		vals := cmd.Val()
+		if len(vals) < 3 || vals[0] == nil {
+			continue
+		}
+		if firstOpen == 0 {
+			firstOpen = parseInt64(fmt.Sprint(vals[0]))
+		}
+		lastPrice = parseInt64(fmt.Sprint(vals[1]))
+		volume += parseInt64(fmt.Sprint(vals[2]))
+	}
+	if firstOpen > 0 {
+		change = int32((lastPrice - firstOpen) * 10000 / firstOpen)
+	}
+	return lastPrice, volume, change, nil
+}
+
+// bestSellLevel 最低卖价及该价位的数量
+func (mu *matchUnit) bestSellLevel() (price int64, quantity int32) {
+	mu.sellOrders.Ascend(func(item btree.Item) bool {
+		order := item.(*SellOrderByPriceAsc)
+		if quantity > 0 && order.Price != price {
+			return false
+		}
+		price = order.Price
+		quantity += order.Quantity
+		return true
+	})
+	return
+}
+
+// bestBuyLevel 最高买价及该价位的数量
+func (mu *matchUnit) bestBuyLevel() (price int64, quantity int32) {
+	mu.buyOrders.Ascend(func(item btree.Item) bool {
+		order := item.(*BuyOrderByPriceDesc)
+		if quantity > 0 && order.Price != price {
+			return false
+		}
+		price = order.Price
+		quantity += order.Quantity
+		return true
+	})
+	return
+}
+
+// refreshMarketIndex 盘口最优价位或成交变化时更新市场索引，并按刷新周期滚动24小时统计（在撮合协程内调用）
+func (mu *matchUnit) refreshMarketIndex(ctx context.Context, now int64) {
+	periodic := now-mu.marketIndexedAt >= marketIndexRefreshSeconds()
+	oldEntry := mu.marketEntry
+	oldCategory := ""
+	if oldEntry != nil {
+		oldCategory = oldEntry.Category
+	}
+
+	entry := &auction.MarketItem{ItemId: mu.itemId, Category: getItemRule(mu.itemId).Category}
+	entry.BestAsk, entry.AskQuantity = mu.bestSellLevel()
+	entry.BestBid, entry.BidQuantity = mu.bestBuyLevel()
+
+	// 没有挂单的道具从索引中移除
+	if entry.AskQuantity == 0 && entry.BidQuantity == 0 {
+		if oldEntry == nil && !periodic {
+			return
+		}
+		if err := mu.store.WriteMarketEntry(ctx, mu.itemId, entry.Category, oldCategory, nil); err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-MARKET-INDEX] remove market entry error: itemId=%s, error: %s", mu.itemId, err.Error())
+			return
+		}
+		mu.marketEntry = nil
+		mu.marketIndexedAt = now
+		mu.marketStatsDirty = false
+		return
+	}
+
+	if oldEntry != nil && !periodic && !mu.marketStatsDirty {
+		if oldEntry.Category == entry.Category &&
+			oldEntry.BestAsk == entry.BestAsk && oldEntry.AskQuantity == entry.AskQuantity &&
+			oldEntry.BestBid == entry.BestBid && oldEntry.BidQuantity == entry.BidQuantity {
+			return
+		}
+		entry.LastPrice, entry.Volume_24H, entry.PriceChange = oldEntry.LastPrice, oldEntry.Volume_24H, oldEntry.PriceChange
+	} else {
+		lastPrice, volume, change, err := mu.store.MarketStats(ctx, mu.itemId, now)
+		if err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-MARKET-INDEX] get market stats error: itemId=%s, error: %s", mu.itemId, err.Error())
+			if oldEntry != nil {
+				lastPrice, volume, change = oldEntry.LastPrice, oldEntry.Volume_24H, oldEntry.PriceChange
+			}
+		}
+		entry.LastPrice, entry.Volume_24H, entry.PriceChange = lastPrice, volume, change
+	}
+	entry.UpdateTime = now
+
+	if err := mu.store.WriteMarketEntry(ctx, mu.itemId, entry.Category, oldCategory, entry); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MARKET-INDEX] write market entry error: itemId=%s, error: %s", mu.itemId, err.Error())
+		return
+	}
+	mu.marketEntry = entry
+	mu.marketIndexedAt = now
+	mu.marketStatsDirty = false
+}
+
+// marketCursorStart 解析分页游标，返回下一页在索引中的起始排名
+// 游标记录上一页最后一个道具的分值和ID：道具仍在原分值时从其后继续，否则从该分值之后继续
+func marketCursorStart(ctx context.Context, key string, cursor string, desc bool) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	scoreStr, itemId, ok := strings.Cut(cursor, ":")
+	score, err := strconv.ParseFloat(scoreStr, 64)
+	if !ok || err != nil || itemId == "" {
+		return 0, fmt.Errorf("invalid cursor")
+	}
+
+	if current, err := redis.GetRedis().ZScore(ctx, key, itemId).Result(); err == nil && current == score {
+		var rank int64
+		if desc {
+			rank, err = redis.GetRedis().ZRevRank(ctx, key, itemId).Result()
+		} else {
+			rank, err = redis.GetRedis().ZRank(ctx, key, itemId).Result()
+		}
+		if err == nil {
+			return rank + 1, nil
+		}
+	}
+	if desc {
+		return redis.GetRedis().ZCount(ctx, key, scoreStr, "+inf").Result()
+	}
+	return redis.GetRedis().ZCount(ctx, key, "-inf", scoreStr).Result()
+}
+
+// SearchMarket 浏览有挂单的道具，按最低卖价、最高买价、24小时成交量或涨跌幅排序，可按分类筛选
+func (m *AuctionManager) SearchMarket(ctx context.Context, req *auction.SearchMarketReq) (resp *auction.SearchMarketRsp, err error) {
+	resp = &auction.SearchMarketRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	field, ok := marketSortFields[req.GetSortBy()]
+	if !ok {
+		resp.Msg = "invalid sort_by"
+		return
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = marketSearchLimit
+	}
+	if limit > marketSearchMaxLimit {
+		limit = marketSearchMaxLimit
+	}
+
+	key := marketIndexKey(field, req.GetCategory())
+	start, err := marketCursorStart(ctx, key, req.GetCursor(), req.GetDesc())
+	if err != nil {
+		resp.Msg = err.Error()
+		err = nil
+		return
+	}
+
+	// 超过3个刷新周期未更新的概况视为残留（撮合单元已不存在），查询时顺带清理
+	staleBefore := time.Now().Unix() - 3*marketIndexRefreshSeconds()
+	redisErr := func(e error) (*auction.SearchMarketRsp, error) {
+		klog.CtxErrorf(ctx, "[AUCTION-MARKET-INDEX] search market error: key=%s, error: %s", key, e.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "search market error"
+		resp.Data = nil
+		return resp, nil
+	}
+
+	resp.Data = make([]*auction.MarketItem, 0, limit)
+	var last goredis.Z
+	stale := make([]interface{}, 0)
+	staleEntries := make([]*auction.MarketItem, 0)
+	for scanned := 0; len(resp.Data) < limit && scanned < marketSearchMaxScan; {
+		batch := int64(limit - len(resp.Data))
+		var zs []goredis.Z
+		if req.GetDesc() {
+			zs, err = redis.GetRedis().ZRevRangeWithScores(ctx, key, start, start+batch-1).Result()
+		} else {
+			zs, err = redis.GetRedis().ZRangeWithScores(ctx, key, start, start+batch-1).Result()
+		}
+		if err != nil {
+			return redisErr(err)
+		}
+		if len(zs) == 0 {
+			break
+		}
+		ids := make([]string, 0, len(zs))
+		for _, z := range zs {
+			ids = append(ids, z.Member.(string))
+		}
+		vals, err := redis.GetRedis().HMGet(ctx, marketEntriesKey, ids...).Result()
+		if err != nil {
+			return redisErr(err)
+		}
+		for i, z := range zs {
+			start++
+			scanned++
+			entry := &auction.MarketItem{}
+			data, _ := vals[i].(string)
+			if data == "" || json.Unmarshal([]byte(data), entry) != nil || (req.GetCategory() != "" && entry.Category != req.GetCategory()) {
+				stale = append(stale, ids[i])
+				continue
+			}
+			if entry.UpdateTime < staleBefore {
+				staleEntries = append(staleEntries, entry)
+				continue
+			}
+			resp.Data = append(resp.Data, entry)
+			last = z
+		}
+		if int64(len(zs)) < batch {
+			break
+		}
+	}
+
+	if len(stale) > 0 {
+		if err := redis.GetRedis().ZRem(ctx, key, stale...).Err(); err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-MARKET-INDEX] remove stale index error: key=%s, error: %s", key, err.Error())
+		}
+	}
+	for _, entry := range staleEntries {
+		klog.CtxInfof(ctx, "[AUCTION-MARKET-INDEX] Remove stale market entry: itemId=%s, updateTime=%d", entry.ItemId, entry.UpdateTime)
+		if err := writeMarketEntry(ctx, entry.ItemId, entry.Category, "", nil); err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-MARKET-INDEX] remove stale market entry error: itemId=%s, error: %s", entry.ItemId, err.Error())
+		}
+	}
+
+	if len(resp.Data) > 0 {
+		total, err := redis.GetRedis().ZCard(ctx, key).Result()
+		if err != nil {
+			return redisErr(err)
+		}
+		// 清理残留条目后排名前移，按清理后的总数判断
+		resp.HasMore = start-int64(len(stale)+len(staleEntries)) < total
+		if resp.HasMore {
+			resp.NextCursor = strconv.FormatFloat(last.Score, 'f', -1, 64) + ":" + last.Member.(string)
+		}
+	}
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	return
+}
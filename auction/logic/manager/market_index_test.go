@@ -0,0 +1,131 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// 测试用例: 市场搜索按撮合单元维护的索引排序、分类筛选和游标分页
+func TestAuctionManager_SearchMarket(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	fake := newFakeInventory()
+	inventory = fake
+	defer func() { inventory = newFakeInventory() }()
+
+	itemA, itemB, itemC := "test_item_market_a", "test_item_market_b", "test_item_market_c"
+	setTestRules(t, &rulesConfig{Categories: map[string]categoryOverride{
+		"gear": {Items: []string{itemA, itemB}},
+	}})
+
+	manager := GetAuctionManager()
+	userCtx := func(userId string) context.Context { return context.WithValue(ctx, "userId", userId) }
+	seq := 0
+	idem := func() string {
+		seq++
+		return fmt.Sprintf("test_market_%d_%d", time.Now().UnixNano(), seq)
+	}
+	sell := func(itemId string, quantity int32, price int64) *auction.SellRsp {
+		resp, err := manager.Sell(userCtx("test_market_seller"), &auction.SellReq{ItemId: itemId, Quantity: quantity, Price: price, IdempotentId: idem()})
+		assert.NoError(t, err)
+		assert.Equal(t, common.ErrorCode_OK, resp.Code, resp.Msg)
+		return resp
+	}
+	buy := func(itemId string, quantity int32, price int64) {
+		resp, err := manager.Buy(userCtx("test_market_buyer"), &auction.BuyReq{ItemId: itemId, Quantity: quantity, Price: price, IdempotentId: idem()})
+		assert.NoError(t, err)
+		assert.Equal(t, common.ErrorCode_OK, resp.Code, resp.Msg)
+	}
+	refresh := func(itemIds ...string) {
+		for _, itemId := range itemIds {
+			mu := testMatchUnit(itemId)
+			mu.runOp(func() { mu.refreshMarketIndex(ctx, time.Now().Unix()) })
+		}
+	}
+	search := func(req *auction.SearchMarketReq) *auction.SearchMarketRsp {
+		resp, err := manager.SearchMarket(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, common.ErrorCode_OK, resp.Code, resp.Msg)
+		return resp
+	}
+	itemIds := func(items []*auction.MarketItem) []string {
+		ids := make([]string, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.ItemId)
+		}
+		return ids
+	}
+
+	sell(itemA, 2, 105)
+	buy(itemA, 1, 95)
+	sell(itemB, 1, 102)
+	buy(itemB, 1, 102)
+	sell(itemB, 3, 104)
+	sellC := sell(itemC, 1, 101)
+	refresh(itemA, itemB, itemC)
+
+	// 1. 按最低卖价升序分页
+	page := search(&auction.SearchMarketReq{SortBy: auction.MarketSortBy_MARKET_SORT_BEST_ASK, Limit: 2})
+	assert.Equal(t, []string{itemC, itemB}, itemIds(page.Data))
+	assert.True(t, page.HasMore)
+	page = search(&auction.SearchMarketReq{SortBy: auction.MarketSortBy_MARKET_SORT_BEST_ASK, Limit: 2, Cursor: page.NextCursor})
+	assert.Equal(t, []string{itemA}, itemIds(page.Data))
+	assert.False(t, page.HasMore)
+	if assert.Len(t, page.Data, 1) {
+		assert.Equal(t, int64(105), page.Data[0].BestAsk)
+		assert.Equal(t, int32(2), page.Data[0].AskQuantity)
+		assert.Equal(t, int64(95), page.Data[0].BestBid)
+		assert.Equal(t, "gear", page.Data[0].Category)
+	}
+
+	// 2. 按分类筛选；按最高买价排序只包含有买单的道具
+	page = search(&auction.SearchMarketReq{Category: "gear", SortBy: auction.MarketSortBy_MARKET_SORT_BEST_ASK, Desc: true})
+	assert.Equal(t, []string{itemA, itemB}, itemIds(page.Data))
+	page = search(&auction.SearchMarketReq{SortBy: auction.MarketSortBy_MARKET_SORT_BEST_BID, Desc: true})
+	assert.Equal(t, []string{itemA}, itemIds(page.Data))
+
+	// 3. 24小时成交量和最近成交价
+	page = search(&auction.SearchMarketReq{SortBy: auction.MarketSortBy_MARKET_SORT_VOLUME_24H, Desc: true, Limit: 1})
+	if assert.Len(t, page.Data, 1) {
+		assert.Equal(t, itemB, page.Data[0].ItemId)
+		assert.Equal(t, int64(1), page.Data[0].Volume_24H)
+		assert.Equal(t, int64(102), page.Data[0].LastPrice)
+	}
+
+	// 4. 撤单后没有挂单的道具从索引中移除
+	cancelResp, err := manager.CancelSell(userCtx("test_market_seller"), &auction.CancelSellReq{OrderId: sellC.Data.OrderId, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, cancelResp.Code)
+	refresh(itemC)
+	page = search(&auction.SearchMarketReq{SortBy: auction.MarketSortBy_MARKET_SORT_BEST_ASK})
+	assert.Equal(t, []string{itemB, itemA}, itemIds(page.Data))
+
+	// 5. 长时间未刷新的残留条目在查询时清理
+	ghost, _ := json.Marshal(&auction.MarketItem{ItemId: "test_item_market_ghost", BestAsk: 1, AskQuantity: 1, UpdateTime: 1})
+	redis.GetRedis().HSet(ctx, marketEntriesKey, "test_item_market_ghost", string(ghost))
+	redis.GetRedis().ZAdd(ctx, marketIndexKey("ask", ""), goredis.Z{Score: 1, Member: "test_item_market_ghost"})
+	page = search(&auction.SearchMarketReq{SortBy: auction.MarketSortBy_MARKET_SORT_BEST_ASK})
+	assert.Equal(t, []string{itemB, itemA}, itemIds(page.Data))
+	exists, _ := redis.GetRedis().HExists(ctx, marketEntriesKey, "test_item_market_ghost").Result()
+	assert.False(t, exists)
+
+	// 6. 非法游标
+	resp, err := manager.SearchMarket(ctx, &auction.SearchMarketReq{Cursor: "bad"})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_PARAM_ERROR, resp.Code)
+}
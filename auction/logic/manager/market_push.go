@@ -0,0 +1,279 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"auction_module/redis/script"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	goredis "github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultMarketPushInterval = 200                    // 未配置时行情推送周期（毫秒）
+	defaultMarketSubTTL       = 30 * 60                // 未配置时行情订阅有效期（秒）
+	defaultMarketSubMax       = 20                     // 未配置时单个用户最多订阅的道具数
+	marketPushQueueSize       = 64                     // 撮合单元待推送行情队列长度
+	marketSubKeyPrefix        = "auction:market:subs:" // 道具订阅者有序集合：member为用户ID，score为订阅过期时间
+)
+
+// userMarketSubsKey 用户已订阅道具的有序集合：member为道具ID，score为订阅过期时间
+func userMarketSubsKey(userId string) string {
+	return userKey(userId, "market_subs")
+}
+
+// markMarketDirty 标记订单簿可能发生变化，下个推送周期计算盘口差异
+func (mu *matchUnit) markMarketDirty() {
+	mu.marketDirty = true
+}
+
+// recordMarketTrade 记录一笔成交，在下个推送周期随盘口变化一起推送（在撮合协程内调用）
+func (mu *matchUnit) recordMarketTrade(price int64, quantity int32, tradeTime int64) {
+	mu.pendingTrades = append(mu.pendingTrades, &auction.MarketTrade{
+		Price:     price,
+		Quantity:  quantity,
+		TradeTime: tradeTime,
+	})
+	mu.marketDirty = true
+	mu.marketStatsDirty = true
+}
+
+// diffLevels 计算两次买5卖5之间变化的价位，移出的价位数量为0
+func diffLevels(itemId string, before []*auction.OrderInfo, after []*auction.OrderInfo) []*auction.OrderInfo {
+	beforeQty := make(map[int64]int32, len(before))
+	for _, level := range before {
+		beforeQty[level.Price] = level.Quantity
+	}
+
+	changed := make([]*auction.OrderInfo, 0)
+	for _, level := range after {
+		if qty, ok := beforeQty[level.Price]; !ok || qty != level.Quantity {
+			changed = append(changed, &auction.OrderInfo{ItemId: itemId, Price: level.Price, Quantity: level.Quantity})
+		}
+		delete(beforeQty, level.Price)
+	}
+	for _, level := range before {
+		if _, ok := beforeQty[level.Price]; ok {
+			changed = append(changed, &auction.OrderInfo{ItemId: itemId, Price: level.Price, Quantity: 0})
+		}
+	}
+	return changed
+}
+
+// publishMarket 计算自上次推送以来的盘口变化，与周期内成交一起放入推送队列（在撮合协程内调用）
+func (mu *matchUnit) publishMarket(ctx context.Context) {
+	if !mu.marketDirty {
+		return
+	}
+	mu.marketDirty = false
+
+	book := mu.getAuctionInfo(ctx)
+	if mu.pushedBook == nil {
+		mu.pushedBook = &auction.ItemAuctionInfo{ItemId: mu.itemId}
+	}
+	sells := diffLevels(mu.itemId, mu.pushedBook.Sells, book.Sells)
+	buys := diffLevels(mu.itemId, mu.pushedBook.Buys, book.Buys)
+	if len(sells) == 0 && len(buys) == 0 && len(mu.pendingTrades) == 0 {
+		return
+	}
+
+	mu.marketSeq++
+	mu.pushedBook = book
+	ntf := &auction.AuctionMarketNtf{
+		ItemId: mu.itemId,
+		Seq:    mu.marketSeq,
+		Sells:  sells,
+		Buys:   buys,
+		Trades: mu.pendingTrades,
+	}
+	mu.pendingTrades = nil
+
+	// 推送在独立协程中进行，队列满时丢弃，客户端发现序号不连续后重新订阅
+	select {
+	case mu.pushQueue <- ntf:
+	default:
+		klog.CtxWarnf(ctx, "[AUCTION-MARKET-PUSH] push queue full, drop market ntf: itemId=%s, seq=%d",
+			mu.itemId, ntf.Seq)
+	}
+}
+
+// marketSnapshot 返回已推送的买5卖5及其序号，调用前先推送未发出的变化，保证快照与后续增量衔接（在撮合协程内调用）
+func (mu *matchUnit) marketSnapshot(ctx context.Context) (*auction.ItemAuctionInfo, int64) {
+	mu.markMarketDirty()
+	mu.publishMarket(ctx)
+	return proto.Clone(mu.pushedBook).(*auction.ItemAuctionInfo), mu.marketSeq
+}
+
+// runMarketPush 按顺序向订阅者推送行情，直到撮合单元停止
+func (mu *matchUnit) runMarketPush(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ntf := <-mu.pushQueue:
+			mu.store.PushMarket(ctx, ntf)
+		}
+	}
+}
+
+// pushMarketNtf 向道具的有效订阅者推送一条行情，顺带清理过期订阅
+func pushMarketNtf(ctx context.Context, ntf *auction.AuctionMarketNtf) {
+	subKey := marketSubKeyPrefix + ntf.ItemId
+	now := time.Now().Unix()
+	redis.GetRedis().ZRemRangeByScore(ctx, subKey, "-inf", fmt.Sprintf("(%d", now))
+	userIds, err := redis.GetRedis().ZRangeByScore(ctx, subKey, &goredis.ZRangeBy{
+		Min: fmt.Sprintf("%d", now),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MARKET-PUSH] get subscribers error: itemId=%s, error: %s", ntf.ItemId, err.Error())
+		return
+	}
+
+	for _, userId := range userIds {
+		if err := notifier.Notify(ctx, userId, ntf); err != nil {
+			klog.CtxWarnf(ctx, "[AUCTION-MARKET-PUSH] push market ntf error: itemId=%s, seq=%d, userId=%s, error: %s",
+				ntf.ItemId, ntf.Seq, userId, err.Error())
+		}
+	}
+}
+
+// subscribeMarket 记录用户对道具行情的订阅，已订阅时续期
+func subscribeMarket(ctx context.Context, userId string, itemId string, now int64) (int64, error) {
+	expireTime := now + configSeconds("auction.market_sub_ttl", defaultMarketSubTTL)
+
+	// 使用Lua脚本原子性地：
+	// 1. 清理用户已过期的订阅
+	// 2. 新订阅时检查订阅数量上限
+	// 3. 写入用户订阅列表
+	// 道具订阅者列表与用户订阅列表位于不同的slot，随后单独写入
+
+	ok, err := script.Run(ctx, redis.GetRedis(), script.SubscribeMarket, []string{userMarketSubsKey(userId)},
+		itemId, now, expireTime, configInt("auction.market_sub_max", defaultMarketSubMax),
+	).Int()
+	if err != nil {
+		return 0, err
+	}
+	if ok == 0 {
+		return 0, nil
+	}
+	if err := redis.GetRedis().ZAdd(ctx, marketSubKeyPrefix+itemId, goredis.Z{Score: float64(expireTime), Member: userId}).Err(); err != nil {
+		// 写入道具订阅者失败时撤销用户订阅，避免占用订阅数量
+		redis.GetRedis().ZRem(ctx, userMarketSubsKey(userId), itemId)
+		return 0, err
+	}
+	return expireTime, nil
+}
+
+// SubscribeItem 订阅道具行情协议，返回当前买5卖5快照及序号，之后通过UserMsg推送AuctionMarketNtf
+func (m *AuctionManager) SubscribeItem(ctx context.Context, req *auction.SubscribeItemReq) (resp *auction.SubscribeItemRsp, err error) {
+	userId := ""
+	if val, ok := ctx.Value("userId").(string); ok {
+		userId = val
+	}
+
+	resp = &auction.SubscribeItemRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+
+	if userId == "" {
+		resp.Msg = "user_id is empty"
+		return
+	}
+	if req.GetItemId() == "" {
+		resp.Msg = "item_id is empty"
+		return
+	}
+
+	expireTime, err := subscribeMarket(ctx, userId, req.GetItemId(), time.Now().Unix())
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MARKET-SUB] subscribe error: userId=%s, itemId=%s, error: %s",
+			userId, req.GetItemId(), err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "subscribe error"
+		err = nil
+		return
+	}
+	if expireTime == 0 {
+		resp.Msg = "too many subscriptions"
+		return
+	}
+
+	// 在撮合协程内取快照，保证与推送序号一致
+	mu, err := getMatchManager().GetMatchUnit(req.GetItemId())
+	if err != nil {
+		klog.CtxWarnf(ctx, "[AUCTION-MARKET-SUB] get match unit error: userId=%s, itemId=%s, error: %s", userId, req.GetItemId(), err.Error())
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
+	}
+	type snapshot struct {
+		info *auction.ItemAuctionInfo
+		seq  int64
+	}
+	var result snapshot
+	if err = mu.call(ctx, func() {
+		info, seq := mu.marketSnapshot(ctx)
+		result = snapshot{info: info, seq: seq}
+	}); err != nil {
+		klog.CtxWarnf(ctx, "[AUCTION-MARKET-SUB] snapshot error: userId=%s, itemId=%s, error: %s", userId, req.GetItemId(), err.Error())
+		resp.Code, resp.Msg = queueErrorCode(err)
+		err = nil
+		return
+	}
+
+	klog.CtxInfof(ctx, "[AUCTION-MARKET-SUB] subscribe: userId=%s, itemId=%s, seq=%d, expireTime=%d",
+		userId, req.GetItemId(), result.seq, expireTime)
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	resp.Data = result.info
+	resp.Seq = result.seq
+	resp.ExpireTime = expireTime
+	return
+}
+
+// UnsubscribeItem 取消订阅道具行情协议
+func (m *AuctionManager) UnsubscribeItem(ctx context.Context, req *auction.UnsubscribeItemReq) (resp *auction.UnsubscribeItemRsp, err error) {
+	userId := ""
+	if val, ok := ctx.Value("userId").(string); ok {
+		userId = val
+	}
+
+	resp = &auction.UnsubscribeItemRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+
+	if userId == "" {
+		resp.Msg = "user_id is empty"
+		return
+	}
+	if req.GetItemId() == "" {
+		resp.Msg = "item_id is empty"
+		return
+	}
+
+	// 道具订阅者列表与用户订阅列表位于不同的slot，分别移除（均为幂等操作）
+	pipe := redis.GetRedis().Pipeline()
+	pipe.ZRem(ctx, marketSubKeyPrefix+req.GetItemId(), userId)
+	pipe.ZRem(ctx, userMarketSubsKey(userId), req.GetItemId())
+	if _, err = pipe.Exec(ctx); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MARKET-SUB] unsubscribe error: userId=%s, itemId=%s, error: %s",
+			userId, req.GetItemId(), err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "unsubscribe error"
+		err = nil
+		return
+	}
+
+	klog.CtxInfof(ctx, "[AUCTION-MARKET-SUB] unsubscribe: userId=%s, itemId=%s", userId, req.GetItemId())
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	return
+}
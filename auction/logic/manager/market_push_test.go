@@ -0,0 +1,157 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// 测试用例: 订阅道具行情后推送盘口变化和成交，取消订阅后不再推送
+func TestAuctionManager_MarketSubscribe(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	ntf := &fakeNotifier{msgs: make(map[string][]proto.Message)}
+	notifier = ntf
+	defer func() { notifier = &gatewayNotifier{} }()
+	inventory = newFakeInventory()
+
+	watcherId := "test_user_market_watcher"
+	watchCtx := context.WithValue(ctx, "userId", watcherId)
+	sellCtx := context.WithValue(ctx, "userId", "test_user_market_seller")
+	buyCtx := context.WithValue(ctx, "userId", "test_user_market_buyer")
+	itemId := "test_item_market_push"
+	redis.GetRedis().Del(ctx, marketSubKeyPrefix+itemId)
+	redis.GetRedis().Del(ctx, userMarketSubsKey(watcherId))
+	defer redis.GetRedis().Del(ctx, marketSubKeyPrefix+itemId)
+	defer redis.GetRedis().Del(ctx, userMarketSubsKey(watcherId))
+
+	manager := GetAuctionManager()
+	mu := testMatchUnit(itemId)
+	price := mu.hourlyAvgPrice
+
+	// flush 立即计算盘口差异，并等待推送协程发出
+	flush := func(expect int) []*auction.AuctionMarketNtf {
+		done := make(chan bool)
+		mu.opChannel <- func() {
+			mu.publishMarket(ctx)
+			done <- true
+		}
+		<-done
+		var msgs []*auction.AuctionMarketNtf
+		for i := 0; i < 100; i++ {
+			ntf.mu.Lock()
+			msgs = msgs[:0]
+			for _, msg := range ntf.msgs[watcherId] {
+				msgs = append(msgs, msg.(*auction.AuctionMarketNtf))
+			}
+			ntf.mu.Unlock()
+			if len(msgs) >= expect {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		return msgs
+	}
+
+	// 1. 参数校验
+	subResp, err := manager.SubscribeItem(ctx, &auction.SubscribeItemReq{ItemId: itemId})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_PARAM_ERROR, subResp.Code)
+
+	// 2. 订阅返回当前快照和序号
+	sellResp, err := manager.Sell(sellCtx, &auction.SellReq{
+		ItemId:       itemId,
+		Quantity:     3,
+		Price:        price,
+		IdempotentId: "test_market_sell_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, sellResp.Code)
+	subResp, err = manager.SubscribeItem(watchCtx, &auction.SubscribeItemReq{ItemId: itemId})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, subResp.Code)
+	assert.Len(t, subResp.Data.Sells, 1)
+	assert.Equal(t, int32(3), subResp.Data.Sells[0].Quantity)
+	assert.Greater(t, subResp.ExpireTime, time.Now().Unix())
+	baseSeq := subResp.Seq
+
+	// 3. 成交后推送成交记录和变化的价位（订阅时推出的快照增量序号不超过baseSeq）
+	buyResp, err := manager.Buy(buyCtx, &auction.BuyReq{
+		ItemId:       itemId,
+		Quantity:     2,
+		Price:        price,
+		IdempotentId: "test_market_buy_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, buyResp.Code)
+	msgs := flush(2)
+	if assert.NotEmpty(t, msgs) {
+		last := msgs[len(msgs)-1]
+		assert.Equal(t, baseSeq+1, last.Seq)
+		assert.Equal(t, itemId, last.ItemId)
+		if assert.Len(t, last.Trades, 1) {
+			assert.Equal(t, price, last.Trades[0].Price)
+			assert.Equal(t, int32(2), last.Trades[0].Quantity)
+		}
+		if assert.Len(t, last.Sells, 1) {
+			assert.Equal(t, int32(1), last.Sells[0].Quantity)
+		}
+		assert.Len(t, last.Buys, 0)
+	}
+
+	// 4. 价位被吃完时推送数量为0
+	buyResp, err = manager.Buy(buyCtx, &auction.BuyReq{
+		ItemId:       itemId,
+		Quantity:     1,
+		Price:        price,
+		IdempotentId: "test_market_buy2_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	assert.NoError(t, err)
+	msgs = flush(len(msgs) + 1)
+	last := msgs[len(msgs)-1]
+	assert.Equal(t, baseSeq+2, last.Seq)
+	if assert.Len(t, last.Sells, 1) {
+		assert.Equal(t, price, last.Sells[0].Price)
+		assert.Equal(t, int32(0), last.Sells[0].Quantity)
+	}
+
+	// 5. 取消订阅后不再推送
+	unsubResp, err := manager.UnsubscribeItem(watchCtx, &auction.UnsubscribeItemReq{ItemId: itemId})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, unsubResp.Code)
+	count := len(msgs)
+	sellResp, err = manager.Sell(sellCtx, &auction.SellReq{
+		ItemId:       itemId,
+		Quantity:     1,
+		Price:        price,
+		IdempotentId: "test_market_sell2_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, flush(count+1), count)
+	getMatchManager().settlePending(ctx)
+}
+
+// 测试用例: 盘口差异计算
+func TestDiffLevels(t *testing.T) {
+	before := []*auction.OrderInfo{{Price: 10, Quantity: 1}, {Price: 11, Quantity: 2}, {Price: 12, Quantity: 3}}
+	after := []*auction.OrderInfo{{Price: 11, Quantity: 2}, {Price: 12, Quantity: 5}, {Price: 13, Quantity: 1}}
+	assert.Equal(t, []*auction.OrderInfo{
+		{ItemId: "x", Price: 12, Quantity: 5},
+		{ItemId: "x", Price: 13, Quantity: 1},
+		{ItemId: "x", Price: 10, Quantity: 0},
+	}, diffLevels("x", before, after))
+	assert.Empty(t, diffLevels("x", after, after))
+}
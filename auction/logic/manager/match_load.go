@@ -0,0 +1,319 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+)
+
+// 价格分布
+const (
+	PriceDistUniform = "uniform" // 在基准价上下浮动范围内均匀分布
+	PriceDistNormal  = "normal"  // 以基准价为均值、浮动范围的1/3为标准差的正态分布
+)
+
+const (
+	loadUserCount      = 1000                  // 压测下单用户数
+	loadSampleInterval = 10 * time.Millisecond // opChannel积压采样间隔
+	loadDrainTimeout   = 30 * time.Second      // 停止下单后等待撮合单元处理完积压的最长时间
+)
+
+// LoadScenario 撮合压测场景：撮合单元使用内存持久化，不依赖Redis
+type LoadScenario struct {
+	Items       int           // 道具数量（每个道具一个撮合单元）
+	OrderRate   int           // 每秒下单数（所有道具合计）
+	Duration    time.Duration // 下单持续时间
+	BasePrice   int64         // 基准价格
+	PriceSpread int64         // 价格浮动范围（基准价上下）
+	PriceDist   string        // 价格分布：uniform、normal
+	MaxQuantity int32         // 单笔最大数量，数量在1到该值之间均匀分布
+	CancelRatio float64       // 撤单比例：每笔下单后以该概率撤销同一道具的一笔挂单
+	Seed        int64         // 随机数种子，相同种子生成相同的订单序列
+}
+
+// LoadReport 压测结果
+type LoadReport struct {
+	Orders       int64         // 下单数
+	Cancels      int64         // 撤单数（撤销时仍在订单簿中的）
+	Fills        int64         // 成交笔数
+	Elapsed      time.Duration // 从开始下单到积压处理完毕的耗时
+	OrdersPerSec float64
+	FillsPerSec  float64
+	LatencyP50   time.Duration // 操作从投递到opChannel到撮合完成的延迟
+	LatencyP90   time.Duration
+	LatencyP99   time.Duration
+	LatencyMax   time.Duration
+	BacklogMax   int     // 单个撮合单元opChannel的最大积压
+	BacklogAvg   float64 // 各撮合单元opChannel的平均积压（按采样）
+	SettleMax    int64   // 单个撮合单元待落库成交的最大积压
+}
+
+// String 输出压测结果
+func (r *LoadReport) String() string {
+	return fmt.Sprintf("orders=%d cancels=%d fills=%d elapsed=%v orders/s=%.0f fills/s=%.0f "+
+		"latency p50=%v p90=%v p99=%v max=%v backlog max=%d avg=%.1f settle max=%d",
+		r.Orders, r.Cancels, r.Fills, r.Elapsed, r.OrdersPerSec, r.FillsPerSec,
+		r.LatencyP50, r.LatencyP90, r.LatencyP99, r.LatencyMax, r.BacklogMax, r.BacklogAvg, r.SettleMax)
+}
+
+// validate 校验场景参数
+func (s *LoadScenario) validate() error {
+	if s.Items <= 0 {
+		return fmt.Errorf("items must be positive")
+	}
+	if s.OrderRate <= 0 {
+		return fmt.Errorf("order rate must be positive")
+	}
+	if s.Duration <= 0 {
+		return fmt.Errorf("duration must be positive")
+	}
+	if s.BasePrice <= 0 || s.PriceSpread < 0 || s.PriceSpread >= s.BasePrice {
+		return fmt.Errorf("invalid price: base=%d, spread=%d", s.BasePrice, s.PriceSpread)
+	}
+	if s.PriceDist != PriceDistUniform && s.PriceDist != PriceDistNormal {
+		return fmt.Errorf("unknown price distribution %q", s.PriceDist)
+	}
+	if s.MaxQuantity <= 0 {
+		return fmt.Errorf("max quantity must be positive")
+	}
+	if s.CancelRatio < 0 || s.CancelRatio > 1 {
+		return fmt.Errorf("cancel ratio must be in [0, 1]")
+	}
+	return nil
+}
+
+// price 按分布生成价格
+func (s *LoadScenario) price(rnd *rand.Rand) int64 {
+	var offset float64
+	if s.PriceDist == PriceDistNormal {
+		offset = rnd.NormFloat64() * float64(s.PriceSpread) / 3
+		offset = math.Max(-float64(s.PriceSpread), math.Min(float64(s.PriceSpread), offset))
+	} else {
+		offset = (rnd.Float64()*2 - 1) * float64(s.PriceSpread)
+	}
+	return s.BasePrice + int64(math.Round(offset))
+}
+
+// loadLatencies 并发记录操作延迟
+type loadLatencies struct {
+	mu      sync.Mutex
+	samples []time.Duration
+}
+
+func (l *loadLatencies) add(d time.Duration) {
+	l.mu.Lock()
+	l.samples = append(l.samples, d)
+	l.mu.Unlock()
+}
+
+// percentile 返回排序后样本的百分位数
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(0, min(idx, len(sorted)-1))]
+}
+
+// initLocalIdClient 独立运行（压测、基准测试）时未初始化AuctionManager，成交ID使用固定节点生成
+func initLocalIdClient() error {
+	if idClient != nil {
+		return nil
+	}
+	node, err := snowflake.NewNode(0)
+	if err != nil {
+		return err
+	}
+	idClient = node
+	return nil
+}
+
+// RunLoadScenario 按场景向撮合单元持续下单和撤单，统计成交速率、操作延迟和opChannel积压
+// 撮合单元运行完整的撮合协程（含过期、行情和市场索引定时任务），持久化使用内存实现
+func RunLoadScenario(ctx context.Context, scenario *LoadScenario) (*LoadReport, error) {
+	if err := scenario.validate(); err != nil {
+		return nil, err
+	}
+	if err := initLocalIdClient(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	store := newMemoryMatchStore()
+	units := make([]*matchUnit, scenario.Items)
+	for i := range units {
+		units[i] = newMatchUnit("load_item_"+strconv.Itoa(i), store)
+		units[i].startMatchProcess(ctx)
+	}
+
+	// 采样opChannel和待落库成交的积压
+	var (
+		settleMax      int64
+		backlogMax     int
+		backlogSum     int64
+		backlogSamples int64
+	)
+	sampleDone := make(chan struct{})
+	sampleStopped := make(chan struct{})
+	go func() {
+		defer close(sampleStopped)
+		ticker := time.NewTicker(loadSampleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-sampleDone:
+				return
+			case <-ticker.C:
+				for _, unit := range units {
+					n := len(unit.opChannel)
+					backlogMax = max(backlogMax, n)
+					backlogSum += int64(n)
+					backlogSamples++
+					settleMax = max(settleMax, unit.settler.pending.Load())
+				}
+			}
+		}
+	}()
+
+	latencies := &loadLatencies{samples: make([]time.Duration, 0, scenario.OrderRate*int(math.Ceil(scenario.Duration.Seconds())))}
+	var cancels atomic.Int64
+	submit := func(unit *matchUnit, op func()) {
+		submitted := time.Now()
+		unit.opChannel <- func() {
+			op()
+			latencies.add(time.Since(submitted))
+		}
+	}
+
+	// 按速率下单：每个刻度补齐到当前应下单的数量
+	rnd := rand.New(rand.NewSource(scenario.Seed))
+	resting := make([][]string, scenario.Items) // 每个道具下过的订单（direction:orderId），撤单时从中随机选择
+	var orders int64
+	start := time.Now()
+	ticker := time.NewTicker(time.Millisecond)
+	for elapsed := time.Duration(0); elapsed < scenario.Duration; elapsed = time.Since(start) {
+		due := int64(elapsed.Seconds() * float64(scenario.OrderRate))
+		for ; orders < due; orders++ {
+			idx := rnd.Intn(scenario.Items)
+			unit := units[idx]
+			orderId := strconv.FormatInt(orders+1, 10)
+			userId := "load_user_" + strconv.Itoa(rnd.Intn(loadUserCount))
+			price := scenario.price(rnd)
+			quantity := rnd.Int31n(scenario.MaxQuantity) + 1
+			createTime := time.Now().Unix()
+
+			if rnd.Intn(2) == 0 {
+				store.PutOrder("sell", orderId, userId, unit.itemId, quantity, price, 0)
+				order := &auction.SellData{OrderId: orderId, ItemId: unit.itemId, Quantity: quantity, Price: price, CreateTime: createTime}
+				submit(unit, func() {
+					unit.bindParty("sell", orderId, userId, 0)
+					unit.AddSellOrder(ctx, order)
+				})
+				resting[idx] = append(resting[idx], "sell:"+orderId)
+			} else {
+				store.PutOrder("buy", orderId, userId, unit.itemId, quantity, price, 0)
+				order := &auction.BuyData{OrderId: orderId, ItemId: unit.itemId, Quantity: quantity, Price: price, CreateTime: createTime}
+				submit(unit, func() {
+					unit.bindParty("buy", orderId, userId, 0)
+					unit.AddBuyOrder(ctx, order)
+				})
+				resting[idx] = append(resting[idx], "buy:"+orderId)
+			}
+
+			if scenario.CancelRatio > 0 && rnd.Float64() < scenario.CancelRatio {
+				list := resting[idx]
+				pick := rnd.Intn(len(list))
+				key := list[pick]
+				list[pick] = list[len(list)-1]
+				resting[idx] = list[:len(list)-1]
+				direction, cancelId, _ := strings.Cut(key, ":")
+				submit(unit, func() {
+					removed := false
+					if direction == "sell" {
+						removed = unit.RemoveSellOrder(ctx, cancelId)
+					} else {
+						removed = unit.RemoveBuyOrder(ctx, cancelId)
+					}
+					if removed {
+						store.RemoveOrder(direction, cancelId)
+						cancels.Add(1)
+					}
+				})
+			}
+		}
+		select {
+		case <-ctx.Done():
+			ticker.Stop()
+			close(sampleDone)
+			<-sampleStopped
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+	ticker.Stop()
+
+	// 等待各撮合单元处理完积压，且成交全部落库
+	var wg sync.WaitGroup
+	for _, unit := range units {
+		wg.Add(1)
+		go func(unit *matchUnit) {
+			defer wg.Done()
+			done := make(chan struct{})
+			select {
+			case unit.opChannel <- func() {
+				ack := unit.settler.barrier()
+				go func() {
+					<-ack
+					close(done)
+				}()
+			}:
+			case <-time.After(loadDrainTimeout):
+				return
+			}
+			select {
+			case <-done:
+			case <-time.After(loadDrainTimeout):
+			}
+		}(unit)
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+	close(sampleDone)
+	<-sampleStopped
+
+	latencies.mu.Lock()
+	samples := latencies.samples
+	latencies.mu.Unlock()
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+
+	report := &LoadReport{
+		Orders:       orders,
+		Cancels:      cancels.Load(),
+		Fills:        store.fills.Load(),
+		Elapsed:      elapsed,
+		OrdersPerSec: float64(orders) / elapsed.Seconds(),
+		FillsPerSec:  float64(store.fills.Load()) / elapsed.Seconds(),
+		LatencyP50:   percentile(samples, 0.50),
+		LatencyP90:   percentile(samples, 0.90),
+		LatencyP99:   percentile(samples, 0.99),
+		LatencyMax:   percentile(samples, 1),
+		BacklogMax:   backlogMax,
+		SettleMax:    settleMax,
+	}
+	if backlogSamples > 0 {
+		report.BacklogAvg = float64(backlogSum) / float64(backlogSamples)
+	}
+	return report, nil
+}
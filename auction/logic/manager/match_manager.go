@@ -6,6 +6,7 @@ import (
 	"context"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 )
@@ -16,8 +17,14 @@ type matchManager struct {
 	mu         sync.RWMutex          // 并发控制锁
 	ctx        context.Context       // 上下文，用于日志记录
 	cancel     context.CancelFunc    // 取消函数，用于取消撮合管理器
+	settleWake chan struct{}         // 唤醒结算协程
+	ownership  itemOwnership         // 道具归属管理（多实例时基于etcd租约）
+	claimMu    sync.Mutex            // 串行化道具归属的获取与订单加载
 }
 
+// unitDrainTimeout 交出撮合单元时等待已投递操作执行完毕的超时时间
+var unitDrainTimeout = 5 * time.Second
+
 var (
 	matchMgr  *matchManager
 	matchOnce sync.Once
@@ -50,124 +57,365 @@ func getMatchManager() *matchManager {
 			mu:         sync.RWMutex{},
 			ctx:        ctx,
 			cancel:     cancel,
+			settleWake: make(chan struct{}, 1),
+			ownership:  newItemOwnership(),
 		}
 
-		// 读取Redis中未成交的订单并放入相应的matchUnit
-		loadOrdersFromRedis(ctx)
+		// 先投递上次退出时未投递完的outbox，保证全局订单列表完整
+		recoverOutboxes(ctx, 0)
+
+		// 按道具挂单索引加载Redis中未成交的订单，只加载归属本实例的道具
+		matchMgr.claimItems(ctx)
+
+		// 监听归属租约，丢失时交出所有撮合单元
+		go matchMgr.watchOwnership(ctx)
+
+		// 监听存活实例变化，按一致性哈希交出和接管道具
+		if matchMgr.ownership.Distributed() {
+			go matchMgr.watchMembership(ctx)
+		}
+
+		// 启动结算协程
+		matchMgr.startSettlementProcess(ctx)
+
+		// 处理到期的唯一道具挂单
+		go matchMgr.runUniqueListingSweeper(ctx)
+
+		// 按道具上报队列积压
+		matchMgr.registerQueueMetrics()
 
 		klog.CtxInfof(ctx, "[AUCTION-MATCH-MGR] MatchManager initialized")
 	})
 	return matchMgr
 }
 
-// GetMatchUnit 根据道具ID获取matchUnit（懒加载模式）
-func (m *matchManager) GetMatchUnit(itemId string) *matchUnit {
+// GetMatchUnit 根据道具ID获取撮合单元
+// 多实例部署时撮合单元只在RouteItem、rebalance获得归属后创建，映射中没有该道具说明归属已转移到其他实例，
+// 返回errItemMoved由客户端重试；单实例部署时懒加载创建
+func (m *matchManager) GetMatchUnit(itemId string) (*matchUnit, error) {
 	m.mu.RLock()
 	matchUnit, exists := m.matchUnits[itemId]
 	m.mu.RUnlock()
-
 	if exists {
-		return matchUnit
+		return matchUnit, nil
+	}
+	if m.ownership.Distributed() {
+		return nil, errItemMoved
 	}
 
-	// 如果不存在，创建新的matchUnit
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	// 再次检查，避免并发创建
 	matchUnit, exists = m.matchUnits[itemId]
 	if exists {
-		return matchUnit
+		return matchUnit, nil
 	}
-
-	// 创建新的matchUnit
-	matchUnit = newMatchUnit(itemId)
-	matchUnit.startMatchProcess(m.ctx)
+	matchUnit = m.startMatchUnit(itemId, nil)
 	m.matchUnits[itemId] = matchUnit
-	klog.Info("[AUCTION-MATCH-MGR] Created matchUnit for item: %s", itemId)
+	klog.Infof("[AUCTION-MATCH-MGR] Created matchUnit for item: %s", itemId)
+	return matchUnit, nil
+}
 
-	return matchUnit
+// startMatchUnit 创建撮合单元：从快照和事件流恢复订单簿后启动撮合协程，orders不为空时再以Redis订单数据校正。
+// 单元加入映射前不会收到其他操作
+func (m *matchManager) startMatchUnit(itemId string, orders *itemOrders) *matchUnit {
+	unit := newMatchUnit(itemId, &redisMatchStore{})
+	unit.restoreFromEventLog(m.ctx)
+	unitCtx, stop := context.WithCancel(m.ctx)
+	unit.stop = stop
+	unit.startMatchProcess(unitCtx)
+	if orders != nil {
+		done := make(chan struct{})
+		if err := unit.send(m.ctx, func() {
+			unit.reconcileOrders(m.ctx, orders.sells, orders.buys, orders.parties)
+			close(done)
+		}); err == nil {
+			<-done
+		}
+	}
+	return unit
 }
 
 // ProcessMatchResult 处理撮合结果
-func (m *matchManager) ProcessMatchResult(ctx context.Context, sellOrder *auction.SellData, buyOrder *auction.BuyData) {
-	// TODO: 实现撮合结果的处理逻辑
-	// 1. 更新订单状态
-	// 2. 处理交易完成后的逻辑
-	// 3. 扣除买家税费（1% 交易金额，放在常量中定义）
-	klog.CtxInfof(ctx, "[AUCTION-MATCH] Process match result: sellOrder=%s, buyOrder=%s",
-		sellOrder.GetOrderId(), buyOrder.GetOrderId())
-}
-
-// loadOrdersFromRedis 从Redis加载未成交的订单到matchUnit
-func loadOrdersFromRedis(ctx context.Context) {
-	// 读取全局出售列表
-	sellOrders, err := redis.GetRedis().SMembers(ctx, "auction:sells").Result()
-	if err != nil {
-		klog.Errorf("[AUCTION-MATCH-MGR] get sell orders error: %s", err.Error())
-	} else {
-		for _, orderKey := range sellOrders {
-			// 获取订单详情
-			orderData, err := redis.GetRedis().HGetAll(ctx, orderKey).Result()
+// 订单状态与结算任务已在matchResult中写入并投递到结算队列，这里只负责唤醒结算协程尽快发放道具和货款
+func (m *matchManager) ProcessMatchResult(ctx context.Context, sellOrderId string, buyOrderId string) {
+	klog.CtxInfof(ctx, "[AUCTION-MATCH] Process match result: sellOrder=%s, buyOrder=%s", sellOrderId, buyOrderId)
+
+	m.wakeSettlement()
+}
+
+// itemOrders 道具在Redis中未成交的订单
+type itemOrders struct {
+	sells   []*auction.SellData
+	buys    []*auction.BuyData
+	parties map[string]*bookParty // direction:orderId -> 订单所有者
+}
+
+// loadItemOrders 按道具挂单索引读取该道具未成交的订单，索引中已删除的订单跳过
+func loadItemOrders(ctx context.Context, itemId string) (*itemOrders, error) {
+	rdb := redis.GetRedis()
+	orders := &itemOrders{parties: make(map[string]*bookParty)}
+	for _, direction := range []string{"sell", "buy"} {
+		orderKeys, err := rdb.SMembers(ctx, itemOrdersKey(itemId, direction)).Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, orderKey := range orderKeys {
+			orderData, err := rdb.HGetAll(ctx, orderKey).Result()
 			if err != nil {
-				klog.Errorf("[AUCTION-MATCH-MGR] get sell order data error: %s", err.Error())
+				return nil, err
+			}
+			if len(orderData) == 0 {
 				continue
 			}
-
-			// 解析订单数据
-			sellData := &auction.SellData{
-				OrderId:    orderData["order_id"],
-				ItemId:     orderData["item_id"],
-				Quantity:   int32(parseInt(orderData["quantity"])),
-				Price:      parseInt64(orderData["price"]),
-				ItemInfo:   orderData["item_info"],
-				CreateTime: parseInt64(orderData["create_time"]),
+			if direction == "sell" {
+				orders.sells = append(orders.sells, &auction.SellData{
+					OrderId:    orderData["order_id"],
+					ItemId:     orderData["item_id"],
+					Quantity:   int32(parseInt(orderData["quantity"])),
+					Price:      parseInt64(orderData["price"]),
+					ItemInfo:   orderData["item_info"],
+					CreateTime: parseInt64(orderData["create_time"]),
+					ExpireTime: loadedExpireTime(orderData),
+					OrderType:  auction.OrderType(parseInt(orderData["order_type"])),
+				})
+				orders.parties[partyKey("sell", orderData["order_id"])] = &bookParty{userId: orderData["user_id"]}
+			} else {
+				orders.buys = append(orders.buys, &auction.BuyData{
+					OrderId:    orderData["order_id"],
+					ItemId:     orderData["item_id"],
+					Quantity:   int32(parseInt(orderData["quantity"])),
+					Price:      parseInt64(orderData["price"]),
+					CreateTime: parseInt64(orderData["create_time"]),
+					ExpireTime: loadedExpireTime(orderData),
+					OrderType:  auction.OrderType(parseInt(orderData["order_type"])),
+				})
+				orders.parties[partyKey("buy", orderData["order_id"])] = &bookParty{
+					userId:     orderData["user_id"],
+					feeReserve: parseInt64(orderData["fee_reserve"]),
+				}
 			}
+		}
+	}
+	return orders, nil
+}
 
-			// 添加到matchUnit
-			mu := matchMgr.GetMatchUnit(sellData.ItemId)
-			mu.opChannel <- func() {
-				mu.AddSellOrder(ctx, sellData)
-			}
+// claimUnit 为已获得归属的道具创建撮合单元并加载其在Redis中未成交的订单（之前的归属实例可能已宕机），
+// 加载失败时返回错误，调用方负责释放归属。调用方需持有claimMu
+func (m *matchManager) claimUnit(ctx context.Context, itemId string) error {
+	orders, err := loadItemOrders(ctx, itemId)
+	if err != nil {
+		return err
+	}
+	unit := m.startMatchUnit(itemId, orders)
+	m.mu.Lock()
+	m.matchUnits[itemId] = unit
+	m.mu.Unlock()
+	klog.CtxInfof(ctx, "[AUCTION-MATCH-MGR] Took over item: %s, sells=%d, buys=%d", itemId, len(orders.sells), len(orders.buys))
+	return nil
+}
+
+// claimItems 接管有过挂单、按一致性哈希归属本实例且尚未持有的道具，只读取这些道具的挂单索引。
+// 返回false表示有道具仍被原实例持有或加载失败，需要稍后重试
+func (m *matchManager) claimItems(ctx context.Context) bool {
+	m.claimMu.Lock()
+	defer m.claimMu.Unlock()
+
+	itemIds, err := redis.GetRedis().SMembers(ctx, orderItemsKey).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MATCH-MGR] get order items error: %s", err.Error())
+		return false
+	}
+	complete := true
+	for _, itemId := range itemIds {
+		m.mu.RLock()
+		_, exists := m.matchUnits[itemId]
+		m.mu.RUnlock()
+		if exists || !m.ownership.Owns(itemId) {
+			continue
+		}
+		_, acquired, err := m.ownership.Acquire(ctx, itemId)
+		if err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-MATCH-MGR] acquire item %s error: %s", itemId, err.Error())
+		}
+		if !acquired {
+			complete = false
+			continue
+		}
+		if err := m.claimUnit(ctx, itemId); err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-MATCH-MGR] load item %s orders error: %s", itemId, err.Error())
+			m.releaseItem(ctx, itemId)
+			complete = false
 		}
-		klog.CtxInfof(ctx, "[AUCTION-MATCH-MGR] loaded %d sell orders", len(sellOrders))
 	}
+	return complete
+}
+
+// RouteItem 确定道具撮合单元所在实例，本实例未持有时尝试获取归属并从Redis加载该道具的订单
+// 返回owner为持有该道具的实例地址，local为true时表示由本实例处理
+func (m *matchManager) RouteItem(ctx context.Context, itemId string) (owner string, local bool, err error) {
+	if !m.ownership.Distributed() {
+		return "", true, nil
+	}
+
+	m.mu.RLock()
+	_, exists := m.matchUnits[itemId]
+	m.mu.RUnlock()
+	if exists {
+		return "", true, nil
+	}
+
+	m.claimMu.Lock()
+	defer m.claimMu.Unlock()
 
-	// 读取全局求购列表
-	buyOrders, err := redis.GetRedis().SMembers(ctx, "auction:buys").Result()
+	// 再次检查，避免并发重复加载
+	m.mu.RLock()
+	_, exists = m.matchUnits[itemId]
+	m.mu.RUnlock()
+	if exists {
+		return "", true, nil
+	}
+
+	owner, acquired, err := m.ownership.Acquire(ctx, itemId)
 	if err != nil {
-		klog.CtxErrorf(ctx, "[AUCTION-MATCH-MGR] get buy orders error: %s", err.Error())
-	} else {
-		for _, orderKey := range buyOrders {
-			// 获取订单详情
-			orderData, err := redis.GetRedis().HGetAll(ctx, orderKey).Result()
-			if err != nil {
-				klog.CtxErrorf(ctx, "[AUCTION-MATCH-MGR] get buy order data error: %s", err.Error())
-				continue
-			}
+		klog.CtxErrorf(ctx, "[AUCTION-MATCH-MGR] acquire item %s error: %s", itemId, err.Error())
+		return "", false, err
+	}
+	if !acquired {
+		return owner, false, nil
+	}
 
-			// 解析订单数据
-			buyData := &auction.BuyData{
-				OrderId:    orderData["order_id"],
-				ItemId:     orderData["item_id"],
-				Quantity:   int32(parseInt(orderData["quantity"])),
-				Price:      parseInt64(orderData["price"]),
-				CreateTime: parseInt64(orderData["create_time"]),
-			}
+	// 新获得归属，创建撮合单元并加载该道具的订单
+	if err = m.claimUnit(ctx, itemId); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MATCH-MGR] load item %s orders error: %s", itemId, err.Error())
+		m.releaseItem(ctx, itemId)
+		return "", false, err
+	}
+	return owner, true, nil
+}
 
-			// 添加到matchUnit
-			mu := matchMgr.GetMatchUnit(buyData.ItemId)
-			mu.opChannel <- func() {
-				mu.AddBuyOrder(ctx, buyData)
+// watchOwnership 归属租约丢失时（网络分区、etcd会话过期）交出本实例所有撮合单元
+func (m *matchManager) watchOwnership(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.ownership.Lost():
+			klog.CtxErrorf(ctx, "[AUCTION-MATCH-MGR] Ownership lease lost, dropping all match units")
+			m.dropAllUnits(ctx, false)
+			// 等待会话重建，避免etcd不可用时空转
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
 			}
 		}
-		for _, mu := range matchMgr.matchUnits {
-			r := make(chan bool, 0)
-			mu.opChannel <- func() {
-				r <- true
-			}
-			<-r
+	}
+}
+
+// watchMembership 存活实例变化时重新分配撮合单元，原实例尚未交出的道具每秒重试接管
+func (m *matchManager) watchMembership(ctx context.Context) {
+	var retry <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.ownership.Changed():
+		case <-retry:
+		}
+		retry = nil
+		if !m.rebalance(ctx) {
+			retry = time.After(time.Second)
+		}
+	}
+}
+
+// rebalance 交出按一致性哈希不再归属本实例的撮合单元并释放归属，再接管有过挂单、新归属本实例的道具。
+// 返回false表示有道具仍被原实例持有，需要稍后重试
+func (m *matchManager) rebalance(ctx context.Context) bool {
+	m.mu.RLock()
+	moved := make([]string, 0)
+	for itemId := range m.matchUnits {
+		if !m.ownership.Owns(itemId) {
+			moved = append(moved, itemId)
 		}
-		klog.CtxInfof(ctx, "[AUCTION-MATCH-MGR] loaded %d buy orders", len(buyOrders))
 	}
+	m.mu.RUnlock()
+	for _, itemId := range moved {
+		m.dropUnit(ctx, itemId, true)
+		klog.CtxInfof(ctx, "[AUCTION-MATCH-MGR] Handed off item: %s", itemId)
+	}
+
+	return m.claimItems(ctx)
+}
+
+// dropUnit 交出撮合单元：先从映射中移除，再等待opChannel中已投递的操作执行完毕并保存小时数据，最后停止撮合协程
+func (m *matchManager) dropUnit(ctx context.Context, itemId string, release bool) {
+	m.mu.Lock()
+	unit, exists := m.matchUnits[itemId]
+	delete(m.matchUnits, itemId)
+	m.mu.Unlock()
+	if !exists {
+		return
+	}
+
+	// 投递排空操作和等待执行共用超时，队列已满或撮合协程卡住时不会一直阻塞
+	drainCtx, cancel := context.WithTimeout(ctx, unitDrainTimeout)
+	defer cancel()
+	done := make(chan struct{})
+	err := unit.send(drainCtx, func() {
+		unit.settler.flush()
+		unit.saveHourlyData(ctx)
+		unit.saveSnapshot(ctx)
+		close(done)
+	})
+	if err == nil {
+		select {
+		case <-done:
+		case <-drainCtx.Done():
+			err = drainCtx.Err()
+		}
+	}
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MATCH-MGR] Drain match unit timeout: %s", itemId)
+	}
+	if unit.stop != nil {
+		unit.stop()
+	}
+
+	if release {
+		m.releaseItem(ctx, itemId)
+	}
+	klog.CtxInfof(ctx, "[AUCTION-MATCH-MGR] Dropped match unit: %s", itemId)
+}
+
+// releaseItem 释放道具归属，其他实例可立即接管
+func (m *matchManager) releaseItem(ctx context.Context, itemId string) {
+	if err := m.ownership.Release(ctx, itemId); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MATCH-MGR] release item %s error: %s", itemId, err.Error())
+	}
+}
+
+// dropAllUnits 交出所有撮合单元
+func (m *matchManager) dropAllUnits(ctx context.Context, release bool) {
+	m.mu.RLock()
+	itemIds := make([]string, 0, len(m.matchUnits))
+	for itemId := range m.matchUnits {
+		itemIds = append(itemIds, itemId)
+	}
+	m.mu.RUnlock()
+
+	for _, itemId := range itemIds {
+		m.dropUnit(ctx, itemId, release)
+	}
+}
+
+// Close 优雅退出：排空所有撮合单元并撤销租约，其他实例可立即接管
+func (m *matchManager) Close(ctx context.Context) {
+	m.dropAllUnits(ctx, false)
+	if err := m.ownership.Close(ctx); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MATCH-MGR] close ownership error: %s", err.Error())
+	}
+	m.cancel()
 }
@@ -0,0 +1,182 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/common"
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	// errMarketBusy 撮合单元的操作队列已满
+	errMarketBusy = errors.New("market busy")
+	// errItemMoved 道具归属已转移到其他实例，本实例不再持有其撮合单元
+	errItemMoved = errors.New("item moved")
+)
+
+// enqueue 把操作投递到撮合协程，不阻塞：ctx已取消时返回ctx.Err()，队列已满时返回errMarketBusy
+func (mu *matchUnit) enqueue(ctx context.Context, op func()) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case mu.opChannel <- op:
+		return nil
+	default:
+		return errMarketBusy
+	}
+}
+
+// send 投递操作，队列已满时等待到ctx结束并返回ctx.Err()，用于交出、加载撮合单元等不能因队列满直接放弃的内部操作
+func (mu *matchUnit) send(ctx context.Context, op func()) error {
+	select {
+	case mu.opChannel <- op:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// call 投递操作并等待执行完毕；ctx在等待期间取消时返回ctx.Err()，已投递的操作仍会执行，调用方不能再读取op写入的结果
+func (mu *matchUnit) call(ctx context.Context, op func()) error {
+	done := make(chan struct{})
+	if err := mu.enqueue(ctx, func() {
+		op()
+		close(done)
+	}); err != nil {
+		return err
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// callSettled 在撮合协程内执行op，再等待此前投递的成交全部落库，用于撤单等随后直接修改Redis订单数据的操作
+func (mu *matchUnit) callSettled(ctx context.Context, op func()) error {
+	var ack <-chan struct{}
+	if err := mu.call(ctx, func() {
+		op()
+		ack = mu.settler.barrier()
+	}); err != nil {
+		return err
+	}
+	select {
+	case <-ack:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// placeOrder 把已写入Redis的新订单投递到撮合协程，等待撮合及本单成交落库完成。
+// 投递失败时以取消关闭订单并退还托管后返回错误；投递后请求超时时订单已被受理，仍会继续撮合，返回nil
+func (mu *matchUnit) placeOrder(ctx context.Context, direction string, orderId string, place func()) error {
+	settled := make(chan (<-chan struct{}), 1)
+	err := mu.enqueue(ctx, func() {
+		place()
+		settled <- mu.settler.barrier()
+	})
+	if err != nil {
+		rejectOrder(ctx, mu.itemId, direction, orderId, err)
+		return err
+	}
+
+	select {
+	case ack := <-settled:
+		select {
+		case <-ack:
+			return nil
+		case <-ctx.Done():
+		}
+	case <-ctx.Done():
+	}
+	klog.CtxWarnf(ctx, "[AUCTION-MATCH-QUEUE] Request done before order settled: itemId=%s, orderId=%s, direction=%s",
+		mu.itemId, orderId, direction)
+	return nil
+}
+
+// rejectOrder 已写入Redis但未能投递到撮合协程的订单以取消关闭并退还托管
+func rejectOrder(ctx context.Context, itemId string, direction string, orderId string, cause error) {
+	klog.CtxWarnf(ctx, "[AUCTION-MATCH-QUEUE] Reject order: itemId=%s, orderId=%s, direction=%s, error: %s",
+		itemId, orderId, direction, cause.Error())
+	if _, err := closeOrder(context.WithoutCancel(ctx), direction, orderId, "取消",
+		"auction:reject:"+orderId, "auction_reject_"+direction, 0); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MATCH-QUEUE] Close rejected order error: orderId=%s, direction=%s, error: %s",
+			orderId, direction, err.Error())
+	}
+}
+
+// busy 操作队列是否已满，下单在托管前先检查，避免托管后再回滚
+func (mu *matchUnit) busy() bool {
+	return len(mu.opChannel) >= cap(mu.opChannel)
+}
+
+// queueErrorCode 获取撮合单元、投递或等待撮合协程失败时返回给客户端的错误码
+func queueErrorCode(err error) (common.ErrorCode, string) {
+	if errors.Is(err, errMarketBusy) {
+		return common.ErrorCode_AUCTION_MARKET_BUSY, "market busy, please retry"
+	}
+	if errors.Is(err, errItemMoved) {
+		return common.ErrorCode_AUCTION_ITEM_MOVED, "item moved to another instance, please retry"
+	}
+	return common.ErrorCode_AUCTION_TIMEOUT, "request timeout"
+}
+
+// queueDepth 撮合单元的队列积压
+type queueDepth struct {
+	ItemId string
+	Ops    int   // opChannel中待执行的操作数
+	Settle int64 // 待落库的成交数
+}
+
+// queueDepths 返回本实例各撮合单元的队列积压，按道具ID排序
+func (m *matchManager) queueDepths() []queueDepth {
+	m.mu.RLock()
+	depths := make([]queueDepth, 0, len(m.matchUnits))
+	for itemId, unit := range m.matchUnits {
+		depths = append(depths, queueDepth{
+			ItemId: itemId,
+			Ops:    len(unit.opChannel),
+			Settle: unit.settler.pending.Load(),
+		})
+	}
+	m.mu.RUnlock()
+	sort.Slice(depths, func(i, j int) bool { return depths[i].ItemId < depths[j].ItemId })
+	return depths
+}
+
+// registerQueueMetrics 注册按道具统计的队列积压指标，由全局MeterProvider采集
+func (m *matchManager) registerQueueMetrics() {
+	meter := otel.Meter("auction_module/logic/manager")
+	ops, err := meter.Int64ObservableGauge("auction.match_unit.op_queue_depth",
+		metric.WithDescription("撮合单元opChannel中待执行的操作数"))
+	if err != nil {
+		klog.Errorf("[AUCTION-MATCH-MGR] register op queue metric error: %s", err.Error())
+		return
+	}
+	settle, err := meter.Int64ObservableGauge("auction.match_unit.settle_queue_depth",
+		metric.WithDescription("撮合单元待落库的成交数"))
+	if err != nil {
+		klog.Errorf("[AUCTION-MATCH-MGR] register settle queue metric error: %s", err.Error())
+		return
+	}
+	_, err = meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		for _, depth := range m.queueDepths() {
+			attrs := metric.WithAttributes(attribute.String("item_id", depth.ItemId))
+			o.ObserveInt64(ops, int64(depth.Ops), attrs)
+			o.ObserveInt64(settle, depth.Settle, attrs)
+		}
+		return nil
+	}, ops, settle)
+	if err != nil {
+		klog.Errorf("[AUCTION-MATCH-MGR] register queue metric callback error: %s", err.Error())
+	}
+}
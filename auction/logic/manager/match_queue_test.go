@@ -0,0 +1,158 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 测试用例: 操作队列已满时返回市场繁忙，请求取消或超时时不再等待撮合协程
+func TestMatchUnit_Enqueue(t *testing.T) {
+	ctx := context.Background()
+	mu := newMatchUnit("test_item_enqueue", newMemoryMatchStore())
+
+	// 撮合协程未启动，投递的操作全部积压在队列中
+	for i := 0; i < cap(mu.opChannel); i++ {
+		assert.NoError(t, mu.enqueue(ctx, func() {}))
+	}
+	assert.True(t, mu.busy())
+	err := mu.enqueue(ctx, func() {})
+	assert.ErrorIs(t, err, errMarketBusy)
+	code, _ := queueErrorCode(err)
+	assert.Equal(t, common.ErrorCode_AUCTION_MARKET_BUSY, code)
+
+	// 已取消的请求不投递
+	<-mu.opChannel
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorIs(t, mu.enqueue(cancelled, func() {}), context.Canceled)
+	assert.False(t, mu.busy())
+
+	// 投递后等待超时
+	timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	err = mu.call(timeout, func() {})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	code, _ = queueErrorCode(err)
+	assert.Equal(t, common.ErrorCode_AUCTION_TIMEOUT, code)
+}
+
+// 测试用例: 撮合单元阻塞时下单返回市场繁忙且不托管，查询盘口超时返回；恢复后成交在下单返回前已落库
+func TestAuctionManager_MarketBusy(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	// 检查Redis连接是否正常
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	fake := newFakeInventory()
+	inventory = fake
+	defer func() { inventory = newFakeInventory() }()
+
+	itemId := "test_item_busy"
+	sellUserId := "test_user_busy_seller"
+	buyUserId := "test_user_busy_buyer"
+	sellCtx := context.WithValue(ctx, "userId", sellUserId)
+	buyCtx := context.WithValue(ctx, "userId", buyUserId)
+	manager := GetAuctionManager()
+	mu := testMatchUnit(itemId)
+	price := mu.hourlyAvgPrice
+
+	// 1. 阻塞撮合协程并填满操作队列
+	stalled, release := make(chan struct{}), make(chan struct{})
+	mu.opChannel <- func() {
+		close(stalled)
+		<-release
+	}
+	<-stalled
+	for !mu.busy() {
+		mu.opChannel <- func() {}
+	}
+	sellResp, err := manager.Sell(sellCtx, &auction.SellReq{
+		ItemId:       itemId,
+		Quantity:     2,
+		Price:        price,
+		IdempotentId: "test_busy_sell_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_MARKET_BUSY, sellResp.Code)
+	assert.Equal(t, int64(0), fake.balance(sellUserId, itemId))
+	sellCount, err := redis.GetRedis().SCard(ctx, userOrdersKey(sellUserId, "sell")).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), sellCount)
+
+	infoResp, err := manager.GetItemAuctionInfo(ctx, &auction.GetItemAuctionInfoReq{ItemIds: []string{itemId}})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_MARKET_BUSY, infoResp.Code)
+
+	// 2. 已写入Redis的订单投递失败时以取消关闭
+	orderId := "test_busy_rejected"
+	redis.GetRedis().HMSet(ctx, sellOrderKey(orderId), map[string]interface{}{
+		"order_id":    orderId,
+		"item_id":     itemId,
+		"quantity":    "1",
+		"price":       strconv.FormatInt(price, 10),
+		"create_time": strconv.FormatInt(time.Now().Unix(), 10),
+		"user_id":     sellUserId,
+	})
+	err = mu.placeOrder(ctx, "sell", orderId, func() {})
+	assert.ErrorIs(t, err, errMarketBusy)
+	exists, err := redis.GetRedis().Exists(ctx, sellOrderKey(orderId)).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), exists)
+	status, err := redis.GetRedis().HGet(ctx, orderStatusKey(orderId), "status").Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "取消", status)
+
+	// 3. 队列未满但撮合协程阻塞，请求超时
+	close(release)
+	unblock := make(chan struct{})
+	mu.opChannel <- func() { <-unblock }
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	infoResp, err = manager.GetItemAuctionInfo(timeoutCtx, &auction.GetItemAuctionInfoReq{ItemIds: []string{itemId}})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_TIMEOUT, infoResp.Code)
+	close(unblock)
+
+	// 4. 恢复后成交：下单返回时成交已落库
+	sellResp, err = manager.Sell(sellCtx, &auction.SellReq{
+		ItemId:       itemId,
+		Quantity:     2,
+		Price:        price,
+		IdempotentId: "test_busy_sell_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, sellResp.Code)
+	buyResp, err := manager.Buy(buyCtx, &auction.BuyReq{
+		ItemId:       itemId,
+		Quantity:     2,
+		Price:        price,
+		IdempotentId: "test_busy_buy_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, buyResp.Code)
+	status, err = redis.GetRedis().HGet(ctx, orderStatusKey(sellResp.Data.OrderId), "status").Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "交易完成", status)
+	assert.Equal(t, int64(0), mu.settler.pending.Load())
+
+	depths := getMatchManager().queueDepths()
+	found := false
+	for _, depth := range depths {
+		if depth.ItemId == itemId {
+			found = true
+			assert.Equal(t, int64(0), depth.Settle)
+		}
+	}
+	assert.True(t, found)
+}
@@ -0,0 +1,342 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/redis"
+	"auction_module/redis/script"
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// matchStore 撮合单元的持久化：订单、成交结算、事件日志、小时数据和行情。
+// 撮合协程和落库协程只通过它访问外部存储，线上使用Redis实现，基准测试和压测使用内存实现。
+// 订单所有者随订单保存在撮合单元内，撮合过程不再读取订单数据
+type matchStore interface {
+	// LoadHourlyPrice 读取最近保存的小时均价，没有记录时ok为false
+	LoadHourlyPrice(ctx context.Context, itemId string) (price int64, ok bool, err error)
+	// SaveHourlyPrice 保存小时均价
+	SaveHourlyPrice(ctx context.Context, itemId string, price int64) error
+	// ItemHalted 道具是否被运维暂停交易
+	ItemHalted(ctx context.Context, itemId string) bool
+
+	// CommitFills 按顺序持久化一批成交：扣减双方订单、写入成交记录和结算任务，返回每笔成交的结果
+	CommitFills(ctx context.Context, fills []*matchFill) []error
+	// CloseOrder 以终态关闭订单并退还剩余托管，订单已成交或已取消时返回nil
+	CloseOrder(ctx context.Context, direction string, orderId string, status string, jobId string, reason string, expireTime int64) (*closedOrder, error)
+
+	// AppendEvents 按顺序追加一批撮合单元事件，返回最后一条事件在事件流中的ID
+	AppendEvents(ctx context.Context, itemId string, events []*matchEvent) (string, error)
+	// SaveSnapshot 保存订单簿快照
+	SaveSnapshot(ctx context.Context, itemId string, snapshot map[string]interface{}) error
+
+	// WriteMarketEntry 写入市场索引中的道具概况，entry为nil时移除
+	WriteMarketEntry(ctx context.Context, itemId string, category string, oldCategory string, entry *auction.MarketItem) error
+	// MarketStats 统计道具24小时内的最近成交价、成交量和涨跌幅
+	MarketStats(ctx context.Context, itemId string, now int64) (lastPrice int64, volume int64, change int32, err error)
+	// PushMarket 向道具的订阅者推送行情
+	PushMarket(ctx context.Context, ntf *auction.AuctionMarketNtf)
+}
+
+// matchFill 一笔待持久化的成交
+type matchFill struct {
+	TransactionId string
+	ItemId        string
+	ItemInfo      string
+	SellOrderId   string
+	BuyOrderId    string
+	SellerId      string
+	BuyerId       string
+	Price         int64
+	Quantity      int32
+	Tax           int64 // 总手续费
+	SellTax       int64 // 卖家承担的手续费
+	BuyTax        int64 // 买家承担的手续费（从买单预留中扣除）
+	RefPrice      int64 // 成交时的参考价（小时均价），用于风控
+	TradeTime     int64
+	Jobs          []*settlementJob // 结算任务
+}
+
+// redisMatchStore 基于Redis的持久化
+type redisMatchStore struct{}
+
+func (s *redisMatchStore) LoadHourlyPrice(ctx context.Context, itemId string) (int64, bool, error) {
+	price, err := redis.GetRedis().Get(ctx, hourlyPriceKey(itemId)).Int64()
+	if err == goredis.Nil {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return price, true, nil
+}
+
+func (s *redisMatchStore) SaveHourlyPrice(ctx context.Context, itemId string, price int64) error {
+	return redis.GetRedis().Set(ctx, hourlyPriceKey(itemId), price, 24*time.Hour*7).Err()
+}
+
+func (s *redisMatchStore) ItemHalted(ctx context.Context, itemId string) bool {
+	return isItemHalted(ctx, itemId)
+}
+
+// CommitFills 一次登记整批成交的卖单outbox，再按顺序逐笔落库
+func (s *redisMatchStore) CommitFills(ctx context.Context, fills []*matchFill) []error {
+	errs := make([]error, len(fills))
+	outboxKeys := make([]string, len(fills))
+	for i, fill := range fills {
+		outboxKeys[i] = orderOutboxKey(fill.SellOrderId)
+	}
+	if err := markOutbox(ctx, outboxKeys...); err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+	// 同一卖单在批次内多次成交时，前一笔投递完成会移除登记，需要重新登记
+	drained := make(map[string]bool, len(fills))
+	for i, fill := range fills {
+		if drained[outboxKeys[i]] {
+			if errs[i] = markOutbox(ctx, outboxKeys[i]); errs[i] != nil {
+				continue
+			}
+		}
+		errs[i] = s.commitFill(ctx, fill)
+		drained[outboxKeys[i]] = true
+	}
+	return errs
+}
+
+// commitFill 成交落库分两步：先在卖单slot内执行成交脚本，成交记录、买单一侧的成交脚本和结算任务写入卖单outbox后依次投递，
+// 两侧都以成交ID去重，投递中断时由恢复协程继续。首次落库后计入K线、对账单和风控，并唤醒结算协程；
+// 落库失败重试时已落库的成交为空操作，不重复计入
+func (s *redisMatchStore) commitFill(ctx context.Context, fill *matchFill) error {
+	transactionRecord := cmdEffect("HSET", transactionKey(fill.TransactionId),
+		"transaction_id", fill.TransactionId,
+		"buy_order_id", fill.BuyOrderId,
+		"sell_order_id", fill.SellOrderId,
+		"item_id", fill.ItemId,
+		"item_info", fill.ItemInfo,
+		"quantity", fill.Quantity,
+		"price", fill.Price,
+		"tax", fill.Tax,
+		"sell_tax", fill.SellTax,
+		"buy_tax", fill.BuyTax,
+		"transaction_time", fill.TradeTime,
+	)
+	buyFill := scriptEffect(script.FillOrder,
+		[]string{buyOrderKey(fill.BuyOrderId), orderStatusKey(fill.BuyOrderId), orderTransactionsKey(fill.BuyOrderId), orderOutboxKey(fill.BuyOrderId)},
+		"buy", fill.BuyOrderId, fill.TransactionId, fill.ItemId, fill.Quantity, fill.Price, fill.BuyTax, fill.BuyerId, currencyItemId(),
+		buyOrdersKey, userOrdersKey(fill.BuyerId, "buy"), settlementPendingKey,
+	)
+	args := []interface{}{
+		"sell", fill.SellOrderId, fill.TransactionId, fill.ItemId, fill.Quantity, fill.Price, fill.SellTax, fill.SellerId, currencyItemId(),
+		sellOrdersKey, userOrdersKey(fill.SellerId, "sell"), settlementPendingKey,
+		transactionRecord, buyFill,
+	}
+	for _, job := range fill.Jobs {
+		args = append(args, settlementEffect(job))
+	}
+	filled, err := runMarkedOutbox(ctx, script.FillOrder,
+		[]string{sellOrderKey(fill.SellOrderId), orderStatusKey(fill.SellOrderId), orderTransactionsKey(fill.SellOrderId), orderOutboxKey(fill.SellOrderId)},
+		args...).Int()
+	if err != nil {
+		return err
+	}
+	if filled == 0 {
+		return nil
+	}
+
+	recordKline(ctx, fill.ItemId, fill.Price, fill.Quantity, fill.TradeTime)
+	recordStatement(ctx, fill.ItemId, fill.SellerId, fill.BuyerId, fill.Price, fill.Quantity, fill.SellTax, fill.BuyTax, fill.TradeTime)
+	detectFraud(ctx, &fraudFill{
+		TransactionId: fill.TransactionId,
+		ItemId:        fill.ItemId,
+		SellerId:      fill.SellerId,
+		BuyerId:       fill.BuyerId,
+		Price:         fill.Price,
+		Quantity:      fill.Quantity,
+		RefPrice:      fill.RefPrice,
+		Time:          fill.TradeTime,
+	})
+	matchMgr.ProcessMatchResult(ctx, fill.SellOrderId, fill.BuyOrderId)
+	return nil
+}
+
+func (s *redisMatchStore) CloseOrder(ctx context.Context, direction string, orderId string, status string, jobId string, reason string, expireTime int64) (*closedOrder, error) {
+	return closeOrder(ctx, direction, orderId, status, jobId, reason, expireTime)
+}
+
+// AppendEvents 一批事件通过一次pipeline写入，遇到失败时返回此前最后一条写入成功的事件ID
+func (s *redisMatchStore) AppendEvents(ctx context.Context, itemId string, events []*matchEvent) (string, error) {
+	maxLen := int64(configInt("auction.event_log_max_len", defaultEventLogMaxLen))
+	pipe := redis.GetRedis().Pipeline()
+	cmds := make([]*goredis.StringCmd, len(events))
+	for i, ev := range events {
+		data, _ := json.Marshal(ev)
+		cmds[i] = pipe.XAdd(ctx, &goredis.XAddArgs{
+			Stream: eventStreamKeyPrefix + itemId,
+			MaxLen: maxLen,
+			Approx: true,
+			Values: map[string]interface{}{
+				"seq":  ev.Seq,
+				"type": ev.Type,
+				"data": string(data),
+			},
+		})
+	}
+	pipe.Exec(ctx)
+
+	lastId := ""
+	for _, cmd := range cmds {
+		id, err := cmd.Result()
+		if err != nil {
+			return lastId, err
+		}
+		lastId = id
+	}
+	return lastId, nil
+}
+
+func (s *redisMatchStore) SaveSnapshot(ctx context.Context, itemId string, snapshot map[string]interface{}) error {
+	return redis.GetRedis().HSet(ctx, snapshotKeyPrefix+itemId, snapshot).Err()
+}
+
+func (s *redisMatchStore) WriteMarketEntry(ctx context.Context, itemId string, category string, oldCategory string, entry *auction.MarketItem) error {
+	return writeMarketEntry(ctx, itemId, category, oldCategory, entry)
+}
+
+func (s *redisMatchStore) MarketStats(ctx context.Context, itemId string, now int64) (int64, int64, int32, error) {
+	return marketStats(ctx, itemId, now)
+}
+
+func (s *redisMatchStore) PushMarket(ctx context.Context, ntf *auction.AuctionMarketNtf) {
+	pushMarketNtf(ctx, ntf)
+}
+
+// memoryOrder 内存实现中的订单
+type memoryOrder struct {
+	userId     string
+	itemId     string
+	quantity   int32
+	price      int64
+	feeReserve int64
+}
+
+// memoryMatchStore 内存实现，用于不依赖Redis的基准测试和压测：
+// 订单和小时均价保存在内存中，成交、结算任务和事件只计数，行情不推送
+type memoryMatchStore struct {
+	mu     sync.Mutex
+	orders map[string]*memoryOrder // direction:orderId -> 订单
+	hourly map[string]int64        // 道具ID -> 小时均价
+
+	fills  atomic.Int64 // 成交笔数
+	jobs   atomic.Int64 // 结算任务数
+	events atomic.Int64 // 事件数
+}
+
+func newMemoryMatchStore() *memoryMatchStore {
+	return &memoryMatchStore{
+		orders: make(map[string]*memoryOrder),
+		hourly: make(map[string]int64),
+	}
+}
+
+// PutOrder 下单（对应线上下单脚本写入的订单数据）
+func (s *memoryMatchStore) PutOrder(direction string, orderId string, userId string, itemId string, quantity int32, price int64, feeReserve int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.orders[direction+":"+orderId] = &memoryOrder{userId: userId, itemId: itemId, quantity: quantity, price: price, feeReserve: feeReserve}
+}
+
+// RemoveOrder 撤单（对应线上撤单脚本删除订单数据），返回订单是否存在
+func (s *memoryMatchStore) RemoveOrder(direction string, orderId string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := direction + ":" + orderId
+	_, ok := s.orders[key]
+	delete(s.orders, key)
+	return ok
+}
+
+func (s *memoryMatchStore) LoadHourlyPrice(ctx context.Context, itemId string) (int64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	price, ok := s.hourly[itemId]
+	return price, ok, nil
+}
+
+func (s *memoryMatchStore) SaveHourlyPrice(ctx context.Context, itemId string, price int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hourly[itemId] = price
+	return nil
+}
+
+func (s *memoryMatchStore) ItemHalted(ctx context.Context, itemId string) bool {
+	return false
+}
+
+func (s *memoryMatchStore) CommitFills(ctx context.Context, fills []*matchFill) []error {
+	for _, fill := range fills {
+		s.commitFill(fill)
+	}
+	return make([]error, len(fills))
+}
+
+func (s *memoryMatchStore) commitFill(fill *matchFill) {
+	s.mu.Lock()
+	for _, key := range []string{"sell:" + fill.SellOrderId, "buy:" + fill.BuyOrderId} {
+		order, ok := s.orders[key]
+		if !ok {
+			continue
+		}
+		order.quantity -= fill.Quantity
+		if order.quantity <= 0 {
+			delete(s.orders, key)
+		}
+	}
+	if order, ok := s.orders["buy:"+fill.BuyOrderId]; ok {
+		order.feeReserve -= fill.BuyTax
+	}
+	s.mu.Unlock()
+
+	s.fills.Add(1)
+	s.jobs.Add(int64(len(fill.Jobs)))
+}
+
+func (s *memoryMatchStore) CloseOrder(ctx context.Context, direction string, orderId string, status string, jobId string, reason string, expireTime int64) (*closedOrder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := direction + ":" + orderId
+	order, ok := s.orders[key]
+	if !ok {
+		return nil, nil
+	}
+	delete(s.orders, key)
+	s.jobs.Add(1)
+	return &closedOrder{userId: order.userId, itemId: order.itemId, remaining: order.quantity, price: order.price}, nil
+}
+
+func (s *memoryMatchStore) AppendEvents(ctx context.Context, itemId string, events []*matchEvent) (string, error) {
+	s.events.Add(int64(len(events)))
+	return "", nil
+}
+
+func (s *memoryMatchStore) SaveSnapshot(ctx context.Context, itemId string, snapshot map[string]interface{}) error {
+	return nil
+}
+
+func (s *memoryMatchStore) WriteMarketEntry(ctx context.Context, itemId string, category string, oldCategory string, entry *auction.MarketItem) error {
+	return nil
+}
+
+func (s *memoryMatchStore) MarketStats(ctx context.Context, itemId string, now int64) (int64, int64, int32, error) {
+	return 0, 0, 0, nil
+}
+
+func (s *memoryMatchStore) PushMarket(ctx context.Context, ntf *auction.AuctionMarketNtf) {
+}
@@ -2,14 +2,14 @@ package manager
 
 import (
 	"auction_module/kitex_gen/auction"
-	"auction_module/redis"
 	"context"
-	"fmt"
 	"sort"
+	"sync/atomic"
 	"time"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/google/btree"
+	"google.golang.org/protobuf/proto"
 )
 
 // matchUnit 撮合单元，处理特定itemId的道具撮合（私有，不对外服务）
@@ -22,35 +22,53 @@ type matchUnit struct {
 	hourlyTotalPrice int64        // 小时内总成交价格
 	hourlyTotalQty   int32        // 小时内总成交数量
 	hourlyAvgPrice   int64        // 小时内平均成交价格
+	stop             func()       // 停止撮合协程（交出归属时使用）
+	expireWheel      *timerWheel  // 订单过期时间轮
+
+	eventSeq            int64 // 最后一条事件的序号
+	eventsSinceSnapshot int   // 上次快照后追加的事件数
+
+	parties map[string]*bookParty // direction:orderId -> 订单所有者（订单簿中的挂单和正在撮合的主动方订单）
+
+	marketDirty   bool                           // 订单簿可能已变化，待计算盘口差异
+	marketSeq     int64                          // 最后一次行情推送的序号
+	pushedBook    *auction.ItemAuctionInfo       // 最后一次推送后的买5卖5
+	pendingTrades []*auction.MarketTrade         // 本推送周期内的成交
+	pushQueue     chan *auction.AuctionMarketNtf // 待推送的行情
+
+	marketEntry      *auction.MarketItem // 最后一次写入市场索引的道具概况
+	marketIndexedAt  int64               // 最后一次写入市场索引的时间
+	marketStatsDirty bool                // 有新成交，下次写入市场索引时重新统计
+
+	halted atomic.Bool // 运维暂停交易：暂停期间不撮合，拒绝新订单
+
+	store   matchStore    // 持久化（线上为Redis，基准测试和压测为内存实现）
+	settler *settleWriter // 成交落库协程
 }
 
 // newMatchUnit 创建新的撮合单元（私有方法）
-func newMatchUnit(itemId string) *matchUnit {
+func newMatchUnit(itemId string, store matchStore) *matchUnit {
 	// 计算当前小时时间戳（小时级）
 	now := time.Now()
 	currentHour := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, now.Location()).Unix()
 
-	// 初始化平均价格
-	hourlyAvgPrice := int64(100) // 默认值为1
+	// 初始化平均价格（无成交记录时使用道具规则的默认参考价）
+	defaultPrice := getItemRule(itemId).DefaultPrice
+	hourlyAvgPrice := defaultPrice
 
-	// 从Redis读取最新的按小时记录的平均成交价格
+	// 读取最新的按小时记录的平均成交价格
 	ctx := context.Background()
-	hourlyPriceKey := "auction:hourly:price:" + itemId
-	avgPriceStr, err := redis.GetRedis().Get(ctx, hourlyPriceKey).Result()
-	if err != nil {
+	if price, ok, err := store.LoadHourlyPrice(ctx, itemId); err != nil {
+		klog.Errorf("[AUCTION-MATCH-UNIT] Load hourly price error: %v, initializing to %d", err, defaultPrice)
+	} else if !ok {
 		// 如果没有找到数据，记录日志
-		klog.Infof("[AUCTION-MATCH-UNIT] No hourly price found in Redis for itemId=%s, hour=%s, initializing to 100",
-			itemId, now.Format("2006-01-02 15:00"))
+		klog.Infof("[AUCTION-MATCH-UNIT] No hourly price found for itemId=%s, hour=%s, initializing to %d",
+			itemId, now.Format("2006-01-02 15:00"), defaultPrice)
 	} else {
-		// 解析平均价格
-		_, err := fmt.Sscanf(avgPriceStr, "%d", &hourlyAvgPrice)
-		if err != nil {
-			klog.Errorf("[AUCTION-MATCH-UNIT] Parse hourly price error: %v, initializing to 100", err)
-			hourlyAvgPrice = 100
-		}
+		hourlyAvgPrice = price
 	}
 
-	return &matchUnit{
+	mu := &matchUnit{
 		itemId:           itemId,
 		sellOrders:       btree.New(32),           // 创建卖单BTree，默认度为32
 		buyOrders:        btree.New(32),           // 创建买单BTree，默认度为32
@@ -59,15 +77,50 @@ func newMatchUnit(itemId string) *matchUnit {
 		hourlyTotalPrice: hourlyAvgPrice,          // 小时内总成交价格（初始化为平均价格）
 		hourlyTotalQty:   0,                       // 小时内总成交数量（初始化为1）
 		hourlyAvgPrice:   hourlyAvgPrice,          // 小时内平均成交价格
+		expireWheel:      newTimerWheel(defaultWheelSlots, now.Unix()),
+		pushQueue:        make(chan *auction.AuctionMarketNtf, marketPushQueueSize),
+		parties:          make(map[string]*bookParty),
+		store:            store,
+		settler:          newSettleWriter(itemId, store),
+	}
+	mu.halted.Store(store.ItemHalted(ctx, itemId))
+	return mu
+}
+
+// bookParty 订单所有者和买单剩余的手续费预留，随订单保存在撮合单元内，撮合时不查询Redis
+type bookParty struct {
+	userId     string
+	feeReserve int64
+}
+
+func partyKey(direction string, orderId string) string {
+	return direction + ":" + orderId
+}
+
+// bindParty 登记订单所有者（在撮合协程内调用，订单进入撮合前登记）
+func (mu *matchUnit) bindParty(direction string, orderId string, userId string, feeReserve int64) {
+	mu.parties[partyKey(direction, orderId)] = &bookParty{userId: userId, feeReserve: feeReserve}
+}
+
+// releaseParty 订单离开订单簿（完全成交、撤销、过期）后移除所有者
+func (mu *matchUnit) releaseParty(direction string, orderId string) {
+	delete(mu.parties, partyKey(direction, orderId))
+}
+
+// party 返回订单所有者，未登记时返回空的所有者
+func (mu *matchUnit) party(direction string, orderId string) *bookParty {
+	if p, ok := mu.parties[partyKey(direction, orderId)]; ok {
+		return p
 	}
+	return &bookParty{}
 }
 
 // SellOrderByPriceAsc 卖单按价格升序排序
 type SellOrderByPriceAsc auction.SellData
 
 // Less 实现btree.Item接口，按价格升序，价格相同按创建时间升序
-func (s SellOrderByPriceAsc) Less(than btree.Item) bool {
-	other := than.(SellOrderByPriceAsc)
+func (s *SellOrderByPriceAsc) Less(than btree.Item) bool {
+	other := than.(*SellOrderByPriceAsc)
 	if s.Price != other.Price {
 		return s.Price < other.Price
 	}
@@ -89,21 +142,37 @@ func (mu *matchUnit) AddSellOrder(ctx context.Context, order *auction.SellData)
 
 	// 如果订单未完全成交，将剩余数量添加到卖单BTree
 	if order.Quantity > 0 {
-		sellOrder := SellOrderByPriceAsc(*order)
-		mu.sellOrders.ReplaceOrInsert(sellOrder)
+		mu.sellOrders.ReplaceOrInsert((*SellOrderByPriceAsc)(proto.Clone(order).(*auction.SellData)))
+		mu.expireWheel.Add(order.OrderId, "sell", order.ExpireTime)
+		mu.appendEvent(ctx, &matchEvent{Type: eventAdd, Direction: "sell", Order: mu.withParty("sell", sellBookOrder(order))})
 		klog.CtxInfof(ctx, "[AUCTION-MATCH-UNIT] Add sell order: orderId=%s, itemId=%s, quantity=%d, price=%d",
 			order.OrderId, order.ItemId, order.Quantity, order.Price)
+	} else {
+		mu.releaseParty("sell", order.OrderId)
 	}
 }
 
 // matchSellOrder 撮合卖单（主动性卖单按买方报价成交）
 func (mu *matchUnit) matchSellOrder(ctx context.Context, sellOrder *auction.SellData) {
+	if mu.halted.Load() {
+		return
+	}
+	// 开启自成交拦截时跳过同一用户的买单
+	takerId := ""
+	if blockSelfMatch() {
+		takerId = mu.party("sell", sellOrder.OrderId).userId
+	}
 	// 遍历买单BTree，按价格降序（从高到低）
 	mu.buyOrders.Ascend(func(item btree.Item) bool {
-		buyOrder := item.(BuyOrderByPriceDesc)
+		buyOrder := item.(*BuyOrderByPriceDesc)
 
 		// 如果卖单价格 <= 买单价格，且卖单数量 > 0
 		if sellOrder.Price <= buyOrder.Price && sellOrder.Quantity > 0 {
+			if takerId != "" && mu.party("buy", buyOrder.OrderId).userId == takerId {
+				klog.CtxInfof(ctx, "[AUCTION-MATCH] Skip self match: sellOrder=%s, buyOrder=%s, userId=%s",
+					sellOrder.OrderId, buyOrder.OrderId, takerId)
+				return true
+			}
 			// 计算成交数量（取两者较小值）
 			quantity := sellOrder.Quantity
 			if buyOrder.Quantity < quantity {
@@ -115,7 +184,16 @@ func (mu *matchUnit) matchSellOrder(ctx context.Context, sellOrder *auction.Sell
 				sellOrder.OrderId, buyOrder.OrderId, buyOrder.Price, quantity)
 
 			// 调用matchResult处理成交结果
-			mu.matchResult(ctx, buyOrder.Price, quantity, sellOrder, (*auction.BuyData)(&buyOrder))
+			mu.matchResult(ctx, buyOrder.Price, quantity, sellOrder, (*auction.BuyData)(buyOrder))
+			mu.appendEvent(ctx, mu.hourlyEvent(&matchEvent{
+				Type:         eventFill,
+				Direction:    "buy",
+				OrderId:      buyOrder.OrderId,
+				TakerOrderId: sellOrder.OrderId,
+				Quantity:     quantity,
+				Price:        buyOrder.Price,
+				FeeReserve:   mu.party("buy", buyOrder.OrderId).feeReserve,
+			}))
 
 			// 更新订单数量
 			sellOrder.Quantity -= quantity
@@ -123,14 +201,13 @@ func (mu *matchUnit) matchSellOrder(ctx context.Context, sellOrder *auction.Sell
 			// 如果买单完全成交，从BTree中移除
 			if buyOrder.Quantity <= quantity {
 				mu.buyOrders.Delete(item)
+				mu.expireWheel.Remove(buyOrder.OrderId)
+				mu.releaseParty("buy", buyOrder.OrderId)
 				return sellOrder.Quantity > 0 // 如果卖单还有剩余，继续撮合
 			}
 
-			// 更新买单剩余数量
-			updateOrder := buyOrder
-			updateOrder.Quantity -= quantity
-			mu.buyOrders.Delete(item)
-			mu.buyOrders.ReplaceOrInsert(updateOrder)
+			// 更新买单剩余数量，数量不参与排序，直接修改订单簿中的订单
+			buyOrder.Quantity -= quantity
 		}
 
 		// 如果卖单已完成或买单价格低于卖单价格，停止撮合
@@ -142,8 +219,8 @@ func (mu *matchUnit) matchSellOrder(ctx context.Context, sellOrder *auction.Sell
 type BuyOrderByPriceDesc auction.BuyData
 
 // Less 实现btree.Item接口，按价格降序，价格相同按创建时间升序
-func (b BuyOrderByPriceDesc) Less(than btree.Item) bool {
-	other := than.(BuyOrderByPriceDesc)
+func (b *BuyOrderByPriceDesc) Less(than btree.Item) bool {
+	other := than.(*BuyOrderByPriceDesc)
 	if b.Price != other.Price {
 		return b.Price > other.Price
 	}
@@ -165,21 +242,37 @@ func (mu *matchUnit) AddBuyOrder(ctx context.Context, order *auction.BuyData) {
 
 	// 如果订单未完全成交，将剩余数量添加到买单BTree
 	if order.Quantity > 0 {
-		buyOrder := BuyOrderByPriceDesc(*order)
-		mu.buyOrders.ReplaceOrInsert(buyOrder)
+		mu.buyOrders.ReplaceOrInsert((*BuyOrderByPriceDesc)(proto.Clone(order).(*auction.BuyData)))
+		mu.expireWheel.Add(order.OrderId, "buy", order.ExpireTime)
+		mu.appendEvent(ctx, &matchEvent{Type: eventAdd, Direction: "buy", Order: mu.withParty("buy", buyBookOrder(order))})
 		klog.CtxInfof(ctx, "[AUCTION-MATCH-UNIT] Add buy order: orderId=%s, itemId=%s, quantity=%d, price=%d",
 			order.OrderId, order.ItemId, order.Quantity, order.Price)
+	} else {
+		mu.releaseParty("buy", order.OrderId)
 	}
 }
 
 // matchBuyOrder 撮合买单（主动性买单按卖方报价成交）
 func (mu *matchUnit) matchBuyOrder(ctx context.Context, buyOrder *auction.BuyData) {
+	if mu.halted.Load() {
+		return
+	}
+	// 开启自成交拦截时跳过同一用户的卖单
+	takerId := ""
+	if blockSelfMatch() {
+		takerId = mu.party("buy", buyOrder.OrderId).userId
+	}
 	// 遍历卖单BTree，按价格升序（从低到高）
 	mu.sellOrders.Ascend(func(item btree.Item) bool {
-		sellOrder := item.(SellOrderByPriceAsc)
+		sellOrder := item.(*SellOrderByPriceAsc)
 
 		// 如果买单价格 >= 卖单价格，且买单数量 > 0
 		if buyOrder.Price >= sellOrder.Price && buyOrder.Quantity > 0 {
+			if takerId != "" && mu.party("sell", sellOrder.OrderId).userId == takerId {
+				klog.CtxInfof(ctx, "[AUCTION-MATCH] Skip self match: buyOrder=%s, sellOrder=%s, userId=%s",
+					buyOrder.OrderId, sellOrder.OrderId, takerId)
+				return true
+			}
 			// 计算成交数量（取两者较小值）
 			quantity := buyOrder.Quantity
 			if sellOrder.Quantity < quantity {
@@ -191,7 +284,15 @@ func (mu *matchUnit) matchBuyOrder(ctx context.Context, buyOrder *auction.BuyDat
 				buyOrder.OrderId, sellOrder.OrderId, sellOrder.Price, quantity)
 
 			// 调用matchResult处理成交结果
-			mu.matchResult(ctx, sellOrder.Price, quantity, (*auction.SellData)(&sellOrder), buyOrder)
+			mu.matchResult(ctx, sellOrder.Price, quantity, (*auction.SellData)(sellOrder), buyOrder)
+			mu.appendEvent(ctx, mu.hourlyEvent(&matchEvent{
+				Type:         eventFill,
+				Direction:    "sell",
+				OrderId:      sellOrder.OrderId,
+				TakerOrderId: buyOrder.OrderId,
+				Quantity:     quantity,
+				Price:        sellOrder.Price,
+			}))
 
 			// 更新订单数量
 			buyOrder.Quantity -= quantity
@@ -199,14 +300,13 @@ func (mu *matchUnit) matchBuyOrder(ctx context.Context, buyOrder *auction.BuyDat
 			// 如果卖单完全成交，从BTree中移除
 			if sellOrder.Quantity <= quantity {
 				mu.sellOrders.Delete(item)
+				mu.expireWheel.Remove(sellOrder.OrderId)
+				mu.releaseParty("sell", sellOrder.OrderId)
 				return buyOrder.Quantity > 0 // 如果买单还有剩余，继续撮合
 			}
 
-			// 更新卖单剩余数量
-			updateOrder := sellOrder
-			updateOrder.Quantity -= quantity
-			mu.sellOrders.Delete(item)
-			mu.sellOrders.ReplaceOrInsert(updateOrder)
+			// 更新卖单剩余数量，数量不参与排序，直接修改订单簿中的订单
+			sellOrder.Quantity -= quantity
 		}
 
 		// 如果买单已完成或卖单价格高于买单价格，停止撮合
@@ -216,11 +316,17 @@ func (mu *matchUnit) matchBuyOrder(ctx context.Context, buyOrder *auction.BuyDat
 
 // RemoveSellOrder 移除卖单
 func (mu *matchUnit) RemoveSellOrder(ctx context.Context, orderId string) bool {
+	return mu.removeSellOrder(ctx, orderId, eventCancel)
+}
+
+// removeSellOrder 移除卖单并记录事件（取消或过期）
+func (mu *matchUnit) removeSellOrder(ctx context.Context, orderId string, eventType string) bool {
 	found := false
 	mu.sellOrders.Ascend(func(item btree.Item) bool {
-		order := item.(SellOrderByPriceAsc)
+		order := item.(*SellOrderByPriceAsc)
 		if order.OrderId == orderId {
 			mu.sellOrders.Delete(item)
+			mu.expireWheel.Remove(orderId)
 			found = true
 			klog.CtxInfof(ctx, "[AUCTION-MATCH-UNIT] Remove sell order: orderId=%s, itemId=%s",
 				orderId, order.ItemId)
@@ -228,16 +334,26 @@ func (mu *matchUnit) RemoveSellOrder(ctx context.Context, orderId string) bool {
 		}
 		return true
 	})
+	if found {
+		mu.releaseParty("sell", orderId)
+		mu.appendEvent(ctx, &matchEvent{Type: eventType, Direction: "sell", OrderId: orderId})
+	}
 	return found
 }
 
 // RemoveBuyOrder 移除买单
 func (mu *matchUnit) RemoveBuyOrder(ctx context.Context, orderId string) bool {
+	return mu.removeBuyOrder(ctx, orderId, eventCancel)
+}
+
+// removeBuyOrder 移除买单并记录事件（取消或过期）
+func (mu *matchUnit) removeBuyOrder(ctx context.Context, orderId string, eventType string) bool {
 	found := false
 	mu.buyOrders.Ascend(func(item btree.Item) bool {
-		order := item.(BuyOrderByPriceDesc)
+		order := item.(*BuyOrderByPriceDesc)
 		if order.OrderId == orderId {
 			mu.buyOrders.Delete(item)
+			mu.expireWheel.Remove(orderId)
 			found = true
 			klog.CtxInfof(ctx, "[AUCTION-MATCH-UNIT] Remove buy order: orderId=%s, itemId=%s",
 				orderId, order.ItemId)
@@ -245,6 +361,10 @@ func (mu *matchUnit) RemoveBuyOrder(ctx context.Context, orderId string) bool {
 		}
 		return true
 	})
+	if found {
+		mu.releaseParty("buy", orderId)
+		mu.appendEvent(ctx, &matchEvent{Type: eventType, Direction: "buy", OrderId: orderId})
+	}
 	return found
 }
 
@@ -267,200 +387,76 @@ func (mu *matchUnit) matchResult(ctx context.Context, price int64, quantity int3
 	// 生成交易ID（使用雪花算法）
 	transactionId := idClient.Generate().String()
 
-	// 计算税费（假设为交易金额的1%）
-	tax := (price * int64(quantity)) / 100
-
-	// 计算各种key
-	transactionKey := "auction:transaction:" + transactionId
-	sellOrderTransactionsKey := "auction:order:" + sellData.OrderId + ":transactions"
-	buyOrderTransactionsKey := "auction:order:" + buyData.OrderId + ":transactions"
-	sellOrderKey := "auction:sell:" + sellData.OrderId
-	buyOrderKey := "auction:buy:" + buyData.OrderId
-	sellStatusKey := "auction:order:" + sellData.OrderId + ":status"
-	buyStatusKey := "auction:order:" + buyData.OrderId + ":status"
-
-	// 获取卖家和买家ID
-	sellerId, _ := redis.GetRedis().HGet(ctx, sellOrderKey, "user_id").Result()
-	buyerId, _ := redis.GetRedis().HGet(ctx, buyOrderKey, "user_id").Result()
-
-	// 执行优化的Lua脚本
-	luaScript := `
-		-- 优化的交易处理脚本
-		local transactionId = ARGV[1]
-		local sellOrderId = ARGV[2]
-		local buyOrderId = ARGV[3]
-		local itemId = ARGV[4]
-		local itemInfo = ARGV[5]
-		local quantity = tonumber(ARGV[6])
-		local price = tonumber(ARGV[7])
-		local tax = tonumber(ARGV[8])
-		local transactionTime = tonumber(ARGV[9])
-		local transactionKey = ARGV[10]
-		local sellOrderTransactionsKey = ARGV[11]
-		local buyOrderTransactionsKey = ARGV[12]
-		local sellerId = ARGV[13]
-		local buyerId = ARGV[14]
-		local sellOrderKey = ARGV[15]
-		local buyOrderKey = ARGV[16]
-		local sellStatusKey = ARGV[17]
-		local buyStatusKey = ARGV[18]
-
-		-- 1. 存储交易记录
-		redis.call('HMSET', transactionKey,
-			'transaction_id', transactionId,
-			'buy_order_id', buyOrderId,
-			'sell_order_id', sellOrderId,
-			'item_id', itemId,
-			'item_info', itemInfo,
-			'quantity', quantity,
-			'price', price,
-			'tax', tax,
-			'transaction_time', transactionTime
-		)
-
-		-- 2. 关联交易ID与订单ID
-		redis.call('SADD', sellOrderTransactionsKey, transactionId)
-		redis.call('SADD', buyOrderTransactionsKey, transactionId)
-
-		-- 3. 获取卖单当前状态信息
-		local sellCreateTime = redis.call('HGET', sellOrderKey, 'create_time')
-		local currentSellFinalPrice = tonumber(redis.call('HGET', sellStatusKey, 'final_price') or '0')
-		local currentSellFinalQuantity = tonumber(redis.call('HGET', sellStatusKey, 'final_quantity') or '0')
-		local currentSellTax = tonumber(redis.call('HGET', sellStatusKey, 'tax') or '0')
-
-		-- 4. 获取买单当前状态信息
-		local buyCreateTime = redis.call('HGET', buyOrderKey, 'create_time')
-		local currentBuyFinalPrice = tonumber(redis.call('HGET', buyStatusKey, 'final_price') or '0')
-		local currentBuyFinalQuantity = tonumber(redis.call('HGET', buyStatusKey, 'final_quantity') or '0')
-
-		-- 5. 计算累加后的数值
-		local newSellFinalPrice = currentSellFinalPrice + (price * quantity)
-		local newSellFinalQuantity = currentSellFinalQuantity + quantity
-		local newSellTax = currentSellTax + tax
-		local newBuyFinalPrice = currentBuyFinalPrice + (price * quantity)
-		local newBuyFinalQuantity = currentBuyFinalQuantity + quantity
-
-		-- 6. 更新卖单剩余数量
-		local sellQuantity = tonumber(redis.call('HGET', sellOrderKey, 'quantity'))
-		local newSellQuantity = sellQuantity - quantity
-
-		-- 7. 更新买单剩余数量
-		local buyQuantity = tonumber(redis.call('HGET', buyOrderKey, 'quantity'))
-		local newBuyQuantity = buyQuantity - quantity
-
-		-- 8. 更新卖单状态
-		if newSellQuantity <= 0 then
-			-- 卖单已完成，更新状态为交易完成
-			redis.call('HMSET', sellStatusKey, 
-				'order_id', sellOrderId,
-				'trade_direction', 'sell',
-				'status', '交易完成',
-				'final_price', newSellFinalPrice,
-				'final_quantity', newSellFinalQuantity,
-				'item_id', itemId,
-				'tax', newSellTax,
-				'create_time', sellCreateTime,
-				'user_id', sellerId
-			)
-			-- 删除卖单
-			redis.call('DEL', sellOrderKey)
-			-- 从用户出售列表中移除
-			if sellerId and sellerId ~= '' then
-				local sellerSellsKey = 'user:' .. sellerId .. ':sells'
-				redis.call('SREM', sellerSellsKey, sellOrderKey)
-			end
-			-- 从全局出售列表中移除
-			redis.call('SREM', 'auction:sells', sellOrderKey)
-		else
-			-- 卖单未完成，更新剩余数量
-			redis.call('HSET', sellOrderKey, 'quantity', newSellQuantity)
-			-- 更新卖单状态（保持原状态，只更新累计数值）
-			redis.call('HMSET', sellStatusKey, 
-				'order_id', sellOrderId,
-				'trade_direction', 'sell',
-				'status', '卖',
-				'final_price', newSellFinalPrice,
-				'final_quantity', newSellFinalQuantity,
-				'item_id', itemId,
-				'tax', newSellTax,
-				'create_time', sellCreateTime,
-				'user_id', sellerId
-			)
-		end
-
-		-- 9. 更新买单状态
-		if newBuyQuantity <= 0 then
-			-- 买单已完成，更新状态为交易完成
-			redis.call('HMSET', buyStatusKey, 
-				'order_id', buyOrderId,
-				'trade_direction', 'buy',
-				'status', '交易完成',
-				'final_price', newBuyFinalPrice,
-				'final_quantity', newBuyFinalQuantity,
-				'item_id', itemId,
-				'tax', 0,
-				'create_time', buyCreateTime,
-				'user_id', buyerId
-			)
-			-- 删除买单
-			redis.call('DEL', buyOrderKey)
-			-- 从用户求购列表中移除
-			if buyerId and buyerId ~= '' then
-				local buyerBuysKey = 'user:' .. buyerId .. ':buys'
-				redis.call('SREM', buyerBuysKey, buyOrderKey)
-			end
-			-- 从全局求购列表中移除
-			redis.call('SREM', 'auction:buys', buyOrderKey)
-		else
-			-- 买单未完成，更新剩余数量
-			redis.call('HSET', buyOrderKey, 'quantity', newBuyQuantity)
-			-- 更新买单状态（保持原状态，只更新累计数值）
-			redis.call('HMSET', buyStatusKey, 
-				'order_id', buyOrderId,
-				'trade_direction', 'buy',
-				'status', '买',
-				'final_price', newBuyFinalPrice,
-				'final_quantity', newBuyFinalQuantity,
-				'item_id', itemId,
-				'tax', 0,
-				'create_time', buyCreateTime,
-				'user_id', buyerId
-			)
-		end
-
-		return {success = true}
-	`
-
-	// 执行Lua脚本
-	_, err := redis.GetRedis().Eval(ctx, luaScript, []string{},
-		transactionId,
-		sellData.OrderId,
-		buyData.OrderId,
-		sellData.ItemId,
-		sellData.ItemInfo,
-		quantity,
-		price,
-		tax,
-		time.Now().Unix(),
-		transactionKey,
-		sellOrderTransactionsKey,
-		buyOrderTransactionsKey,
-		sellerId,
-		buyerId,
-		sellOrderKey,
-		buyOrderKey,
-		sellStatusKey,
-		buyStatusKey,
-	).Result()
-
-	if err != nil {
-		klog.CtxErrorf(ctx, "[AUCTION-MATCH-UNIT] Execute transaction script error: %v", err)
-		return
+	// 按道具交易规则计算手续费
+	rule := getItemRule(sellData.ItemId)
+	tax := rule.fee(price * int64(quantity))
+
+	// 卖家和买家取自订单簿内存中登记的所有者
+	seller, buyer := mu.party("sell", sellData.OrderId), mu.party("buy", buyData.OrderId)
+	sellerId, buyerId := seller.userId, buyer.userId
+
+	// 手续费由买家支付时从买单的手续费预留中扣除，预留不足（下单后规则变更）的部分仍由卖家支付
+	sellTax, buyTax := tax, int64(0)
+	if rule.FeePayer == feePayerBuyer {
+		buyTax = min(tax, buyer.feeReserve)
+		sellTax = tax - buyTax
+		buyer.feeReserve -= buyTax
+	}
+
+	// 构造结算任务：买家收货、卖家收款（扣除税费）、买家退还报价与成交价的差额
+	settlementJobs := make([]*settlementJob, 0, 3)
+	if sellerId == "" || buyerId == "" {
+		klog.CtxErrorf(ctx, "[AUCTION-MATCH-UNIT] Missing user id, settlement skipped: transactionId=%s, sellerId=%s, buyerId=%s",
+			transactionId, sellerId, buyerId)
+	} else {
+		amount := price * int64(quantity)
+		settlementJobs = append(settlementJobs, &settlementJob{
+			Id:     "auction:settle:" + transactionId + ":buyer",
+			UserId: buyerId,
+			ItemId: sellData.ItemId,
+			Count:  int64(quantity),
+			Reason: "auction_buy",
+		})
+		if amount-sellTax > 0 {
+			settlementJobs = append(settlementJobs, &settlementJob{
+				Id:     "auction:settle:" + transactionId + ":seller",
+				UserId: sellerId,
+				ItemId: currencyItemId(),
+				Count:  amount - sellTax,
+				Reason: "auction_sell",
+			})
+		}
+		if refund := (buyData.Price - price) * int64(quantity); refund > 0 {
+			settlementJobs = append(settlementJobs, &settlementJob{
+				Id:     "auction:settle:" + transactionId + ":refund",
+				UserId: buyerId,
+				ItemId: currencyItemId(),
+				Count:  refund,
+				Reason: "auction_buy_refund",
+			})
+		}
 	}
 
-	// 记录数据变更日志
-	klog.CtxInfof(ctx, "[AUCTION-MATCH-UNIT] Transaction completed: transactionId=%s, buyOrder=%s, sellOrder=%s, quantity=%d",
-		transactionId, buyData.OrderId, sellData.OrderId, quantity)
+	// 成交交给落库协程异步写入（成交记录、双方订单、结算任务），撮合协程不等待Redis
+	tradeTime := time.Now().Unix()
+	mu.settler.submit(&matchFill{
+		TransactionId: transactionId,
+		ItemId:        sellData.ItemId,
+		ItemInfo:      sellData.ItemInfo,
+		SellOrderId:   sellData.OrderId,
+		BuyOrderId:    buyData.OrderId,
+		SellerId:      sellerId,
+		BuyerId:       buyerId,
+		Price:         price,
+		Quantity:      quantity,
+		Tax:           tax,
+		SellTax:       sellTax,
+		BuyTax:        buyTax,
+		RefPrice:      mu.hourlyAvgPrice,
+		TradeTime:     tradeTime,
+		Jobs:          settlementJobs,
+	})
+	mu.recordMarketTrade(price, quantity, tradeTime)
 }
 
 // validateTransaction 验证交易合法性
@@ -501,20 +497,18 @@ func (mu *matchUnit) processHourlyData(price int64, quantity int32) {
 	mu.hourlyTotalQty += quantity
 }
 
-// saveHourlyData 保存小时数据到Redis
-func (mu *matchUnit) saveHourlyData() {
-	// 构造Redis键（固定格式）
-	hourlyPriceKey := "auction:hourly:price:" + mu.itemId
-
-	// 计算平均价格
-	avgPrice := max(mu.hourlyAvgPrice, 100)
+// saveHourlyData 保存小时数据
+func (mu *matchUnit) saveHourlyData(ctx context.Context) {
+	// 计算平均价格（不低于道具规则的默认参考价）
+	floorPrice := getItemRule(mu.itemId).DefaultPrice
+	avgPrice := max(mu.hourlyAvgPrice, floorPrice)
 
-	// 如果当前小时成交量为0，平均价格下降10%，直到价格为100
-	if mu.hourlyTotalQty == 0 && avgPrice > 100 {
+	// 如果当前小时成交量为0，平均价格下降10%，直到价格为默认参考价
+	if mu.hourlyTotalQty == 0 && avgPrice > floorPrice {
 		avgPrice = int64(float64(avgPrice) * 0.9)
-		// 确保价格不低于1
-		if avgPrice < 100 {
-			avgPrice = 100
+		// 确保价格不低于默认参考价
+		if avgPrice < floorPrice {
+			avgPrice = floorPrice
 		}
 		// 更新结构体中的平均价格
 		mu.hourlyAvgPrice = avgPrice
@@ -525,14 +519,12 @@ func (mu *matchUnit) saveHourlyData() {
 		avgPrice = mu.hourlyTotalPrice / int64(mu.hourlyTotalQty)
 		mu.hourlyAvgPrice = avgPrice
 	}
-	avgPrice = max(mu.hourlyAvgPrice, 100)
+	avgPrice = max(mu.hourlyAvgPrice, floorPrice)
 
-	// 写入Redis
-	err := redis.GetRedis().Set(getMatchManager().ctx, hourlyPriceKey, avgPrice, 24*time.Hour*7).Err()
-	if err != nil {
-		klog.Errorf("[AUCTION-MATCH-UNIT] Save hourly price to Redis error: %v", err)
+	if err := mu.store.SaveHourlyPrice(ctx, mu.itemId, avgPrice); err != nil {
+		klog.Errorf("[AUCTION-MATCH-UNIT] Save hourly price error: %v", err)
 	} else {
-		klog.Infof("[AUCTION-MATCH-UNIT] Save hourly price to Redis: itemId=%s, avgPrice=%d",
+		klog.Infof("[AUCTION-MATCH-UNIT] Save hourly price: itemId=%s, avgPrice=%d",
 			mu.itemId, avgPrice)
 	}
 }
@@ -550,7 +542,7 @@ func (mu *matchUnit) getAuctionInfo(ctx context.Context) *auction.ItemAuctionInf
 	// 聚合卖单（相同价格的订单聚合，遍历到足够的价格数量）
 	sellPriceMap := make(map[int64]int32)
 	mu.sellOrders.Ascend(func(item btree.Item) bool {
-		sellOrder := item.(SellOrderByPriceAsc)
+		sellOrder := item.(*SellOrderByPriceAsc)
 		sellPriceMap[sellOrder.Price] += sellOrder.Quantity
 		// 当收集到6个不同的价格时，停止遍历
 		// 这样可以确保所有相同价格的订单都被处理
@@ -585,7 +577,7 @@ func (mu *matchUnit) getAuctionInfo(ctx context.Context) *auction.ItemAuctionInf
 	// 聚合买单（相同价格的订单聚合，遍历到足够的价格数量）
 	buyPriceMap := make(map[int64]int32)
 	mu.buyOrders.Ascend(func(item btree.Item) bool {
-		buyOrder := item.(BuyOrderByPriceDesc)
+		buyOrder := item.(*BuyOrderByPriceDesc)
 		buyPriceMap[buyOrder.Price] += buyOrder.Quantity
 		// 当收集到6个不同的价格时，停止遍历
 		// 这样可以确保所有相同价格的订单都被处理
@@ -633,6 +625,12 @@ func (mu *matchUnit) startMatchProcess(ctx context.Context) {
 		// 等待到下一个小时
 		timer := time.NewTimer(duration)
 		needReset := true
+		// 每秒推进一次订单过期时间轮
+		expireTicker := time.NewTicker(time.Second)
+		defer expireTicker.Stop()
+		// 按推送周期合并盘口变化和成交推送给订阅者
+		pushTicker := time.NewTicker(time.Duration(configInt("auction.market_push_interval_ms", defaultMarketPushInterval)) * time.Millisecond)
+		defer pushTicker.Stop()
 		for {
 			// 计算下一个小时的开始时间
 			select {
@@ -641,9 +639,15 @@ func (mu *matchUnit) startMatchProcess(ctx context.Context) {
 				return
 			case op := <-mu.opChannel:
 				op()
+				mu.markMarketDirty()
+			case <-expireTicker.C:
+				mu.expireOrders(ctx, time.Now().Unix())
+			case <-pushTicker.C:
+				mu.publishMarket(ctx)
+				mu.refreshMarketIndex(ctx, time.Now().Unix())
 			case <-timer.C:
 				// 保存当前小时的数据
-				mu.saveHourlyData()
+				mu.saveHourlyData(ctx)
 
 				if needReset {
 					needReset = false
@@ -655,9 +659,12 @@ func (mu *matchUnit) startMatchProcess(ctx context.Context) {
 				mu.hourlyTotalPrice = 0
 				mu.hourlyTotalQty = 0
 				mu.hourlyAvgPrice = 0
+				mu.appendEvent(ctx, mu.hourlyEvent(&matchEvent{Type: eventHourlyRoll}))
 			}
 		}
 	}(ctx)
+	go mu.runMarketPush(ctx)
+	go mu.settler.run(ctx)
 
 	klog.CtxInfof(ctx, "[AUCTION-MATCH-UNIT] Match process started for item: %s", mu.itemId)
 }
@@ -0,0 +1,36 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 测试用例: 成交双方和手续费预留取自撮合单元内登记的订单所有者，自成交拦截不查询订单数据
+func TestMatchUnit_FillParties(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryMatchStore()
+	mu := newMatchUnit("test_item_parties", store)
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go mu.settler.run(runCtx)
+
+	mu.bindParty("buy", "b1", "buyer", 30)
+	mu.AddBuyOrder(ctx, &auction.BuyData{OrderId: "b1", ItemId: mu.itemId, Quantity: 3, Price: 1000})
+	mu.bindParty("sell", "s1", "seller", 0)
+	mu.AddSellOrder(ctx, &auction.SellData{OrderId: "s1", ItemId: mu.itemId, Quantity: 1, Price: 1000})
+	mu.settler.flush()
+
+	// 卖单完全成交后移除登记，买单仍在订单簿中
+	_, sellBound := mu.parties[partyKey("sell", "s1")]
+	assert.False(t, sellBound)
+	assert.Equal(t, "buyer", mu.party("buy", "b1").userId)
+	assert.Equal(t, int64(1), store.fills.Load())
+	assert.Equal(t, int64(2), store.jobs.Load()) // 买家收货、卖家收款
+
+	// 撤单后移除登记
+	assert.True(t, mu.RemoveBuyOrder(ctx, "b1"))
+	assert.Empty(t, mu.parties)
+}
@@ -0,0 +1,472 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"auction_module/redis/script"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/google/btree"
+)
+
+// amendedOrder 修改后的订单信息
+type amendedOrder struct {
+	orderId    string
+	itemId     string
+	itemInfo   string
+	quantity   int32
+	price      int64
+	createTime int64
+	expireTime int64
+	orderType  auction.OrderType
+}
+
+// toMap 转换为幂等结果中保存的订单字段
+func (o *amendedOrder) toMap(data map[string]interface{}) {
+	data["order_id"] = o.orderId
+	data["item_id"] = o.itemId
+	data["item_info"] = o.itemInfo
+	data["quantity"] = o.quantity
+	data["price"] = o.price
+	data["create_time"] = o.createTime
+	data["expire_time"] = o.expireTime
+	data["order_type"] = int(o.orderType)
+}
+
+// amendedOrderFromMap 从幂等结果中解析订单字段
+func amendedOrderFromMap(resultMap map[string]interface{}) *amendedOrder {
+	return &amendedOrder{
+		orderId:    fmt.Sprintf("%v", resultMap["order_id"]),
+		itemId:     fmt.Sprintf("%v", resultMap["item_id"]),
+		itemInfo:   fmt.Sprintf("%v", resultMap["item_info"]),
+		quantity:   int32(parseInt(fmt.Sprintf("%v", resultMap["quantity"]))),
+		price:      parseInt64(fmt.Sprintf("%v", resultMap["price"])),
+		createTime: parseInt64(fmt.Sprintf("%v", resultMap["create_time"])),
+		expireTime: parseInt64(fmt.Sprintf("%v", resultMap["expire_time"])),
+		orderType:  auction.OrderType(parseInt(fmt.Sprintf("%v", resultMap["order_type"]))),
+	}
+}
+
+// amendRedisOrder 原子性地修改Redis中的订单（在撮合协程内调用）：
+// 订单数量与订单簿不一致（已成交或已关闭）时放弃修改并退还追加的托管，返回false
+func amendRedisOrder(ctx context.Context, direction string, orderId string, bookQty int32, newQty int32, newPrice int64,
+	newReserve int64, topup int64, requeueTime int64, jobId string) (bool, error) {
+	// 使用Lua脚本原子性地：
+	// 1. 校验订单仍存在且剩余数量与订单簿一致
+	// 2. 卖单退还减少的托管道具；买单按新报价重新计算托管，多退少补（追加部分已在调用前扣除）
+	// 3. 更新订单数据和订单状态，修改价格时以requeueTime作为新的排队时间
+
+	ok, err := runWithOutbox(ctx, script.AmendOrder, []string{orderKey(direction, orderId), orderStatusKey(orderId), orderOutboxKey(orderId)},
+		direction,
+		orderId,
+		bookQty,
+		newQty,
+		newPrice,
+		newReserve,
+		topup,
+		requeueTime,
+		jobId,
+		currencyItemId(),
+	).Int()
+	if err != nil {
+		return false, err
+	}
+	matchMgr.wakeSettlement()
+	return ok == 1, nil
+}
+
+// queueTailTime 计算重新排队的时间，保证订单排在新价位所有挂单之后
+// 订单簿按时间（秒）和订单ID排序，同一秒内订单ID较小的旧订单需要顺延1秒才能排到队尾
+func (mu *matchUnit) queueTailTime(direction string, price int64, orderId string, now int64) int64 {
+	var tailTime int64
+	var tailId string
+	if direction == "sell" {
+		mu.sellOrders.AscendGreaterOrEqual(&SellOrderByPriceAsc{Price: price}, func(item btree.Item) bool {
+			order := item.(*SellOrderByPriceAsc)
+			if order.Price != price {
+				return false
+			}
+			tailTime, tailId = order.CreateTime, order.OrderId
+			return true
+		})
+	} else {
+		mu.buyOrders.AscendGreaterOrEqual(&BuyOrderByPriceDesc{Price: price}, func(item btree.Item) bool {
+			order := item.(*BuyOrderByPriceDesc)
+			if order.Price != price {
+				return false
+			}
+			tailTime, tailId = order.CreateTime, order.OrderId
+			return true
+		})
+	}
+	if tailId != "" && orderId < tailId {
+		tailTime++
+	}
+	return max(now, tailTime)
+}
+
+// amendBookOrder 修改订单簿中的订单（在撮合协程内调用）
+// 只减少数量时原地修改保留排队顺序；修改价格时移出订单簿，以新价格作为主动方重新撮合，剩余部分重新排队
+func (mu *matchUnit) amendBookOrder(ctx context.Context, direction string, orderId string, quantity int32, price int64,
+	reserveFor func(quantity int32) int64, topup int64, jobId string) (*amendedOrder, common.ErrorCode, error) {
+	// 修改脚本按订单簿中的数量校验Redis订单，先等待已撮合的成交落库
+	mu.settler.flush()
+
+	var order *amendedOrder
+	if direction == "sell" {
+		if item := mu.findSellOrder(orderId); item != nil {
+			order = &amendedOrder{orderId: orderId, itemId: item.ItemId, itemInfo: item.ItemInfo, quantity: item.Quantity,
+				price: item.Price, createTime: item.CreateTime, expireTime: item.ExpireTime, orderType: item.OrderType}
+		}
+	} else {
+		if item := mu.findBuyOrder(orderId); item != nil {
+			order = &amendedOrder{orderId: orderId, itemId: item.ItemId, quantity: item.Quantity,
+				price: item.Price, createTime: item.CreateTime, expireTime: item.ExpireTime, orderType: item.OrderType}
+		}
+	}
+
+	// 订单已不在订单簿中（已成交、撤销或过期），bookQty为-1时Lua脚本只退还追加的托管
+	bookQty := int32(-1)
+	if order != nil {
+		bookQty = order.quantity
+	}
+	newQty := min(quantity, max(bookQty, 0))
+	requeue := order != nil && price != order.price
+	var requeueTime int64
+	if requeue {
+		requeueTime = mu.queueTailTime(direction, price, orderId, time.Now().Unix())
+	}
+
+	amended, err := amendRedisOrder(ctx, direction, orderId, bookQty, newQty, price, reserveFor(newQty), topup, requeueTime, jobId)
+	if err != nil {
+		return nil, common.ErrorCode_AUCTION_REDIS_ERROR, err
+	}
+	if !amended {
+		if order == nil {
+			return nil, common.ErrorCode_AUCTION_ORDER_NOT_FOUND, nil
+		}
+		return nil, common.ErrorCode_AUCTION_ORDER_CHANGED, nil
+	}
+
+	result := *order
+	result.quantity, result.price = newQty, price
+	// 买单的手续费预留按新数量和新价格重新计算
+	party := mu.party(direction, orderId)
+	if direction == "buy" {
+		party.feeReserve = reserveFor(newQty)
+	}
+
+	if !requeue {
+		if direction == "sell" {
+			mu.findSellOrder(orderId).Quantity = newQty
+		} else {
+			mu.findBuyOrder(orderId).Quantity = newQty
+		}
+		mu.appendEvent(ctx, &matchEvent{Type: eventAmend, Direction: direction, OrderId: orderId, Quantity: newQty, FeeReserve: party.feeReserve})
+		klog.CtxInfof(ctx, "[AUCTION-MATCH-UNIT] Amend %s order quantity: orderId=%s, quantity=%d", direction, orderId, newQty)
+		return &result, common.ErrorCode_OK, nil
+	}
+
+	// 修改价格：失去原排队顺序，以新价格重新撮合
+	result.createTime = requeueTime
+	if direction == "sell" {
+		mu.removeSellOrder(ctx, orderId, eventRequeue)
+		mu.bindParty("sell", orderId, party.userId, party.feeReserve)
+		mu.AddSellOrder(ctx, &auction.SellData{
+			OrderId:    orderId,
+			ItemId:     order.itemId,
+			Quantity:   newQty,
+			Price:      price,
+			ItemInfo:   order.itemInfo,
+			CreateTime: requeueTime,
+			ExpireTime: order.expireTime,
+			OrderType:  order.orderType,
+		})
+	} else {
+		mu.removeBuyOrder(ctx, orderId, eventRequeue)
+		mu.bindParty("buy", orderId, party.userId, party.feeReserve)
+		mu.AddBuyOrder(ctx, &auction.BuyData{
+			OrderId:    orderId,
+			ItemId:     order.itemId,
+			Quantity:   newQty,
+			Price:      price,
+			CreateTime: requeueTime,
+			ExpireTime: order.expireTime,
+			OrderType:  order.orderType,
+		})
+	}
+	klog.CtxInfof(ctx, "[AUCTION-MATCH-UNIT] Amend %s order requeued: orderId=%s, quantity=%d, price=%d",
+		direction, orderId, newQty, price)
+	return &result, common.ErrorCode_OK, nil
+}
+
+// amendOrder 修改订单价格或剩余数量，price/quantity为0表示不修改
+func (m *AuctionManager) amendOrder(ctx context.Context, direction string, userId string, orderId string, price int64,
+	quantity int32, idempotentId string) (*amendedOrder, common.ErrorCode, string) {
+	logTag := "[AUCTION-MGR-AMEND-" + map[string]string{"sell": "SELL", "buy": "BUY"}[direction] + "]"
+
+	if orderId == "" {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "order_id is empty"
+	}
+	if price < 0 || quantity < 0 {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "price and quantity must not be negative"
+	}
+	if price == 0 && quantity == 0 {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "nothing to amend"
+	}
+
+	fields, err := redis.GetRedis().HGetAll(ctx, orderKey(direction, orderId)).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "%s get order error: orderId=%s, error: %s", logTag, orderId, err.Error())
+		return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "get order error"
+	}
+	if len(fields) == 0 {
+		return nil, common.ErrorCode_AUCTION_ORDER_NOT_FOUND, "order not found"
+	}
+	if fields["user_id"] != userId {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "order_not_belong_to_user"
+	}
+	if auction.OrderType(parseInt(fields["order_type"])) != auction.OrderType_LIMIT {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "only limit orders can be amended"
+	}
+
+	itemId := fields["item_id"]
+	curQty := int32(parseInt(fields["quantity"]))
+	curPrice := parseInt64(fields["price"])
+	curReserve := parseInt64(fields["fee_reserve"])
+	newQty, newPrice := curQty, curPrice
+	if quantity > 0 {
+		newQty = quantity
+	}
+	if price > 0 {
+		newPrice = price
+	}
+	if newQty > curQty {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "quantity can only be reduced"
+	}
+	if newQty == curQty && newPrice == curPrice {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "nothing to amend"
+	}
+
+	// 按道具交易规则检查新数量和新价格，修改价格等同于重新下单，暂停交易时不允许
+	rule := getItemRule(itemId)
+	if newQty != curQty && newQty < rule.MinQuantity {
+		return nil, common.ErrorCode_AUCTION_QUANTITY_TOO_SMALL, "quantity too small"
+	}
+	mu, err := getMatchManager().GetMatchUnit(itemId)
+	if err != nil {
+		klog.CtxWarnf(ctx, "%s Get match unit error, orderId: %s, error: %s", logTag, orderId, err.Error())
+		code, msg := queueErrorCode(err)
+		return nil, code, msg
+	}
+	if newPrice != curPrice {
+		if mu.halted.Load() {
+			return nil, common.ErrorCode_AUCTION_TRADING_HALTED, "trading halted"
+		}
+		minPrice, maxPrice := rule.priceRange(mu.hourlyAvgPrice)
+		if newPrice < minPrice || newPrice > maxPrice {
+			klog.CtxInfof(ctx, "%s Price exceeds limit, orderId: %s, price: %d, minPrice: %d, maxPrice: %d",
+				logTag, orderId, newPrice, minPrice, maxPrice)
+			return nil, common.ErrorCode_AUCTION_PRICE_OUT_OF_BAND, "price exceeds limit"
+		}
+		if !rule.onTick(newPrice) {
+			return nil, common.ErrorCode_AUCTION_PRICE_TICK_INVALID, "price is not a multiple of tick size"
+		}
+	}
+
+	// 撮合队列已满时在追加托管前拒绝
+	if mu.busy() {
+		code, msg := queueErrorCode(errMarketBusy)
+		return nil, code, msg
+	}
+
+	// 买单按新报价重新计算托管：手续费由买家支付时按新成交额预留
+	reserveFor := func(quantity int32) int64 { return 0 }
+	var topup int64
+	jobId := "auction:amend:" + orderId + ":" + idempotentId
+	if direction == "buy" {
+		if rule.FeePayer == feePayerBuyer {
+			reserveFor = func(quantity int32) int64 { return rule.fee(newPrice * int64(quantity)) }
+		}
+		required := newPrice*int64(newQty) + reserveFor(newQty)
+		if required > math.MaxInt32 {
+			return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "amount exceeds limit"
+		}
+		if held := curPrice*int64(curQty) + curReserve; required > held {
+			topup = required - held
+			if err = inventory.DeleteItem(ctx, userId, currencyItemId(), topup, "auction_amend_buy", jobId); err != nil {
+				klog.CtxErrorf(ctx, "%s Escrow currency error, userId: %s, orderId: %s, amount: %d, error: %s",
+					logTag, userId, orderId, topup, err.Error())
+				return nil, common.ErrorCode_AUCTION_ESCROW_FAILED, "escrow currency failed"
+			}
+		}
+	}
+
+	type amendResult struct {
+		order *amendedOrder
+		code  common.ErrorCode
+		err   error
+	}
+	// 修改价格可能立即成交，等待成交落库后再返回
+	var result amendResult
+	if err = mu.callSettled(ctx, func() {
+		order, code, err := mu.amendBookOrder(ctx, direction, orderId, newQty, newPrice, reserveFor, topup, jobId)
+		result = amendResult{order: order, code: code, err: err}
+	}); err != nil {
+		klog.CtxWarnf(ctx, "%s amend order error: orderId=%s, error: %s", logTag, orderId, err.Error())
+		// 未投递到撮合协程时退还追加的托管；已投递时由修改脚本处理
+		if errors.Is(err, errMarketBusy) && topup > 0 {
+			if refundErr := inventory.AddItem(context.WithoutCancel(ctx), userId, currencyItemId(), topup,
+				"auction_amend_buy_rollback", jobId+":rollback"); refundErr != nil {
+				klog.CtxErrorf(ctx, "%s Rollback escrow error, userId: %s, orderId: %s, error: %s", logTag, userId, orderId, refundErr.Error())
+			}
+		}
+		code, msg := queueErrorCode(err)
+		return nil, code, msg
+	}
+
+	switch result.code {
+	case common.ErrorCode_OK:
+		klog.CtxInfof(ctx, "%s amend success: userId=%s, orderId=%s, quantity=%d->%d, price=%d->%d",
+			logTag, userId, orderId, curQty, result.order.quantity, curPrice, result.order.price)
+		return result.order, common.ErrorCode_OK, "success"
+	case common.ErrorCode_AUCTION_REDIS_ERROR:
+		klog.CtxErrorf(ctx, "%s amend order error: orderId=%s, error: %s", logTag, orderId, result.err.Error())
+		return nil, result.code, "amend order error"
+	case common.ErrorCode_AUCTION_ORDER_NOT_FOUND:
+		return nil, result.code, "order not found"
+	default:
+		return nil, result.code, "order changed, please retry"
+	}
+}
+
+// AmendSell 修改出售协议：只减少数量时保留排队顺序，修改价格时重新排队并可能立即成交
+func (m *AuctionManager) AmendSell(ctx context.Context, req *auction.AmendSellReq) (resp *auction.AmendSellRsp, err error) {
+	userId := ""
+	if val, ok := ctx.Value("userId").(string); ok {
+		userId = val
+	}
+
+	resp = &auction.AmendSellRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+
+	order, code, msg := m.amendWithIdempotency(ctx, "sell", userId, req.GetOrderId(), req.GetPrice(), req.GetQuantity(), req.GetIdempotentId())
+	resp.Code, resp.Msg = code, msg
+	if order != nil {
+		resp.Data = &auction.SellData{
+			OrderId:    order.orderId,
+			ItemId:     order.itemId,
+			Quantity:   order.quantity,
+			Price:      order.price,
+			ItemInfo:   order.itemInfo,
+			CreateTime: order.createTime,
+			ExpireTime: order.expireTime,
+			OrderType:  order.orderType,
+		}
+	}
+	return
+}
+
+// AmendBuy 修改求购协议：只减少数量时保留排队顺序，修改价格时重新排队并可能立即成交，托管货币多退少补
+func (m *AuctionManager) AmendBuy(ctx context.Context, req *auction.AmendBuyReq) (resp *auction.AmendBuyRsp, err error) {
+	userId := ""
+	if val, ok := ctx.Value("userId").(string); ok {
+		userId = val
+	}
+
+	resp = &auction.AmendBuyRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+
+	order, code, msg := m.amendWithIdempotency(ctx, "buy", userId, req.GetOrderId(), req.GetPrice(), req.GetQuantity(), req.GetIdempotentId())
+	resp.Code, resp.Msg = code, msg
+	if order != nil {
+		resp.Data = &auction.BuyData{
+			OrderId:    order.orderId,
+			ItemId:     order.itemId,
+			Quantity:   order.quantity,
+			Price:      order.price,
+			CreateTime: order.createTime,
+			ExpireTime: order.expireTime,
+			OrderType:  order.orderType,
+		}
+	}
+	return
+}
+
+// amendWithIdempotency 加用户锁和幂等检查后修改订单，重复请求直接返回上次的结果
+func (m *AuctionManager) amendWithIdempotency(ctx context.Context, direction string, userId string, orderId string,
+	price int64, quantity int32, idempotentId string) (order *amendedOrder, code common.ErrorCode, msg string) {
+	logTag := "[AUCTION-MGR-AMEND-" + map[string]string{"sell": "SELL", "buy": "BUY"}[direction] + "]"
+
+	if userId == "" {
+		return nil, common.ErrorCode_AUCTION_USER_NOT_FOUND, "user_id is empty"
+	}
+
+	// 获取分布式锁（5秒超时）
+	lockAcquired, err := m.checkLock(ctx, userId)
+	if err != nil {
+		klog.CtxErrorf(ctx, "%s acquire lock error: %s", logTag, err.Error())
+		return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "acquire lock error"
+	}
+	if !lockAcquired {
+		klog.CtxInfof(ctx, "%s User %s is busy, cannot amend", logTag, userId)
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "user is busy"
+	}
+	defer func() {
+		_ = m.releaseLock(ctx, userId)
+	}()
+
+	// 幂等性检查
+	if idempotentId == "" {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "idempotent_id is empty"
+	}
+	idempotentKey := "auction:idempotent:" + userId + ":" + idempotentId
+	resultMap, err := m.checkIdempotency(ctx, idempotentKey, logTag)
+	if err != nil {
+		klog.CtxErrorf(ctx, "%s Check idempotent error: %s", logTag, err.Error())
+		return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "check idempotent error"
+	}
+	if len(resultMap) > 0 {
+		codeValue, _ := strconv.ParseInt(fmt.Sprintf("%v", resultMap["code"]), 10, 32)
+		code, msg = common.ErrorCode(codeValue), fmt.Sprintf("%v", resultMap["msg"])
+		if code == common.ErrorCode_OK {
+			order = amendedOrderFromMap(resultMap)
+		}
+		klog.CtxWarnf(ctx, "%s Idempotent request detected, returning cached result: idempotentId=%s", logTag, idempotentId)
+		return
+	}
+
+	defer func() {
+		klog.CtxInfof(ctx, "%s result: userId: %s, orderId: %s, resp: %d", logTag, userId, orderId, code)
+
+		// 统一处理幂等性结果存储到Redis
+		data := map[string]interface{}{
+			"code":      int(code),
+			"msg":       msg,
+			"timestamp": time.Now().Unix(),
+		}
+		if code == common.ErrorCode_OK && order != nil {
+			order.toMap(data)
+		}
+		if hmsetErr := redis.GetRedis().HMSet(ctx, idempotentKey, data).Err(); hmsetErr != nil {
+			klog.CtxErrorf(ctx, "%s Store idempotent result error: %s", logTag, hmsetErr.Error())
+		}
+		if expireErr, _ := redis.GetRedis().Expire(ctx, idempotentKey, 30*24*time.Hour).Result(); !expireErr {
+			klog.CtxErrorf(ctx, "%s Set idempotent key expire error", logTag)
+		}
+	}()
+
+	order, code, msg = m.amendOrder(ctx, direction, userId, orderId, price, quantity, idempotentId)
+	return
+}
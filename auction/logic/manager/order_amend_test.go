@@ -0,0 +1,141 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// 测试用例: 修改挂单价格和数量
+func TestAuctionManager_AmendOrder(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	fake := newFakeInventory()
+	inventory = fake
+	defer func() { inventory = newFakeInventory() }()
+
+	itemId := "test_item_amend"
+	userCtx := func(userId string) context.Context { return context.WithValue(ctx, "userId", userId) }
+	currency := currencyItemId()
+	manager := GetAuctionManager()
+	mgr := getMatchManager()
+	seq := 0
+	idem := func() string {
+		seq++
+		return fmt.Sprintf("test_amend_%d_%d", time.Now().UnixNano(), seq)
+	}
+	sell := func(userId string, quantity int32, price int64) *auction.SellRsp {
+		resp, err := manager.Sell(userCtx(userId), &auction.SellReq{ItemId: itemId, Quantity: quantity, Price: price, IdempotentId: idem()})
+		assert.NoError(t, err)
+		assert.Equal(t, common.ErrorCode_OK, resp.Code)
+		return resp
+	}
+	buy := func(userId string, quantity int32, price int64) *auction.BuyRsp {
+		resp, err := manager.Buy(userCtx(userId), &auction.BuyReq{ItemId: itemId, Quantity: quantity, Price: price, IdempotentId: idem()})
+		assert.NoError(t, err)
+		assert.Equal(t, common.ErrorCode_OK, resp.Code)
+		return resp
+	}
+
+	// 1. 只减少数量保留排队顺序，退还减少的托管道具
+	s1 := sell("test_amend_a", 5, 105)
+	s2 := sell("test_amend_b", 5, 105)
+	amendResp, err := manager.AmendSell(userCtx("test_amend_a"), &auction.AmendSellReq{OrderId: s1.Data.OrderId, Quantity: 3, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, amendResp.Code)
+	assert.Equal(t, int32(3), amendResp.Data.Quantity)
+	assert.Equal(t, s1.Data.CreateTime, amendResp.Data.CreateTime)
+	assert.Equal(t, []string{
+		"sell:" + s1.Data.OrderId + ":3",
+		"sell:" + s2.Data.OrderId + ":5",
+	}, dumpBook(testMatchUnit(itemId)))
+
+	buy("test_amend_buyer", 3, 105)
+	mgr.settlePending(ctx)
+	assert.Equal(t, int64(-3), fake.balance("test_amend_a", itemId))
+	assert.Equal(t, int64(312), fake.balance("test_amend_a", currency))
+	assert.Equal(t, int64(0), fake.balance("test_amend_b", currency))
+
+	// 2. 修改价格重新排队，排在新价位已有挂单之后
+	s3 := sell("test_amend_c", 2, 106)
+	amendResp, err = manager.AmendSell(userCtx("test_amend_b"), &auction.AmendSellReq{OrderId: s2.Data.OrderId, Price: 106, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, amendResp.Code)
+	assert.Equal(t, []string{
+		"sell:" + s3.Data.OrderId + ":2",
+		"sell:" + s2.Data.OrderId + ":5",
+	}, dumpBook(testMatchUnit(itemId)))
+	buy("test_amend_buyer", 2, 106)
+	mgr.settlePending(ctx)
+	assert.Equal(t, int64(210), fake.balance("test_amend_c", currency))
+	assert.Equal(t, int64(0), fake.balance("test_amend_b", currency))
+
+	// 3. 修改后的价格与对手盘交叉时立即成交
+	b1 := buy("test_amend_buyer", 2, 100)
+	amendResp, err = manager.AmendSell(userCtx("test_amend_b"), &auction.AmendSellReq{OrderId: s2.Data.OrderId, Price: 100, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, amendResp.Code)
+	mgr.settlePending(ctx)
+	assert.Equal(t, int64(198), fake.balance("test_amend_b", currency))
+	assert.Equal(t, []string{"sell:" + s2.Data.OrderId + ":3"}, dumpBook(testMatchUnit(itemId)))
+	amendBuyResp, err := manager.AmendBuy(userCtx("test_amend_buyer"), &auction.AmendBuyReq{OrderId: b1.Data.OrderId, Quantity: 1, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_ORDER_NOT_FOUND, amendBuyResp.Code)
+
+	// 4. 买单改价按新报价多退少补托管货币，减少数量退还对应货币
+	b2 := buy("test_amend_d", 4, 95)
+	mgr.settlePending(ctx)
+	assert.Equal(t, int64(-380), fake.balance("test_amend_d", currency))
+	amendBuyResp, err = manager.AmendBuy(userCtx("test_amend_d"), &auction.AmendBuyReq{OrderId: b2.Data.OrderId, Price: 98, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, amendBuyResp.Code)
+	mgr.settlePending(ctx)
+	assert.Equal(t, int64(-392), fake.balance("test_amend_d", currency))
+	idemId := idem()
+	amendBuyResp, err = manager.AmendBuy(userCtx("test_amend_d"), &auction.AmendBuyReq{OrderId: b2.Data.OrderId, Quantity: 2, IdempotentId: idemId})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, amendBuyResp.Code)
+	mgr.settlePending(ctx)
+	assert.Equal(t, int64(-196), fake.balance("test_amend_d", currency))
+
+	// 重复请求返回相同结果，不重复退款
+	repeatResp, err := manager.AmendBuy(userCtx("test_amend_d"), &auction.AmendBuyReq{OrderId: b2.Data.OrderId, Quantity: 2, IdempotentId: idemId})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, repeatResp.Code)
+	assert.True(t, proto.Equal(amendBuyResp.Data, repeatResp.Data))
+	mgr.settlePending(ctx)
+	assert.Equal(t, int64(-196), fake.balance("test_amend_d", currency))
+
+	// 5. 非法修改
+	amendBuyResp, err = manager.AmendBuy(userCtx("test_amend_d"), &auction.AmendBuyReq{OrderId: b2.Data.OrderId, Quantity: 3, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_PARAM_ERROR, amendBuyResp.Code)
+	amendBuyResp, err = manager.AmendBuy(userCtx("test_amend_d"), &auction.AmendBuyReq{OrderId: b2.Data.OrderId, Price: 200, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_PRICE_OUT_OF_BAND, amendBuyResp.Code)
+	amendResp, err = manager.AmendSell(userCtx("test_amend_d"), &auction.AmendSellReq{OrderId: s2.Data.OrderId, Quantity: 1, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_PARAM_ERROR, amendResp.Code)
+
+	// 6. 修改记录在事件流中，崩溃恢复后订单簿一致
+	expected := dumpBook(testMatchUnit(itemId))
+	crashUnit(mgr, itemId)
+	assert.Equal(t, expected, dumpBook(testMatchUnit(itemId)))
+	assert.Equal(t, []string{
+		"sell:" + s2.Data.OrderId + ":3",
+		"buy:" + b2.Data.OrderId + ":2",
+	}, expected)
+}
@@ -0,0 +1,48 @@
+package manager
+
+import (
+	"auction_module/redis/script"
+	"context"
+)
+
+// closedOrder 被关闭订单的剩余信息
+type closedOrder struct {
+	userId    string
+	itemId    string
+	remaining int32 // 关闭时未成交的剩余数量（已退还）
+	price     int64
+}
+
+// closeOrder 以终态关闭仍在Redis中的订单（过期、即时单撤销），退还剩余托管
+// 订单已成交或已取消时返回nil
+func closeOrder(ctx context.Context, direction string, orderId string, status string, jobId string, reason string, expireTime int64) (*closedOrder, error) {
+	// 使用Lua脚本原子性地：
+	// 1. 更新订单状态
+	// 2. 写入退还托管的结算任务（卖单退道具，买单退货币）
+	// 3. 删除订单，用户/全局列表和道具挂单索引的移除写入订单outbox
+
+	result, err := runWithOutbox(ctx, script.CloseOrder, []string{orderKey(direction, orderId), orderStatusKey(orderId), orderOutboxKey(orderId)},
+		orderId,
+		direction,
+		status,
+		jobId,
+		reason,
+		currencyItemId(),
+		expireTime,
+		ordersKey(direction),
+	).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+	if len(result) < 4 {
+		return nil, nil
+	}
+
+	matchMgr.wakeSettlement()
+	return &closedOrder{
+		userId:    result[0],
+		itemId:    result[1],
+		remaining: int32(parseInt(result[2])),
+		price:     parseInt64(result[3]),
+	}, nil
+}
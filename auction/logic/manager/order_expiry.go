@@ -0,0 +1,132 @@
+package manager
+
+import (
+	"auction_module/config"
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/kitex_gen/gate_way"
+	"auction_module/rpc"
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	defaultOrderTTL = 3 * 24 * 3600  // 未配置时订单默认有效期（秒）
+	defaultOrderMax = 30 * 24 * 3600 // 未配置时订单最长有效期（秒）
+)
+
+// userNotifier 用户消息推送接口，默认通过网关推送，测试时可替换
+type userNotifier interface {
+	Notify(ctx context.Context, userId string, msg proto.Message) error
+}
+
+// gatewayNotifier 基于网关UserMsg RPC的推送实现
+type gatewayNotifier struct{}
+
+var notifier userNotifier = &gatewayNotifier{}
+
+func (n *gatewayNotifier) Notify(ctx context.Context, userId string, msg proto.Message) error {
+	if rpc.GatewayClient == nil {
+		return fmt.Errorf("gateway client not initialized")
+	}
+	anyMsg, err := anypb.New(msg)
+	if err != nil {
+		return err
+	}
+	rsp, err := rpc.GatewayClient.UserMsg(ctx, &gate_way.UserMsgReq{
+		Id:  userId,
+		Msg: anyMsg,
+	})
+	if err != nil {
+		return err
+	}
+	if rsp.GetCode() != common.ErrorCode_OK {
+		return fmt.Errorf("push user msg failed: code=%d", rsp.GetCode())
+	}
+	return nil
+}
+
+// configSeconds 读取秒数配置，未配置或非法时返回默认值
+func configSeconds(key string, def int64) int64 {
+	if v, ok := config.Get(key).(int); ok && v > 0 {
+		return int64(v)
+	}
+	return def
+}
+
+// resolveExpireTime 计算订单过期时间：未指定时使用默认有效期，指定时必须晚于当前时间且不超过最长有效期
+func resolveExpireTime(expireTime int64, now int64) (int64, error) {
+	if expireTime == 0 {
+		return now + configSeconds("auction.order_default_ttl", defaultOrderTTL), nil
+	}
+	if expireTime <= now {
+		return 0, fmt.Errorf("expire_time must be later than now")
+	}
+	if expireTime > now+configSeconds("auction.order_max_ttl", defaultOrderMax) {
+		return 0, fmt.Errorf("expire_time exceeds max ttl")
+	}
+	return expireTime, nil
+}
+
+// loadedExpireTime 解析Redis中订单的过期时间，没有过期时间的历史订单按创建时间+默认有效期处理
+func loadedExpireTime(orderData map[string]string) int64 {
+	if expireTime := parseInt64(orderData["expire_time"]); expireTime > 0 {
+		return expireTime
+	}
+	return parseInt64(orderData["create_time"]) + configSeconds("auction.order_default_ttl", defaultOrderTTL)
+}
+
+// expireOrders 推进过期时间轮，下架所有到期订单（在撮合协程内调用）
+func (mu *matchUnit) expireOrders(ctx context.Context, now int64) {
+	expired := mu.expireWheel.Advance(now)
+	if len(expired) > 0 {
+		// 过期按Redis中的剩余数量退还托管，先等待已撮合的成交落库
+		mu.settler.flush()
+	}
+	for _, t := range expired {
+		if t.direction == "sell" {
+			mu.removeSellOrder(ctx, t.orderId, eventExpire)
+		} else {
+			mu.removeBuyOrder(ctx, t.orderId, eventExpire)
+		}
+		mu.expireOrder(ctx, t)
+		mu.markMarketDirty()
+	}
+}
+
+// expireOrder 将订单状态置为过期，退还剩余托管并通知订单所有者
+func (mu *matchUnit) expireOrder(ctx context.Context, t *wheelTimer) {
+	order, err := mu.store.CloseOrder(ctx, t.direction, t.orderId, "过期",
+		"auction:expire:"+t.orderId, "auction_expire_"+t.direction, t.expireTime)
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MATCH-EXPIRE] expire order error: orderId=%s, direction=%s, error: %s",
+			t.orderId, t.direction, err.Error())
+		return
+	}
+	if order == nil {
+		klog.CtxInfof(ctx, "[AUCTION-MATCH-EXPIRE] order already closed: orderId=%s", t.orderId)
+		return
+	}
+
+	klog.CtxInfof(ctx, "[AUCTION-MATCH-EXPIRE] Order expired: orderId=%s, direction=%s, userId=%s, itemId=%s, remaining=%d",
+		t.orderId, t.direction, order.userId, order.itemId, order.remaining)
+	if order.userId == "" {
+		return
+	}
+	ntf := &auction.AuctionOrderExpiredNtf{
+		OrderId:        t.orderId,
+		ItemId:         order.itemId,
+		TradeDirection: t.direction,
+		Quantity:       order.remaining,
+		Price:          order.price,
+		ExpireTime:     t.expireTime,
+	}
+	if err := notifier.Notify(ctx, order.userId, ntf); err != nil {
+		klog.CtxWarnf(ctx, "[AUCTION-MATCH-EXPIRE] notify user error: userId=%s, orderId=%s, error: %s",
+			order.userId, t.orderId, err.Error())
+	}
+}
@@ -0,0 +1,116 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// 测试用例: 订单到期自动下架，退还托管并通知订单所有者
+func TestAuctionManager_OrderExpiry(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	// 检查Redis连接是否正常
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	fake := newFakeInventory()
+	inventory = fake
+	defer func() { inventory = newFakeInventory() }()
+	ntf := &fakeNotifier{msgs: make(map[string][]proto.Message)}
+	notifier = ntf
+	defer func() { notifier = &gatewayNotifier{} }()
+
+	userId := "test_user_expiry"
+	ctx = context.WithValue(ctx, "userId", userId)
+	itemId := "test_item_expiry"
+	manager := GetAuctionManager()
+	mu := testMatchUnit(itemId)
+	price := mu.hourlyAvgPrice
+	now := time.Now().Unix()
+
+	// 1. 过期时间早于当前时间时拒绝
+	sellResp, err := manager.Sell(ctx, &auction.SellReq{
+		ItemId:       itemId,
+		Quantity:     2,
+		Price:        price,
+		IdempotentId: "test_expiry_past_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+		ExpireTime:   now - 1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_PARAM_ERROR, sellResp.Code)
+
+	// 2. 未指定过期时间使用默认有效期
+	buyResp, err := manager.Buy(ctx, &auction.BuyReq{
+		ItemId:       itemId,
+		Quantity:     1,
+		Price:        price - 1,
+		IdempotentId: "test_expiry_buy_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, buyResp.Code)
+	assert.Equal(t, buyResp.Data.CreateTime+configSeconds("auction.order_default_ttl", defaultOrderTTL), buyResp.Data.ExpireTime)
+
+	// 3. 指定过期时间的卖单
+	sellResp, err = manager.Sell(ctx, &auction.SellReq{
+		ItemId:       itemId,
+		Quantity:     2,
+		Price:        price,
+		IdempotentId: "test_expiry_sell_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+		ExpireTime:   now + 60,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, sellResp.Code)
+	assert.Equal(t, int64(-2), fake.balance(userId, itemId))
+
+	// 4. 推进时间轮到卖单过期之后，买单未到期
+	r := make(chan bool)
+	mu.opChannel <- func() {
+		mu.expireOrders(ctx, now+61)
+		r <- true
+	}
+	<-r
+	getMatchManager().settlePending(ctx)
+
+	orderId := sellResp.Data.OrderId
+	status, err := redis.GetRedis().HGet(ctx, orderStatusKey(orderId), "status").Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "过期", status)
+	exists, err := redis.GetRedis().Exists(ctx, sellOrderKey(orderId)).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), exists)
+	sellCount, err := redis.GetRedis().SCard(ctx, userOrdersKey(userId, "sell")).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), sellCount)
+	buyCount, err := redis.GetRedis().SCard(ctx, userOrdersKey(userId, "buy")).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), buyCount)
+	assert.Equal(t, int64(0), fake.balance(userId, itemId))
+
+	// 5. 撮合单元中不再有该卖单
+	info := mu.getAuctionInfo(ctx)
+	assert.Len(t, info.Sells, 0)
+	assert.Len(t, info.Buys, 1)
+
+	// 6. 订单所有者收到过期通知
+	ntf.mu.Lock()
+	msgs := ntf.msgs[userId]
+	ntf.mu.Unlock()
+	if assert.Len(t, msgs, 1) {
+		expired := msgs[0].(*auction.AuctionOrderExpiredNtf)
+		assert.Equal(t, orderId, expired.OrderId)
+		assert.Equal(t, "sell", expired.TradeDirection)
+		assert.Equal(t, int32(2), expired.Quantity)
+		assert.Equal(t, now+60, expired.ExpireTime)
+	}
+}
@@ -0,0 +1,102 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"context"
+	"strings"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/google/btree"
+)
+
+// PlaceSellOrder 按订单类型执行卖单（在撮合协程内调用）
+// 限价单未成交部分挂单；市价单/IOC/FOK只做即时撮合，未成交部分撤销并退还托管
+func (mu *matchUnit) PlaceSellOrder(ctx context.Context, order *auction.SellData) {
+	if order.OrderType == auction.OrderType_LIMIT {
+		mu.AddSellOrder(ctx, order)
+		return
+	}
+
+	// FOK在撮合前检查可成交深度，不足时一笔都不成交
+	if order.OrderType == auction.OrderType_FOK && mu.buyDepth(order.Price, order.Quantity) < order.Quantity {
+		klog.CtxInfof(ctx, "[AUCTION-MATCH-UNIT] FOK sell order not fillable: orderId=%s, quantity=%d, price=%d",
+			order.OrderId, order.Quantity, order.Price)
+	} else {
+		mu.matchSellOrder(ctx, order)
+	}
+
+	if order.Quantity > 0 {
+		mu.cancelRemainder(ctx, "sell", order.OrderId, order.OrderType)
+	}
+	mu.releaseParty("sell", order.OrderId)
+}
+
+// PlaceBuyOrder 按订单类型执行买单（在撮合协程内调用）
+// 限价单未成交部分挂单；市价单/IOC/FOK只做即时撮合，未成交部分撤销并退还托管
+func (mu *matchUnit) PlaceBuyOrder(ctx context.Context, order *auction.BuyData) {
+	if order.OrderType == auction.OrderType_LIMIT {
+		mu.AddBuyOrder(ctx, order)
+		return
+	}
+
+	// FOK在撮合前检查可成交深度，不足时一笔都不成交
+	if order.OrderType == auction.OrderType_FOK && mu.sellDepth(order.Price, order.Quantity) < order.Quantity {
+		klog.CtxInfof(ctx, "[AUCTION-MATCH-UNIT] FOK buy order not fillable: orderId=%s, quantity=%d, price=%d",
+			order.OrderId, order.Quantity, order.Price)
+	} else {
+		mu.matchBuyOrder(ctx, order)
+	}
+
+	if order.Quantity > 0 {
+		mu.cancelRemainder(ctx, "buy", order.OrderId, order.OrderType)
+	}
+	mu.releaseParty("buy", order.OrderId)
+}
+
+// buyDepth 统计报价不低于price的买单总量，达到need后提前返回
+func (mu *matchUnit) buyDepth(price int64, need int32) int32 {
+	var depth int32
+	mu.buyOrders.Ascend(func(item btree.Item) bool {
+		order := item.(*BuyOrderByPriceDesc)
+		if order.Price < price {
+			return false
+		}
+		depth += order.Quantity
+		return depth < need
+	})
+	return depth
+}
+
+// sellDepth 统计报价不高于price的卖单总量，达到need后提前返回
+func (mu *matchUnit) sellDepth(price int64, need int32) int32 {
+	var depth int32
+	mu.sellOrders.Ascend(func(item btree.Item) bool {
+		order := item.(*SellOrderByPriceAsc)
+		if order.Price > price {
+			return false
+		}
+		depth += order.Quantity
+		return depth < need
+	})
+	return depth
+}
+
+// cancelRemainder 撤销即时订单的未成交部分，订单状态置为取消并退还剩余托管
+func (mu *matchUnit) cancelRemainder(ctx context.Context, direction string, orderId string, orderType auction.OrderType) {
+	name := strings.ToLower(orderType.String())
+	// 撤销按Redis中的剩余数量退还托管，先等待本单已撮合的成交落库
+	mu.settler.flush()
+	order, err := mu.store.CloseOrder(ctx, direction, orderId, "取消",
+		"auction:"+name+":"+orderId, "auction_"+name+"_"+direction, 0)
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MATCH-UNIT] cancel %s remainder error: orderId=%s, direction=%s, error: %s",
+			name, orderId, direction, err.Error())
+		return
+	}
+	if order != nil {
+		// 未进入订单簿，回放时为空操作，仅用于审计
+		mu.appendEvent(ctx, &matchEvent{Type: eventCancel, Direction: direction, OrderId: orderId})
+		klog.CtxInfof(ctx, "[AUCTION-MATCH-UNIT] Cancel %s remainder: orderId=%s, direction=%s, remaining=%d",
+			name, orderId, direction, order.remaining)
+	}
+}
@@ -0,0 +1,105 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 测试用例: FOK深度不足时不成交，IOC成交后撤销剩余，市价单无对手盘时全部撤销
+func TestAuctionManager_OrderTypes(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	// 检查Redis连接是否正常
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	fake := newFakeInventory()
+	inventory = fake
+	defer func() { inventory = newFakeInventory() }()
+
+	buyUserId := "test_user_type_buyer"
+	sellUserId := "test_user_type_seller"
+	buyCtx := context.WithValue(ctx, "userId", buyUserId)
+	sellCtx := context.WithValue(ctx, "userId", sellUserId)
+	itemId := "test_item_order_type"
+	currency := currencyItemId()
+
+	manager := GetAuctionManager()
+	mu := testMatchUnit(itemId)
+	avgPrice := mu.hourlyAvgPrice
+	buyPrice := avgPrice + 5
+
+	// 1. 挂一个限价卖单作为盘口
+	sellResp, err := manager.Sell(sellCtx, &auction.SellReq{
+		ItemId:       itemId,
+		Quantity:     3,
+		Price:        avgPrice,
+		IdempotentId: "test_type_sell_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, sellResp.Code)
+
+	// 2. FOK买单需求超过盘口深度，不成交并全额退还
+	fokResp, err := manager.Buy(buyCtx, &auction.BuyReq{
+		ItemId:       itemId,
+		Quantity:     5,
+		Price:        buyPrice,
+		IdempotentId: "test_type_fok_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+		OrderType:    auction.OrderType_FOK,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, fokResp.Code)
+	getMatchManager().settlePending(ctx)
+	assert.Equal(t, int64(0), fake.balance(buyUserId, currency))
+	assert.Equal(t, int64(0), fake.balance(buyUserId, itemId))
+	status, err := redis.GetRedis().HGet(ctx, orderStatusKey(fokResp.Data.OrderId), "status").Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "取消", status)
+	assert.Len(t, mu.getAuctionInfo(ctx).Sells, 1)
+
+	// 3. IOC买单成交盘口的3个，剩余2个撤销而不挂单
+	iocResp, err := manager.Buy(buyCtx, &auction.BuyReq{
+		ItemId:       itemId,
+		Quantity:     5,
+		Price:        buyPrice,
+		IdempotentId: "test_type_ioc_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+		OrderType:    auction.OrderType_IOC,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, iocResp.Code)
+	getMatchManager().settlePending(ctx)
+	assert.Equal(t, int64(3), fake.balance(buyUserId, itemId))
+	assert.Equal(t, -avgPrice*3, fake.balance(buyUserId, currency))
+	info := mu.getAuctionInfo(ctx)
+	assert.Len(t, info.Sells, 0)
+	assert.Len(t, info.Buys, 0)
+	buyCount, err := redis.GetRedis().SCard(ctx, userOrdersKey(buyUserId, "buy")).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), buyCount)
+
+	// 4. 市价卖单没有对手盘，全部撤销并退还道具
+	marketResp, err := manager.Sell(sellCtx, &auction.SellReq{
+		ItemId:       itemId,
+		Quantity:     2,
+		IdempotentId: "test_type_market_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+		OrderType:    auction.OrderType_MARKET,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, marketResp.Code)
+	assert.Equal(t, int64(float64(avgPrice)*0.9), marketResp.Data.Price)
+	getMatchManager().settlePending(ctx)
+	assert.Equal(t, int64(-3), fake.balance(sellUserId, itemId))
+	sellCount, err := redis.GetRedis().SCard(ctx, userOrdersKey(sellUserId, "sell")).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), sellCount)
+}
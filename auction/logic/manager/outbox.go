@@ -0,0 +1,199 @@
+package manager
+
+import (
+	"auction_module/redis"
+	"auction_module/redis/script"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// outbox：Redis Cluster中Lua脚本只能访问同一个slot的key，脚本修改本实体的数据后，
+// 把需要写入其他slot的操作（用户列表、全局列表、索引、结算任务、对手方订单）追加到本实体的outbox，
+// 脚本执行后立即按顺序投递。投递的每个操作都是幂等的（集合增删、覆盖写入、带幂等ID的结算任务、以成交ID去重的脚本），
+// 进程在投递前退出时由恢复协程重新投递。
+
+const (
+	outboxPendingKey   = "auction:outbox:pending" // 待投递的outbox（ZSET，member为outbox key，score为登记时间）
+	outboxRecoverAge   = 10 * time.Second         // 登记超过该时间仍未投递完的outbox由恢复协程投递
+	outboxRecoverBatch = 100                      // 恢复协程每批投递的outbox数量
+)
+
+// outboxEffect outbox中的一条操作：单key命令，或对其他实体执行的脚本（脚本的最后一个KEY为该实体的outbox）
+type outboxEffect struct {
+	Cmd    []interface{} `json:"cmd,omitempty"`
+	Script string        `json:"script,omitempty"`
+	Keys   []string      `json:"keys,omitempty"`
+	Args   []interface{} `json:"args,omitempty"`
+}
+
+func (e *outboxEffect) encode() string {
+	data, _ := json.Marshal(e)
+	return string(data)
+}
+
+// cmdEffect 单key命令
+func cmdEffect(args ...interface{}) string {
+	return (&outboxEffect{Cmd: args}).encode()
+}
+
+// settlementEffect 写入结算任务
+func settlementEffect(job *settlementJob) string {
+	return cmdEffect("RPUSH", settlementPendingKey, job.encode())
+}
+
+// scriptEffect 对其他实体执行已注册的脚本
+func scriptEffect(name string, keys []string, args ...interface{}) string {
+	return (&outboxEffect{Script: name, Keys: keys, Args: args}).encode()
+}
+
+// runWithOutbox 执行写入outbox的已注册脚本，outbox为最后一个KEY
+func runWithOutbox(ctx context.Context, name string, keys []string, args ...interface{}) *goredis.Cmd {
+	return withOutbox(ctx, keys[len(keys)-1], func() *goredis.Cmd {
+		return script.Run(ctx, redis.GetRedis(), name, keys, args...)
+	})
+}
+
+// runMarkedOutbox 执行写入outbox的已注册脚本，调用方已登记outbox（批量落库时一次登记整批）
+func runMarkedOutbox(ctx context.Context, name string, keys []string, args ...interface{}) *goredis.Cmd {
+	return drainAfter(ctx, keys[len(keys)-1], script.Run(ctx, redis.GetRedis(), name, keys, args...))
+}
+
+// withOutbox 先登记outbox再执行脚本，保证脚本写入的操作在进程退出后仍能被恢复协程找到；脚本执行后立即投递
+func withOutbox(ctx context.Context, outboxKey string, run func() *goredis.Cmd) *goredis.Cmd {
+	if err := markOutbox(ctx, outboxKey); err != nil {
+		cmd := goredis.NewCmd(ctx)
+		cmd.SetErr(err)
+		return cmd
+	}
+	return drainAfter(ctx, outboxKey, run())
+}
+
+// drainAfter 脚本执行成功后投递outbox
+func drainAfter(ctx context.Context, outboxKey string, cmd *goredis.Cmd) *goredis.Cmd {
+	if err := cmd.Err(); err != nil && err != goredis.Nil {
+		return cmd
+	}
+	if err := drainOutbox(ctx, outboxKey); err != nil {
+		// 投递失败不影响脚本结果，由恢复协程重试
+		klog.CtxErrorf(ctx, "[AUCTION-OUTBOX] drain outbox error: key=%s, error: %s", outboxKey, err.Error())
+	}
+	return cmd
+}
+
+func markOutbox(ctx context.Context, outboxKeys ...string) error {
+	now := float64(time.Now().Unix())
+	members := make([]goredis.Z, len(outboxKeys))
+	for i, key := range outboxKeys {
+		members[i] = goredis.Z{Score: now, Member: key}
+	}
+	return redis.GetRedis().ZAdd(ctx, outboxPendingKey, members...).Err()
+}
+
+// drainOutbox 按顺序投递outbox中的操作，全部投递后移除登记
+// 多个协程同时投递同一个outbox时，操作可能被重复执行（均为幂等），但不会被跳过
+func drainOutbox(ctx context.Context, outboxKey string) error {
+	// 只在队首仍是刚投递的操作时弹出，避免并发投递时弹出未执行的操作
+	for {
+		raw, err := redis.GetRedis().LIndex(ctx, outboxKey, 0).Result()
+		if err == goredis.Nil {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := applyOutboxEffect(ctx, raw); err != nil {
+			return fmt.Errorf("apply %s: %w", raw, err)
+		}
+		if err := script.Run(ctx, redis.GetRedis(), script.PopOutbox, []string{outboxKey}, raw).Err(); err != nil {
+			return err
+		}
+	}
+
+	if err := redis.GetRedis().ZRem(ctx, outboxPendingKey, outboxKey).Err(); err != nil {
+		return err
+	}
+	// 移除登记后再次检查，期间有新写入时重新登记
+	if n, err := redis.GetRedis().LLen(ctx, outboxKey).Result(); err == nil && n > 0 {
+		return markOutbox(ctx, outboxKey)
+	}
+	return nil
+}
+
+// applyOutboxEffect 执行outbox中的一条操作
+func applyOutboxEffect(ctx context.Context, raw string) error {
+	effect := &outboxEffect{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	decoder.UseNumber()
+	if err := decoder.Decode(effect); err != nil {
+		return err
+	}
+
+	// 数值以字符串发送，避免大整数被格式化为浮点数
+	numbersToStrings(effect.Cmd)
+	numbersToStrings(effect.Args)
+
+	if effect.Script != "" {
+		if len(effect.Keys) == 0 {
+			return fmt.Errorf("script %s without outbox", effect.Script)
+		}
+		err := runWithOutbox(ctx, effect.Script, effect.Keys, effect.Args...).Err()
+		if err == goredis.Nil {
+			return nil
+		}
+		return err
+	}
+
+	if len(effect.Cmd) < 2 {
+		return fmt.Errorf("invalid command")
+	}
+	err := redis.GetRedis().Do(ctx, effect.Cmd...).Err()
+	if err == goredis.Nil {
+		return nil
+	}
+	return err
+}
+
+func numbersToStrings(args []interface{}) {
+	for i, arg := range args {
+		if n, ok := arg.(json.Number); ok {
+			args[i] = n.String()
+		}
+	}
+}
+
+// recoverOutboxes 投递登记时间早于minAge之前仍未投递完的outbox（进程退出或投递失败遗留）
+func recoverOutboxes(ctx context.Context, minAge time.Duration) {
+	for ctx.Err() == nil {
+		keys, err := redis.GetRedis().ZRangeByScore(ctx, outboxPendingKey, &goredis.ZRangeBy{
+			Min:   "-inf",
+			Max:   strconv.FormatInt(time.Now().Add(-minAge).Unix(), 10),
+			Count: outboxRecoverBatch,
+		}).Result()
+		if err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-OUTBOX] get pending outboxes error: %s", err.Error())
+			return
+		}
+		recovered := 0
+		for _, key := range keys {
+			if err := drainOutbox(ctx, key); err != nil {
+				klog.CtxErrorf(ctx, "[AUCTION-OUTBOX] recover outbox error: key=%s, error: %s", key, err.Error())
+				continue
+			}
+			recovered++
+		}
+		if recovered > 0 {
+			klog.CtxInfof(ctx, "[AUCTION-OUTBOX] Recovered %d outboxes", recovered)
+		}
+		// 本批有投递失败的outbox时等待下一轮，避免反复重试
+		if len(keys) < outboxRecoverBatch || recovered < len(keys) {
+			return
+		}
+	}
+}
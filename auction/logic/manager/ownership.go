@@ -0,0 +1,367 @@
+package manager
+
+import (
+	"auction_module/config"
+	"auction_module/etcd"
+	"context"
+	"fmt"
+	"hash/crc32"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+// 多实例模式下道具按一致性哈希分配到存活实例：每个实例以租约注册到memberKeyPrefix下，
+// 所有实例监听成员变化并重建哈希环。归属key仍然绑定租约，保证交接期间同一道具只在一个实例上撮合：
+// 哈希环指向本实例且归属key空闲时才能获取，不再归属本实例的道具由原实例交出并释放后新实例接管
+
+const (
+	ownershipKeyPrefix       = "/auction/owner/"  // 道具撮合单元归属key前缀，value为所属实例的RPC地址
+	memberKeyPrefix          = "/auction/member/" // 存活实例注册key前缀，value为实例的RPC地址
+	defaultOwnershipLeaseTTL = 10                 // 默认租约时长（秒）
+	hashRingReplicas         = 100                // 每个实例在哈希环上的虚拟节点数
+)
+
+// itemOwnership 道具撮合单元归属管理，保证同一道具只在一个实例上撮合
+type itemOwnership interface {
+	// Acquire 尝试获取道具归属，返回应处理该道具的实例地址以及是否归属本实例
+	Acquire(ctx context.Context, itemId string) (owner string, acquired bool, err error)
+	// Release 释放道具归属
+	Release(ctx context.Context, itemId string) error
+	// Owns 按当前存活实例计算，道具是否应由本实例撮合
+	Owns(itemId string) bool
+	// Changed 存活实例变化时收到通知，本实例需交出不再归属的道具并接管新归属的道具
+	Changed() <-chan struct{}
+	// Lost 归属租约丢失时关闭的channel，本实例持有的所有道具都需要交出
+	Lost() <-chan struct{}
+	// Distributed 是否为多实例模式
+	Distributed() bool
+	// Close 释放所有归属
+	Close(ctx context.Context) error
+}
+
+// localOwnership 单实例模式，所有道具都归属本实例
+type localOwnership struct{}
+
+func (o *localOwnership) Acquire(ctx context.Context, itemId string) (string, bool, error) {
+	return "", true, nil
+}
+
+func (o *localOwnership) Release(ctx context.Context, itemId string) error {
+	return nil
+}
+
+func (o *localOwnership) Owns(itemId string) bool {
+	return true
+}
+
+func (o *localOwnership) Changed() <-chan struct{} {
+	return nil
+}
+
+func (o *localOwnership) Lost() <-chan struct{} {
+	return nil
+}
+
+func (o *localOwnership) Distributed() bool {
+	return false
+}
+
+func (o *localOwnership) Close(ctx context.Context) error {
+	return nil
+}
+
+// hashRing 一致性哈希环，道具ID哈希后顺时针找到的第一个虚拟节点所属实例即为归属实例
+type hashRing struct {
+	hashes []uint32
+	nodes  map[uint32]string
+}
+
+// newHashRing 按实例地址构建哈希环，实例增减时只有相邻区间的道具改变归属
+func newHashRing(members []string) *hashRing {
+	r := &hashRing{nodes: make(map[uint32]string, len(members)*hashRingReplicas)}
+	for _, member := range members {
+		for i := 0; i < hashRingReplicas; i++ {
+			h := crc32.ChecksumIEEE([]byte(member + "#" + strconv.Itoa(i)))
+			if _, exists := r.nodes[h]; exists {
+				continue
+			}
+			r.nodes[h] = member
+			r.hashes = append(r.hashes, h)
+		}
+	}
+	sort.Slice(r.hashes, func(i, j int) bool { return r.hashes[i] < r.hashes[j] })
+	return r
+}
+
+// Owner 返回道具的归属实例，环为空时返回空字符串
+func (r *hashRing) Owner(itemId string) string {
+	if len(r.hashes) == 0 {
+		return ""
+	}
+	h := crc32.ChecksumIEEE([]byte(itemId))
+	i := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= h })
+	if i == len(r.hashes) {
+		i = 0
+	}
+	return r.nodes[r.hashes[i]]
+}
+
+// etcdOwnership 基于etcd租约的道具归属，实例宕机后租约过期，成员注册和归属自动释放
+type etcdOwnership struct {
+	client  *clientv3.Client
+	self    string // 本实例RPC地址
+	ttl     int
+	mu      sync.Mutex
+	session *concurrency.Session
+
+	ringMu  sync.RWMutex
+	ring    *hashRing
+	changed chan struct{}      // 存活实例变化通知
+	cancel  context.CancelFunc // 停止成员监听
+}
+
+// newItemOwnership 根据配置创建道具归属管理器
+func newItemOwnership() itemOwnership {
+	if enable, _ := config.Get("auction_cluster.enable").(bool); !enable {
+		return &localOwnership{}
+	}
+
+	client, err := etcd.GetEtcdKVClient()
+	if err != nil {
+		klog.Fatalf("[AUCTION-OWNERSHIP] create etcd client error: %s", err.Error())
+	}
+
+	ttl := defaultOwnershipLeaseTTL
+	if v, ok := config.Get("auction_cluster.lease_ttl").(int); ok && v > 0 {
+		ttl = v
+	}
+
+	o := &etcdOwnership{
+		client:  client,
+		self:    advertiseAddr(),
+		ttl:     ttl,
+		ring:    newHashRing(nil),
+		changed: make(chan struct{}, 1),
+	}
+	if _, err := o.getSession(); err != nil {
+		klog.Fatalf("[AUCTION-OWNERSHIP] create etcd session error: %s", err.Error())
+	}
+	// 启动时同步加载一次成员，之后按watch事件更新
+	ctx, cancel := context.WithCancel(context.Background())
+	o.cancel = cancel
+	rev, err := o.loadMembers(ctx)
+	if err != nil {
+		klog.Fatalf("[AUCTION-OWNERSHIP] load members error: %s", err.Error())
+	}
+	go o.watchMembers(ctx, rev)
+	klog.Infof("[AUCTION-OWNERSHIP] Cluster mode enabled, self=%s, ttl=%d", o.self, o.ttl)
+	return o
+}
+
+// advertiseAddr 本实例对其他实例暴露的RPC地址
+func advertiseAddr() string {
+	if addr, _ := config.Get("auction_cluster.advertise_addr").(string); addr != "" {
+		return addr
+	}
+
+	// 未配置时使用本机第一个非回环IPv4地址 + RPC监听端口
+	_, port, err := net.SplitHostPort(config.Get("auction_rpc.addr").(string))
+	if err != nil {
+		klog.Fatalf("[AUCTION-OWNERSHIP] parse auction_rpc.addr error: %s", err.Error())
+	}
+	addrs, _ := net.InterfaceAddrs()
+	for _, a := range addrs {
+		if ipNet, ok := a.(*net.IPNet); ok && !ipNet.IP.IsLoopback() && ipNet.IP.To4() != nil {
+			return net.JoinHostPort(ipNet.IP.String(), port)
+		}
+	}
+	return net.JoinHostPort("127.0.0.1", port)
+}
+
+// getSession 获取当前租约会话，会话失效后重新创建
+func (o *etcdOwnership) getSession() (*concurrency.Session, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.session != nil {
+		select {
+		case <-o.session.Done():
+			o.session = nil
+		default:
+			return o.session, nil
+		}
+	}
+
+	session, err := concurrency.NewSession(o.client, concurrency.WithTTL(o.ttl))
+	if err != nil {
+		return nil, err
+	}
+	// 成员注册绑定在会话租约上，会话重建时重新注册
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(o.ttl)*time.Second)
+	defer cancel()
+	if _, err := o.client.Put(ctx, memberKeyPrefix+o.self, o.self, clientv3.WithLease(session.Lease())); err != nil {
+		session.Close()
+		return nil, err
+	}
+	o.session = session
+	return session, nil
+}
+
+// loadMembers 读取存活实例并重建哈希环，返回读取时的revision
+func (o *etcdOwnership) loadMembers(ctx context.Context) (int64, error) {
+	resp, err := o.client.Get(ctx, memberKeyPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+	members := make([]string, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		members = append(members, string(kv.Value))
+	}
+	o.ringMu.Lock()
+	o.ring = newHashRing(members)
+	o.ringMu.Unlock()
+	klog.CtxInfof(ctx, "[AUCTION-OWNERSHIP] Members updated: %v", members)
+
+	select {
+	case o.changed <- struct{}{}:
+	default:
+	}
+	return resp.Header.Revision, nil
+}
+
+// watchMembers 监听成员变化，任何变化都重新读取全部成员；watch中断时从最新revision重新开始
+func (o *etcdOwnership) watchMembers(ctx context.Context, rev int64) {
+	for {
+		watchCh := o.client.Watch(clientv3.WithRequireLeader(ctx), memberKeyPrefix, clientv3.WithPrefix(), clientv3.WithRev(rev+1))
+		for resp := range watchCh {
+			if resp.Err() != nil {
+				break
+			}
+			if len(resp.Events) == 0 {
+				continue
+			}
+			if newRev, err := o.loadMembers(ctx); err == nil {
+				rev = newRev
+			} else {
+				klog.CtxErrorf(ctx, "[AUCTION-OWNERSHIP] load members error: %s", err.Error())
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+		newRev, err := o.loadMembers(ctx)
+		if err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-OWNERSHIP] load members error: %s", err.Error())
+			continue
+		}
+		rev = newRev
+	}
+}
+
+// owner 按当前哈希环计算道具的归属实例
+func (o *etcdOwnership) owner(itemId string) string {
+	o.ringMu.RLock()
+	defer o.ringMu.RUnlock()
+	return o.ring.Owner(itemId)
+}
+
+func (o *etcdOwnership) Acquire(ctx context.Context, itemId string) (string, bool, error) {
+	target := o.owner(itemId)
+	if target == "" {
+		return "", false, fmt.Errorf("no live auction instance for item %s", itemId)
+	}
+	if target != o.self {
+		return target, false, nil
+	}
+
+	session, err := o.getSession()
+	if err != nil {
+		return "", false, err
+	}
+
+	key := ownershipKeyPrefix + itemId
+	// key不存在时写入本实例地址并绑定租约，否则读取当前归属
+	txnResp, err := o.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0)).
+		Then(clientv3.OpPut(key, o.self, clientv3.WithLease(session.Lease()))).
+		Else(clientv3.OpGet(key)).
+		Commit()
+	if err != nil {
+		return "", false, err
+	}
+	if txnResp.Succeeded {
+		klog.CtxInfof(ctx, "[AUCTION-OWNERSHIP] Acquired item: %s", itemId)
+		return o.self, true, nil
+	}
+
+	kvs := txnResp.Responses[0].GetResponseRange().GetKvs()
+	if len(kvs) == 0 {
+		return "", false, fmt.Errorf("ownership of item %s changed, retry later", itemId)
+	}
+	owner := string(kvs[0].Value)
+	// 本实例之前持有的归属（仍在同一租约下），否则原实例尚未交出
+	return owner, owner == o.self && clientv3.LeaseID(kvs[0].Lease) == session.Lease(), nil
+}
+
+func (o *etcdOwnership) Release(ctx context.Context, itemId string) error {
+	session, err := o.getSession()
+	if err != nil {
+		return err
+	}
+
+	key := ownershipKeyPrefix + itemId
+	// 只删除自己持有的归属
+	_, err = o.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.LeaseValue(key), "=", session.Lease())).
+		Then(clientv3.OpDelete(key)).
+		Commit()
+	return err
+}
+
+func (o *etcdOwnership) Owns(itemId string) bool {
+	return o.owner(itemId) == o.self
+}
+
+func (o *etcdOwnership) Changed() <-chan struct{} {
+	return o.changed
+}
+
+func (o *etcdOwnership) Lost() <-chan struct{} {
+	session, err := o.getSession()
+	if err != nil {
+		// 无法建立会话，视为已丢失
+		c := make(chan struct{})
+		close(c)
+		return c
+	}
+	return session.Done()
+}
+
+func (o *etcdOwnership) Distributed() bool {
+	return true
+}
+
+func (o *etcdOwnership) Close(ctx context.Context) error {
+	if o.cancel != nil {
+		o.cancel()
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.session == nil {
+		return nil
+	}
+	// 撤销租约，成员注册和归属key全部删除，其他实例重建哈希环后立即接管
+	err := o.session.Close()
+	o.session = nil
+	return err
+}
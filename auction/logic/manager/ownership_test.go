@@ -0,0 +1,254 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHashRing_Owner 道具均匀分布到各实例，增加实例时只有移到新实例的道具改变归属
+func TestHashRing_Owner(t *testing.T) {
+	members := []string{"10.0.0.1:11001", "10.0.0.2:11001", "10.0.0.3:11001"}
+	ring := newHashRing(members)
+	assert.Equal(t, "", newHashRing(nil).Owner("item"))
+
+	counts := make(map[string]int)
+	before := make(map[string]string)
+	for i := 0; i < 3000; i++ {
+		itemId := fmt.Sprintf("item_%d", i)
+		owner := ring.Owner(itemId)
+		assert.Equal(t, owner, newHashRing([]string{members[2], members[0], members[1]}).Owner(itemId), "owner should not depend on member order")
+		before[itemId] = owner
+		counts[owner]++
+	}
+	for _, member := range members {
+		assert.Greater(t, counts[member], 600, "member %s owns too few items", member)
+	}
+
+	scaled := newHashRing(append(members, "10.0.0.4:11001"))
+	moved := 0
+	for itemId, owner := range before {
+		if now := scaled.Owner(itemId); now != owner {
+			assert.Equal(t, "10.0.0.4:11001", now)
+			moved++
+		}
+	}
+	assert.Greater(t, moved, 0)
+	assert.Less(t, moved, 1500)
+}
+
+// fakeOwnership 模拟多实例归属，owners中记录的道具归属其他实例，handedOff中的道具按哈希环已不归属本实例
+type fakeOwnership struct {
+	owners    map[string]string
+	handedOff map[string]bool
+	released  []string
+}
+
+func (o *fakeOwnership) Acquire(ctx context.Context, itemId string) (string, bool, error) {
+	if owner, ok := o.owners[itemId]; ok {
+		return owner, false, nil
+	}
+	return "self", true, nil
+}
+
+func (o *fakeOwnership) Release(ctx context.Context, itemId string) error {
+	delete(o.owners, itemId)
+	o.released = append(o.released, itemId)
+	return nil
+}
+
+func (o *fakeOwnership) Owns(itemId string) bool {
+	return !o.handedOff[itemId]
+}
+
+func (o *fakeOwnership) Changed() <-chan struct{} {
+	return nil
+}
+
+func (o *fakeOwnership) Lost() <-chan struct{} {
+	return nil
+}
+
+func (o *fakeOwnership) Distributed() bool {
+	return true
+}
+
+func (o *fakeOwnership) Close(ctx context.Context) error {
+	return nil
+}
+
+// 测试用例: 道具归属其他实例时返回归属地址，归属实例宕机后接管并从Redis加载订单
+func TestMatchManager_RouteItem_TakeOver(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	// 检查Redis连接是否正常
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	mgr := getMatchManager()
+	ownership := &fakeOwnership{owners: map[string]string{"test_item_route": "10.0.0.2:11001"}}
+	original := mgr.ownership
+	mgr.ownership = ownership
+	defer func() { mgr.ownership = original }()
+
+	// 其他实例挂在Redis中的卖单
+	routeKey := sellOrderKey("test_route_order")
+	redis.GetRedis().HMSet(ctx, routeKey, map[string]interface{}{
+		"order_id":    "test_route_order",
+		"item_id":     "test_item_route",
+		"quantity":    "4",
+		"price":       "100",
+		"item_info":   "Test Item",
+		"create_time": strconv.FormatInt(time.Now().Unix(), 10),
+		"user_id":     "test_user_route",
+	})
+	redis.GetRedis().SAdd(ctx, sellOrdersKey, routeKey)
+	redis.GetRedis().SAdd(ctx, itemOrdersKey("test_item_route", "sell"), routeKey)
+
+	// 1. 归属其他实例，不在本实例创建撮合单元，直接获取撮合单元和下单返回道具已迁移
+	owner, local, err := mgr.RouteItem(ctx, "test_item_route")
+	assert.NoError(t, err)
+	assert.False(t, local)
+	assert.Equal(t, "10.0.0.2:11001", owner)
+	_, err = mgr.GetMatchUnit("test_item_route")
+	assert.ErrorIs(t, err, errItemMoved)
+	sellResp, err := GetAuctionManager().Sell(context.WithValue(ctx, "userId", "test_user_route"),
+		&auction.SellReq{ItemId: "test_item_route", Quantity: 1, Price: 100, IdempotentId: "test_route_moved"})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_ITEM_MOVED, sellResp.Code)
+	mgr.mu.RLock()
+	_, exists := mgr.matchUnits["test_item_route"]
+	mgr.mu.RUnlock()
+	assert.False(t, exists)
+
+	// 2. 归属实例宕机，租约过期后由本实例接管
+	ownership.Release(ctx, "test_item_route")
+	_, local, err = mgr.RouteItem(ctx, "test_item_route")
+	assert.NoError(t, err)
+	assert.True(t, local)
+
+	mu := testMatchUnit("test_item_route")
+	r := make(chan int, 1)
+	mu.opChannel <- func() {
+		r <- mu.sellOrders.Len()
+	}
+	assert.Equal(t, 1, <-r)
+
+	// 3. 交出撮合单元
+	mgr.dropUnit(ctx, "test_item_route", true)
+	mgr.mu.RLock()
+	_, exists = mgr.matchUnits["test_item_route"]
+	mgr.mu.RUnlock()
+	assert.False(t, exists)
+}
+
+// 测试用例: 撮合协程卡住且操作队列已满时，交出撮合单元在超时后返回，不会阻塞在投递上
+func TestMatchManager_DropUnit_QueueFull(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	ctx := context.Background()
+	if _, err := redis.GetRedis().Ping(ctx).Result(); err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	mgr := getMatchManager()
+	originalTimeout := unitDrainTimeout
+	unitDrainTimeout = 200 * time.Millisecond
+	defer func() { unitDrainTimeout = originalTimeout }()
+
+	mu := testMatchUnit("test_item_drop_full")
+	blocked := make(chan struct{})
+	defer close(blocked)
+	assert.NoError(t, mu.enqueue(ctx, func() { <-blocked }))
+	for mu.enqueue(ctx, func() {}) == nil {
+	}
+
+	done := make(chan struct{})
+	go func() {
+		mgr.dropUnit(ctx, "test_item_drop_full", false)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("dropUnit blocked on full queue")
+	}
+	mgr.mu.RLock()
+	_, exists := mgr.matchUnits["test_item_drop_full"]
+	mgr.mu.RUnlock()
+	assert.False(t, exists)
+}
+
+// 测试用例: 存活实例变化后交出不再归属本实例的撮合单元并释放归属，按道具挂单索引接管新归属本实例且有未成交订单的道具
+func TestMatchManager_Rebalance(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	ctx := context.Background()
+	if _, err := redis.GetRedis().Ping(ctx).Result(); err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	mgr := getMatchManager()
+	ownership := &fakeOwnership{owners: map[string]string{}, handedOff: map[string]bool{"test_item_claim": true}}
+	original := mgr.ownership
+	mgr.ownership = ownership
+	defer func() { mgr.ownership = original }()
+
+	claimKey := sellOrderKey("test_claim_order")
+	redis.GetRedis().HMSet(ctx, claimKey, map[string]interface{}{
+		"order_id":    "test_claim_order",
+		"item_id":     "test_item_claim",
+		"quantity":    "2",
+		"price":       "100",
+		"item_info":   "Test Item",
+		"create_time": strconv.FormatInt(time.Now().Unix(), 10),
+		"user_id":     "test_user_claim",
+	})
+	redis.GetRedis().SAdd(ctx, sellOrdersKey, claimKey)
+	redis.GetRedis().SAdd(ctx, itemOrdersKey("test_item_claim", "sell"), claimKey)
+	redis.GetRedis().SAdd(ctx, orderItemsKey, "test_item_claim")
+	_, local, err := mgr.RouteItem(ctx, "test_item_handoff")
+	assert.NoError(t, err)
+	assert.True(t, local)
+	hasUnit := func(itemId string) bool {
+		mgr.mu.RLock()
+		defer mgr.mu.RUnlock()
+		_, exists := mgr.matchUnits[itemId]
+		return exists
+	}
+
+	// 1. 新实例加入，test_item_handoff改为归属新实例：交出并释放归属，不归属本实例的订单不加载
+	ownership.handedOff["test_item_handoff"] = true
+	assert.True(t, mgr.rebalance(ctx))
+	assert.False(t, hasUnit("test_item_handoff"))
+	assert.False(t, hasUnit("test_item_claim"))
+	assert.Equal(t, []string{"test_item_handoff"}, ownership.released)
+
+	// 2. 实例退出，test_item_claim改为归属本实例，原实例尚未释放时稍后重试
+	delete(ownership.handedOff, "test_item_claim")
+	ownership.owners["test_item_claim"] = "10.0.0.2:11001"
+	assert.False(t, mgr.rebalance(ctx))
+	assert.False(t, hasUnit("test_item_claim"))
+
+	// 3. 原实例释放后接管并加载订单
+	delete(ownership.owners, "test_item_claim")
+	assert.True(t, mgr.rebalance(ctx))
+	if assert.True(t, hasUnit("test_item_claim")) {
+		mu := testMatchUnit("test_item_claim")
+		r := make(chan int, 1)
+		mu.opChannel <- func() {
+			r <- mu.sellOrders.Len()
+		}
+		assert.Equal(t, 1, <-r)
+	}
+}
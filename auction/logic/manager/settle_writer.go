@@ -0,0 +1,195 @@
+package manager
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	defaultSettleQueueSize     = 1024            // 未配置时撮合单元待落库队列长度
+	defaultSettleBatchSize     = 64              // 未配置时每批写入的最大成交和事件数
+	defaultSettleRetryInterval = 100             // 未配置时成交落库失败后首次重试的间隔（毫秒）
+	maxSettleRetryInterval     = 5 * time.Second // 成交落库重试间隔上限
+)
+
+// settleTask 待写入的成交、事件或快照，三者都为nil时为屏障：之前投递的写入全部完成后确认
+type settleTask struct {
+	fill     *matchFill
+	event    *matchEvent
+	snapshot map[string]interface{}
+	ack      chan struct{} // 写入完成后关闭，可为nil
+}
+
+// settleWriter 成交落库协程：撮合协程只负责把成交和事件投递到队列，由写入协程按投递顺序分批写入，
+// 撮合不再等待Redis。确认按投递顺序发出，等待某个屏障即等待此前的所有成交落库、事件写入完成。
+// 队列满时撮合协程阻塞，积压传导到opChannel，由入队方返回市场繁忙
+type settleWriter struct {
+	itemId        string
+	store         matchStore
+	queue         chan *settleTask
+	batchSize     int
+	retryInterval time.Duration
+	pending       atomic.Int64 // 已投递未写入的成交、事件和快照数
+
+	lastEventId string // 最后一条写入事件流的事件ID，只在写入协程内访问（启动前由恢复流程设置）
+}
+
+func newSettleWriter(itemId string, store matchStore) *settleWriter {
+	return &settleWriter{
+		itemId:        itemId,
+		store:         store,
+		queue:         make(chan *settleTask, configInt("auction.settle_queue_size", defaultSettleQueueSize)),
+		batchSize:     configInt("auction.settle_batch_size", defaultSettleBatchSize),
+		retryInterval: time.Duration(configInt("auction.settle_retry_interval", defaultSettleRetryInterval)) * time.Millisecond,
+	}
+}
+
+// submit 投递一笔成交（在撮合协程内调用）
+func (w *settleWriter) submit(fill *matchFill) {
+	w.pending.Add(1)
+	w.queue <- &settleTask{fill: fill}
+}
+
+// submitEvent 投递一条撮合单元事件（在撮合协程内调用）
+func (w *settleWriter) submitEvent(ev *matchEvent) {
+	w.pending.Add(1)
+	w.queue <- &settleTask{event: ev}
+}
+
+// submitSnapshot 投递订单簿快照（在撮合协程内调用），在此前投递的事件写入后保存
+func (w *settleWriter) submitSnapshot(snapshot map[string]interface{}) {
+	w.pending.Add(1)
+	w.queue <- &settleTask{snapshot: snapshot}
+}
+
+// barrier 返回在此前投递的写入全部完成后关闭的channel（在撮合协程内调用）
+func (w *settleWriter) barrier() <-chan struct{} {
+	ack := make(chan struct{})
+	if w.pending.Load() == 0 {
+		close(ack)
+		return ack
+	}
+	w.queue <- &settleTask{ack: ack}
+	return ack
+}
+
+// flush 等待此前投递的成交全部落库（在撮合协程内调用，用于关闭、修改订单等需要读取最新订单数据的操作）
+func (w *settleWriter) flush() {
+	<-w.barrier()
+}
+
+// run 写入协程：ctx取消后处理完队列中已投递的任务再退出；写入使用不随ctx取消的上下文，避免成交写到一半被中断
+func (w *settleWriter) run(ctx context.Context) {
+	writeCtx := context.WithoutCancel(ctx)
+	batch := make([]*settleTask, 0, w.batchSize)
+	for {
+		select {
+		case task := <-w.queue:
+			batch = append(batch[:0], task)
+		case <-ctx.Done():
+			select {
+			case task := <-w.queue:
+				batch = append(batch[:0], task)
+			default:
+				return
+			}
+		}
+	drain:
+		for len(batch) < w.batchSize {
+			select {
+			case task := <-w.queue:
+				batch = append(batch, task)
+			default:
+				break drain
+			}
+		}
+		w.write(writeCtx, batch)
+	}
+}
+
+// write 先按顺序落库一批成交，再按顺序写入事件和快照，最后按顺序发出确认
+func (w *settleWriter) write(ctx context.Context, batch []*settleTask) {
+	fills := make([]*matchFill, 0, len(batch))
+	written := 0
+	for _, task := range batch {
+		if task.fill != nil {
+			fills = append(fills, task.fill)
+		}
+		if task.fill != nil || task.event != nil || task.snapshot != nil {
+			written++
+		}
+	}
+	w.commitFills(ctx, fills)
+
+	// 快照记录此前最后一条事件的ID，遇到快照时先写入之前的事件
+	events := make([]*matchEvent, 0, len(batch))
+	for _, task := range batch {
+		if task.event != nil {
+			events = append(events, task.event)
+		} else if task.snapshot != nil {
+			w.appendEvents(ctx, events)
+			events = events[:0]
+			w.saveSnapshot(ctx, task.snapshot)
+		}
+	}
+	w.appendEvents(ctx, events)
+
+	w.pending.Add(-int64(written))
+	for _, task := range batch {
+		if task.ack != nil {
+			close(task.ack)
+		}
+	}
+}
+
+// commitFills 落库一批成交，失败的成交按原顺序退避重试直到落库（成交以成交ID去重，重试不会重复扣减）。
+// 重试期间不确认后续屏障，下单、撤单等待落库，积压传导到撮合队列，成交不会在Redis故障时被丢弃
+func (w *settleWriter) commitFills(ctx context.Context, fills []*matchFill) {
+	interval := w.retryInterval
+	for len(fills) > 0 {
+		failed := make([]*matchFill, 0)
+		for i, err := range w.store.CommitFills(ctx, fills) {
+			if err != nil {
+				klog.CtxErrorf(ctx, "[AUCTION-SETTLE-WRITER] Commit fill error, retry in %s: transactionId=%s, sellOrder=%s, buyOrder=%s, error: %v",
+					interval, fills[i].TransactionId, fills[i].SellOrderId, fills[i].BuyOrderId, err)
+				failed = append(failed, fills[i])
+				continue
+			}
+			klog.CtxInfof(ctx, "[AUCTION-SETTLE-WRITER] Transaction completed: transactionId=%s, buyOrder=%s, sellOrder=%s, quantity=%d",
+				fills[i].TransactionId, fills[i].BuyOrderId, fills[i].SellOrderId, fills[i].Quantity)
+		}
+		if fills = failed; len(fills) > 0 {
+			time.Sleep(interval)
+			interval = min(interval*2, maxSettleRetryInterval)
+		}
+	}
+}
+
+// appendEvents 一次写入一批事件，失败时只记录日志：事件流缺失的部分由loadOrdersFromRedis以Redis订单数据校正
+func (w *settleWriter) appendEvents(ctx context.Context, events []*matchEvent) {
+	if len(events) == 0 {
+		return
+	}
+	id, err := w.store.AppendEvents(ctx, w.itemId, events)
+	if id != "" {
+		w.lastEventId = id
+	}
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-EVENT-LOG] append events error: itemId=%s, seq=%d-%d, error: %s",
+			w.itemId, events[0].Seq, events[len(events)-1].Seq, err.Error())
+	}
+}
+
+// saveSnapshot 保存订单簿快照，回放从快照记录的事件ID之后开始
+func (w *settleWriter) saveSnapshot(ctx context.Context, snapshot map[string]interface{}) {
+	snapshot["event_id"] = w.lastEventId
+	if err := w.store.SaveSnapshot(ctx, w.itemId, snapshot); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-EVENT-LOG] save snapshot error: itemId=%s, seq=%v, error: %s",
+			w.itemId, snapshot["seq"], err.Error())
+		return
+	}
+	klog.CtxInfof(ctx, "[AUCTION-EVENT-LOG] Snapshot saved: itemId=%s, seq=%v", w.itemId, snapshot["seq"])
+}
@@ -0,0 +1,163 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// gatedMatchStore 落库前等待gate，记录落库顺序和批次大小
+type gatedMatchStore struct {
+	*memoryMatchStore
+	gate      chan struct{}
+	mu        sync.Mutex
+	committed []string
+	batches   []int
+}
+
+func (s *gatedMatchStore) CommitFills(ctx context.Context, fills []*matchFill) []error {
+	<-s.gate
+	s.mu.Lock()
+	for _, fill := range fills {
+		s.committed = append(s.committed, fill.TransactionId)
+	}
+	s.batches = append(s.batches, len(fills))
+	s.mu.Unlock()
+	return s.memoryMatchStore.CommitFills(ctx, fills)
+}
+
+// 测试用例: 成交按投递顺序分批落库，屏障在此前的成交全部落库后才确认
+func TestSettleWriter_OrderedAcks(t *testing.T) {
+	store := &gatedMatchStore{memoryMatchStore: newMemoryMatchStore(), gate: make(chan struct{})}
+	w := newSettleWriter("test_item_settle", store)
+	w.batchSize = 4
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.run(ctx)
+
+	// 没有待落库的成交时屏障立即确认
+	select {
+	case <-w.barrier():
+	default:
+		t.Fatal("barrier without pending fills should be acknowledged")
+	}
+
+	w.submit(&matchFill{TransactionId: "t1"})
+	first := w.barrier()
+	want := []string{"t1"}
+	for i := 2; i <= 10; i++ {
+		id := "t" + strconv.Itoa(i)
+		w.submit(&matchFill{TransactionId: id})
+		want = append(want, id)
+	}
+	last := w.barrier()
+	assert.Equal(t, int64(10), w.pending.Load())
+
+	select {
+	case <-first:
+		t.Fatal("barrier acknowledged before fills committed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(store.gate)
+	select {
+	case <-last:
+	case <-time.After(5 * time.Second):
+		t.Fatal("barrier not acknowledged")
+	}
+	select {
+	case <-first:
+	default:
+		t.Fatal("earlier barrier should be acknowledged first")
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	assert.Equal(t, want, store.committed)
+	for _, n := range store.batches {
+		assert.LessOrEqual(t, n, 4)
+	}
+	assert.Equal(t, int64(0), w.pending.Load())
+	assert.Equal(t, int64(10), store.fills.Load())
+}
+
+// flakyMatchStore 前failures次落库失败，记录事件批次和快照
+type flakyMatchStore struct {
+	*memoryMatchStore
+	mu        sync.Mutex
+	failures  int
+	attempts  int
+	events    [][]int64 // 每批写入的事件序号
+	snapshots []map[string]interface{}
+}
+
+func (s *flakyMatchStore) CommitFills(ctx context.Context, fills []*matchFill) []error {
+	s.mu.Lock()
+	s.attempts++
+	fail := s.attempts <= s.failures
+	s.mu.Unlock()
+	if fail {
+		errs := make([]error, len(fills))
+		for i := range errs {
+			errs[i] = errors.New("redis unavailable")
+		}
+		return errs
+	}
+	return s.memoryMatchStore.CommitFills(ctx, fills)
+}
+
+func (s *flakyMatchStore) AppendEvents(ctx context.Context, itemId string, events []*matchEvent) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	seqs := make([]int64, len(events))
+	for i, ev := range events {
+		seqs[i] = ev.Seq
+	}
+	s.events = append(s.events, seqs)
+	return "id-" + strconv.FormatInt(seqs[len(seqs)-1], 10), nil
+}
+
+func (s *flakyMatchStore) SaveSnapshot(ctx context.Context, itemId string, snapshot map[string]interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots = append(s.snapshots, snapshot)
+	return nil
+}
+
+// 测试用例: 落库失败的成交重试到成功后才确认屏障；事件分批写入，快照记录此前最后一条事件的ID
+func TestSettleWriter_RetryAndEventBatch(t *testing.T) {
+	store := &flakyMatchStore{memoryMatchStore: newMemoryMatchStore(), failures: 2}
+	w := newSettleWriter("test_item_settle", store)
+	w.retryInterval = 10 * time.Millisecond
+
+	w.submit(&matchFill{TransactionId: "t1"})
+	w.submitEvent(&matchEvent{Seq: 1})
+	w.submitEvent(&matchEvent{Seq: 2})
+	w.submitSnapshot(map[string]interface{}{"seq": int64(2)})
+	w.submitEvent(&matchEvent{Seq: 3})
+	ack := w.barrier()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.run(ctx)
+	select {
+	case <-ack:
+	case <-time.After(5 * time.Second):
+		t.Fatal("barrier not acknowledged")
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	assert.Equal(t, 3, store.attempts)
+	assert.Equal(t, int64(1), store.fills.Load())
+	assert.Equal(t, [][]int64{{1, 2}, {3}}, store.events)
+	assert.Len(t, store.snapshots, 1)
+	assert.Equal(t, "id-2", store.snapshots[0]["event_id"])
+	assert.Equal(t, "id-3", w.lastEventId)
+	assert.Equal(t, int64(0), w.pending.Load())
+}
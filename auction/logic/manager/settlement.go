@@ -0,0 +1,297 @@
+package manager
+
+import (
+	"auction_module/config"
+	"auction_module/kitex_gen/common"
+	"auction_module/kitex_gen/item"
+	"auction_module/redis"
+	"auction_module/redis/script"
+	"auction_module/rpc"
+	"auction_module/rpc_middleware"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const (
+	settlementPendingKey    = "auction:{settlement}:pending"    // 待结算任务队列
+	settlementProcessingKey = "auction:{settlement}:processing" // 正在结算的任务队列
+	settlementFailedKey     = "auction:{settlement}:failed"     // 多次重试仍失败的任务，需要人工处理
+	maxSettlementAttempts   = 10                                // 单个结算任务最大重试次数
+	defaultCurrencyItemId   = "2"                               // 未配置时作为货币使用的道具ID
+)
+
+// errItemNotTradable 托管出售的道具不可交易或已绑定
+var errItemNotTradable = errors.New("item not tradable or bound")
+
+// inventoryService 道具/货币托管接口，默认通过item_manager实现，测试时可替换
+type inventoryService interface {
+	// DeleteItem 从用户背包中扣除道具（托管）
+	DeleteItem(ctx context.Context, userId string, itemUniqueId string, count int64, reason string, idempotentId string) error
+	// EscrowItem 从卖家背包中扣除待出售的道具，道具不可交易或已绑定时返回errItemNotTradable
+	EscrowItem(ctx context.Context, userId string, itemUniqueId string, count int64, reason string, idempotentId string) error
+	// AddItem 向用户背包发放道具（成交交付、货款结算、托管退还）
+	AddItem(ctx context.Context, userId string, itemId string, count int64, reason string, idempotentId string) error
+	// GetItem 查询用户背包中的单个道具实例
+	GetItem(ctx context.Context, userId string, itemUniqueId string) (*item.ItemInfo, error)
+	// RestoreItem 将escrowUserId托管的唯一道具实例写回userId的背包（托管退还、成交交付），
+	// item_manager按托管记录校验并写回托管时的属性
+	RestoreItem(ctx context.Context, userId string, escrowUserId string, info *item.ItemInfo, reason string, idempotentId string) error
+}
+
+// itemInventory 基于item_manager RPC的托管实现
+type itemInventory struct{}
+
+var inventory inventoryService = &itemInventory{}
+
+func (i *itemInventory) DeleteItem(ctx context.Context, userId string, itemUniqueId string, count int64, reason string, idempotentId string) error {
+	return i.deleteItem(ctx, userId, itemUniqueId, count, reason, idempotentId, false)
+}
+
+func (i *itemInventory) EscrowItem(ctx context.Context, userId string, itemUniqueId string, count int64, reason string, idempotentId string) error {
+	return i.deleteItem(ctx, userId, itemUniqueId, count, reason, idempotentId, true)
+}
+
+func (i *itemInventory) deleteItem(ctx context.Context, userId string, itemUniqueId string, count int64, reason string, idempotentId string, forTrade bool) error {
+	if count <= 0 || count > math.MaxInt32 {
+		return fmt.Errorf("invalid count: %d", count)
+	}
+
+	ctx = rpc_middleware.SetUserIdToContext(ctx, userId)
+	rsp, err := rpc.ItemClient.DeleteItem(ctx, &item.DeleteItemReq{
+		ItemDeleteList: []*item.ItemDeleteInfo{
+			{ItemUniqueId: itemUniqueId, Count: int32(count)},
+		},
+		OperationReason: reason,
+		IdempotentId:    idempotentId,
+		ForTrade:        forTrade,
+	})
+	if err != nil {
+		return err
+	}
+	switch rsp.GetCode() {
+	case common.ErrorCode_OK:
+	case common.ErrorCode_ITEM_NOT_TRADABLE, common.ErrorCode_ITEM_BOUND:
+		return fmt.Errorf("%w: code=%d", errItemNotTradable, rsp.GetCode())
+	default:
+		return fmt.Errorf("delete item failed: code=%d, msg=%s", rsp.GetCode(), rsp.GetMsg())
+	}
+	return nil
+}
+
+func (i *itemInventory) AddItem(ctx context.Context, userId string, itemId string, count int64, reason string, idempotentId string) error {
+	if count <= 0 || count > math.MaxInt32 {
+		return fmt.Errorf("invalid count: %d", count)
+	}
+	id, err := strconv.ParseInt(itemId, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid item_id: %s", itemId)
+	}
+
+	ctx = rpc_middleware.SetUserIdToContext(ctx, userId)
+	rsp, err := rpc.ItemClient.AddItem(ctx, &item.AddItemReq{
+		ItemAddList: []*item.ItemAddInfo{
+			{ItemId: int32(id), Count: int32(count)},
+		},
+		OperationReason: reason,
+		IdempotentId:    idempotentId,
+	})
+	if err != nil {
+		return err
+	}
+	if rsp.GetCode() != common.ErrorCode_OK {
+		return fmt.Errorf("add item failed: code=%d, msg=%s", rsp.GetCode(), rsp.GetMsg())
+	}
+	// 背包放不下的部分已转入用户邮箱，由用户领取，同样视为已交付
+	if mails := rsp.GetData().GetMailboxList(); len(mails) > 0 {
+		klog.CtxInfof(ctx, "[AUCTION-SETTLEMENT] Item delivered to mailbox: userId=%s, itemId=%s, mailbox=%v, idempotentId=%s",
+			userId, itemId, mails, idempotentId)
+	}
+	return nil
+}
+
+func (i *itemInventory) GetItem(ctx context.Context, userId string, itemUniqueId string) (*item.ItemInfo, error) {
+	ctx = rpc_middleware.SetUserIdToContext(ctx, userId)
+	rsp, err := rpc.ItemClient.GetItem(ctx, &item.GetItemReq{ItemUniqueId: itemUniqueId})
+	if err != nil {
+		return nil, err
+	}
+	if rsp.GetCode() != common.ErrorCode_OK {
+		return nil, fmt.Errorf("get item failed: code=%d, msg=%s", rsp.GetCode(), rsp.GetMsg())
+	}
+	return rsp.GetData().GetItemInfo(), nil
+}
+
+func (i *itemInventory) RestoreItem(ctx context.Context, userId string, escrowUserId string, info *item.ItemInfo, reason string, idempotentId string) error {
+	ctx = rpc_middleware.SetUserIdToContext(ctx, userId)
+	rsp, err := rpc.ItemInternalClient.RestoreItems(ctx, &item.RestoreItemsReq{
+		ItemInfoList:    []*item.ItemInfo{info},
+		OperationReason: reason,
+		IdempotentId:    idempotentId,
+		EscrowUserId:    escrowUserId,
+	})
+	if err != nil {
+		return err
+	}
+	if rsp.GetCode() != common.ErrorCode_OK {
+		return fmt.Errorf("restore item failed: code=%d, msg=%s", rsp.GetCode(), rsp.GetMsg())
+	}
+	return nil
+}
+
+// escrowItemError 托管出售道具失败时返回给客户端的错误码
+func escrowItemError(err error) (common.ErrorCode, string) {
+	if errors.Is(err, errItemNotTradable) {
+		return common.ErrorCode_AUCTION_ITEM_NOT_TRADABLE, "item not tradable"
+	}
+	return common.ErrorCode_AUCTION_ESCROW_FAILED, "escrow item failed"
+}
+
+// currencyItemId 获取作为货币使用的道具ID
+func currencyItemId() string {
+	if v := config.Get("auction.currency_item_id"); v != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return defaultCurrencyItemId
+}
+
+// settlementJob 结算任务，每个任务对应一次道具发放，Id同时作为item_manager的幂等ID
+type settlementJob struct {
+	Id       string `json:"id"`       // 幂等ID
+	UserId   string `json:"user_id"`  // 收款/收货用户
+	ItemId   string `json:"item_id"`  // 发放的道具ID（道具或货币）
+	Count    int64  `json:"count"`    // 发放数量
+	Reason   string `json:"reason"`   // 操作原因
+	Attempts int    `json:"attempts"` // 已重试次数
+
+	// 以下字段仅唯一道具实例的交付/退还使用，按原唯一id与属性写回背包
+	ItemUniqueId string `json:"item_unique_id,omitempty"` // 道具唯一id
+	EscrowUserId string `json:"escrow_user_id,omitempty"` // 托管该实例的用户（卖家），为空时为收货用户
+	ItemType     int32  `json:"item_type,omitempty"`      // 道具类型
+	Properties   string `json:"properties,omitempty"`     // 道具属性（json字符串）
+}
+
+// deliver 执行结算任务：唯一道具实例按原属性写回，其余按道具ID发放
+func (j *settlementJob) deliver(ctx context.Context) error {
+	if j.ItemUniqueId == "" {
+		return inventory.AddItem(ctx, j.UserId, j.ItemId, j.Count, j.Reason, j.Id)
+	}
+	id, err := strconv.ParseInt(j.ItemId, 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid item_id: %s", j.ItemId)
+	}
+	escrowUserId := j.EscrowUserId
+	if escrowUserId == "" {
+		escrowUserId = j.UserId
+	}
+	return inventory.RestoreItem(ctx, j.UserId, escrowUserId, &item.ItemInfo{
+		ItemId:       int32(id),
+		ItemUniqueId: j.ItemUniqueId,
+		ItemType:     j.ItemType,
+		Properties:   j.Properties,
+		Count:        int32(j.Count),
+	}, j.Reason, j.Id)
+}
+
+func (j *settlementJob) encode() string {
+	data, _ := json.Marshal(j)
+	return string(data)
+}
+
+// startSettlementProcess 启动结算协程，消费撮合/取消时写入的结算任务
+func (m *matchManager) startSettlementProcess(ctx context.Context) {
+	// 上次进程退出时未完成的任务重新放回待结算队列（发放操作幂等，重复执行无副作用）
+	for {
+		_, err := redis.GetRedis().LMove(ctx, settlementProcessingKey, settlementPendingKey, "LEFT", "LEFT").Result()
+		if err != nil {
+			if err != goredis.Nil {
+				klog.CtxErrorf(ctx, "[AUCTION-SETTLEMENT] requeue processing jobs error: %s", err.Error())
+			}
+			break
+		}
+	}
+
+	go func(ctx context.Context) {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			m.settlePending(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-m.settleWake:
+			case <-ticker.C:
+				// 投递中断的outbox（投递失败、实例退出），其中的结算任务随后由本协程处理
+				recoverOutboxes(ctx, outboxRecoverAge)
+			}
+		}
+	}(ctx)
+
+	klog.CtxInfof(ctx, "[AUCTION-SETTLEMENT] Settlement process started")
+}
+
+// wakeSettlement 唤醒结算协程（非阻塞）
+func (m *matchManager) wakeSettlement() {
+	select {
+	case m.settleWake <- struct{}{}:
+	default:
+	}
+}
+
+// settlePending 处理待结算队列中的所有任务，返回成功结算的任务数
+func (m *matchManager) settlePending(ctx context.Context) int {
+	settled := 0
+	for ctx.Err() == nil {
+		raw, err := redis.GetRedis().LMove(ctx, settlementPendingKey, settlementProcessingKey, "LEFT", "RIGHT").Result()
+		if err != nil {
+			if err != goredis.Nil {
+				klog.CtxErrorf(ctx, "[AUCTION-SETTLEMENT] fetch job error: %s", err.Error())
+			}
+			return settled
+		}
+
+		job := &settlementJob{}
+		if err := json.Unmarshal([]byte(raw), job); err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-SETTLEMENT] invalid job: %s, error: %s", raw, err.Error())
+			m.finishSettlementJob(ctx, raw, settlementFailedKey, raw)
+			continue
+		}
+
+		if err := job.deliver(ctx); err != nil {
+			job.Attempts++
+			klog.CtxErrorf(ctx, "[AUCTION-SETTLEMENT] settle job error: id=%s, userId=%s, itemId=%s, count=%d, attempts=%d, error: %s",
+				job.Id, job.UserId, job.ItemId, job.Count, job.Attempts, err.Error())
+			if job.Attempts >= maxSettlementAttempts {
+				m.finishSettlementJob(ctx, raw, settlementFailedKey, job.encode())
+				continue
+			}
+			// 放回队尾，等待下一轮重试
+			m.finishSettlementJob(ctx, raw, settlementPendingKey, job.encode())
+			return settled
+		}
+
+		m.finishSettlementJob(ctx, raw, "", "")
+		settled++
+		klog.CtxInfof(ctx, "[AUCTION-SETTLEMENT] Job settled: id=%s, userId=%s, itemId=%s, count=%d",
+			job.Id, job.UserId, job.ItemId, job.Count)
+	}
+	return settled
+}
+
+// finishSettlementJob 从处理中队列移除任务，必要时原子地转移到目标队列
+func (m *matchManager) finishSettlementJob(ctx context.Context, raw string, targetKey string, payload string) {
+	keys := []string{settlementProcessingKey}
+	if targetKey != "" {
+		keys = append(keys, targetKey)
+	}
+	if err := script.Run(ctx, redis.GetRedis(), script.FinishSettlementJob, keys, raw, payload).Err(); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-SETTLEMENT] finish job error: %s", err.Error())
+	}
+}
@@ -0,0 +1,119 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 测试用例: 成交后买家收到道具、卖家收到扣税后的货款，取消求购退还剩余托管货币
+func TestAuctionManager_Settlement_TradeAndCancel(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	// 检查Redis连接是否正常
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	fake := newFakeInventory()
+	inventory = fake
+	defer func() { inventory = newFakeInventory() }()
+
+	buyUserId := "test_user_settle_buyer"
+	sellUserId := "test_user_settle_seller"
+	buyCtx := context.WithValue(ctx, "userId", buyUserId)
+	sellCtx := context.WithValue(ctx, "userId", sellUserId)
+	itemId := "test_item_settle"
+	currency := currencyItemId()
+
+	manager := GetAuctionManager()
+	avgPrice := testMatchUnit(itemId).hourlyAvgPrice
+	buyPrice := avgPrice + 5
+
+	// 1. 挂买单，托管报价*数量的货币
+	buyResp, err := manager.Buy(buyCtx, &auction.BuyReq{
+		ItemId:       itemId,
+		Quantity:     5,
+		Price:        buyPrice,
+		IdempotentId: "test_settle_buy_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, buyResp.Code)
+	assert.Equal(t, -buyPrice*5, fake.balance(buyUserId, currency))
+
+	// 2. 挂卖单，托管道具并与买单部分成交（主动性卖单按买方报价成交）
+	sellResp, err := manager.Sell(sellCtx, &auction.SellReq{
+		ItemId:       itemId,
+		Quantity:     3,
+		Price:        avgPrice,
+		ItemInfo:     "Test Item",
+		IdempotentId: "test_settle_sell_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, sellResp.Code)
+	assert.Equal(t, int64(-3), fake.balance(sellUserId, itemId))
+
+	// 3. 执行结算
+	getMatchManager().settlePending(ctx)
+	amount := buyPrice * 3
+	assert.Equal(t, int64(3), fake.balance(buyUserId, itemId))
+	assert.Equal(t, amount-amount/100, fake.balance(sellUserId, currency))
+
+	// 4. 取消剩余买单，退还剩余托管货币
+	cancelResp, err := manager.CancelBuy(buyCtx, &auction.CancelBuyReq{
+		OrderId:      buyResp.Data.OrderId,
+		IdempotentId: "test_settle_cancel_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, cancelResp.Code)
+	getMatchManager().settlePending(ctx)
+	assert.Equal(t, -amount, fake.balance(buyUserId, currency))
+
+	// 5. 结算队列已清空
+	pending, err := redis.GetRedis().LLen(ctx, settlementPendingKey).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), pending)
+}
+
+// 测试用例: 托管道具失败时不创建卖单
+func TestAuctionManager_Sell_EscrowFailed(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	// 检查Redis连接是否正常
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	fake := newFakeInventory()
+	fake.failDelete = true
+	inventory = fake
+	defer func() { inventory = newFakeInventory() }()
+
+	userId := "test_user_escrow_failed"
+	ctx = context.WithValue(ctx, "userId", userId)
+	mu := testMatchUnit("test_item_escrow")
+
+	resp, err := GetAuctionManager().Sell(ctx, &auction.SellReq{
+		ItemId:       "test_item_escrow",
+		Quantity:     1,
+		Price:        mu.hourlyAvgPrice,
+		ItemInfo:     "Test Item",
+		IdempotentId: "test_escrow_failed_" + strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_ESCROW_FAILED, resp.Code)
+
+	sellCount, err := redis.GetRedis().SCard(ctx, userOrdersKey(userId, "sell")).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), sellCount)
+}
@@ -0,0 +1,446 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"auction_module/redis/script"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	goredis "github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// 个人对账单：成交时按用户、自然日汇总各道具的买卖数量、金额和手续费，查询时只读取日汇总。
+// 上线前的历史成交在用户首次查询时从 user:{<id>}:transactions:time 和成交记录中回填一次，
+// 回填只统计 auction:statement:since 之前的成交，之后的成交由撮合实时汇总，两者不重叠
+
+const (
+	statementSinceKey          = "auction:statement:since" // 开始实时汇总的时间
+	statementDayFormat         = "20060102"                // 日汇总key中的日期格式
+	defaultStatementRetention  = 400                       // 未配置时日汇总保留天数
+	defaultStatementMaxDays    = 366                       // 未配置时单次查询最多跨越的天数
+	defaultStatementRangeDays  = 30                        // 未指定开始时间时统计的天数
+	statementBackfillBatchSize = 100                       // 回填时每批处理的订单数
+)
+
+// 日汇总中的统计字段，field为 <道具ID>|<字段>
+const (
+	statementBuyQty     = "buy_qty"
+	statementBuyAmount  = "buy_amount"
+	statementBuyFee     = "buy_fee"
+	statementSellQty    = "sell_qty"
+	statementSellAmount = "sell_amount"
+	statementSellFee    = "sell_fee"
+)
+
+// statementDaysKey 用户有日汇总的日期（有序集合，member为日期，score为当日0点）
+func statementDaysKey(userId string) string {
+	return userKey(userId, "statement:days")
+}
+
+// statementDayKey 用户单日汇总
+func statementDayKey(userId string, day string) string {
+	return userKey(userId, "statement:"+day)
+}
+
+// statementBackfilledKey 用户历史成交已回填的标记
+func statementBackfilledKey(userId string) string {
+	return userKey(userId, "statement:backfilled")
+}
+
+// dayStart 时间戳所在自然日的0点
+func dayStart(ts int64) time.Time {
+	t := time.Unix(ts, 0)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// statementTTL 日汇总保留时长（秒）
+func statementTTL() int64 {
+	return int64(configInt("auction_statement.retention_days", defaultStatementRetention)) * 86400
+}
+
+// recordStatement 将一笔成交计入买卖双方的日汇总（在撮合协程内调用）
+func recordStatement(ctx context.Context, itemId string, sellerId string, buyerId string, price int64, quantity int32, sellTax int64, buyTax int64, tradeTime int64) {
+	if sellerId == "" || buyerId == "" {
+		return
+	}
+	// 首次汇总时记录实时汇总开始时间
+	if err := redis.GetRedis().SetNX(ctx, statementSinceKey, tradeTime, 0).Err(); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-STATEMENT] record statement since error: %s", err.Error())
+		return
+	}
+
+	// 买卖双方的汇总位于不同的slot，分别计入
+	day := dayStart(tradeTime)
+	for _, side := range []struct {
+		userId    string
+		direction string
+		fee       int64
+	}{
+		{userId: sellerId, direction: "sell", fee: sellTax},
+		{userId: buyerId, direction: "buy", fee: buyTax},
+	} {
+		err := script.Run(ctx, redis.GetRedis(), script.RecordStatement,
+			[]string{statementDayKey(side.userId, day.Format(statementDayFormat)), statementDaysKey(side.userId)},
+			day.Format(statementDayFormat),
+			day.Unix(),
+			statementTTL(),
+			itemId,
+			quantity,
+			price*int64(quantity),
+			side.fee,
+			side.direction,
+		).Err()
+		if err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-STATEMENT] record statement error: itemId=%s, userId=%s, direction=%s, error: %s",
+				itemId, side.userId, side.direction, err.Error())
+		}
+	}
+}
+
+// backfillStatement 将实时汇总开始前的历史成交回填到用户日汇总（每个用户只执行一次）
+func backfillStatement(ctx context.Context, userId string) error {
+	markerKey := statementBackfilledKey(userId)
+	ok, err := redis.GetRedis().SetNX(ctx, markerKey, time.Now().Unix(), 0).Result()
+	if err != nil || !ok {
+		return err
+	}
+	// 回填失败时清除标记，下次查询重试
+	done := false
+	defer func() {
+		if !done {
+			redis.GetRedis().Del(ctx, markerKey)
+		}
+	}()
+
+	redis.GetRedis().SetNX(ctx, statementSinceKey, time.Now().Unix(), 0)
+	since, err := redis.GetRedis().Get(ctx, statementSinceKey).Int64()
+	if err != nil {
+		return err
+	}
+	orderIds, err := redis.GetRedis().ZRangeByScore(ctx, userTransactionsTimeKey(userId), &goredis.ZRangeBy{
+		Min: "-inf",
+		Max: "(" + strconv.FormatInt(since, 10),
+	}).Result()
+	if err != nil {
+		return err
+	}
+
+	// days[日期][字段] 累计值
+	days := make(map[string]map[string]int64)
+	add := func(tradeTime int64, field string, value int64) {
+		if value == 0 {
+			return
+		}
+		day := dayStart(tradeTime).Format(statementDayFormat)
+		if days[day] == nil {
+			days[day] = make(map[string]int64)
+		}
+		days[day][field] += value
+	}
+
+	for begin := 0; begin < len(orderIds); begin += statementBackfillBatchSize {
+		batch := orderIds[begin:min(begin+statementBackfillBatchSize, len(orderIds))]
+		pipe := redis.GetRedis().Pipeline()
+		statusCmds := make([]*goredis.SliceCmd, 0, len(batch))
+		txCmds := make([]*goredis.StringSliceCmd, 0, len(batch))
+		for _, orderId := range batch {
+			statusCmds = append(statusCmds, pipe.HMGet(ctx, orderStatusKey(orderId), "trade_direction", "item_id", "tax", "final_quantity"))
+			txCmds = append(txCmds, pipe.SMembers(ctx, orderTransactionsKey(orderId)))
+		}
+		if _, err = pipe.Exec(ctx); err != nil && err != goredis.Nil {
+			return err
+		}
+
+		for i := range batch {
+			status := statusCmds[i].Val()
+			if len(status) < 4 || status[0] == nil || status[1] == nil {
+				continue
+			}
+			direction, itemId := fmt.Sprint(status[0]), fmt.Sprint(status[1])
+			orderTax, orderQty := parseInt64(fmt.Sprint(status[2])), parseInt64(fmt.Sprint(status[3]))
+			transactionIds := txCmds[i].Val()
+			if len(transactionIds) == 0 {
+				continue
+			}
+
+			txPipe := redis.GetRedis().Pipeline()
+			fillCmds := make([]*goredis.SliceCmd, 0, len(transactionIds))
+			for _, transactionId := range transactionIds {
+				fillCmds = append(fillCmds, txPipe.HMGet(ctx, transactionKey(transactionId),
+					"price", "quantity", "transaction_time", "sell_tax", "buy_tax"))
+			}
+			if _, err = txPipe.Exec(ctx); err != nil && err != goredis.Nil {
+				return err
+			}
+
+			qtyField, amountField, feeField, taxField := statementBuyQty, statementBuyAmount, statementBuyFee, 4
+			if direction == "sell" {
+				qtyField, amountField, feeField, taxField = statementSellQty, statementSellAmount, statementSellFee, 3
+			}
+			for _, cmd := range fillCmds {
+				fill := cmd.Val()
+				if len(fill) < 5 || fill[0] == nil {
+					continue
+				}
+				tradeTime := parseInt64(fmt.Sprint(fill[2]))
+				if tradeTime >= since {
+					continue
+				}
+				price, quantity := parseInt64(fmt.Sprint(fill[0])), parseInt64(fmt.Sprint(fill[1]))
+				add(tradeTime, itemId+"|"+qtyField, quantity)
+				add(tradeTime, itemId+"|"+amountField, price*quantity)
+				// 早期成交记录没有拆分买卖双方的手续费，按成交数量分摊订单累计手续费
+				if fill[taxField] != nil {
+					add(tradeTime, itemId+"|"+feeField, parseInt64(fmt.Sprint(fill[taxField])))
+				} else if orderQty > 0 {
+					add(tradeTime, itemId+"|"+feeField, orderTax*quantity/orderQty)
+				}
+			}
+		}
+	}
+
+	if len(days) > 0 {
+		ttl := statementTTL()
+		_, err = redis.GetRedis().TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+			for day, fields := range days {
+				start, _ := time.ParseInLocation(statementDayFormat, day, time.Local)
+				if start.Unix()+ttl <= time.Now().Unix() {
+					continue
+				}
+				dayKey := statementDayKey(userId, day)
+				for field, value := range fields {
+					pipe.HIncrBy(ctx, dayKey, field, value)
+				}
+				pipe.Expire(ctx, dayKey, time.Duration(start.Unix()+ttl-time.Now().Unix())*time.Second)
+				pipe.ZAdd(ctx, statementDaysKey(userId), goredis.Z{Score: float64(start.Unix()), Member: day})
+			}
+			pipe.ZRemRangeByScore(ctx, statementDaysKey(userId), "-inf", "("+strconv.FormatInt(time.Now().Unix()-ttl, 10))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	klog.CtxInfof(ctx, "[AUCTION-STATEMENT] Backfill statement: userId=%s, orders=%d, days=%d", userId, len(orderIds), len(days))
+	done = true
+	return nil
+}
+
+// buildStatement 读取日汇总生成对账单：统计区间内的买卖和手续费，已实现盈亏按截至结束日的平均买入成本计算
+func buildStatement(ctx context.Context, userId string, startTime int64, endTime int64) (*auction.AuctionStatement, error) {
+	if err := backfillStatement(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	start := dayStart(startTime).Unix()
+	days, err := redis.GetRedis().ZRangeByScoreWithScores(ctx, statementDaysKey(userId), &goredis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(endTime, 10),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	pipe := redis.GetRedis().Pipeline()
+	cmds := make([]*goredis.MapStringStringCmd, 0, len(days))
+	for _, day := range days {
+		cmds = append(cmds, pipe.HGetAll(ctx, statementDayKey(userId, day.Member.(string))))
+	}
+	if len(cmds) > 0 {
+		if _, err = pipe.Exec(ctx); err != nil && err != goredis.Nil {
+			return nil, err
+		}
+	}
+
+	// inRange[道具][字段] 为统计区间内的累计值，total[道具][字段] 为截至结束日的累计值（用于买入成本）
+	inRange := make(map[string]map[string]int64)
+	total := make(map[string]map[string]int64)
+	accumulate := func(m map[string]map[string]int64, itemId string, field string, value int64) {
+		if m[itemId] == nil {
+			m[itemId] = make(map[string]int64)
+		}
+		m[itemId][field] += value
+	}
+	for i, day := range days {
+		for key, value := range cmds[i].Val() {
+			itemId, field, ok := strings.Cut(key, "|")
+			if !ok {
+				continue
+			}
+			v := parseInt64(value)
+			accumulate(total, itemId, field, v)
+			if int64(day.Score) >= start {
+				accumulate(inRange, itemId, field, v)
+			}
+		}
+	}
+
+	statement := &auction.AuctionStatement{StartTime: start, EndTime: endTime, Items: make([]*auction.StatementItem, 0, len(inRange))}
+	for itemId, fields := range inRange {
+		item := &auction.StatementItem{
+			ItemId:       itemId,
+			BuyQuantity:  fields[statementBuyQty],
+			BuyAmount:    fields[statementBuyAmount],
+			SellQuantity: fields[statementSellQty],
+			SellAmount:   fields[statementSellAmount],
+			Fee:          fields[statementBuyFee] + fields[statementSellFee],
+		}
+		if item.BuyQuantity > 0 {
+			item.AvgBuyPrice = item.BuyAmount / item.BuyQuantity
+		}
+		if item.SellQuantity > 0 {
+			item.AvgSellPrice = item.SellAmount / item.SellQuantity
+			// 非拍卖买入的道具没有买入成本
+			item.RealizedPnl = item.SellAmount - fields[statementSellFee]
+			if cost := total[itemId]; cost[statementBuyQty] > 0 {
+				item.RealizedPnl -= item.SellQuantity * (cost[statementBuyAmount] + cost[statementBuyFee]) / cost[statementBuyQty]
+			}
+		}
+		statement.Items = append(statement.Items, item)
+		statement.TotalBuyAmount += item.BuyAmount
+		statement.TotalSellAmount += item.SellAmount
+		statement.TotalFee += item.Fee
+		statement.TotalRealizedPnl += item.RealizedPnl
+	}
+	sort.Slice(statement.Items, func(i, j int) bool {
+		return statement.Items[i].ItemId < statement.Items[j].ItemId
+	})
+	return statement, nil
+}
+
+// statementRange 校验并补全对账单时间范围
+func statementRange(startTime int64, endTime int64) (int64, int64, error) {
+	if startTime < 0 || endTime < 0 {
+		return 0, 0, fmt.Errorf("invalid time range")
+	}
+	if endTime == 0 {
+		endTime = time.Now().Unix()
+	}
+	if startTime == 0 {
+		startTime = endTime - defaultStatementRangeDays*86400
+	}
+	if startTime > endTime {
+		return 0, 0, fmt.Errorf("start_time is later than end_time")
+	}
+	if maxDays := int64(configInt("auction_statement.max_range_days", defaultStatementMaxDays)); endTime-startTime > maxDays*86400 {
+		return 0, 0, fmt.Errorf("time range exceeds %d days", maxDays)
+	}
+	return startTime, endTime, nil
+}
+
+// GetAuctionStatement 获取个人对账单
+func (m *AuctionManager) GetAuctionStatement(ctx context.Context, req *auction.GetAuctionStatementReq) (resp *auction.GetAuctionStatementRsp, err error) {
+	resp = &auction.GetAuctionStatementRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	userId := ""
+	if val, ok := ctx.Value("userId").(string); ok {
+		userId = val
+	}
+	if userId == "" {
+		resp.Code = common.ErrorCode_AUCTION_USER_NOT_FOUND
+		resp.Msg = "user_id is empty"
+		return
+	}
+	startTime, endTime, err := statementRange(req.GetStartTime(), req.GetEndTime())
+	if err != nil {
+		resp.Msg = err.Error()
+		err = nil
+		return
+	}
+
+	statement, err := buildStatement(ctx, userId, startTime, endTime)
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-STATEMENT] build statement error: userId=%s, error: %s", userId, err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "get statement error"
+		err = nil
+		return
+	}
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	resp.Data = statement
+	return
+}
+
+// statementCSV 将对账单渲染为CSV，最后一行为合计
+func statementCSV(statement *auction.AuctionStatement) (string, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	records := [][]string{{"item_id", "buy_quantity", "buy_amount", "avg_buy_price", "sell_quantity", "sell_amount", "avg_sell_price", "fee", "realized_pnl"}}
+	for _, item := range statement.GetItems() {
+		records = append(records, []string{
+			item.ItemId,
+			strconv.FormatInt(item.BuyQuantity, 10),
+			strconv.FormatInt(item.BuyAmount, 10),
+			strconv.FormatInt(item.AvgBuyPrice, 10),
+			strconv.FormatInt(item.SellQuantity, 10),
+			strconv.FormatInt(item.SellAmount, 10),
+			strconv.FormatInt(item.AvgSellPrice, 10),
+			strconv.FormatInt(item.Fee, 10),
+			strconv.FormatInt(item.RealizedPnl, 10),
+		})
+	}
+	records = append(records, []string{
+		"total", "", strconv.FormatInt(statement.TotalBuyAmount, 10), "", "", strconv.FormatInt(statement.TotalSellAmount, 10), "",
+		strconv.FormatInt(statement.TotalFee, 10), strconv.FormatInt(statement.TotalRealizedPnl, 10),
+	})
+	if err := w.WriteAll(records); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// ExportAuctionStatement 导出个人对账单（CSV或JSON）
+func (m *AuctionManager) ExportAuctionStatement(ctx context.Context, req *auction.ExportAuctionStatementReq) (resp *auction.ExportAuctionStatementRsp, err error) {
+	resp = &auction.ExportAuctionStatementRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	format := strings.ToLower(req.GetFormat())
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "json" {
+		resp.Msg = "format must be csv or json"
+		return
+	}
+
+	stmtResp, err := m.GetAuctionStatement(ctx, &auction.GetAuctionStatementReq{StartTime: req.GetStartTime(), EndTime: req.GetEndTime()})
+	if err != nil || stmtResp.GetCode() != common.ErrorCode_OK {
+		resp.Code, resp.Msg = stmtResp.GetCode(), stmtResp.GetMsg()
+		return
+	}
+	statement := stmtResp.GetData()
+
+	fileName := fmt.Sprintf("auction_statement_%s_%s", time.Unix(statement.StartTime, 0).Format(statementDayFormat),
+		time.Unix(statement.EndTime, 0).Format(statementDayFormat))
+	if format == "csv" {
+		content, err := statementCSV(statement)
+		if err != nil {
+			resp.Msg = err.Error()
+			return resp, nil
+		}
+		resp.FileName, resp.ContentType, resp.Content = fileName+".csv", "text/csv; charset=utf-8", content
+	} else {
+		content, err := protojson.MarshalOptions{EmitUnpopulated: true, UseProtoNames: true}.Marshal(statement)
+		if err != nil {
+			resp.Msg = err.Error()
+			return resp, nil
+		}
+		resp.FileName, resp.ContentType, resp.Content = fileName+".json", "application/json", string(content)
+	}
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	return
+}
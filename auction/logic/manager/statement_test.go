@@ -0,0 +1,129 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// 测试用例: 个人对账单由成交实时汇总和历史成交回填组成，支持CSV/JSON导出
+func TestAuctionManager_Statement(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	fake := newFakeInventory()
+	inventory = fake
+	defer func() { inventory = newFakeInventory() }()
+
+	manager := GetAuctionManager()
+	userCtx := func(userId string) context.Context { return context.WithValue(ctx, "userId", userId) }
+	seq := 0
+	idem := func() string {
+		seq++
+		return fmt.Sprintf("test_stmt_%d_%d", time.Now().UnixNano(), seq)
+	}
+	statementOf := func(userId string) *auction.AuctionStatement {
+		resp, err := manager.GetAuctionStatement(userCtx(userId), &auction.GetAuctionStatementReq{})
+		assert.NoError(t, err)
+		assert.Equal(t, common.ErrorCode_OK, resp.Code, resp.Msg)
+		return resp.Data
+	}
+
+	// 1. 实时汇总开始前的历史成交在首次查询时回填，早期成交按数量分摊订单手续费
+	now := time.Now().Unix()
+	legacyTime := now - 2*86400
+	redis.GetRedis().Set(ctx, statementSinceKey, now-86400, 0)
+	legacyUser, legacyItem := "test_stmt_legacy", "test_item_stmt_old"
+	redis.GetRedis().HSet(ctx, orderStatusKey("legacy_sell"), "trade_direction", "sell", "item_id", legacyItem, "tax", 3, "final_quantity", 3)
+	redis.GetRedis().SAdd(ctx, orderTransactionsKey("legacy_sell"), "legacy_tx1", "legacy_tx2")
+	redis.GetRedis().HSet(ctx, transactionKey("legacy_tx1"), "price", 50, "quantity", 1, "transaction_time", legacyTime)
+	redis.GetRedis().HSet(ctx, transactionKey("legacy_tx2"), "price", 60, "quantity", 2, "transaction_time", legacyTime)
+	redis.GetRedis().HSet(ctx, orderStatusKey("legacy_buy"), "trade_direction", "buy", "item_id", legacyItem, "tax", 4, "final_quantity", 2)
+	redis.GetRedis().SAdd(ctx, orderTransactionsKey("legacy_buy"), "legacy_tx3")
+	redis.GetRedis().HSet(ctx, transactionKey("legacy_tx3"), "price", 40, "quantity", 2, "sell_tax", 0, "buy_tax", 4, "transaction_time", legacyTime)
+	redis.GetRedis().ZAdd(ctx, userTransactionsTimeKey(legacyUser),
+		goredis.Z{Score: float64(legacyTime), Member: "legacy_sell"}, goredis.Z{Score: float64(legacyTime), Member: "legacy_buy"})
+
+	statement := statementOf(legacyUser)
+	if assert.Len(t, statement.Items, 1) {
+		item := statement.Items[0]
+		assert.Equal(t, legacyItem, item.ItemId)
+		assert.Equal(t, []int64{2, 80, 40}, []int64{item.BuyQuantity, item.BuyAmount, item.AvgBuyPrice})
+		assert.Equal(t, []int64{3, 170, 56}, []int64{item.SellQuantity, item.SellAmount, item.AvgSellPrice})
+		assert.Equal(t, int64(7), item.Fee)
+		assert.Equal(t, int64(170-3-3*84/2), item.RealizedPnl)
+	}
+	// 回填只执行一次
+	assert.Equal(t, statement.TotalFee, statementOf(legacyUser).TotalFee)
+
+	// 2. 成交实时计入买卖双方的日汇总，已实现盈亏按平均买入成本计算
+	itemId := "test_item_stmt"
+	sell := func(userId string, quantity int32, price int64) {
+		resp, err := manager.Sell(userCtx(userId), &auction.SellReq{ItemId: itemId, Quantity: quantity, Price: price, IdempotentId: idem()})
+		assert.NoError(t, err)
+		assert.Equal(t, common.ErrorCode_OK, resp.Code, resp.Msg)
+	}
+	buy := func(userId string, quantity int32, price int64) {
+		resp, err := manager.Buy(userCtx(userId), &auction.BuyReq{ItemId: itemId, Quantity: quantity, Price: price, IdempotentId: idem()})
+		assert.NoError(t, err)
+		assert.Equal(t, common.ErrorCode_OK, resp.Code, resp.Msg)
+	}
+	sell("test_stmt_seller", 2, 100)
+	buy("test_stmt_trader", 2, 100)
+	buy("test_stmt_buyer", 1, 110)
+	sell("test_stmt_trader", 1, 110)
+
+	statement = statementOf("test_stmt_trader")
+	if assert.Len(t, statement.Items, 1) {
+		item := statement.Items[0]
+		assert.Equal(t, []int64{2, 200, 100}, []int64{item.BuyQuantity, item.BuyAmount, item.AvgBuyPrice})
+		assert.Equal(t, []int64{1, 110, 110}, []int64{item.SellQuantity, item.SellAmount, item.AvgSellPrice})
+		assert.Equal(t, int64(1), item.Fee)
+		assert.Equal(t, int64(9), item.RealizedPnl)
+	}
+	statement = statementOf("test_stmt_seller")
+	assert.Equal(t, int64(200), statement.TotalSellAmount)
+	assert.Equal(t, int64(2), statement.TotalFee)
+	assert.Equal(t, int64(198), statement.TotalRealizedPnl)
+
+	// 3. 导出CSV和JSON
+	exportResp, err := manager.ExportAuctionStatement(userCtx("test_stmt_trader"), &auction.ExportAuctionStatementReq{})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, exportResp.Code, exportResp.Msg)
+	assert.True(t, strings.HasSuffix(exportResp.FileName, ".csv"))
+	assert.Equal(t, "item_id,buy_quantity,buy_amount,avg_buy_price,sell_quantity,sell_amount,avg_sell_price,fee,realized_pnl\n"+
+		itemId+",2,200,100,1,110,110,1,9\ntotal,,200,,,110,,1,9\n", exportResp.Content)
+
+	exportResp, err = manager.ExportAuctionStatement(userCtx("test_stmt_trader"), &auction.ExportAuctionStatementReq{Format: "json"})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, exportResp.Code, exportResp.Msg)
+	exported := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal([]byte(exportResp.Content), &exported))
+	assert.Equal(t, "9", exported["total_realized_pnl"])
+
+	exportResp, err = manager.ExportAuctionStatement(userCtx("test_stmt_trader"), &auction.ExportAuctionStatementReq{Format: "xml"})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_PARAM_ERROR, exportResp.Code)
+
+	// 4. 非法时间范围
+	resp, err := manager.GetAuctionStatement(userCtx("test_stmt_trader"), &auction.GetAuctionStatementReq{StartTime: now, EndTime: now - 1})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_PARAM_ERROR, resp.Code)
+	resp, err = manager.GetAuctionStatement(userCtx("test_stmt_trader"), &auction.GetAuctionStatementReq{StartTime: now - 400*86400, EndTime: now})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_PARAM_ERROR, resp.Code)
+}
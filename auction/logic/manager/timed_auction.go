@@ -0,0 +1,573 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/redis"
+	"auction_module/redis/script"
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// 限时拍卖（英式拍卖）：卖家托管一批道具或一个唯一道具实例，设置起拍价、最小加价幅度、可选的一口价和结束时间，
+// 玩家依次加价，出价被托管，被超过时退还；结束前出价会延长拍卖防止狙击；到期由调度协程结算给最高出价者。
+// 拍卖状态全部保存在Redis中并通过Lua脚本原子修改，调度协程按结束时间索引扫描，进程重启后自动继续处理。
+const (
+	timedAuctionKeyPrefix = "auction:timed:"      // 拍卖hash：auction:timed:{auctionId}
+	timedItemIndexPrefix  = "auction:timed:item:" // 道具竞拍中的拍卖索引（ZSET，score为结束时间）
+	timedEndKey           = "auction:timed:end"   // 拍卖结束时间索引（ZSET，score为结束时间）
+	timedClosedTTL        = 7 * 24 * 3600         // 结束的拍卖保留时间（秒）
+	timedCloseBatch       = 100                   // 调度协程每批结算的拍卖数量
+	timedListLimit        = 20                    // 查询默认每页数量
+	timedListMaxLimit     = 100                   // 查询最大每页数量
+)
+
+func timedAuctionKey(auctionId string) string {
+	return timedAuctionKeyPrefix + "{" + auctionId + "}"
+}
+
+// timedOutboxKey 拍卖的outbox，索引、用户列表和结算任务位于其他slot，通过outbox写入
+func timedOutboxKey(auctionId string) string {
+	return timedAuctionKey(auctionId) + ":outbox"
+}
+
+func timedItemIndexKey(itemId string) string {
+	return timedItemIndexPrefix + itemId
+}
+
+func userTimedAuctionsKey(userId string) string {
+	return userKey(userId, "timed_auctions")
+}
+
+// TimedAuctionManager 限时拍卖管理器，与AuctionManager共用用户锁和幂等处理
+type TimedAuctionManager struct {
+	auctionMgr *AuctionManager
+	ctx        context.Context
+	cancel     context.CancelFunc
+}
+
+var (
+	timedAuctionManager *TimedAuctionManager
+	timedOnce           sync.Once
+)
+
+func GetTimedAuctionManager() *TimedAuctionManager {
+	timedOnce.Do(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		timedAuctionManager = &TimedAuctionManager{
+			auctionMgr: GetAuctionManager(),
+			ctx:        ctx,
+			cancel:     cancel,
+		}
+
+		// 启动结算调度协程
+		go timedAuctionManager.runScheduler(ctx)
+
+		klog.CtxInfof(ctx, "[AUCTION-TIMED] TimedAuctionManager initialized")
+	})
+	return timedAuctionManager
+}
+
+// Close 停止结算调度协程，未结算的拍卖由其他实例或重启后的进程继续处理
+func (m *TimedAuctionManager) Close() {
+	m.cancel()
+}
+
+// timedAuctionFromMap 将拍卖hash转换为协议结构
+func timedAuctionFromMap(data map[string]string) *auction.TimedAuction {
+	return &auction.TimedAuction{
+		AuctionId:     data["auction_id"],
+		SellerId:      data["seller_id"],
+		ItemId:        data["item_id"],
+		Quantity:      int32(parseInt(data["quantity"])),
+		ItemUniqueId:  data["item_unique_id"],
+		Properties:    data["properties"],
+		StartPrice:    parseInt64(data["start_price"]),
+		MinIncrement:  parseInt64(data["min_increment"]),
+		BuyoutPrice:   parseInt64(data["buyout_price"]),
+		CreateTime:    parseInt64(data["create_time"]),
+		EndTime:       parseInt64(data["end_time"]),
+		Status:        auction.TimedAuctionStatus(parseInt(data["status"])),
+		HighestBidder: data["bid_user_id"],
+		HighestBid:    parseInt64(data["bid_price"]),
+		BidCount:      int32(parseInt(data["bid_count"])),
+		WinnerId:      data["winner_id"],
+		FinalPrice:    parseInt64(data["final_price"]),
+	}
+}
+
+// getTimedAuction 读取拍卖，不存在时返回nil
+func getTimedAuction(ctx context.Context, auctionId string) (*auction.TimedAuction, error) {
+	data, err := redis.GetRedis().HGetAll(ctx, timedAuctionKey(auctionId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return timedAuctionFromMap(data), nil
+}
+
+// notifyTimedAuction 推送限时拍卖事件
+func notifyTimedAuction(ctx context.Context, userId string, a *auction.TimedAuction, event string) {
+	if userId == "" {
+		return
+	}
+	ntf := &auction.AuctionTimedNtf{Auction: a, Event: event}
+	if err := notifier.Notify(ctx, userId, ntf); err != nil {
+		klog.CtxWarnf(ctx, "[AUCTION-TIMED] notify user error: userId=%s, auctionId=%s, event=%s, error: %s",
+			userId, a.GetAuctionId(), event, err.Error())
+	}
+}
+
+// notifyTimedClosed 拍卖结束后通知卖家和成交买家
+func notifyTimedClosed(ctx context.Context, a *auction.TimedAuction) {
+	switch a.GetStatus() {
+	case auction.TimedAuctionStatus_TIMED_SOLD:
+		notifyTimedAuction(ctx, a.GetSellerId(), a, "sold")
+		notifyTimedAuction(ctx, a.GetWinnerId(), a, "won")
+	case auction.TimedAuctionStatus_TIMED_UNSOLD:
+		notifyTimedAuction(ctx, a.GetSellerId(), a, "unsold")
+	}
+}
+
+// minTimedBid 计算拍卖当前可接受的最低出价（不超过一口价）
+func minTimedBid(a *auction.TimedAuction) int64 {
+	minBid := a.GetStartPrice()
+	if a.GetHighestBidder() != "" {
+		minBid = a.GetHighestBid() + a.GetMinIncrement()
+	}
+	if a.GetBuyoutPrice() > 0 && minBid > a.GetBuyoutPrice() {
+		minBid = a.GetBuyoutPrice()
+	}
+	return minBid
+}
+
+// evalTimedScript 执行拍卖脚本，返回脚本状态、附加信息和执行后的拍卖
+func evalTimedScript(ctx context.Context, name string, auctionId string, itemId string, args ...interface{}) (status string, extra string, a *auction.TimedAuction, err error) {
+	keys := []string{timedAuctionKey(auctionId), timedOutboxKey(auctionId)}
+	args = append(args, timedEndKey, timedItemIndexKey(itemId))
+	val, err := runWithOutbox(ctx, name, keys, args...).Result()
+	if err != nil {
+		return "", "", nil, err
+	}
+	status, extra, data := parseUniqueScriptResult(val)
+	if len(data) > 0 {
+		a = timedAuctionFromMap(data)
+	}
+	return status, extra, a, nil
+}
+
+// timedScriptError 将拍卖脚本的失败状态转换为错误码
+func timedScriptError(status string) (common.ErrorCode, string) {
+	if status == "has_bids" {
+		return common.ErrorCode_AUCTION_AUCTION_HAS_BIDS, "auction has bids"
+	}
+	code, msg := uniqueScriptError(status)
+	if code == common.ErrorCode_AUCTION_LISTING_NOT_FOUND {
+		msg = "auction not found or closed"
+	}
+	return code, msg
+}
+
+// withIdempotency 限时拍卖写操作的公共流程，重放请求时返回拍卖当前状态
+func (m *TimedAuctionManager) withIdempotency(ctx context.Context, logTag string, userId string, idempotentId string,
+	op func() (*auction.TimedAuction, common.ErrorCode, string)) (a *auction.TimedAuction, code common.ErrorCode, msg string) {
+	auctionId, replay, code, msg := m.auctionMgr.withIdempotency(ctx, logTag, userId, idempotentId, func() (string, common.ErrorCode, string) {
+		var opCode common.ErrorCode
+		var opMsg string
+		a, opCode, opMsg = op()
+		return a.GetAuctionId(), opCode, opMsg
+	})
+	if replay && code == common.ErrorCode_OK && auctionId != "" {
+		var err error
+		if a, err = getTimedAuction(ctx, auctionId); err != nil {
+			klog.CtxErrorf(ctx, "%s Load auction error: %s", logTag, err.Error())
+		}
+		if a == nil {
+			a = &auction.TimedAuction{AuctionId: auctionId}
+		}
+	}
+	return
+}
+
+// CreateTimedAuction 创建限时拍卖
+func (m *TimedAuctionManager) CreateTimedAuction(ctx context.Context, req *auction.CreateTimedAuctionReq) (resp *auction.CreateTimedAuctionRsp, err error) {
+	userId, _ := ctx.Value("userId").(string)
+	resp = &auction.CreateTimedAuctionRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	resp.Data, resp.Code, resp.Msg = m.withIdempotency(ctx, "[AUCTION-TIMED-CREATE]", userId, req.GetIdempotentId(),
+		func() (*auction.TimedAuction, common.ErrorCode, string) {
+			return m.createTimedAuction(ctx, userId, req)
+		})
+	return
+}
+
+func (m *TimedAuctionManager) createTimedAuction(ctx context.Context, userId string, req *auction.CreateTimedAuctionReq) (*auction.TimedAuction, common.ErrorCode, string) {
+	now := time.Now().Unix()
+	if req.GetEndTime() < now+configSeconds("auction_timed.min_duration", 60) ||
+		req.GetEndTime() > now+configSeconds("auction_timed.max_duration", 7*24*3600) {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "invalid end_time"
+	}
+	if req.GetStartPrice() <= 0 || req.GetMinIncrement() <= 0 {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "start_price and min_increment must be greater than 0"
+	}
+	if req.GetBuyoutPrice() != 0 && req.GetBuyoutPrice() <= req.GetStartPrice() {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "buyout_price must be greater than start_price"
+	}
+
+	// 确定拍卖的道具：指定唯一id时拍卖该唯一道具实例，否则拍卖一批非唯一道具
+	itemId, quantity, escrowUniqueId := req.GetItemId(), req.GetQuantity(), req.GetItemId()
+	var itemType int32
+	var properties string
+	if req.GetItemUniqueId() != "" {
+		info, err := inventory.GetItem(ctx, userId, req.GetItemUniqueId())
+		if err != nil {
+			klog.CtxInfof(ctx, "[AUCTION-TIMED-CREATE] Get item error, userId: %s, itemUniqueId: %s, error: %s", userId, req.GetItemUniqueId(), err.Error())
+			return nil, common.ErrorCode_AUCTION_ESCROW_FAILED, "item not found"
+		}
+		if !isUniqueInstance(info) {
+			return nil, common.ErrorCode_AUCTION_ITEM_NOT_UNIQUE, "item is not a unique instance"
+		}
+		itemId, quantity, escrowUniqueId = strconv.Itoa(int(info.GetItemId())), 1, info.GetItemUniqueId()
+		itemType, properties = info.GetItemType(), info.GetProperties()
+	} else if itemId == "" || quantity <= 0 {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "item_id is empty or quantity must be greater than 0"
+	}
+
+	rule := getItemRule(itemId)
+	if quantity < rule.MinQuantity && req.GetItemUniqueId() == "" {
+		return nil, common.ErrorCode_AUCTION_QUANTITY_TOO_SMALL, "quantity too small"
+	}
+	if !rule.onTick(req.GetStartPrice()) || !rule.onTick(req.GetMinIncrement()) || !rule.onTick(req.GetBuyoutPrice()) {
+		return nil, common.ErrorCode_AUCTION_PRICE_TICK_INVALID, "price is not a multiple of tick size"
+	}
+	if isItemHalted(ctx, itemId) {
+		return nil, common.ErrorCode_AUCTION_TRADING_HALTED, "trading halted"
+	}
+
+	// 单个用户同时进行的拍卖数量受出售挂单上限限制
+	auctionCount, err := redis.GetRedis().SCard(ctx, userTimedAuctionsKey(userId)).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-TIMED-CREATE] Get user auctions count error: %s", err.Error())
+		return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "get user auctions count error"
+	}
+	if int32(auctionCount) >= rule.MaxSellOrders {
+		return nil, common.ErrorCode_AUCTION_ORDER_LIMIT_EXCEEDED, "auction count exceeds limit"
+	}
+
+	// 托管拍卖道具：以拍卖ID作为幂等ID从卖家背包中扣除
+	auctionId := idClient.Generate().String()
+	if err := inventory.EscrowItem(ctx, userId, escrowUniqueId, int64(quantity), "auction_timed_create", "auction:timed:create:"+auctionId); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-TIMED-CREATE] Escrow item error, userId: %s, itemId: %s, quantity: %d, error: %s", userId, itemId, quantity, err.Error())
+		code, msg := escrowItemError(err)
+		return nil, code, msg
+	}
+
+	keys := []string{timedAuctionKey(auctionId), timedOutboxKey(auctionId)}
+	err = runWithOutbox(ctx, script.CreateTimedAuction, keys, auctionId, userId, itemId, quantity, req.GetItemUniqueId(), itemType,
+		properties, req.GetStartPrice(), req.GetMinIncrement(), req.GetBuyoutPrice(), now, req.GetEndTime(),
+		timedEndKey, timedItemIndexKey(itemId), userTimedAuctionsKey(userId)).Err()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-TIMED-CREATE] Save auction error, rollback escrow, userId: %s, auctionId: %s, error: %s", userId, auctionId, err.Error())
+		enqueueSettlementJobs(ctx, &settlementJob{
+			Id:           "auction:timed:create:" + auctionId + ":rollback",
+			UserId:       userId,
+			ItemId:       itemId,
+			Count:        int64(quantity),
+			Reason:       "auction_timed_create_rollback",
+			ItemUniqueId: req.GetItemUniqueId(),
+			EscrowUserId: userId,
+			ItemType:     itemType,
+			Properties:   properties,
+		})
+		return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "save auction error"
+	}
+
+	klog.CtxInfof(ctx, "[AUCTION-TIMED-CREATE] Auction created, userId: %s, auctionId: %s, itemId: %s, quantity: %d, startPrice: %d, buyout: %d, endTime: %d",
+		userId, auctionId, itemId, quantity, req.GetStartPrice(), req.GetBuyoutPrice(), req.GetEndTime())
+	return &auction.TimedAuction{
+		AuctionId:    auctionId,
+		SellerId:     userId,
+		ItemId:       itemId,
+		Quantity:     quantity,
+		ItemUniqueId: req.GetItemUniqueId(),
+		Properties:   properties,
+		StartPrice:   req.GetStartPrice(),
+		MinIncrement: req.GetMinIncrement(),
+		BuyoutPrice:  req.GetBuyoutPrice(),
+		CreateTime:   now,
+		EndTime:      req.GetEndTime(),
+		Status:       auction.TimedAuctionStatus_TIMED_ACTIVE,
+	}, common.ErrorCode_OK, "success"
+}
+
+// BidTimedAuction 限时拍卖出价
+func (m *TimedAuctionManager) BidTimedAuction(ctx context.Context, req *auction.BidTimedAuctionReq) (resp *auction.BidTimedAuctionRsp, err error) {
+	userId, _ := ctx.Value("userId").(string)
+	resp = &auction.BidTimedAuctionRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	resp.Data, resp.Code, resp.Msg = m.withIdempotency(ctx, "[AUCTION-TIMED-BID]", userId, req.GetIdempotentId(),
+		func() (*auction.TimedAuction, common.ErrorCode, string) {
+			return m.bidTimedAuction(ctx, userId, req)
+		})
+	return
+}
+
+func (m *TimedAuctionManager) bidTimedAuction(ctx context.Context, userId string, req *auction.BidTimedAuctionReq) (*auction.TimedAuction, common.ErrorCode, string) {
+	if req.GetAuctionId() == "" {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "auction_id is empty"
+	}
+	a, err := getTimedAuction(ctx, req.GetAuctionId())
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-TIMED-BID] Get auction error: %s", err.Error())
+		return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "get auction error"
+	}
+	if a == nil || a.GetStatus() != auction.TimedAuctionStatus_TIMED_ACTIVE || a.GetEndTime() <= time.Now().Unix() {
+		return nil, common.ErrorCode_AUCTION_LISTING_NOT_FOUND, "auction not found or closed"
+	}
+	if a.GetSellerId() == userId {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "cannot trade own listing"
+	}
+
+	// 超过一口价的出价按一口价成交
+	price := req.GetPrice()
+	if a.GetBuyoutPrice() > 0 && price > a.GetBuyoutPrice() {
+		price = a.GetBuyoutPrice()
+	}
+	rule := getItemRule(a.GetItemId())
+	if minBid := minTimedBid(a); price < minBid {
+		klog.CtxInfof(ctx, "[AUCTION-TIMED-BID] Bid too low, userId: %s, auctionId: %s, price: %d, minBid: %d", userId, req.GetAuctionId(), price, minBid)
+		return nil, common.ErrorCode_AUCTION_OFFER_TOO_LOW, "bid must be at least " + strconv.FormatInt(minBid, 10)
+	}
+	if !rule.onTick(price) {
+		return nil, common.ErrorCode_AUCTION_PRICE_TICK_INVALID, "price is not a multiple of tick size"
+	}
+	if isItemHalted(ctx, a.GetItemId()) {
+		return nil, common.ErrorCode_AUCTION_TRADING_HALTED, "trading halted"
+	}
+
+	// 托管出价（买家承担手续费时一并托管）
+	escrow, proceeds := rule.tradeAmounts(price)
+	bidId := idClient.Generate().String()
+	escrowId := "auction:timed:bid:" + bidId
+	if err := inventory.DeleteItem(ctx, userId, currencyItemId(), escrow, "auction_timed_bid", escrowId); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-TIMED-BID] Escrow currency error, userId: %s, amount: %d, error: %s", userId, escrow, err.Error())
+		return nil, common.ErrorCode_AUCTION_ESCROW_FAILED, "escrow currency failed"
+	}
+
+	status, prevBidder, updated, err := evalTimedScript(ctx, script.TimedBid, req.GetAuctionId(), a.GetItemId(),
+		userId, price, escrow, proceeds, bidId, time.Now().Unix(), currencyItemId(),
+		configSeconds("auction_timed.snipe_window", 60), configSeconds("auction_timed.snipe_extend", 60),
+		configSeconds("auction_timed.max_extend", 600), timedClosedTTL)
+	if err != nil || (status != "ok" && status != "bought") {
+		enqueueSettlementJobs(ctx, &settlementJob{
+			Id:     escrowId + ":rollback",
+			UserId: userId,
+			ItemId: currencyItemId(),
+			Count:  escrow,
+			Reason: "auction_timed_bid_rollback",
+		})
+		if err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-TIMED-BID] Save bid error: auctionId=%s, error: %s", req.GetAuctionId(), err.Error())
+			return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "save bid error"
+		}
+		code, msg := timedScriptError(status)
+		klog.CtxInfof(ctx, "[AUCTION-TIMED-BID] Bid rejected: userId=%s, auctionId=%s, status=%s", userId, req.GetAuctionId(), status)
+		return nil, code, msg
+	}
+
+	getMatchManager().wakeSettlement()
+	klog.CtxInfof(ctx, "[AUCTION-TIMED-BID] Bid placed: userId=%s, auctionId=%s, price=%d, previous=%s, status=%s, endTime=%d",
+		userId, req.GetAuctionId(), price, prevBidder, status, updated.GetEndTime())
+	if prevBidder != "" && prevBidder != userId {
+		notifyTimedAuction(ctx, prevBidder, updated, "outbid")
+	}
+	if status == "bought" {
+		notifyTimedClosed(ctx, updated)
+	}
+	return updated, common.ErrorCode_OK, "success"
+}
+
+// CancelTimedAuction 取消无人出价的限时拍卖，道具退回卖家
+func (m *TimedAuctionManager) CancelTimedAuction(ctx context.Context, req *auction.CancelTimedAuctionReq) (resp *auction.CancelTimedAuctionRsp, err error) {
+	userId, _ := ctx.Value("userId").(string)
+	resp = &auction.CancelTimedAuctionRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	resp.Data, resp.Code, resp.Msg = m.withIdempotency(ctx, "[AUCTION-TIMED-CANCEL]", userId, req.GetIdempotentId(),
+		func() (*auction.TimedAuction, common.ErrorCode, string) {
+			itemId, err := redis.GetRedis().HGet(ctx, timedAuctionKey(req.GetAuctionId()), "item_id").Result()
+			if err == goredis.Nil || req.GetAuctionId() == "" {
+				return nil, common.ErrorCode_AUCTION_LISTING_NOT_FOUND, "auction not found or closed"
+			}
+			if err != nil {
+				klog.CtxErrorf(ctx, "[AUCTION-TIMED-CANCEL] Get auction error: %s", err.Error())
+				return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "get auction error"
+			}
+			status, _, a, err := evalTimedScript(ctx, script.TimedCancel, req.GetAuctionId(), itemId,
+				userId, time.Now().Unix(), currencyItemId(), timedClosedTTL)
+			if err != nil {
+				klog.CtxErrorf(ctx, "[AUCTION-TIMED-CANCEL] Cancel auction error: auctionId=%s, error: %s", req.GetAuctionId(), err.Error())
+				return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "cancel auction error"
+			}
+			if status != "ok" {
+				code, msg := timedScriptError(status)
+				return nil, code, msg
+			}
+			getMatchManager().wakeSettlement()
+			klog.CtxInfof(ctx, "[AUCTION-TIMED-CANCEL] Auction cancelled: userId=%s, auctionId=%s", userId, req.GetAuctionId())
+			return a, common.ErrorCode_OK, "success"
+		})
+	return
+}
+
+// GetTimedAuction 查询单个限时拍卖
+func (m *TimedAuctionManager) GetTimedAuction(ctx context.Context, req *auction.GetTimedAuctionReq) (resp *auction.GetTimedAuctionRsp, err error) {
+	resp = &auction.GetTimedAuctionRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	if req.GetAuctionId() == "" {
+		resp.Msg = "auction_id is empty"
+		return
+	}
+	a, err := getTimedAuction(ctx, req.GetAuctionId())
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-TIMED-GET] Get auction error: %s", err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "get auction error"
+		err = nil
+		return
+	}
+	if a == nil {
+		resp.Code = common.ErrorCode_AUCTION_LISTING_NOT_FOUND
+		resp.Msg = "auction not found"
+		return
+	}
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	resp.Data = a
+	return
+}
+
+// GetTimedAuctions 按道具查询竞拍中的限时拍卖，结束时间近的在前
+func (m *TimedAuctionManager) GetTimedAuctions(ctx context.Context, req *auction.GetTimedAuctionsReq) (resp *auction.GetTimedAuctionsRsp, err error) {
+	resp = &auction.GetTimedAuctionsRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	if req.GetItemId() == "" || req.GetCursor() < 0 {
+		resp.Msg = "item_id is empty or invalid cursor"
+		return
+	}
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = timedListLimit
+	}
+	if limit > timedListMaxLimit {
+		limit = timedListMaxLimit
+	}
+
+	// 多取一条用于判断是否还有下一页
+	start := int64(req.GetCursor())
+	ids, err := redis.GetRedis().ZRange(ctx, timedItemIndexKey(req.GetItemId()), start, start+limit).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-TIMED-LIST] Range auctions error: %s", err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "get auctions error"
+		err = nil
+		return
+	}
+	if int64(len(ids)) > limit {
+		ids = ids[:limit]
+		resp.HasMore = true
+	}
+	resp.Data = make([]*auction.TimedAuction, 0, len(ids))
+	for _, id := range ids {
+		a, getErr := getTimedAuction(ctx, id)
+		if getErr != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-TIMED-LIST] Get auction %s error: %s", id, getErr.Error())
+			continue
+		}
+		if a != nil && a.GetStatus() == auction.TimedAuctionStatus_TIMED_ACTIVE {
+			resp.Data = append(resp.Data, a)
+		}
+	}
+	resp.NextCursor = int32(start) + int32(len(ids))
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	return
+}
+
+// runScheduler 按结束时间索引结算到期的拍卖，启动时立即处理停机期间到期的拍卖
+func (m *TimedAuctionManager) runScheduler(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		m.closeEndedAuctions(ctx, time.Now().Unix())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// closeEndedAuctions 结算所有到期的拍卖，多实例同时处理时由脚本中的状态检查保证只结算一次，返回结算数量
+func (m *TimedAuctionManager) closeEndedAuctions(ctx context.Context, now int64) int {
+	ids, err := redis.GetRedis().ZRangeByScore(ctx, timedEndKey, &goredis.ZRangeBy{
+		Min: "-inf", Max: strconv.FormatInt(now, 10), Count: timedCloseBatch,
+	}).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-TIMED-CLOSE] range ended auctions error: %s", err.Error())
+		return 0
+	}
+
+	closed := 0
+	for _, auctionId := range ids {
+		itemId, err := redis.GetRedis().HGet(ctx, timedAuctionKey(auctionId), "item_id").Result()
+		if err == goredis.Nil {
+			// 拍卖数据已不存在，清理索引
+			redis.GetRedis().ZRem(ctx, timedEndKey, auctionId)
+			continue
+		}
+		if err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-TIMED-CLOSE] get auction %s error: %s", auctionId, err.Error())
+			continue
+		}
+		haltedFlag := "0"
+		if isItemHalted(ctx, itemId) {
+			haltedFlag = "1"
+		}
+		status, _, a, err := evalTimedScript(ctx, script.TimedClose, auctionId, itemId, now, currencyItemId(), timedClosedTTL, haltedFlag)
+		if err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-TIMED-CLOSE] close auction %s error: %s", auctionId, err.Error())
+			continue
+		}
+		if status != "ok" {
+			if status == "closed" {
+				redis.GetRedis().ZRem(ctx, timedEndKey, auctionId)
+			}
+			continue
+		}
+		closed++
+		klog.CtxInfof(ctx, "[AUCTION-TIMED-CLOSE] Auction closed: auctionId=%s, status=%s, winnerId=%s, finalPrice=%d",
+			auctionId, a.GetStatus().String(), a.GetWinnerId(), a.GetFinalPrice())
+		notifyTimedClosed(ctx, a)
+	}
+	if closed > 0 {
+		getMatchManager().wakeSettlement()
+	}
+	return closed
+}
@@ -0,0 +1,148 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/kitex_gen/item"
+	"auction_module/redis"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// 测试用例: 限时拍卖出价、防狙击延长、一口价、取消、到期结算与流拍
+func TestTimedAuctionManager_Auctions(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	fake := newFakeInventory()
+	inventory = fake
+	defer func() { inventory = newFakeInventory() }()
+	ntf := &fakeNotifier{msgs: make(map[string][]proto.Message)}
+	notifier = ntf
+	defer func() { notifier = &gatewayNotifier{} }()
+
+	// 停止后台调度，由测试直接驱动到期结算
+	tm := GetTimedAuctionManager()
+	tm.Close()
+
+	itemId := "test_item_timed"
+	userCtx := func(userId string) context.Context { return context.WithValue(ctx, "userId", userId) }
+	currency := currencyItemId()
+	mgr := getMatchManager()
+	seq := 0
+	idem := func() string {
+		seq++
+		return fmt.Sprintf("test_timed_%d_%d", time.Now().UnixNano(), seq)
+	}
+	create := func(req *auction.CreateTimedAuctionReq) *auction.TimedAuction {
+		req.IdempotentId = idem()
+		if req.EndTime == 0 {
+			req.EndTime = time.Now().Unix() + 3600
+		}
+		resp, err := tm.CreateTimedAuction(userCtx("test_timed_seller"), req)
+		assert.NoError(t, err)
+		assert.Equal(t, common.ErrorCode_OK, resp.Code, resp.Msg)
+		return resp.Data
+	}
+	bid := func(userId string, auctionId string, price int64) *auction.BidTimedAuctionRsp {
+		resp, err := tm.BidTimedAuction(userCtx(userId), &auction.BidTimedAuctionReq{AuctionId: auctionId, Price: price, IdempotentId: idem()})
+		assert.NoError(t, err)
+		return resp
+	}
+	endNow := func(auctionId string) int64 {
+		now := time.Now().Unix()
+		redis.GetRedis().HSet(ctx, timedAuctionKey(auctionId), "end_time", now-1)
+		redis.GetRedis().ZAdd(ctx, timedEndKey, goredis.Z{Score: float64(now - 1), Member: auctionId})
+		return now
+	}
+	lastEvent := func(userId string) string {
+		ntf.mu.Lock()
+		defer ntf.mu.Unlock()
+		msgs := ntf.msgs[userId]
+		if len(msgs) == 0 {
+			return ""
+		}
+		return msgs[len(msgs)-1].(*auction.AuctionTimedNtf).Event
+	}
+
+	// 1. 创建拍卖托管道具，出价需满足最小加价，被超过的出价退还并通知
+	a1 := create(&auction.CreateTimedAuctionReq{ItemId: itemId, Quantity: 3, StartPrice: 100, MinIncrement: 10, BuyoutPrice: 300})
+	assert.Equal(t, int64(-3), fake.balance("test_timed_seller", itemId))
+	assert.Equal(t, common.ErrorCode_OK, bid("test_timed_a", a1.AuctionId, 100).Code)
+	assert.Equal(t, common.ErrorCode_AUCTION_OFFER_TOO_LOW, bid("test_timed_b", a1.AuctionId, 105).Code)
+	assert.Equal(t, common.ErrorCode_OK, bid("test_timed_b", a1.AuctionId, 110).Code)
+	mgr.settlePending(ctx)
+	assert.Equal(t, int64(0), fake.balance("test_timed_a", currency))
+	assert.Equal(t, "outbid", lastEvent("test_timed_a"))
+	list, err := tm.GetTimedAuctions(ctx, &auction.GetTimedAuctionsReq{ItemId: itemId})
+	assert.NoError(t, err)
+	if assert.Len(t, list.Data, 1) {
+		assert.Equal(t, int64(110), list.Data[0].HighestBid)
+		assert.Equal(t, int32(2), list.Data[0].BidCount)
+	}
+
+	// 2. 已有出价的拍卖不能取消
+	cancelResp, err := tm.CancelTimedAuction(userCtx("test_timed_seller"), &auction.CancelTimedAuctionReq{AuctionId: a1.AuctionId, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_AUCTION_HAS_BIDS, cancelResp.Code)
+
+	// 3. 临近结束的出价延长拍卖
+	redis.GetRedis().HSet(ctx, timedAuctionKey(a1.AuctionId), "end_time", time.Now().Unix()+10)
+	snipe := bid("test_timed_a", a1.AuctionId, 120)
+	assert.Equal(t, common.ErrorCode_OK, snipe.Code)
+	assert.GreaterOrEqual(t, snipe.Data.EndTime, time.Now().Unix()+59)
+
+	// 4. 到期成交给最高出价者，卖家收到货款
+	now := endNow(a1.AuctionId)
+	assert.Equal(t, 1, tm.closeEndedAuctions(ctx, now))
+	mgr.settlePending(ctx)
+	_, proceeds := getItemRule(itemId).tradeAmounts(120)
+	assert.Equal(t, int64(3), fake.balance("test_timed_a", itemId))
+	assert.Equal(t, proceeds, fake.balance("test_timed_seller", currency))
+	assert.Equal(t, int64(0), fake.balance("test_timed_b", currency))
+	assert.Equal(t, "won", lastEvent("test_timed_a"))
+	assert.Equal(t, "sold", lastEvent("test_timed_seller"))
+	closed, err := tm.GetTimedAuction(ctx, &auction.GetTimedAuctionReq{AuctionId: a1.AuctionId})
+	assert.NoError(t, err)
+	assert.Equal(t, auction.TimedAuctionStatus_TIMED_SOLD, closed.Data.Status)
+	assert.Equal(t, "test_timed_a", closed.Data.WinnerId)
+
+	// 5. 唯一道具实例拍卖，超过一口价的出价按一口价立即成交
+	fake.grantInstance("test_timed_seller", &item.ItemInfo{ItemId: 1002, ItemUniqueId: "91001", Properties: `{"level":9}`, Count: 1})
+	a2 := create(&auction.CreateTimedAuctionReq{ItemUniqueId: "91001", StartPrice: 100, MinIncrement: 10, BuyoutPrice: 300})
+	assert.Equal(t, "1002", a2.ItemId)
+	buyout := bid("test_timed_c", a2.AuctionId, 500)
+	assert.Equal(t, common.ErrorCode_OK, buyout.Code)
+	assert.Equal(t, auction.TimedAuctionStatus_TIMED_SOLD, buyout.Data.Status)
+	assert.Equal(t, int64(300), buyout.Data.FinalPrice)
+	mgr.settlePending(ctx)
+	if got := fake.instance("test_timed_c", "91001"); assert.NotNil(t, got) {
+		assert.Equal(t, `{"level":9}`, got.Properties)
+	}
+	escrow, _ := getItemRule("1002").tradeAmounts(300)
+	assert.Equal(t, -escrow, fake.balance("test_timed_c", currency))
+
+	// 6. 无人出价的拍卖可取消，到期流拍，道具均退回卖家
+	a3 := create(&auction.CreateTimedAuctionReq{ItemId: itemId, Quantity: 2, StartPrice: 100, MinIncrement: 10})
+	a4 := create(&auction.CreateTimedAuctionReq{ItemId: itemId, Quantity: 4, StartPrice: 100, MinIncrement: 10})
+	cancelResp, err = tm.CancelTimedAuction(userCtx("test_timed_seller"), &auction.CancelTimedAuctionReq{AuctionId: a3.AuctionId, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, cancelResp.Code)
+	assert.Equal(t, auction.TimedAuctionStatus_TIMED_CANCELLED, cancelResp.Data.Status)
+	now = endNow(a4.AuctionId)
+	assert.Equal(t, 1, tm.closeEndedAuctions(ctx, now))
+	mgr.settlePending(ctx)
+	assert.Equal(t, int64(-3), fake.balance("test_timed_seller", itemId))
+	assert.Equal(t, "unsold", lastEvent("test_timed_seller"))
+}
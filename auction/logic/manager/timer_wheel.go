@@ -0,0 +1,101 @@
+package manager
+
+const defaultWheelSlots = 3600 // 时间轮默认槽位数，每个槽位1秒
+
+// wheelTimer 时间轮中的一个订单定时器
+type wheelTimer struct {
+	orderId    string // 订单ID
+	direction  string // 交易方向（buy/sell）
+	expireTime int64  // 过期时间戳（秒）
+}
+
+// timerWheel 单层时间轮，以秒为刻度管理订单过期时间
+// 只在撮合协程内访问，无需加锁；超过一圈的定时器通过比较过期时间留到后续轮次
+type timerWheel struct {
+	slots    []map[string]*wheelTimer // 槽位 -> 订单定时器
+	index    map[string]int           // 订单ID -> 所在槽位
+	lastTick int64                    // 上次推进到的时间戳（秒）
+}
+
+// newTimerWheel 创建时间轮，now为起始时间
+func newTimerWheel(slotCount int, now int64) *timerWheel {
+	if slotCount <= 0 {
+		slotCount = defaultWheelSlots
+	}
+	slots := make([]map[string]*wheelTimer, slotCount)
+	for i := range slots {
+		slots[i] = make(map[string]*wheelTimer)
+	}
+	return &timerWheel{
+		slots:    slots,
+		index:    make(map[string]int),
+		lastTick: now,
+	}
+}
+
+// Add 添加或更新订单定时器，expireTime<=0表示永不过期
+func (w *timerWheel) Add(orderId string, direction string, expireTime int64) {
+	w.Remove(orderId)
+	if expireTime <= 0 {
+		return
+	}
+
+	// 已经过期的订单放到下一个刻度，保证下次推进时立即触发
+	tick := expireTime
+	if tick <= w.lastTick {
+		tick = w.lastTick + 1
+	}
+	slot := int(tick % int64(len(w.slots)))
+	w.slots[slot][orderId] = &wheelTimer{
+		orderId:    orderId,
+		direction:  direction,
+		expireTime: expireTime,
+	}
+	w.index[orderId] = slot
+}
+
+// Remove 移除订单定时器（订单成交或取消时调用）
+func (w *timerWheel) Remove(orderId string) {
+	slot, ok := w.index[orderId]
+	if !ok {
+		return
+	}
+	delete(w.slots[slot], orderId)
+	delete(w.index, orderId)
+}
+
+// Len 返回时间轮中的定时器数量
+func (w *timerWheel) Len() int {
+	return len(w.index)
+}
+
+// Advance 推进时间轮到now，返回所有已到期的定时器
+func (w *timerWheel) Advance(now int64) []*wheelTimer {
+	if now <= w.lastTick {
+		return nil
+	}
+
+	var expired []*wheelTimer
+	collect := func(slot int) {
+		for orderId, t := range w.slots[slot] {
+			if t.expireTime <= now {
+				expired = append(expired, t)
+				delete(w.slots[slot], orderId)
+				delete(w.index, orderId)
+			}
+		}
+	}
+
+	if now-w.lastTick >= int64(len(w.slots)) {
+		// 间隔超过一圈，扫描全部槽位
+		for slot := range w.slots {
+			collect(slot)
+		}
+	} else {
+		for tick := w.lastTick + 1; tick <= now; tick++ {
+			collect(int(tick % int64(len(w.slots))))
+		}
+	}
+	w.lastTick = now
+	return expired
+}
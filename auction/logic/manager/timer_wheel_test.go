@@ -0,0 +1,28 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 测试用例: 时间轮按过期时间触发，移除的定时器不再触发
+func TestTimerWheel_Advance(t *testing.T) {
+	w := newTimerWheel(8, 100)
+	w.Add("a", "sell", 101)
+	w.Add("b", "buy", 103)
+	w.Add("c", "sell", 120) // 超过一圈
+	w.Add("d", "buy", 90)   // 已过期
+	w.Add("e", "sell", 102)
+	w.Remove("e")
+
+	expired := w.Advance(101)
+	assert.Len(t, expired, 2)
+	assert.Equal(t, 2, w.Len())
+	assert.Len(t, w.Advance(104), 1)
+	assert.Len(t, w.Advance(119), 0)
+	expired = w.Advance(200)
+	assert.Len(t, expired, 1)
+	assert.Equal(t, "c", expired[0].orderId)
+	assert.Equal(t, 0, w.Len())
+}
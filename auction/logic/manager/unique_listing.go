@@ -0,0 +1,762 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/kitex_gen/item"
+	"auction_module/redis"
+	"auction_module/redis/script"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// 唯一道具（装备、皮肤等）按实例挂单，不进入按道具ID撮合的订单簿：
+// 每个挂单引用卖家托管的一个道具实例，成交时该实例原样（唯一id与属性不变）转移到买家背包。
+// 挂单的所有状态变化都在Redis Lua脚本中原子完成，因此不需要路由到道具归属实例。
+// 唯一道具成交价只代表单个实例，不计入道具的成交记录、K线和参考价。
+const (
+	uniqueListingKeyPrefix = "auction:unique:"       // 挂单hash：auction:unique:{listingId}
+	uniqueItemIndexPrefix  = "auction:unique:item:"  // 道具挂单价格索引（ZSET，score为价格）
+	uniqueExpireKey        = "auction:unique:expire" // 挂单过期索引（ZSET，score为过期时间）
+	uniqueClosedTTL        = 7 * 24 * 3600           // 结束的挂单保留时间（秒）
+	uniqueSweepBatch       = 100                     // 过期扫描每批处理数量
+	uniqueSearchBatch      = 200                     // 搜索时每批读取的挂单数量
+	uniqueSearchMaxScan    = 2000                    // 单次搜索最多扫描的挂单数量
+	uniqueSearchLimit      = 20                      // 搜索默认每页数量
+	uniqueSearchMaxLimit   = 100                     // 搜索最大每页数量
+	uniqueStatusActive     = "0"                     // 挂单中（auction.UniqueListingStatus_UNIQUE_ACTIVE）
+)
+
+func uniqueListingKey(listingId string) string {
+	return uniqueListingKeyPrefix + "{" + listingId + "}"
+}
+
+// uniqueOutboxKey 挂单的outbox，索引、用户列表和结算任务位于其他slot，通过outbox写入
+func uniqueOutboxKey(listingId string) string {
+	return uniqueListingKey(listingId) + ":outbox"
+}
+
+func uniqueItemIndexKey(itemId string) string {
+	return uniqueItemIndexPrefix + itemId
+}
+
+func userUniqueListingsKey(userId string) string {
+	return userKey(userId, "unique_listings")
+}
+
+// uniqueListingFromMap 将挂单hash转换为协议结构
+func uniqueListingFromMap(data map[string]string) *auction.UniqueListing {
+	return &auction.UniqueListing{
+		ListingId:    data["listing_id"],
+		SellerId:     data["seller_id"],
+		ItemId:       data["item_id"],
+		ItemUniqueId: data["item_unique_id"],
+		ItemType:     int32(parseInt(data["item_type"])),
+		Properties:   data["properties"],
+		ListingType:  auction.UniqueListingType(parseInt(data["listing_type"])),
+		Price:        parseInt64(data["price"]),
+		BestOffer:    parseInt64(data["offer_price"]),
+		CreateTime:   parseInt64(data["create_time"]),
+		ExpireTime:   parseInt64(data["expire_time"]),
+		Status:       auction.UniqueListingStatus(parseInt(data["status"])),
+		BuyerId:      data["buyer_id"],
+		FinalPrice:   parseInt64(data["final_price"]),
+	}
+}
+
+// parseUniqueScriptResult 解析挂单脚本返回值 {状态, 附加信息, 挂单hash字段...}
+func parseUniqueScriptResult(val interface{}) (status string, extra string, data map[string]string) {
+	arr, _ := val.([]interface{})
+	data = make(map[string]string)
+	if len(arr) < 2 {
+		return "invalid", "", data
+	}
+	status, _ = arr[0].(string)
+	extra, _ = arr[1].(string)
+	for i := 2; i+1 < len(arr); i += 2 {
+		k, _ := arr[i].(string)
+		v, _ := arr[i+1].(string)
+		data[k] = v
+	}
+	return
+}
+
+// uniqueScriptError 将挂单脚本的失败状态转换为错误码
+func uniqueScriptError(status string) (common.ErrorCode, string) {
+	switch status {
+	case "not_found", "closed":
+		return common.ErrorCode_AUCTION_LISTING_NOT_FOUND, "listing not found or closed"
+	case "not_owner":
+		return common.ErrorCode_AUCTION_PARAM_ERROR, "not listing owner"
+	case "wrong_type":
+		return common.ErrorCode_AUCTION_PARAM_ERROR, "wrong listing type"
+	case "self_trade":
+		return common.ErrorCode_AUCTION_PARAM_ERROR, "cannot trade own listing"
+	case "no_offer":
+		return common.ErrorCode_AUCTION_PARAM_ERROR, "listing has no offer"
+	case "changed":
+		return common.ErrorCode_AUCTION_ORDER_CHANGED, "listing changed"
+	case "too_low":
+		return common.ErrorCode_AUCTION_OFFER_TOO_LOW, "offer too low"
+	case "halted":
+		return common.ErrorCode_AUCTION_TRADING_HALTED, "trading halted"
+	}
+	return common.ErrorCode_AUCTION_REDIS_ERROR, "listing script error: " + status
+}
+
+// isUniqueInstance 判断道具是否为唯一道具实例（非唯一道具以道具ID作为唯一id）
+func isUniqueInstance(info *item.ItemInfo) bool {
+	return info.GetItemUniqueId() != strconv.Itoa(int(info.GetItemId())) && info.GetCount() == 1
+}
+
+// checkUniquePrice 检查唯一道具价格：只检查绝对价格上下限和最小价格变动单位，不使用参考价区间
+func checkUniquePrice(rule *itemRule, price int64) (common.ErrorCode, string) {
+	if price <= 0 {
+		return common.ErrorCode_AUCTION_PARAM_ERROR, "price must be greater than 0"
+	}
+	if (rule.PriceFloor > 0 && price < rule.PriceFloor) || (rule.PriceCeiling > 0 && price > rule.PriceCeiling) {
+		return common.ErrorCode_AUCTION_PRICE_OUT_OF_BAND, "price exceeds limit"
+	}
+	if !rule.onTick(price) {
+		return common.ErrorCode_AUCTION_PRICE_TICK_INVALID, "price is not a multiple of tick size"
+	}
+	return common.ErrorCode_OK, ""
+}
+
+// enqueueSettlementJobs 写入结算任务并唤醒结算协程，写入失败时直接发放
+func enqueueSettlementJobs(ctx context.Context, jobs ...*settlementJob) {
+	payloads := make([]interface{}, 0, len(jobs))
+	for _, job := range jobs {
+		payloads = append(payloads, job.encode())
+	}
+	if err := redis.GetRedis().RPush(ctx, settlementPendingKey, payloads...).Err(); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-UNIQUE] enqueue settlement jobs error: %s", err.Error())
+		for _, job := range jobs {
+			if err := job.deliver(ctx); err != nil {
+				klog.CtxErrorf(ctx, "[AUCTION-UNIQUE] deliver job error, need manual handling: job=%s, error: %s", job.encode(), err.Error())
+			}
+		}
+		return
+	}
+	getMatchManager().wakeSettlement()
+}
+
+// notifyUniqueListing 推送唯一道具挂单事件
+func notifyUniqueListing(ctx context.Context, userId string, listing *auction.UniqueListing, event string) {
+	if userId == "" {
+		return
+	}
+	ntf := &auction.AuctionUniqueListingNtf{Listing: listing, Event: event}
+	if err := notifier.Notify(ctx, userId, ntf); err != nil {
+		klog.CtxWarnf(ctx, "[AUCTION-UNIQUE] notify user error: userId=%s, listingId=%s, event=%s, error: %s",
+			userId, listing.GetListingId(), event, err.Error())
+	}
+}
+
+// getUniqueListing 读取挂单，不存在时返回nil
+func getUniqueListing(ctx context.Context, listingId string) (*auction.UniqueListing, error) {
+	data, err := redis.GetRedis().HGetAll(ctx, uniqueListingKey(listingId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return uniqueListingFromMap(data), nil
+}
+
+// finishUniqueListing 执行结束挂单脚本，返回脚本状态、结束前的最高出价者和结束后的挂单
+func finishUniqueListing(ctx context.Context, action string, listingId string, itemId string, userId string,
+	price int64, proceeds int64, halted bool) (status string, offerUser string, listing *auction.UniqueListing, err error) {
+	haltedFlag := "0"
+	if halted {
+		haltedFlag = "1"
+	}
+	keys := []string{uniqueListingKey(listingId), uniqueOutboxKey(listingId)}
+	val, err := runWithOutbox(ctx, script.FinishUniqueListing, keys,
+		action, userId, time.Now().Unix(), currencyItemId(), price, proceeds, haltedFlag, uniqueClosedTTL,
+		uniqueItemIndexKey(itemId), uniqueExpireKey).Result()
+	if err != nil {
+		return "", "", nil, err
+	}
+	status, offerUser, data := parseUniqueScriptResult(val)
+	if status != "ok" {
+		return status, "", nil, nil
+	}
+	getMatchManager().wakeSettlement()
+	return status, offerUser, uniqueListingFromMap(data), nil
+}
+
+// notifyUniqueClosed 挂单结束后通知卖家、买家和被退还出价的出价者
+func notifyUniqueClosed(ctx context.Context, listing *auction.UniqueListing, offerUser string) {
+	switch listing.GetStatus() {
+	case auction.UniqueListingStatus_UNIQUE_SOLD:
+		notifyUniqueListing(ctx, listing.GetSellerId(), listing, "sold")
+		notifyUniqueListing(ctx, listing.GetBuyerId(), listing, "won")
+	case auction.UniqueListingStatus_UNIQUE_EXPIRED:
+		notifyUniqueListing(ctx, listing.GetSellerId(), listing, "expired")
+		notifyUniqueListing(ctx, offerUser, listing, "outbid")
+	case auction.UniqueListingStatus_UNIQUE_CANCELLED:
+		notifyUniqueListing(ctx, offerUser, listing, "cancelled")
+	}
+}
+
+// uniqueWithIdempotency 唯一道具挂单写操作的公共流程，重放请求时返回挂单当前状态
+func (m *AuctionManager) uniqueWithIdempotency(ctx context.Context, logTag string, userId string, idempotentId string,
+	op func() (*auction.UniqueListing, common.ErrorCode, string)) (listing *auction.UniqueListing, code common.ErrorCode, msg string) {
+	listingId, replay, code, msg := m.withIdempotency(ctx, logTag, userId, idempotentId, func() (string, common.ErrorCode, string) {
+		var opCode common.ErrorCode
+		var opMsg string
+		listing, opCode, opMsg = op()
+		return listing.GetListingId(), opCode, opMsg
+	})
+	if replay && code == common.ErrorCode_OK && listingId != "" {
+		var err error
+		if listing, err = getUniqueListing(ctx, listingId); err != nil {
+			klog.CtxErrorf(ctx, "%s Load listing error: %s", logTag, err.Error())
+		}
+		if listing == nil {
+			listing = &auction.UniqueListing{ListingId: listingId}
+		}
+	}
+	return
+}
+
+// ListUniqueItem 唯一道具挂单 - 托管卖家背包中的一个道具实例并创建挂单
+func (m *AuctionManager) ListUniqueItem(ctx context.Context, req *auction.ListUniqueItemReq) (resp *auction.ListUniqueItemRsp, err error) {
+	userId, _ := ctx.Value("userId").(string)
+	resp = &auction.ListUniqueItemRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	resp.Data, resp.Code, resp.Msg = m.uniqueWithIdempotency(ctx, "[AUCTION-MGR-UNIQUE-LIST]", userId, req.GetIdempotentId(),
+		func() (*auction.UniqueListing, common.ErrorCode, string) {
+			return m.listUniqueItem(ctx, userId, req)
+		})
+	return
+}
+
+func (m *AuctionManager) listUniqueItem(ctx context.Context, userId string, req *auction.ListUniqueItemReq) (*auction.UniqueListing, common.ErrorCode, string) {
+	if req.GetItemUniqueId() == "" {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "item_unique_id is empty"
+	}
+	if _, ok := auction.UniqueListingType_name[int32(req.GetListingType())]; !ok {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "invalid listing_type"
+	}
+	createTime := time.Now().Unix()
+	expireTime, expireErr := resolveExpireTime(req.GetExpireTime(), createTime)
+	if expireErr != nil {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, expireErr.Error()
+	}
+
+	// 读取要挂出的道具实例：非唯一道具以道具ID作为唯一id，不能按实例挂单
+	info, err := inventory.GetItem(ctx, userId, req.GetItemUniqueId())
+	if err != nil {
+		klog.CtxInfof(ctx, "[AUCTION-MGR-UNIQUE-LIST] Get item error, userId: %s, itemUniqueId: %s, error: %s", userId, req.GetItemUniqueId(), err.Error())
+		return nil, common.ErrorCode_AUCTION_ESCROW_FAILED, "item not found"
+	}
+	itemId := strconv.Itoa(int(info.GetItemId()))
+	if !isUniqueInstance(info) {
+		klog.CtxInfof(ctx, "[AUCTION-MGR-UNIQUE-LIST] Item is not unique, userId: %s, itemUniqueId: %s, itemId: %s", userId, req.GetItemUniqueId(), itemId)
+		return nil, common.ErrorCode_AUCTION_ITEM_NOT_UNIQUE, "item is not a unique instance"
+	}
+
+	rule := getItemRule(itemId)
+	if code, msg := checkUniquePrice(rule, req.GetPrice()); code != common.ErrorCode_OK {
+		klog.CtxInfof(ctx, "[AUCTION-MGR-UNIQUE-LIST] Invalid price, userId: %s, itemId: %s, price: %d, msg: %s", userId, itemId, req.GetPrice(), msg)
+		return nil, code, msg
+	}
+	if isItemHalted(ctx, itemId) {
+		return nil, common.ErrorCode_AUCTION_TRADING_HALTED, "trading halted"
+	}
+
+	// 单个用户同时挂出的唯一道具数量受出售挂单上限限制
+	listingCount, err := redis.GetRedis().SCard(ctx, userUniqueListingsKey(userId)).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-UNIQUE-LIST] Get user listings count error: %s", err.Error())
+		return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "get user listings count error"
+	}
+	if int32(listingCount) >= rule.MaxSellOrders {
+		klog.CtxInfof(ctx, "[AUCTION-MGR-UNIQUE-LIST] Listing count exceeds limit, userId: %s, currentCount: %d, maxLimit: %d", userId, listingCount, rule.MaxSellOrders)
+		return nil, common.ErrorCode_AUCTION_ORDER_LIMIT_EXCEEDED, "listing count exceeds limit"
+	}
+
+	// 托管道具实例：以挂单ID作为幂等ID从卖家背包中扣除
+	listingId := idClient.Generate().String()
+	if err := inventory.EscrowItem(ctx, userId, info.GetItemUniqueId(), 1, "auction_unique_list", "auction:unique:list:"+listingId); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-UNIQUE-LIST] Escrow item error, userId: %s, itemUniqueId: %s, error: %s", userId, info.GetItemUniqueId(), err.Error())
+		code, msg := escrowItemError(err)
+		return nil, code, msg
+	}
+
+	keys := []string{uniqueListingKey(listingId), uniqueOutboxKey(listingId)}
+	err = runWithOutbox(ctx, script.CreateUniqueListing, keys, listingId, userId, itemId, info.GetItemUniqueId(), info.GetItemType(),
+		info.GetProperties(), int(req.GetListingType()), req.GetPrice(), createTime, expireTime,
+		uniqueItemIndexKey(itemId), uniqueExpireKey, userUniqueListingsKey(userId)).Err()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-UNIQUE-LIST] Save listing error, rollback escrow, userId: %s, listingId: %s, error: %s", userId, listingId, err.Error())
+		if rollbackErr := inventory.RestoreItem(ctx, userId, userId, info, "auction_unique_list_rollback", "auction:unique:list:"+listingId+":rollback"); rollbackErr != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-MGR-UNIQUE-LIST] Rollback escrow error, need manual handling: userId: %s, itemUniqueId: %s, error: %s", userId, info.GetItemUniqueId(), rollbackErr.Error())
+		}
+		return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "save listing error"
+	}
+
+	klog.CtxInfof(ctx, "[AUCTION-MGR-UNIQUE-LIST] Listing created, userId: %s, listingId: %s, itemId: %s, itemUniqueId: %s, type: %s, price: %d",
+		userId, listingId, itemId, info.GetItemUniqueId(), req.GetListingType().String(), req.GetPrice())
+	return &auction.UniqueListing{
+		ListingId:    listingId,
+		SellerId:     userId,
+		ItemId:       itemId,
+		ItemUniqueId: info.GetItemUniqueId(),
+		ItemType:     info.GetItemType(),
+		Properties:   info.GetProperties(),
+		ListingType:  req.GetListingType(),
+		Price:        req.GetPrice(),
+		CreateTime:   createTime,
+		ExpireTime:   expireTime,
+		Status:       auction.UniqueListingStatus_UNIQUE_ACTIVE,
+	}, common.ErrorCode_OK, "success"
+}
+
+// CancelUniqueListing 取消唯一道具挂单 - 道具实例退回卖家，当前出价退还出价者
+func (m *AuctionManager) CancelUniqueListing(ctx context.Context, req *auction.CancelUniqueListingReq) (resp *auction.CancelUniqueListingRsp, err error) {
+	userId, _ := ctx.Value("userId").(string)
+	resp = &auction.CancelUniqueListingRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	resp.Data, resp.Code, resp.Msg = m.uniqueWithIdempotency(ctx, "[AUCTION-MGR-UNIQUE-CANCEL]", userId, req.GetIdempotentId(),
+		func() (*auction.UniqueListing, common.ErrorCode, string) {
+			return m.closeUniqueListing(ctx, "[AUCTION-MGR-UNIQUE-CANCEL]", "cancel", userId, req.GetListingId(), 0, 0)
+		})
+	return
+}
+
+// AcceptUniqueOffer 卖家接受竞价挂单的当前最高出价
+func (m *AuctionManager) AcceptUniqueOffer(ctx context.Context, req *auction.AcceptUniqueOfferReq) (resp *auction.AcceptUniqueOfferRsp, err error) {
+	userId, _ := ctx.Value("userId").(string)
+	resp = &auction.AcceptUniqueOfferRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	resp.Data, resp.Code, resp.Msg = m.uniqueWithIdempotency(ctx, "[AUCTION-MGR-UNIQUE-ACCEPT]", userId, req.GetIdempotentId(),
+		func() (*auction.UniqueListing, common.ErrorCode, string) {
+			return m.closeUniqueListing(ctx, "[AUCTION-MGR-UNIQUE-ACCEPT]", "accept", userId, req.GetListingId(), 0, 0)
+		})
+	return
+}
+
+// closeUniqueListing 取消、接受出价或一口价购买挂单
+func (m *AuctionManager) closeUniqueListing(ctx context.Context, logTag string, action string, userId string, listingId string,
+	price int64, proceeds int64) (*auction.UniqueListing, common.ErrorCode, string) {
+	if listingId == "" {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "listing_id is empty"
+	}
+	itemId, err := redis.GetRedis().HGet(ctx, uniqueListingKey(listingId), "item_id").Result()
+	if err == goredis.Nil {
+		return nil, common.ErrorCode_AUCTION_LISTING_NOT_FOUND, "listing not found or closed"
+	}
+	if err != nil {
+		klog.CtxErrorf(ctx, "%s Get listing error: %s", logTag, err.Error())
+		return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "get listing error"
+	}
+	halted := action != "cancel" && isItemHalted(ctx, itemId)
+	if halted && action == "accept" {
+		return nil, common.ErrorCode_AUCTION_TRADING_HALTED, "trading halted"
+	}
+
+	status, offerUser, listing, err := finishUniqueListing(ctx, action, listingId, itemId, userId, price, proceeds, halted)
+	if err != nil {
+		klog.CtxErrorf(ctx, "%s Close listing error: listingId=%s, error: %s", logTag, listingId, err.Error())
+		return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "close listing error"
+	}
+	if status != "ok" {
+		code, msg := uniqueScriptError(status)
+		klog.CtxInfof(ctx, "%s Close listing rejected: userId=%s, listingId=%s, status=%s", logTag, userId, listingId, status)
+		return nil, code, msg
+	}
+
+	klog.CtxInfof(ctx, "%s Listing closed: userId=%s, listingId=%s, status=%s, buyerId=%s, finalPrice=%d",
+		logTag, userId, listingId, listing.GetStatus().String(), listing.GetBuyerId(), listing.GetFinalPrice())
+	notifyUniqueClosed(ctx, listing, offerUser)
+	return listing, common.ErrorCode_OK, "success"
+}
+
+// BuyUniqueItem 按标价购买一口价唯一道具挂单
+func (m *AuctionManager) BuyUniqueItem(ctx context.Context, req *auction.BuyUniqueItemReq) (resp *auction.BuyUniqueItemRsp, err error) {
+	userId, _ := ctx.Value("userId").(string)
+	resp = &auction.BuyUniqueItemRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	resp.Data, resp.Code, resp.Msg = m.uniqueWithIdempotency(ctx, "[AUCTION-MGR-UNIQUE-BUY]", userId, req.GetIdempotentId(),
+		func() (*auction.UniqueListing, common.ErrorCode, string) {
+			return m.buyUniqueItem(ctx, userId, req)
+		})
+	return
+}
+
+func (m *AuctionManager) buyUniqueItem(ctx context.Context, userId string, req *auction.BuyUniqueItemReq) (*auction.UniqueListing, common.ErrorCode, string) {
+	if req.GetListingId() == "" {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "listing_id is empty"
+	}
+	listing, err := getUniqueListing(ctx, req.GetListingId())
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-UNIQUE-BUY] Get listing error: %s", err.Error())
+		return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "get listing error"
+	}
+	if listing == nil || listing.GetStatus() != auction.UniqueListingStatus_UNIQUE_ACTIVE {
+		return nil, common.ErrorCode_AUCTION_LISTING_NOT_FOUND, "listing not found or closed"
+	}
+	if listing.GetListingType() != auction.UniqueListingType_UNIQUE_FIXED_PRICE {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "wrong listing type"
+	}
+	if listing.GetSellerId() == userId {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "cannot trade own listing"
+	}
+	if req.GetPrice() != listing.GetPrice() {
+		return nil, common.ErrorCode_AUCTION_ORDER_CHANGED, "listing changed"
+	}
+	if isItemHalted(ctx, listing.GetItemId()) {
+		return nil, common.ErrorCode_AUCTION_TRADING_HALTED, "trading halted"
+	}
+
+	// 托管货款（买家承担手续费时一并托管），挂单已被买走或变化时退还
+	escrow, proceeds := getItemRule(listing.GetItemId()).tradeAmounts(listing.GetPrice())
+	escrowId := "auction:unique:buy:" + idClient.Generate().String()
+	if err := inventory.DeleteItem(ctx, userId, currencyItemId(), escrow, "auction_unique_buy", escrowId); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-UNIQUE-BUY] Escrow currency error, userId: %s, amount: %d, error: %s", userId, escrow, err.Error())
+		return nil, common.ErrorCode_AUCTION_ESCROW_FAILED, "escrow currency failed"
+	}
+
+	closed, code, msg := m.closeUniqueListing(ctx, "[AUCTION-MGR-UNIQUE-BUY]", "buy", userId, req.GetListingId(), listing.GetPrice(), proceeds)
+	if code != common.ErrorCode_OK {
+		enqueueSettlementJobs(ctx, &settlementJob{
+			Id:     escrowId + ":rollback",
+			UserId: userId,
+			ItemId: currencyItemId(),
+			Count:  escrow,
+			Reason: "auction_unique_buy_rollback",
+		})
+	}
+	return closed, code, msg
+}
+
+// OfferUniqueItem 对竞价唯一道具挂单出价，出价被托管，被更高出价超过或挂单取消时退还
+func (m *AuctionManager) OfferUniqueItem(ctx context.Context, req *auction.OfferUniqueItemReq) (resp *auction.OfferUniqueItemRsp, err error) {
+	userId, _ := ctx.Value("userId").(string)
+	resp = &auction.OfferUniqueItemRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	resp.Data, resp.Code, resp.Msg = m.uniqueWithIdempotency(ctx, "[AUCTION-MGR-UNIQUE-OFFER]", userId, req.GetIdempotentId(),
+		func() (*auction.UniqueListing, common.ErrorCode, string) {
+			return m.offerUniqueItem(ctx, userId, req)
+		})
+	return
+}
+
+func (m *AuctionManager) offerUniqueItem(ctx context.Context, userId string, req *auction.OfferUniqueItemReq) (*auction.UniqueListing, common.ErrorCode, string) {
+	if req.GetListingId() == "" {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "listing_id is empty"
+	}
+	listing, err := getUniqueListing(ctx, req.GetListingId())
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-UNIQUE-OFFER] Get listing error: %s", err.Error())
+		return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "get listing error"
+	}
+	if listing == nil || listing.GetStatus() != auction.UniqueListingStatus_UNIQUE_ACTIVE {
+		return nil, common.ErrorCode_AUCTION_LISTING_NOT_FOUND, "listing not found or closed"
+	}
+	if listing.GetListingType() != auction.UniqueListingType_UNIQUE_OFFER {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "wrong listing type"
+	}
+	if listing.GetSellerId() == userId {
+		return nil, common.ErrorCode_AUCTION_PARAM_ERROR, "cannot trade own listing"
+	}
+	rule := getItemRule(listing.GetItemId())
+	if code, msg := checkUniquePrice(rule, req.GetPrice()); code != common.ErrorCode_OK {
+		return nil, code, msg
+	}
+	minOffer := listing.GetPrice()
+	if listing.GetBestOffer() > 0 && listing.GetBestOffer()+rule.TickSize > minOffer {
+		minOffer = listing.GetBestOffer() + rule.TickSize
+	}
+	if req.GetPrice() < minOffer {
+		klog.CtxInfof(ctx, "[AUCTION-MGR-UNIQUE-OFFER] Offer too low, userId: %s, listingId: %s, price: %d, minOffer: %d", userId, req.GetListingId(), req.GetPrice(), minOffer)
+		return nil, common.ErrorCode_AUCTION_OFFER_TOO_LOW, fmt.Sprintf("offer must be at least %d", minOffer)
+	}
+	if isItemHalted(ctx, listing.GetItemId()) {
+		return nil, common.ErrorCode_AUCTION_TRADING_HALTED, "trading halted"
+	}
+
+	// 托管出价（买家承担手续费时一并托管）
+	escrow, proceeds := rule.tradeAmounts(req.GetPrice())
+	offerId := idClient.Generate().String()
+	escrowId := "auction:unique:offer:" + offerId
+	if err := inventory.DeleteItem(ctx, userId, currencyItemId(), escrow, "auction_unique_offer", escrowId); err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-UNIQUE-OFFER] Escrow currency error, userId: %s, amount: %d, error: %s", userId, escrow, err.Error())
+		return nil, common.ErrorCode_AUCTION_ESCROW_FAILED, "escrow currency failed"
+	}
+
+	// 原子地校验并替换最高出价，被超过的出价写入退还任务
+	keys := []string{uniqueListingKey(req.GetListingId()), uniqueOutboxKey(req.GetListingId())}
+	val, err := runWithOutbox(ctx, script.UniqueOffer, keys, userId, req.GetPrice(), escrow, proceeds, offerId,
+		time.Now().Unix(), rule.TickSize, currencyItemId()).Result()
+	status, prevUser, data := "", "", map[string]string(nil)
+	if err == nil {
+		status, prevUser, data = parseUniqueScriptResult(val)
+	}
+	if err != nil || status != "ok" {
+		enqueueSettlementJobs(ctx, &settlementJob{
+			Id:     escrowId + ":rollback",
+			UserId: userId,
+			ItemId: currencyItemId(),
+			Count:  escrow,
+			Reason: "auction_unique_offer_rollback",
+		})
+		if err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-MGR-UNIQUE-OFFER] Save offer error: listingId=%s, error: %s", req.GetListingId(), err.Error())
+			return nil, common.ErrorCode_AUCTION_REDIS_ERROR, "save offer error"
+		}
+		code, msg := uniqueScriptError(status)
+		klog.CtxInfof(ctx, "[AUCTION-MGR-UNIQUE-OFFER] Offer rejected: userId=%s, listingId=%s, status=%s", userId, req.GetListingId(), status)
+		return nil, code, msg
+	}
+
+	listing = uniqueListingFromMap(data)
+	klog.CtxInfof(ctx, "[AUCTION-MGR-UNIQUE-OFFER] Offer placed: userId=%s, listingId=%s, price=%d, previous=%s",
+		userId, req.GetListingId(), req.GetPrice(), prevUser)
+	getMatchManager().wakeSettlement()
+	if prevUser != "" && prevUser != userId {
+		notifyUniqueListing(ctx, prevUser, listing, "outbid")
+	}
+	return listing, common.ErrorCode_OK, "success"
+}
+
+// SearchUniqueListings 按道具ID搜索挂单中的唯一道具，支持价格区间、挂单类型和道具属性过滤
+// 游标为价格索引中的扫描位置，翻页期间挂单变化可能导致个别挂单重复或遗漏
+func (m *AuctionManager) SearchUniqueListings(ctx context.Context, req *auction.SearchUniqueListingsReq) (resp *auction.SearchUniqueListingsRsp, err error) {
+	resp = &auction.SearchUniqueListingsRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	if req.GetItemId() == "" {
+		resp.Msg = "item_id is empty"
+		return
+	}
+	if req.GetCursor() < 0 || req.GetMinPrice() < 0 || req.GetMaxPrice() < 0 {
+		resp.Msg = "invalid cursor or price range"
+		return
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = uniqueSearchLimit
+	}
+	if limit > uniqueSearchMaxLimit {
+		limit = uniqueSearchMaxLimit
+	}
+	types := make(map[auction.UniqueListingType]bool)
+	for _, t := range req.GetListingTypes() {
+		types[t] = true
+	}
+
+	minScore, maxScore := "-inf", "+inf"
+	if req.GetMinPrice() > 0 {
+		minScore = strconv.FormatInt(req.GetMinPrice(), 10)
+	}
+	if req.GetMaxPrice() > 0 {
+		maxScore = strconv.FormatInt(req.GetMaxPrice(), 10)
+	}
+
+	indexKey := uniqueItemIndexKey(req.GetItemId())
+	offset := int64(req.GetCursor())
+	scanned := 0
+	resp.Data = make([]*auction.UniqueListing, 0, limit)
+	for len(resp.Data) < limit && scanned < uniqueSearchMaxScan {
+		rangeBy := &goredis.ZRangeBy{Min: minScore, Max: maxScore, Offset: offset, Count: uniqueSearchBatch}
+		var ids []string
+		if req.GetPriceDesc() {
+			ids, err = redis.GetRedis().ZRevRangeByScore(ctx, indexKey, rangeBy).Result()
+		} else {
+			ids, err = redis.GetRedis().ZRangeByScore(ctx, indexKey, rangeBy).Result()
+		}
+		if err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-MGR-UNIQUE-SEARCH] Range index error: %s", err.Error())
+			resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+			resp.Msg = "search listings error"
+			resp.Data = nil
+			err = nil
+			return
+		}
+
+		pipe := redis.GetRedis().Pipeline()
+		cmds := make([]*goredis.MapStringStringCmd, len(ids))
+		for i, id := range ids {
+			cmds[i] = pipe.HGetAll(ctx, uniqueListingKey(id))
+		}
+		if len(ids) > 0 {
+			if _, err = pipe.Exec(ctx); err != nil {
+				klog.CtxErrorf(ctx, "[AUCTION-MGR-UNIQUE-SEARCH] Load listings error: %s", err.Error())
+				resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+				resp.Msg = "search listings error"
+				resp.Data = nil
+				err = nil
+				return
+			}
+		}
+
+		for _, cmd := range cmds {
+			offset++
+			scanned++
+			data := cmd.Val()
+			if data["status"] != uniqueStatusActive {
+				continue
+			}
+			listing := uniqueListingFromMap(data)
+			if len(types) > 0 && !types[listing.GetListingType()] {
+				continue
+			}
+			if !matchUniqueProperties(listing.GetProperties(), req.GetPropertyFilters()) {
+				continue
+			}
+			resp.Data = append(resp.Data, listing)
+			if len(resp.Data) >= limit {
+				break
+			}
+		}
+		if len(ids) < uniqueSearchBatch && len(resp.Data) < limit {
+			// 索引已扫描完
+			resp.NextCursor = int32(offset)
+			resp.Code = common.ErrorCode_OK
+			resp.Msg = "success"
+			return
+		}
+	}
+
+	resp.NextCursor = int32(offset)
+	resp.HasMore = true
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	return
+}
+
+// matchUniqueProperties 判断道具属性json的顶层字段是否与所有过滤条件相等
+func matchUniqueProperties(properties string, filters map[string]string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	props := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(properties), &props); err != nil {
+		return false
+	}
+	for k, want := range filters {
+		v, ok := props[k]
+		if !ok || fmt.Sprintf("%v", v) != want {
+			return false
+		}
+	}
+	return true
+}
+
+// GetMyUniqueListings 查看自己挂单中的唯一道具
+func (m *AuctionManager) GetMyUniqueListings(ctx context.Context, req *auction.GetMyUniqueListingsReq) (resp *auction.GetMyUniqueListingsRsp, err error) {
+	userId, _ := ctx.Value("userId").(string)
+	resp = &auction.GetMyUniqueListingsRsp{
+		Code: common.ErrorCode_AUCTION_PARAM_ERROR,
+		Msg:  "default error",
+	}
+	if userId == "" {
+		resp.Code = common.ErrorCode_AUCTION_USER_NOT_FOUND
+		resp.Msg = "user_id is empty"
+		return
+	}
+
+	ids, err := redis.GetRedis().SMembers(ctx, userUniqueListingsKey(userId)).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-MGR-UNIQUE-MY] Get user listings error: %s", err.Error())
+		resp.Code = common.ErrorCode_AUCTION_REDIS_ERROR
+		resp.Msg = "get user listings error"
+		err = nil
+		return
+	}
+	resp.Data = make([]*auction.UniqueListing, 0, len(ids))
+	for _, id := range ids {
+		listing, getErr := getUniqueListing(ctx, id)
+		if getErr != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-MGR-UNIQUE-MY] Get listing %s error: %s", id, getErr.Error())
+			continue
+		}
+		if listing != nil {
+			resp.Data = append(resp.Data, listing)
+		}
+	}
+	sort.Slice(resp.Data, func(i, j int) bool {
+		return resp.Data[i].GetCreateTime() > resp.Data[j].GetCreateTime()
+	})
+	resp.Code = common.ErrorCode_OK
+	resp.Msg = "success"
+	return
+}
+
+// runUniqueListingSweeper 定时处理到期的唯一道具挂单，多实例同时处理时由脚本中的状态检查保证只结束一次
+func (m *matchManager) runUniqueListingSweeper(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.sweepUniqueListings(ctx, time.Now().Unix())
+		}
+	}
+}
+
+// sweepUniqueListings 结束所有到期的挂单：有出价的竞价挂单成交给最高出价者，其余道具实例退回卖家
+func (m *matchManager) sweepUniqueListings(ctx context.Context, now int64) int {
+	ids, err := redis.GetRedis().ZRangeByScore(ctx, uniqueExpireKey, &goredis.ZRangeBy{
+		Min: "-inf", Max: strconv.FormatInt(now, 10), Count: uniqueSweepBatch,
+	}).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-UNIQUE-EXPIRE] range expired listings error: %s", err.Error())
+		return 0
+	}
+
+	closed := 0
+	for _, listingId := range ids {
+		itemId, err := redis.GetRedis().HGet(ctx, uniqueListingKey(listingId), "item_id").Result()
+		if err == goredis.Nil {
+			// 挂单数据已不存在，清理索引
+			redis.GetRedis().ZRem(ctx, uniqueExpireKey, listingId)
+			continue
+		}
+		if err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-UNIQUE-EXPIRE] get listing %s error: %s", listingId, err.Error())
+			continue
+		}
+		status, offerUser, listing, err := finishUniqueListing(ctx, "expire", listingId, itemId, "", 0, 0, isItemHalted(ctx, itemId))
+		if err != nil {
+			klog.CtxErrorf(ctx, "[AUCTION-UNIQUE-EXPIRE] expire listing %s error: %s", listingId, err.Error())
+			continue
+		}
+		if status != "ok" {
+			if status == "closed" {
+				redis.GetRedis().ZRem(ctx, uniqueExpireKey, listingId)
+			}
+			continue
+		}
+		closed++
+		klog.CtxInfof(ctx, "[AUCTION-UNIQUE-EXPIRE] Listing expired: listingId=%s, status=%s, buyerId=%s, finalPrice=%d",
+			listingId, listing.GetStatus().String(), listing.GetBuyerId(), listing.GetFinalPrice())
+		notifyUniqueClosed(ctx, listing, offerUser)
+	}
+	return closed
+}
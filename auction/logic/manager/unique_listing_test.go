@@ -0,0 +1,169 @@
+package manager
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/common"
+	"auction_module/kitex_gen/item"
+	"auction_module/redis"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// 测试用例: 唯一道具按实例挂单、搜索、一口价购买、竞价、接受出价、取消与到期
+func TestAuctionManager_UniqueListings(t *testing.T) {
+	setupTest()
+	defer teardownTest()
+	ctx := context.Background()
+	_, err := redis.GetRedis().Ping(ctx).Result()
+	if err != nil {
+		t.Skip("Redis connection failed, skipping test:", err)
+	}
+
+	fake := newFakeInventory()
+	inventory = fake
+	defer func() { inventory = newFakeInventory() }()
+	ntf := &fakeNotifier{msgs: make(map[string][]proto.Message)}
+	notifier = ntf
+	defer func() { notifier = &gatewayNotifier{} }()
+
+	userCtx := func(userId string) context.Context { return context.WithValue(ctx, "userId", userId) }
+	currency := currencyItemId()
+	manager := GetAuctionManager()
+	mgr := getMatchManager()
+	seq := 0
+	idem := func() string {
+		seq++
+		return fmt.Sprintf("test_unique_%d_%d", time.Now().UnixNano(), seq)
+	}
+	grant := func(userId string, uniqueId string, properties string) {
+		fake.grantInstance(userId, &item.ItemInfo{ItemId: 1001, ItemUniqueId: uniqueId, ItemType: 3, Properties: properties, Count: 1})
+	}
+	list := func(userId string, uniqueId string, listingType auction.UniqueListingType, price int64) *auction.UniqueListing {
+		resp, err := manager.ListUniqueItem(userCtx(userId), &auction.ListUniqueItemReq{
+			ItemUniqueId: uniqueId, ListingType: listingType, Price: price, IdempotentId: idem()})
+		assert.NoError(t, err)
+		assert.Equal(t, common.ErrorCode_OK, resp.Code, resp.Msg)
+		return resp.Data
+	}
+	lastNtf := func(userId string) *auction.AuctionUniqueListingNtf {
+		ntf.mu.Lock()
+		defer ntf.mu.Unlock()
+		msgs := ntf.msgs[userId]
+		if len(msgs) == 0 {
+			return nil
+		}
+		return msgs[len(msgs)-1].(*auction.AuctionUniqueListingNtf)
+	}
+	_, sellerGets := getItemRule("1001").tradeAmounts(500)
+
+	// 1. 挂单托管具体的道具实例，非唯一道具不能按实例挂单
+	grant("test_unique_seller", "90001", `{"color":"red","level":5}`)
+	grant("test_unique_seller", "1001", `{"item_id":1001}`)
+	listing := list("test_unique_seller", "90001", auction.UniqueListingType_UNIQUE_FIXED_PRICE, 500)
+	assert.Equal(t, "1001", listing.ItemId)
+	assert.Equal(t, `{"color":"red","level":5}`, listing.Properties)
+	assert.Nil(t, fake.instance("test_unique_seller", "90001"))
+	notUnique, err := manager.ListUniqueItem(userCtx("test_unique_seller"), &auction.ListUniqueItemReq{ItemUniqueId: "1001", Price: 500, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_ITEM_NOT_UNIQUE, notUnique.Code)
+	grant("test_unique_seller", "90009", `{"bindable":true}`)
+	bound, err := manager.ListUniqueItem(userCtx("test_unique_seller"), &auction.ListUniqueItemReq{ItemUniqueId: "90009", Price: 500, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_ITEM_NOT_TRADABLE, bound.Code)
+	assert.NotNil(t, fake.instance("test_unique_seller", "90009"))
+
+	// 2. 按道具ID和属性搜索
+	search := func(filters map[string]string) []*auction.UniqueListing {
+		resp, err := manager.SearchUniqueListings(ctx, &auction.SearchUniqueListingsReq{ItemId: "1001", PropertyFilters: filters})
+		assert.NoError(t, err)
+		assert.Equal(t, common.ErrorCode_OK, resp.Code)
+		return resp.Data
+	}
+	assert.Len(t, search(map[string]string{"color": "red", "level": "5"}), 1)
+	assert.Len(t, search(map[string]string{"color": "blue"}), 0)
+
+	// 3. 一口价购买：实例原样交付买家，卖家收到扣除手续费后的货款，重复购买失败
+	buyResp, err := manager.BuyUniqueItem(userCtx("test_unique_buyer"), &auction.BuyUniqueItemReq{ListingId: listing.ListingId, Price: 500, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, buyResp.Code, buyResp.Msg)
+	assert.Equal(t, auction.UniqueListingStatus_UNIQUE_SOLD, buyResp.Data.Status)
+	mgr.settlePending(ctx)
+	if got := fake.instance("test_unique_buyer", "90001"); assert.NotNil(t, got) {
+		assert.Equal(t, `{"color":"red","level":5}`, got.Properties)
+	}
+	assert.Equal(t, sellerGets, fake.balance("test_unique_seller", currency))
+	assert.Equal(t, "sold", lastNtf("test_unique_seller").Event)
+	again, err := manager.BuyUniqueItem(userCtx("test_unique_buyer2"), &auction.BuyUniqueItemReq{ListingId: listing.ListingId, Price: 500, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_LISTING_NOT_FOUND, again.Code)
+	mgr.settlePending(ctx)
+	assert.Equal(t, int64(0), fake.balance("test_unique_buyer2", currency))
+	assert.Len(t, search(nil), 0)
+
+	// 4. 竞价：出价必须高于当前最高价，被超过的出价退还，卖家接受最高出价
+	grant("test_unique_seller", "90002", `{"color":"blue"}`)
+	auctionListing := list("test_unique_seller", "90002", auction.UniqueListingType_UNIQUE_OFFER, 100)
+	offer := func(userId string, price int64) *auction.OfferUniqueItemRsp {
+		resp, err := manager.OfferUniqueItem(userCtx(userId), &auction.OfferUniqueItemReq{ListingId: auctionListing.ListingId, Price: price, IdempotentId: idem()})
+		assert.NoError(t, err)
+		return resp
+	}
+	assert.Equal(t, common.ErrorCode_OK, offer("test_unique_bidder_a", 100).Code)
+	assert.Equal(t, common.ErrorCode_AUCTION_OFFER_TOO_LOW, offer("test_unique_bidder_b", 100).Code)
+	assert.Equal(t, common.ErrorCode_OK, offer("test_unique_bidder_b", 120).Code)
+	mgr.settlePending(ctx)
+	assert.Equal(t, int64(0), fake.balance("test_unique_bidder_a", currency))
+	assert.Equal(t, "outbid", lastNtf("test_unique_bidder_a").Event)
+	notOwner, err := manager.AcceptUniqueOffer(userCtx("test_unique_bidder_a"), &auction.AcceptUniqueOfferReq{ListingId: auctionListing.ListingId, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_AUCTION_PARAM_ERROR, notOwner.Code)
+	accept, err := manager.AcceptUniqueOffer(userCtx("test_unique_seller"), &auction.AcceptUniqueOfferReq{ListingId: auctionListing.ListingId, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, accept.Code, accept.Msg)
+	assert.Equal(t, "test_unique_bidder_b", accept.Data.BuyerId)
+	assert.Equal(t, int64(120), accept.Data.FinalPrice)
+	mgr.settlePending(ctx)
+	assert.NotNil(t, fake.instance("test_unique_bidder_b", "90002"))
+	escrowB, _ := getItemRule("1001").tradeAmounts(120)
+	assert.Equal(t, -escrowB, fake.balance("test_unique_bidder_b", currency))
+	assert.Equal(t, "won", lastNtf("test_unique_bidder_b").Event)
+
+	// 5. 取消挂单：实例退回卖家，当前出价退还
+	grant("test_unique_seller", "90003", `{}`)
+	cancelListing := list("test_unique_seller", "90003", auction.UniqueListingType_UNIQUE_OFFER, 100)
+	auctionListing = cancelListing
+	assert.Equal(t, common.ErrorCode_OK, offer("test_unique_bidder_c", 100).Code)
+	cancelResp, err := manager.CancelUniqueListing(userCtx("test_unique_seller"), &auction.CancelUniqueListingReq{ListingId: cancelListing.ListingId, IdempotentId: idem()})
+	assert.NoError(t, err)
+	assert.Equal(t, common.ErrorCode_OK, cancelResp.Code)
+	assert.Equal(t, auction.UniqueListingStatus_UNIQUE_CANCELLED, cancelResp.Data.Status)
+	mgr.settlePending(ctx)
+	assert.NotNil(t, fake.instance("test_unique_seller", "90003"))
+	assert.Equal(t, int64(0), fake.balance("test_unique_bidder_c", currency))
+
+	// 6. 到期：有出价的竞价挂单成交给最高出价者，无出价的挂单退回卖家
+	grant("test_unique_seller", "90004", `{}`)
+	grant("test_unique_seller", "90005", `{}`)
+	auctionListing = list("test_unique_seller", "90004", auction.UniqueListingType_UNIQUE_OFFER, 100)
+	assert.Equal(t, common.ErrorCode_OK, offer("test_unique_bidder_d", 150).Code)
+	unsold := list("test_unique_seller", "90005", auction.UniqueListingType_UNIQUE_FIXED_PRICE, 100)
+	now := time.Now().Unix()
+	for _, id := range []string{auctionListing.ListingId, unsold.ListingId} {
+		redis.GetRedis().HSet(ctx, uniqueListingKey(id), "expire_time", now-1)
+		redis.GetRedis().ZAdd(ctx, uniqueExpireKey, goredis.Z{Score: float64(now - 1), Member: id})
+	}
+	assert.Equal(t, 2, mgr.sweepUniqueListings(ctx, now))
+	mgr.settlePending(ctx)
+	assert.NotNil(t, fake.instance("test_unique_bidder_d", "90004"))
+	assert.NotNil(t, fake.instance("test_unique_seller", "90005"))
+	assert.Equal(t, "expired", lastNtf("test_unique_seller").Event)
+	my, err := manager.GetMyUniqueListings(userCtx("test_unique_seller"), &auction.GetMyUniqueListingsReq{})
+	assert.NoError(t, err)
+	assert.Len(t, my.Data, 0)
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"auction_module/kitex_gen/auction_admin"
+	"auction_module/kitex_gen/common"
+	"auction_module/logic/manager"
+	"context"
+)
+
+// 运维服务（AuctionAdminService）实现，按道具转发到撮合单元所在实例
+
+func (x *AuctionService) AdminHaltItem(ctx context.Context, req *auction_admin.AdminHaltItemReq) (resp *auction_admin.AdminHaltItemRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	if peer, err := routeAdminItem(ctx, req.GetItemId()); err != nil {
+		return &auction_admin.AdminHaltItemRsp{Code: common.ErrorCode_AUCTION_MARKET_UNAVAILABLE, Msg: err.Error()}, nil
+	} else if peer != nil {
+		return peer.AdminHaltItem(forwardContext(ctx), req)
+	}
+	return auctionMgr.AdminHaltItem(ctx, req)
+}
+
+func (x *AuctionService) AdminResumeItem(ctx context.Context, req *auction_admin.AdminResumeItemReq) (resp *auction_admin.AdminResumeItemRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	if peer, err := routeAdminItem(ctx, req.GetItemId()); err != nil {
+		return &auction_admin.AdminResumeItemRsp{Code: common.ErrorCode_AUCTION_MARKET_UNAVAILABLE, Msg: err.Error()}, nil
+	} else if peer != nil {
+		return peer.AdminResumeItem(forwardContext(ctx), req)
+	}
+	return auctionMgr.AdminResumeItem(ctx, req)
+}
+
+// AdminCancelOrders 只指定用户时按用户挂单涉及的道具拆分，逐个道具撤单后汇总撤单数量
+func (x *AuctionService) AdminCancelOrders(ctx context.Context, req *auction_admin.AdminCancelOrdersReq) (resp *auction_admin.AdminCancelOrdersRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	if req.GetItemId() == "" && req.GetUserId() == "" {
+		return auctionMgr.AdminCancelOrders(ctx, req)
+	}
+	if req.GetItemId() != "" {
+		if peer, err := routeAdminItem(ctx, req.GetItemId()); err != nil {
+			return &auction_admin.AdminCancelOrdersRsp{Code: common.ErrorCode_AUCTION_MARKET_UNAVAILABLE, Msg: err.Error()}, nil
+		} else if peer != nil {
+			return peer.AdminCancelOrders(forwardContext(ctx), req)
+		}
+		return auctionMgr.AdminCancelOrders(ctx, req)
+	}
+
+	itemIds, err := auctionMgr.AdminUserOrderItems(ctx, req.GetUserId())
+	if err != nil {
+		return &auction_admin.AdminCancelOrdersRsp{Code: common.ErrorCode_AUCTION_REDIS_ERROR, Msg: "get user orders error"}, nil
+	}
+	resp = &auction_admin.AdminCancelOrdersRsp{Code: common.ErrorCode_OK, Msg: "success"}
+	for _, itemId := range itemIds {
+		itemReq := &auction_admin.AdminCancelOrdersReq{
+			OperatorId: req.GetOperatorId(),
+			UserId:     req.GetUserId(),
+			ItemId:     itemId,
+			Reason:     req.GetReason(),
+		}
+		itemResp, err := x.AdminCancelOrders(ctx, itemReq)
+		if err != nil {
+			return nil, err
+		}
+		if itemResp.GetCode() != common.ErrorCode_OK {
+			itemResp.Cancelled += resp.Cancelled
+			return itemResp, nil
+		}
+		resp.Cancelled += itemResp.GetCancelled()
+	}
+	return resp, nil
+}
+
+func (x *AuctionService) AdminResetReferencePrice(ctx context.Context, req *auction_admin.AdminResetReferencePriceReq) (resp *auction_admin.AdminResetReferencePriceRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	if peer, err := routeAdminItem(ctx, req.GetItemId()); err != nil {
+		return &auction_admin.AdminResetReferencePriceRsp{Code: common.ErrorCode_AUCTION_MARKET_UNAVAILABLE, Msg: err.Error()}, nil
+	} else if peer != nil {
+		return peer.AdminResetReferencePrice(forwardContext(ctx), req)
+	}
+	return auctionMgr.AdminResetReferencePrice(ctx, req)
+}
+
+func (x *AuctionService) AdminDumpBook(ctx context.Context, req *auction_admin.AdminDumpBookReq) (resp *auction_admin.AdminDumpBookRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	if peer, err := routeAdminItem(ctx, req.GetItemId()); err != nil {
+		return &auction_admin.AdminDumpBookRsp{Code: common.ErrorCode_AUCTION_MARKET_UNAVAILABLE, Msg: err.Error()}, nil
+	} else if peer != nil {
+		return peer.AdminDumpBook(forwardContext(ctx), req)
+	}
+	return auctionMgr.AdminDumpBook(ctx, req)
+}
+
+// AdminGetFraudFlags 风控标记保存在Redis中，任意实例均可直接查询
+func (x *AuctionService) AdminGetFraudFlags(ctx context.Context, req *auction_admin.AdminGetFraudFlagsReq) (resp *auction_admin.AdminGetFraudFlagsRsp, err error) {
+	return manager.GetAuctionManager().AdminGetFraudFlags(ctx, req)
+}
@@ -6,12 +6,15 @@ import (
 	"auction_module/etcd"
 	auction_http "auction_module/http"
 	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/auction_admin_service/auctionadminservice"
 	"auction_module/kitex_gen/auction_service/auctionservice"
+	"auction_module/kitex_gen/common"
 	"auction_module/logic/manager"
 	"auction_module/rpc_middleware"
 	"context"
 	"net"
 	nethttp "net/http"
+	"strconv"
 	"sync"
 
 	"github.com/cloudwego/kitex/pkg/klog"
@@ -46,6 +49,7 @@ func (s *AuctionService) ListenAndServe(ctx context.Context) {
 	clientRouter := auction_http.GetClientRouter()
 	group := clientRouter.Group("api").Group("1.0").Group("public").Group("auction")
 	group.POST(":method", s.ginRoute)
+	group.GET("export/statement", s.exportStatement)
 
 	httpServer := &nethttp.Server{
 		Addr:    config.Get("auction_http.addr").(string),
@@ -80,6 +84,8 @@ func (s *AuctionService) ListenAndServe(ctx context.Context) {
 
 	ser := NewKitexServer()
 	auctionservice.RegisterService(ser, s)
+	// 运维服务与玩家服务共用端口，仅供内部运维工具调用，不经过网关和HTTP路由
+	auctionadminservice.RegisterService(ser, s)
 
 	go func() {
 		if err := ser.Run(); err != nil {
@@ -105,7 +111,33 @@ func (s *AuctionService) ginRoute(ctx *gin.Context) {
 	}
 }
 
+// exportStatement 以文件下载的形式导出个人对账单，参数通过query传入：start_time、end_time、format(csv/json)
+func (s *AuctionService) exportStatement(ctx *gin.Context) {
+	req := &auction.ExportAuctionStatementReq{Format: ctx.Query("format")}
+	req.StartTime, _ = strconv.ParseInt(ctx.Query("start_time"), 10, 64)
+	req.EndTime, _ = strconv.ParseInt(ctx.Query("end_time"), 10, 64)
+
+	resp, err := s.ExportAuctionStatement(context.WithValue(ctx.Request.Context(), "userId", ctx.Value("userId").(string)), req)
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-SVR-EXPORT-STATEMENT] %s", err.Error())
+		ctx.AbortWithStatus(nethttp.StatusInternalServerError)
+		return
+	}
+	str := driver.NewHttpStream(ctx.Writer, ctx.Request)
+	if resp.GetCode() != common.ErrorCode_OK {
+		err = str.SendMsg(resp)
+	} else {
+		err = driver.SendFile(ctx.Writer, resp.GetFileName(), resp.GetContentType(), []byte(resp.GetContent()))
+	}
+	if err != nil {
+		klog.CtxErrorf(ctx, "[AUCTION-SVR-EXPORT-STATEMENT] write response error: %s", err.Error())
+	}
+}
+
 func (s *AuctionService) Close() {
+	// 停止限时拍卖结算调度，排空撮合单元并释放道具归属，其他实例可立即接管
+	manager.GetTimedAuctionManager().Close()
+	manager.GetAuctionManager().Close(context.Background())
 }
 
 func (x *AuctionService) Ping(ctx context.Context, req *auction.PingReq) (resp *auction.PingRsp, err error) {
@@ -115,24 +147,64 @@ func (x *AuctionService) Ping(ctx context.Context, req *auction.PingReq) (resp *
 
 func (x *AuctionService) Sell(ctx context.Context, req *auction.SellReq) (resp *auction.SellRsp, err error) {
 	auctionMgr := manager.GetAuctionManager()
+	if peer, err := routeItem(ctx, req.GetItemId()); err != nil {
+		return &auction.SellRsp{Code: common.ErrorCode_AUCTION_MARKET_UNAVAILABLE, Msg: err.Error()}, nil
+	} else if peer != nil {
+		return peer.Sell(forwardContext(ctx), req)
+	}
 	return auctionMgr.Sell(ctx, req)
 }
 
 func (x *AuctionService) Buy(ctx context.Context, req *auction.BuyReq) (resp *auction.BuyRsp, err error) {
 	auctionMgr := manager.GetAuctionManager()
+	if peer, err := routeItem(ctx, req.GetItemId()); err != nil {
+		return &auction.BuyRsp{Code: common.ErrorCode_AUCTION_MARKET_UNAVAILABLE, Msg: err.Error()}, nil
+	} else if peer != nil {
+		return peer.Buy(forwardContext(ctx), req)
+	}
 	return auctionMgr.Buy(ctx, req)
 }
 
 func (x *AuctionService) CancelSell(ctx context.Context, req *auction.CancelSellReq) (resp *auction.CancelSellRsp, err error) {
 	auctionMgr := manager.GetAuctionManager()
+	if peer, err := routeOrder(ctx, "sell", req.GetOrderId()); err != nil {
+		return &auction.CancelSellRsp{Code: common.ErrorCode_AUCTION_MARKET_UNAVAILABLE, Msg: err.Error()}, nil
+	} else if peer != nil {
+		return peer.CancelSell(forwardContext(ctx), req)
+	}
 	return auctionMgr.CancelSell(ctx, req)
 }
 
 func (x *AuctionService) CancelBuy(ctx context.Context, req *auction.CancelBuyReq) (resp *auction.CancelBuyRsp, err error) {
 	auctionMgr := manager.GetAuctionManager()
+	if peer, err := routeOrder(ctx, "buy", req.GetOrderId()); err != nil {
+		return &auction.CancelBuyRsp{Code: common.ErrorCode_AUCTION_MARKET_UNAVAILABLE, Msg: err.Error()}, nil
+	} else if peer != nil {
+		return peer.CancelBuy(forwardContext(ctx), req)
+	}
 	return auctionMgr.CancelBuy(ctx, req)
 }
 
+func (x *AuctionService) AmendSell(ctx context.Context, req *auction.AmendSellReq) (resp *auction.AmendSellRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	if peer, err := routeOrder(ctx, "sell", req.GetOrderId()); err != nil {
+		return &auction.AmendSellRsp{Code: common.ErrorCode_AUCTION_MARKET_UNAVAILABLE, Msg: err.Error()}, nil
+	} else if peer != nil {
+		return peer.AmendSell(forwardContext(ctx), req)
+	}
+	return auctionMgr.AmendSell(ctx, req)
+}
+
+func (x *AuctionService) AmendBuy(ctx context.Context, req *auction.AmendBuyReq) (resp *auction.AmendBuyRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	if peer, err := routeOrder(ctx, "buy", req.GetOrderId()); err != nil {
+		return &auction.AmendBuyRsp{Code: common.ErrorCode_AUCTION_MARKET_UNAVAILABLE, Msg: err.Error()}, nil
+	} else if peer != nil {
+		return peer.AmendBuy(forwardContext(ctx), req)
+	}
+	return auctionMgr.AmendBuy(ctx, req)
+}
+
 func (x *AuctionService) GetMySells(ctx context.Context, req *auction.GetMySellsReq) (resp *auction.GetMySellsRsp, err error) {
 	auctionMgr := manager.GetAuctionManager()
 	return auctionMgr.GetMySells(ctx, req)
@@ -144,8 +216,17 @@ func (x *AuctionService) GetMyBuys(ctx context.Context, req *auction.GetMyBuysRe
 }
 
 func (x *AuctionService) GetItemAuctionInfo(ctx context.Context, req *auction.GetItemAuctionInfoReq) (resp *auction.GetItemAuctionInfoRsp, err error) {
+	resp, err = getItemAuctionInfo(ctx, req)
+	if err != nil {
+		return &auction.GetItemAuctionInfoRsp{Code: common.ErrorCode_AUCTION_MARKET_UNAVAILABLE, Msg: err.Error()}, nil
+	}
+	return resp, nil
+}
+
+// SearchMarket 市场索引由各撮合单元写入Redis，任意实例均可直接查询
+func (x *AuctionService) SearchMarket(ctx context.Context, req *auction.SearchMarketReq) (resp *auction.SearchMarketRsp, err error) {
 	auctionMgr := manager.GetAuctionManager()
-	return auctionMgr.GetItemAuctionInfo(ctx, req)
+	return auctionMgr.SearchMarket(ctx, req)
 }
 
 func (x *AuctionService) GetTransactionHistory(ctx context.Context, req *auction.GetTransactionHistoryReq) (resp *auction.GetTransactionHistoryRsp, err error) {
@@ -157,3 +238,91 @@ func (x *AuctionService) GetTransactionsByTime(ctx context.Context, req *auction
 	auctionMgr := manager.GetAuctionManager()
 	return auctionMgr.GetTransactionsByTime(ctx, req)
 }
+
+// 对账单只读取Redis中的日汇总，任意实例均可直接处理
+func (x *AuctionService) GetAuctionStatement(ctx context.Context, req *auction.GetAuctionStatementReq) (resp *auction.GetAuctionStatementRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	return auctionMgr.GetAuctionStatement(ctx, req)
+}
+
+func (x *AuctionService) ExportAuctionStatement(ctx context.Context, req *auction.ExportAuctionStatementReq) (resp *auction.ExportAuctionStatementRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	return auctionMgr.ExportAuctionStatement(ctx, req)
+}
+
+func (x *AuctionService) GetItemKline(ctx context.Context, req *auction.GetItemKlineReq) (resp *auction.GetItemKlineRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	return auctionMgr.GetItemKline(ctx, req)
+}
+
+func (x *AuctionService) SubscribeItem(ctx context.Context, req *auction.SubscribeItemReq) (resp *auction.SubscribeItemRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	if peer, err := routeItem(ctx, req.GetItemId()); err != nil {
+		return &auction.SubscribeItemRsp{Code: common.ErrorCode_AUCTION_MARKET_UNAVAILABLE, Msg: err.Error()}, nil
+	} else if peer != nil {
+		return peer.SubscribeItem(forwardContext(ctx), req)
+	}
+	return auctionMgr.SubscribeItem(ctx, req)
+}
+
+func (x *AuctionService) UnsubscribeItem(ctx context.Context, req *auction.UnsubscribeItemReq) (resp *auction.UnsubscribeItemRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	return auctionMgr.UnsubscribeItem(ctx, req)
+}
+
+// 唯一道具挂单的状态变化都在Redis脚本中原子完成，任意实例均可直接处理，不需要路由
+func (x *AuctionService) ListUniqueItem(ctx context.Context, req *auction.ListUniqueItemReq) (resp *auction.ListUniqueItemRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	return auctionMgr.ListUniqueItem(ctx, req)
+}
+
+func (x *AuctionService) CancelUniqueListing(ctx context.Context, req *auction.CancelUniqueListingReq) (resp *auction.CancelUniqueListingRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	return auctionMgr.CancelUniqueListing(ctx, req)
+}
+
+func (x *AuctionService) BuyUniqueItem(ctx context.Context, req *auction.BuyUniqueItemReq) (resp *auction.BuyUniqueItemRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	return auctionMgr.BuyUniqueItem(ctx, req)
+}
+
+func (x *AuctionService) OfferUniqueItem(ctx context.Context, req *auction.OfferUniqueItemReq) (resp *auction.OfferUniqueItemRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	return auctionMgr.OfferUniqueItem(ctx, req)
+}
+
+func (x *AuctionService) AcceptUniqueOffer(ctx context.Context, req *auction.AcceptUniqueOfferReq) (resp *auction.AcceptUniqueOfferRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	return auctionMgr.AcceptUniqueOffer(ctx, req)
+}
+
+func (x *AuctionService) SearchUniqueListings(ctx context.Context, req *auction.SearchUniqueListingsReq) (resp *auction.SearchUniqueListingsRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	return auctionMgr.SearchUniqueListings(ctx, req)
+}
+
+func (x *AuctionService) GetMyUniqueListings(ctx context.Context, req *auction.GetMyUniqueListingsReq) (resp *auction.GetMyUniqueListingsRsp, err error) {
+	auctionMgr := manager.GetAuctionManager()
+	return auctionMgr.GetMyUniqueListings(ctx, req)
+}
+
+// 限时拍卖的状态变化都在Redis脚本中原子完成，任意实例均可直接处理，不需要路由
+func (x *AuctionService) CreateTimedAuction(ctx context.Context, req *auction.CreateTimedAuctionReq) (resp *auction.CreateTimedAuctionRsp, err error) {
+	return manager.GetTimedAuctionManager().CreateTimedAuction(ctx, req)
+}
+
+func (x *AuctionService) BidTimedAuction(ctx context.Context, req *auction.BidTimedAuctionReq) (resp *auction.BidTimedAuctionRsp, err error) {
+	return manager.GetTimedAuctionManager().BidTimedAuction(ctx, req)
+}
+
+func (x *AuctionService) CancelTimedAuction(ctx context.Context, req *auction.CancelTimedAuctionReq) (resp *auction.CancelTimedAuctionRsp, err error) {
+	return manager.GetTimedAuctionManager().CancelTimedAuction(ctx, req)
+}
+
+func (x *AuctionService) GetTimedAuction(ctx context.Context, req *auction.GetTimedAuctionReq) (resp *auction.GetTimedAuctionRsp, err error) {
+	return manager.GetTimedAuctionManager().GetTimedAuction(ctx, req)
+}
+
+func (x *AuctionService) GetTimedAuctions(ctx context.Context, req *auction.GetTimedAuctionsReq) (resp *auction.GetTimedAuctionsRsp, err error) {
+	return manager.GetTimedAuctionManager().GetTimedAuctions(ctx, req)
+}
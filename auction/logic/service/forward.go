@@ -0,0 +1,128 @@
+package service
+
+import (
+	"auction_module/kitex_gen/auction"
+	"auction_module/kitex_gen/auction_admin_service/auctionadminservice"
+	"auction_module/kitex_gen/auction_service/auctionservice"
+	"auction_module/kitex_gen/common"
+	"auction_module/logic/manager"
+	"auction_module/rpc"
+	"auction_module/rpc_middleware"
+	"context"
+	"fmt"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// routeOwner 判断道具是否由本实例撮合，不是时返回持有该道具的实例地址（本实例处理时返回空字符串）
+func routeOwner(ctx context.Context, itemId string) (string, error) {
+	owner, local, err := manager.GetAuctionManager().RouteItem(ctx, itemId)
+	if err != nil {
+		return "", err
+	}
+	if local {
+		return "", nil
+	}
+
+	// 已经被转发过一次仍不在本实例，说明归属正在迁移，交由客户端重试
+	if rpc_middleware.IsForwarded(ctx) {
+		klog.CtxWarnf(ctx, "[AUCTION-SVR-FORWARD] item %s owned by %s, forwarded request rejected", itemId, owner)
+		return "", fmt.Errorf("item %s is moving to another instance", itemId)
+	}
+
+	klog.CtxInfof(ctx, "[AUCTION-SVR-FORWARD] forward item %s to %s", itemId, owner)
+	return owner, nil
+}
+
+// routeItem 判断道具是否由本实例撮合，不是时返回持有该道具的实例客户端（本实例处理时返回nil）
+func routeItem(ctx context.Context, itemId string) (auctionservice.Client, error) {
+	owner, err := routeOwner(ctx, itemId)
+	if err != nil || owner == "" {
+		return nil, err
+	}
+	return rpc.GetAuctionPeerClient(owner)
+}
+
+// routeAdminItem 同routeItem，返回持有该道具的实例的运维服务客户端
+func routeAdminItem(ctx context.Context, itemId string) (auctionadminservice.Client, error) {
+	owner, err := routeOwner(ctx, itemId)
+	if err != nil || owner == "" {
+		return nil, err
+	}
+	return rpc.GetAuctionAdminPeerClient(owner)
+}
+
+// routeOrder 根据订单所属道具判断处理实例，订单不存在时由本实例处理
+func routeOrder(ctx context.Context, direction string, orderId string) (auctionservice.Client, error) {
+	itemId, err := manager.GetAuctionManager().GetOrderItemId(ctx, direction, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if itemId == "" {
+		return nil, nil
+	}
+	return routeItem(ctx, itemId)
+}
+
+// forwardContext 构造转发到其他实例的上下文
+func forwardContext(ctx context.Context) context.Context {
+	if userId, ok := ctx.Value("userId").(string); ok && userId != "" {
+		ctx = rpc_middleware.SetUserIdToContext(ctx, userId)
+	}
+	return rpc_middleware.SetForwardedToContext(ctx)
+}
+
+// getItemAuctionInfo 按道具归属拆分请求，本实例的道具直接查询，其他道具转发到对应实例后按原顺序合并
+func getItemAuctionInfo(ctx context.Context, req *auction.GetItemAuctionInfoReq) (*auction.GetItemAuctionInfoRsp, error) {
+	auctionMgr := manager.GetAuctionManager()
+
+	localIds := make([]string, 0, len(req.GetItemIds()))
+	remoteIds := make(map[auctionservice.Client][]string)
+	for _, itemId := range req.GetItemIds() {
+		peer, err := routeItem(ctx, itemId)
+		if err != nil {
+			return nil, err
+		}
+		if peer == nil {
+			localIds = append(localIds, itemId)
+		} else {
+			remoteIds[peer] = append(remoteIds[peer], itemId)
+		}
+	}
+
+	if len(remoteIds) == 0 {
+		return auctionMgr.GetItemAuctionInfo(ctx, req)
+	}
+
+	infos := make(map[string]*auction.ItemAuctionInfo, len(req.GetItemIds()))
+	if len(localIds) > 0 {
+		resp, err := auctionMgr.GetItemAuctionInfo(ctx, &auction.GetItemAuctionInfoReq{ItemIds: localIds})
+		if err != nil || resp.GetCode() != common.ErrorCode_OK {
+			return resp, err
+		}
+		for _, info := range resp.GetData() {
+			infos[info.GetItemId()] = info
+		}
+	}
+	for peer, itemIds := range remoteIds {
+		resp, err := peer.GetItemAuctionInfo(forwardContext(ctx), &auction.GetItemAuctionInfoReq{ItemIds: itemIds})
+		if err != nil || resp.GetCode() != common.ErrorCode_OK {
+			return resp, err
+		}
+		for _, info := range resp.GetData() {
+			infos[info.GetItemId()] = info
+		}
+	}
+
+	data := make([]*auction.ItemAuctionInfo, 0, len(req.GetItemIds()))
+	for _, itemId := range req.GetItemIds() {
+		if info, ok := infos[itemId]; ok {
+			data = append(data, info)
+		}
+	}
+	return &auction.GetItemAuctionInfoRsp{
+		Code: common.ErrorCode_OK,
+		Msg:  "success",
+		Data: data,
+	}, nil
+}
@@ -5,6 +5,8 @@ import (
 	"auction_module/logic/manager"
 	"auction_module/logic/service"
 	"auction_module/redis"
+	"auction_module/redis/script"
+	"auction_module/rpc"
 	"auction_module/tracer"
 	"context"
 	"os"
@@ -22,15 +24,28 @@ func main() {
 	defer cancel()
 	config.LoadConfig()
 	tracer.InitTracer(config.Get("auction_rpc.service_name").(string), config.Get("tracer.address").(string))
-	redis.GetRedis()
+	if err := script.Load(ctx, redis.GetRedis()); err != nil {
+		panic(err)
+	}
+	if err := manager.MigrateKeyLayout(ctx); err != nil {
+		panic(err)
+	}
+	if err := rpc.InitItemClient(); err != nil {
+		panic(err)
+	}
+	if err := rpc.InitGateWayClient(); err != nil {
+		panic(err)
+	}
 	service.GetAuctionService().ListenAndServe(ctx)
 	manager.GetAuctionManager()
+	manager.GetTimedAuctionManager()
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGHUP, syscall.SIGQUIT, syscall.SIGTERM, syscall.SIGINT)
 	s := <-quit
 	switch s {
 	case syscall.SIGQUIT, syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP:
+		service.GetAuctionService().Close()
 		cancel()
 	default:
 	}
@@ -0,0 +1,180 @@
+// Package clustertest 测试用的Redis Cluster替身：单节点miniredis，按Redis Cluster的规则校验命令访问的key，
+// 同一条命令、同一次Lua脚本执行访问的key不在同一个slot时返回CROSSSLOT错误。
+// MULTI事务由ClusterClient按slot拆分后发送，这里不做校验
+package clustertest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/alicebob/miniredis/v2/server"
+)
+
+const slotCount = 16384
+
+const crossSlotError = "CROSSSLOT Keys in request don't hash to the same slot"
+
+// Cluster 单节点的集群替身
+type Cluster struct {
+	*miniredis.Miniredis
+
+	mu         sync.Mutex
+	scriptCtx  interface{} // 正在执行的Lua脚本（miniredis串行执行脚本，脚本内的调用共享同一个Ctx）
+	scriptSlot int         // 正在执行的Lua脚本已访问的slot
+	violations []string    // 被拒绝的命令，便于定位
+}
+
+// Run 启动集群替身
+func Run() (*Cluster, error) {
+	m, err := miniredis.Run()
+	if err != nil {
+		return nil, err
+	}
+	c := &Cluster{Miniredis: m, scriptSlot: -1}
+	m.Server().SetPreHook(c.hook)
+	return c, nil
+}
+
+// Violations 返回被拒绝的命令
+func (c *Cluster) Violations() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.violations...)
+}
+
+func (c *Cluster) hook(peer *server.Peer, cmd string, args ...string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	slot, ok := keysSlot(commandKeys(cmd, args))
+	if !ok {
+		return c.reject(peer, cmd, args)
+	}
+
+	// Lua脚本内的调用：同一次执行的所有key必须在同一个slot
+	if isScriptCall(peer) {
+		if peer.Ctx != c.scriptCtx {
+			c.scriptCtx, c.scriptSlot = peer.Ctx, -1
+		}
+		if slot >= 0 {
+			if c.scriptSlot >= 0 && c.scriptSlot != slot {
+				return c.reject(peer, cmd, args)
+			}
+			c.scriptSlot = slot
+		}
+		return false
+	}
+	return false
+}
+
+func (c *Cluster) reject(peer *server.Peer, cmd string, args []string) bool {
+	c.violations = append(c.violations, cmd+" "+strings.Join(args, " "))
+	peer.WriteError(crossSlotError)
+	return true
+}
+
+// isScriptCall 是否为Lua脚本内通过redis.call发起的调用
+func isScriptCall(peer *server.Peer) bool {
+	v := reflect.ValueOf(peer.Ctx)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	nested := v.Elem().FieldByName("nested")
+	return nested.IsValid() && nested.Kind() == reflect.Bool && nested.Bool()
+}
+
+// commandKeys 返回命令访问的key
+func commandKeys(cmd string, args []string) []string {
+	switch cmd {
+	case "PING", "ECHO", "SELECT", "AUTH", "HELLO", "CLIENT", "CLUSTER", "INFO", "TIME", "SCRIPT",
+		"MULTI", "EXEC", "DISCARD", "UNWATCH", "FLUSHALL", "FLUSHDB", "DBSIZE", "KEYS", "SCAN", "QUIT",
+		"PUBLISH", "SUBSCRIBE", "UNSUBSCRIBE", "PSUBSCRIBE", "PUNSUBSCRIBE", "PUBSUB", "COMMAND", "CONFIG":
+		return nil
+	case "EVAL", "EVALSHA", "EVAL_RO", "EVALSHA_RO":
+		return numKeys(args, 1)
+	case "ZUNIONSTORE", "ZINTERSTORE", "ZDIFFSTORE":
+		if len(args) == 0 {
+			return nil
+		}
+		return append([]string{args[0]}, numKeys(args, 1)...)
+	case "ZUNION", "ZINTER", "ZDIFF":
+		return numKeys(args, 0)
+	case "DEL", "UNLINK", "EXISTS", "TOUCH", "WATCH", "MGET", "SINTER", "SUNION", "SDIFF",
+		"SINTERSTORE", "SUNIONSTORE", "SDIFFSTORE", "PFCOUNT", "PFMERGE":
+		return args
+	case "MSET", "MSETNX":
+		keys := make([]string, 0, len(args)/2)
+		for i := 0; i < len(args); i += 2 {
+			keys = append(keys, args[i])
+		}
+		return keys
+	case "RENAME", "RENAMENX", "RPOPLPUSH", "LMOVE", "SMOVE", "COPY", "ZRANGESTORE", "BLMOVE", "BRPOPLPUSH":
+		if len(args) < 2 {
+			return args
+		}
+		return args[:2]
+	case "BLPOP", "BRPOP", "BZPOPMIN", "BZPOPMAX":
+		if len(args) < 2 {
+			return nil
+		}
+		return args[:len(args)-1]
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	return args[:1]
+}
+
+// numKeys 解析 "numkeys key [key ...]" 形式的参数，args[pos]为numkeys
+func numKeys(args []string, pos int) []string {
+	if len(args) <= pos {
+		return nil
+	}
+	var n int
+	if _, err := fmt.Sscanf(args[pos], "%d", &n); err != nil || n <= 0 || pos+1+n > len(args) {
+		return nil
+	}
+	return args[pos+1 : pos+1+n]
+}
+
+// keysSlot 返回key共同的slot，没有key时返回-1，不在同一个slot时ok为false
+func keysSlot(keys []string) (slot int, ok bool) {
+	slot = -1
+	for _, key := range keys {
+		s := KeySlot(key)
+		if slot >= 0 && s != slot {
+			return slot, false
+		}
+		slot = s
+	}
+	return slot, true
+}
+
+// KeySlot 按Redis Cluster规则计算key的slot：key中包含非空的{...}时只对第一个{}内的部分计算
+func KeySlot(key string) int {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+	return int(crc16(key)) % slotCount
+}
+
+// crc16 CRC16-CCITT（XMODEM），与Redis Cluster一致
+func crc16(s string) uint16 {
+	var crc uint16
+	for i := 0; i < len(s); i++ {
+		crc ^= uint16(s[i]) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,42 @@
+package clustertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// 测试用例: slot计算与Redis Cluster一致，hash tag内的部分决定slot
+func TestKeySlot(t *testing.T) {
+	assert.Equal(t, 12739, KeySlot("123456789"))
+	assert.Equal(t, KeySlot("user1000"), KeySlot("{user1000}.following"))
+	assert.Equal(t, KeySlot("{user1000}.following"), KeySlot("x{user1000}{y}"))
+	assert.Equal(t, KeySlot("foo{}bar"), int(crc16("foo{}bar"))%slotCount)
+}
+
+// 测试用例: 跨slot的命令、脚本返回CROSSSLOT错误
+func TestCluster_CrossSlot(t *testing.T) {
+	ctx := context.Background()
+	c, err := Run()
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer c.Close()
+	rdb := redis.NewClusterClient(&redis.ClusterOptions{Addrs: []string{c.Addr()}})
+	defer rdb.Close()
+
+	assert.NoError(t, rdb.MSet(ctx, "{u1}:a", 1, "{u1}:b", 2).Err())
+	assert.ErrorContains(t, rdb.Do(ctx, "MSET", "{u1}:a", 1, "{u2}:b", 2).Err(), "CROSSSLOT")
+
+	// 声明的KEYS不在同一个slot
+	assert.ErrorContains(t, rdb.Eval(ctx, "return 1", []string{"{u1}:a", "{u2}:a"}).Err(), "CROSSSLOT")
+
+	// 脚本访问未声明且不在同一个slot的key
+	script := "redis.call('GET', KEYS[1]) return redis.call('GET', ARGV[1])"
+	assert.NoError(t, rdb.Eval(ctx, script, []string{"{u1}:a"}, "{u1}:b").Err())
+	assert.ErrorContains(t, rdb.Eval(ctx, script, []string{"{u1}:a"}, "{u2}:b").Err(), "CROSSSLOT")
+
+	assert.Len(t, c.Violations(), 3)
+}
@@ -31,13 +31,20 @@ func GetRedis() redis.UniversalClient {
 			password = val
 		}
 
-		rdb := redis.NewUniversalClient(&redis.UniversalOptions{
+		opts := &redis.UniversalOptions{
 			Addrs:           addrs,
 			Password:        password,
 			DB:              0,
 			MaxIdleConns:    16,
 			ConnMaxIdleTime: time.Minute * 5,
-		})
+		}
+		// 只配置一个地址时UniversalClient使用单机模式，集群需要显式开启
+		var rdb redis.UniversalClient
+		if cluster, _ := config.Get("redis.cluster").(bool); cluster {
+			rdb = redis.NewClusterClient(opts.Cluster())
+		} else {
+			rdb = redis.NewUniversalClient(opts)
+		}
 		err := rdb.Ping(context.Background()).Err()
 		if err != nil {
 			panic("redis not connected:" + err.Error())
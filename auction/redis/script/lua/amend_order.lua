@@ -0,0 +1,74 @@
+-- version: 1
+-- 改单：校验订单剩余数量与订单簿一致后更新数量和价格，退还减少的托管
+-- KEYS[1] 订单hash，KEYS[2] 订单状态，KEYS[3] 订单outbox
+-- ARGV[1] 方向，ARGV[2] 订单ID，ARGV[3] 订单簿中的数量，ARGV[4] 新数量，ARGV[5] 新价格，ARGV[6] 新托管，
+-- ARGV[7] 已追加的托管，ARGV[8] 新排队时间，ARGV[9] 结算任务ID，ARGV[10] 货币道具ID
+-- include: outbox
+local direction = ARGV[1]
+local orderId = ARGV[2]
+local bookQty = tonumber(ARGV[3])
+local newQty = tonumber(ARGV[4])
+local newPrice = tonumber(ARGV[5])
+local newReserve = tonumber(ARGV[6])
+local topup = tonumber(ARGV[7])
+local requeueTime = tonumber(ARGV[8])
+local jobId = ARGV[9]
+local currency = ARGV[10]
+local orderKey = KEYS[1]
+
+local userId = redis.call('HGET', orderKey, 'user_id')
+local qty = tonumber(redis.call('HGET', orderKey, 'quantity') or '-1')
+
+-- 退还追加的托管货币
+local function refundTopup()
+	if userId and topup > 0 then
+		emit_job({
+			id = jobId .. ':rollback',
+			user_id = userId,
+			item_id = currency,
+			count = topup,
+			reason = 'auction_amend_buy_rollback',
+			attempts = 0
+		})
+	end
+end
+
+if not userId or qty ~= bookQty then
+	refundTopup()
+	return 0
+end
+
+local refundItemId = redis.call('HGET', orderKey, 'item_id')
+local refundCount = qty - newQty
+if direction == 'buy' then
+	local price = tonumber(redis.call('HGET', orderKey, 'price'))
+	local reserve = tonumber(redis.call('HGET', orderKey, 'fee_reserve') or '0')
+	refundItemId = currency
+	refundCount = price * qty + reserve + topup - (newPrice * newQty + newReserve)
+	if refundCount < 0 then
+		refundTopup()
+		return 0
+	end
+	redis.call('HSET', orderKey, 'fee_reserve', newReserve)
+end
+if refundCount > 0 then
+	emit_job({
+		id = jobId .. ':refund',
+		user_id = userId,
+		item_id = refundItemId,
+		count = refundCount,
+		reason = 'auction_amend_' .. direction,
+		attempts = 0
+	})
+end
+
+redis.call('HSET', orderKey, 'quantity', newQty, 'price', newPrice)
+if requeueTime > 0 then
+	redis.call('HSET', orderKey, 'create_time', requeueTime)
+end
+
+-- 订单状态中的委托数量同步减少，保证累计成交数量不超过委托数量
+local statusKey = KEYS[2]
+redis.call('HINCRBY', statusKey, 'quantity', newQty - qty)
+redis.call('HSET', statusKey, 'price', newPrice)
+return 1
@@ -0,0 +1,62 @@
+-- version: 1
+-- 取消买单：删除订单并更新订单状态，列表移除和托管货币退还写入订单outbox
+-- KEYS[1] 订单hash，KEYS[2] 订单状态，KEYS[3] 订单outbox
+-- ARGV[1] 订单ID，ARGV[2] 用户ID，ARGV[3] 货币道具ID，ARGV[4] 用户求购列表，ARGV[5] 全局求购列表
+-- include: outbox
+local orderId = ARGV[1]
+local userId = ARGV[2]
+local orderKey = KEYS[1]
+
+-- 检查订单是否存在
+local exists = redis.call('EXISTS', orderKey)
+if exists == 0 then
+	return {'err', 'order_not_found'}
+end
+
+-- 检查订单是否属于该用户
+local orderUserId = redis.call('HGET', orderKey, 'user_id')
+if orderUserId ~= userId then
+	return {'err', 'order_not_belong_to_user'}
+end
+
+-- 获取订单信息
+local itemId = redis.call('HGET', orderKey, 'item_id')
+local createTime = redis.call('HGET', orderKey, 'create_time')
+
+-- 更新订单状态到新的Redis结构
+local statusKey = KEYS[2]
+redis.call('HMSET', statusKey,
+	'order_id', orderId,
+	'trade_direction', 'buy',
+	'status', '取消',
+	'item_id', itemId,
+	'tax', 0,
+	'create_time', createTime,
+	'user_id', userId
+)
+
+-- 退还剩余数量对应的托管货币（写入结算队列，由结算协程发放）
+-- 买家支付手续费时一并退还未使用的手续费预留
+local remaining = tonumber(redis.call('HGET', orderKey, 'quantity') or '0')
+local price = tonumber(redis.call('HGET', orderKey, 'price') or '0')
+local refund = remaining * price + tonumber(redis.call('HGET', orderKey, 'fee_reserve') or '0')
+if refund > 0 then
+	emit_job({
+		id = 'auction:cancel:' .. orderId,
+		user_id = userId,
+		item_id = ARGV[3],
+		count = refund,
+		reason = 'auction_cancel_buy',
+		attempts = 0
+	})
+end
+
+-- 删除订单
+redis.call('DEL', orderKey)
+
+-- 从用户求购列表、全局求购列表和道具挂单索引中移除
+emit('SREM', ARGV[4], orderKey)
+emit('SREM', ARGV[5], orderKey)
+emit('SREM', 'auction:item:{' .. itemId .. '}:buys', orderKey)
+
+return {'success', 'true'}
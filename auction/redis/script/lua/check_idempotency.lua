@@ -0,0 +1,13 @@
+-- version: 1
+-- 幂等检查：幂等键存在时返回已记录的结果，否则写入"正在处理"状态
+-- KEYS[1] 幂等键，ARGV[1] 当前时间
+local key = KEYS[1]
+local exists = redis.call('EXISTS', key)
+if exists == 1 then
+	local result = redis.call('HGETALL', key)
+	return result
+else
+	redis.call('HMSET', key, 'code', 1306, 'msg', 'processing', 'timestamp', ARGV[1])
+	redis.call('EXPIRE', key, 2592000) -- 1个月过期
+	return {}
+end
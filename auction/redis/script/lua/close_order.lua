@@ -0,0 +1,64 @@
+-- version: 1
+-- 以终态关闭订单（过期、即时单撤销）：更新订单状态，写入退还托管的结算任务，列表和索引的移除写入订单outbox
+-- KEYS[1] 订单hash，KEYS[2] 订单状态，KEYS[3] 订单outbox
+-- ARGV[1] 订单ID，ARGV[2] 方向（sell/buy），ARGV[3] 终态，ARGV[4] 结算任务ID，ARGV[5] 原因，ARGV[6] 货币道具ID，ARGV[7] 过期时间，ARGV[8] 全局订单列表
+-- include: outbox
+local orderId = ARGV[1]
+local direction = ARGV[2]
+local orderKey = KEYS[1]
+
+-- 订单已成交或已取消
+if redis.call('EXISTS', orderKey) == 0 then
+	return {}
+end
+
+local userId = redis.call('HGET', orderKey, 'user_id') or ''
+local itemId = redis.call('HGET', orderKey, 'item_id')
+local createTime = redis.call('HGET', orderKey, 'create_time')
+local remaining = tonumber(redis.call('HGET', orderKey, 'quantity') or '0')
+local price = tonumber(redis.call('HGET', orderKey, 'price') or '0')
+
+-- 更新订单状态（保留累计成交数据）
+local statusKey = KEYS[2]
+redis.call('HMSET', statusKey,
+	'order_id', orderId,
+	'trade_direction', direction,
+	'status', ARGV[3],
+	'item_id', itemId,
+	'create_time', createTime,
+	'user_id', userId
+)
+if ARGV[7] ~= '0' then
+	redis.call('HSET', statusKey, 'expire_time', ARGV[7])
+end
+
+-- 退还剩余的托管（写入结算队列，由结算协程发放）
+-- 买单同时退还未使用的手续费预留
+local refundItemId = itemId
+local refundCount = remaining
+if direction == 'buy' then
+	refundItemId = ARGV[6]
+	refundCount = remaining * price + tonumber(redis.call('HGET', orderKey, 'fee_reserve') or '0')
+end
+if userId ~= '' and refundCount > 0 then
+	emit_job({
+		id = ARGV[4],
+		user_id = userId,
+		item_id = refundItemId,
+		count = refundCount,
+		reason = ARGV[5],
+		attempts = 0
+	})
+end
+
+-- 删除订单
+redis.call('DEL', orderKey)
+
+-- 从用户列表、全局列表和道具挂单索引中移除
+if userId ~= '' then
+	emit('SREM', 'user:{' .. userId .. '}:' .. direction .. 's', orderKey)
+end
+emit('SREM', ARGV[8], orderKey)
+emit('SREM', 'auction:item:{' .. itemId .. '}:' .. direction .. 's', orderKey)
+
+return {userId, itemId, tostring(remaining), tostring(price)}
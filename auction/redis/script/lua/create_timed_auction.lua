@@ -0,0 +1,28 @@
+-- version: 1
+-- 创建限时拍卖，结束时间索引、道具索引和用户拍卖列表写入拍卖outbox
+-- KEYS[1] 拍卖hash，KEYS[2] 拍卖outbox
+-- ARGV[1..12] 拍卖字段，ARGV[13] 结束时间索引，ARGV[14] 道具索引，ARGV[15] 用户拍卖列表
+-- include: outbox
+redis.call('HSET', KEYS[1],
+	'auction_id', ARGV[1],
+	'seller_id', ARGV[2],
+	'item_id', ARGV[3],
+	'quantity', ARGV[4],
+	'item_unique_id', ARGV[5],
+	'item_type', ARGV[6],
+	'properties', ARGV[7],
+	'start_price', ARGV[8],
+	'min_increment', ARGV[9],
+	'buyout_price', ARGV[10],
+	'create_time', ARGV[11],
+	'end_time', ARGV[12],
+	'original_end_time', ARGV[12],
+	'status', '0',
+	'bid_user_id', '',
+	'bid_price', 0,
+	'bid_count', 0
+)
+emit('ZADD', ARGV[13], ARGV[12], ARGV[1])
+emit('ZADD', ARGV[14], ARGV[12], ARGV[1])
+emit('SADD', ARGV[15], ARGV[1])
+return 1
@@ -0,0 +1,24 @@
+-- version: 1
+-- 创建唯一道具挂单，道具索引、过期索引和用户挂单列表写入挂单outbox
+-- KEYS[1] 挂单hash，KEYS[2] 挂单outbox
+-- ARGV[1..10] 挂单字段，ARGV[11] 道具索引，ARGV[12] 过期索引，ARGV[13] 用户挂单列表
+-- include: outbox
+redis.call('HSET', KEYS[1],
+	'listing_id', ARGV[1],
+	'seller_id', ARGV[2],
+	'item_id', ARGV[3],
+	'item_unique_id', ARGV[4],
+	'item_type', ARGV[5],
+	'properties', ARGV[6],
+	'listing_type', ARGV[7],
+	'price', ARGV[8],
+	'create_time', ARGV[9],
+	'expire_time', ARGV[10],
+	'status', '0',
+	'offer_user_id', '',
+	'offer_price', 0
+)
+emit('ZADD', ARGV[11], ARGV[8], ARGV[1])
+emit('ZADD', ARGV[12], ARGV[10], ARGV[1])
+emit('SADD', ARGV[13], ARGV[1])
+return 1
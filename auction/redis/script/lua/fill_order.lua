@@ -0,0 +1,91 @@
+-- version: 2
+-- 成交落库（单边）：累计订单状态、扣减或删除挂单，订单所在slot之外的写入追加到订单outbox
+-- 卖单和买单位于不同的slot，撮合时先执行卖单一侧，买单一侧作为卖单outbox中的操作执行
+-- KEYS[1] 订单hash，KEYS[2] 订单状态，KEYS[3] 订单成交ID集合，KEYS[4] 订单outbox
+-- ARGV[1] 方向（sell/buy），ARGV[2] 订单ID，ARGV[3] 成交ID，ARGV[4] 道具ID，ARGV[5] 成交数量，ARGV[6] 成交价，
+-- ARGV[7] 本方手续费，ARGV[8] 用户ID，ARGV[9] 货币道具ID，ARGV[10] 全局订单列表，ARGV[11] 用户订单列表，
+-- ARGV[12] 待结算队列，ARGV[13..] 订单更新后追加到outbox的操作
+local direction = ARGV[1]
+local orderId = ARGV[2]
+local transactionId = ARGV[3]
+local itemId = ARGV[4]
+local quantity = tonumber(ARGV[5])
+local price = tonumber(ARGV[6])
+local tax = tonumber(ARGV[7])
+local userId = ARGV[8]
+
+local function emit(...)
+	redis.call('RPUSH', KEYS[4], cjson.encode({cmd = {...}}))
+end
+
+-- 1. 以成交ID去重，outbox重复投递时不会重复扣减
+if redis.call('SADD', KEYS[3], transactionId) == 0 then
+	return 0
+end
+
+-- 2. 获取订单当前状态信息
+local createTime = redis.call('HGET', KEYS[1], 'create_time')
+local currentFinalPrice = tonumber(redis.call('HGET', KEYS[2], 'final_price') or '0')
+local currentFinalQuantity = tonumber(redis.call('HGET', KEYS[2], 'final_quantity') or '0')
+local currentTax = tonumber(redis.call('HGET', KEYS[2], 'tax') or '0')
+local feeReserve = tonumber(redis.call('HGET', KEYS[1], 'fee_reserve') or '0')
+
+-- 3. 计算累加后的数值和订单剩余数量
+local newFinalPrice = currentFinalPrice + (price * quantity)
+local newFinalQuantity = currentFinalQuantity + quantity
+local newTax = currentTax + tax
+local newQuantity = tonumber(redis.call('HGET', KEYS[1], 'quantity')) - quantity
+
+-- 4. 更新订单状态
+local status = '卖'
+if direction == 'buy' then
+	status = '买'
+end
+if newQuantity <= 0 then
+	status = '交易完成'
+end
+redis.call('HMSET', KEYS[2],
+	'order_id', orderId,
+	'trade_direction', direction,
+	'status', status,
+	'final_price', newFinalPrice,
+	'final_quantity', newFinalQuantity,
+	'item_id', itemId,
+	'tax', newTax,
+	'create_time', createTime,
+	'user_id', userId
+)
+
+if newQuantity <= 0 then
+	-- 订单已完成：买单退还未使用的手续费预留
+	if direction == 'buy' and feeReserve - tax > 0 then
+		emit('RPUSH', ARGV[12], cjson.encode({
+			id = 'auction:settle:' .. transactionId .. ':fee_refund',
+			user_id = userId,
+			item_id = ARGV[9],
+			count = feeReserve - tax,
+			reason = 'auction_fee_refund',
+			attempts = 0
+		}))
+	end
+	-- 删除订单，并从用户列表、全局列表和道具挂单索引中移除
+	redis.call('DEL', KEYS[1])
+	if userId ~= '' then
+		emit('SREM', ARGV[11], KEYS[1])
+	end
+	emit('SREM', ARGV[10], KEYS[1])
+	emit('SREM', 'auction:item:{' .. itemId .. '}:' .. direction .. 's', KEYS[1])
+else
+	-- 订单未完成，更新剩余数量和手续费预留
+	redis.call('HSET', KEYS[1], 'quantity', newQuantity)
+	if direction == 'buy' and tax > 0 then
+		redis.call('HSET', KEYS[1], 'fee_reserve', feeReserve - tax)
+	end
+end
+
+-- 5. 追加成交记录、对手方订单、结算任务等操作
+for i = 13, #ARGV do
+	redis.call('RPUSH', KEYS[4], ARGV[i])
+end
+
+return 1
@@ -0,0 +1,8 @@
+-- version: 1
+-- 从处理中队列移除结算任务，KEYS[2]存在时原子地转移到该队列
+-- KEYS[1] 处理中队列，KEYS[2] 目标队列（可选），ARGV[1] 原任务，ARGV[2] 写入目标队列的任务
+redis.call('LREM', KEYS[1], 1, ARGV[1])
+if KEYS[2] then
+	redis.call('RPUSH', KEYS[2], ARGV[2])
+end
+return 1
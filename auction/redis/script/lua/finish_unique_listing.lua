@@ -0,0 +1,104 @@
+-- version: 1
+-- 结束唯一道具挂单：取消、到期、一口价购买、接受出价
+-- 成交时道具实例交付买家、货款结算给卖家；未成交时道具实例退回卖家、当前出价退还出价者
+-- KEYS: 挂单hash、挂单outbox，ARGV[9]、ARGV[10] 为道具价格索引和过期索引
+-- include: outbox
+local data = redis.call('HGETALL', KEYS[1])
+if #data == 0 then
+	return {'not_found', ''}
+end
+local l = {}
+for i = 1, #data, 2 do
+	l[data[i]] = data[i + 1]
+end
+if l.status ~= '0' then
+	return {'closed', ''}
+end
+
+local action = ARGV[1]
+local now = tonumber(ARGV[3])
+local buyer, price, proceeds = '', 0, 0
+if action == 'cancel' then
+	if l.seller_id ~= ARGV[2] then
+		return {'not_owner', ''}
+	end
+elseif action == 'expire' then
+	if tonumber(l.expire_time) > now then
+		return {'not_expired', ''}
+	end
+elseif action == 'buy' then
+	if l.listing_type ~= '0' then
+		return {'wrong_type', ''}
+	end
+	if tonumber(l.expire_time) <= now then
+		return {'closed', ''}
+	end
+	if l.seller_id == ARGV[2] then
+		return {'self_trade', ''}
+	end
+	if l.price ~= ARGV[5] then
+		return {'changed', ''}
+	end
+	buyer, price, proceeds = ARGV[2], tonumber(ARGV[5]), tonumber(ARGV[6])
+elseif action == 'accept' then
+	if l.seller_id ~= ARGV[2] then
+		return {'not_owner', ''}
+	end
+	if l.offer_user_id == '' then
+		return {'no_offer', ''}
+	end
+end
+-- 竞价挂单被接受或到期时成交给最高出价者（暂停交易期间到期的挂单保留到恢复后处理）
+if (action == 'accept' or action == 'expire') and l.offer_user_id ~= '' then
+	if ARGV[7] == '1' then
+		return {'halted', ''}
+	end
+	buyer, price, proceeds = l.offer_user_id, tonumber(l.offer_price), tonumber(l.offer_proceeds)
+end
+
+local job_prefix = 'auction:unique:close:' .. l.listing_id
+local function instance_job(user_id, reason)
+	return {
+		id = job_prefix .. ':item', user_id = user_id, item_id = l.item_id, count = 1,
+		reason = reason, attempts = 0, item_unique_id = l.item_unique_id, escrow_user_id = l.seller_id,
+		item_type = tonumber(l.item_type), properties = l.properties
+	}
+end
+
+local status
+if buyer ~= '' then
+	status = '1'
+	emit_job(instance_job(buyer, 'auction_unique_buy'))
+	if proceeds > 0 then
+		emit_job({
+			id = job_prefix .. ':proceeds', user_id = l.seller_id, item_id = ARGV[4], count = proceeds,
+			reason = 'auction_unique_sell', attempts = 0
+		})
+	end
+else
+	if action == 'cancel' then
+		status = '2'
+	else
+		status = '3'
+	end
+	emit_job(instance_job(l.seller_id, 'auction_unique_' .. action))
+	if l.offer_user_id ~= '' then
+		emit_job({
+			id = 'auction:unique:refund:' .. l.offer_id, user_id = l.offer_user_id, item_id = ARGV[4],
+			count = tonumber(l.offer_escrow), reason = 'auction_unique_offer_refund', attempts = 0
+		})
+	end
+end
+
+redis.call('HSET', KEYS[1], 'status', status, 'buyer_id', buyer, 'final_price', price, 'close_time', now)
+emit('ZREM', ARGV[9], l.listing_id)
+emit('ZREM', ARGV[10], l.listing_id)
+emit('SREM', 'user:{' .. l.seller_id .. '}:unique_listings', l.listing_id)
+redis.call('EXPIRE', KEYS[1], ARGV[8])
+
+local result = {'ok', l.offer_user_id}
+local updated = redis.call('HGETALL', KEYS[1])
+for i = 1, #updated do
+	table.insert(result, updated[i])
+end
+return result
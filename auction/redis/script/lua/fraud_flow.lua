@@ -0,0 +1,39 @@
+-- version: 1
+-- 更新对手方成交计数和道具流向，并检测道具是否经一到两个中间用户回到卖家
+-- 返回 {对手方成交次数, 环路长度(0表示无环), 中间用户}
+-- KEYS[1] 对手方成交计数，KEYS[2] 卖家道具流向
+local pairKey = KEYS[1]
+local sellerFlowKey = KEYS[2]
+local seller = ARGV[1]
+local buyer = ARGV[2]
+local now = tonumber(ARGV[3])
+local pairWindow = tonumber(ARGV[4])
+local flowWindow = tonumber(ARGV[5])
+local fanout = tonumber(ARGV[6])
+local flowPrefix = ARGV[7]
+local cutoff = now - flowWindow
+
+local pairCount = redis.call('INCR', pairKey)
+if pairCount == 1 then
+	redis.call('EXPIRE', pairKey, pairWindow)
+end
+
+redis.call('ZADD', sellerFlowKey, now, buyer)
+redis.call('ZREMRANGEBYSCORE', sellerFlowKey, '-inf', '(' .. cutoff)
+redis.call('EXPIRE', sellerFlowKey, flowWindow)
+
+local buyerFlowKey = flowPrefix .. buyer
+local back = redis.call('ZSCORE', buyerFlowKey, seller)
+if back and tonumber(back) >= cutoff then
+	return {pairCount, 2, ''}
+end
+local nexts = redis.call('ZREVRANGEBYSCORE', buyerFlowKey, '+inf', cutoff, 'LIMIT', 0, fanout)
+for _, via in ipairs(nexts) do
+	if via ~= seller and via ~= buyer then
+		local score = redis.call('ZSCORE', flowPrefix .. via, seller)
+		if score and tonumber(score) >= cutoff then
+			return {pairCount, 3, via}
+		end
+	end
+end
+return {pairCount, 0, ''}
@@ -0,0 +1,9 @@
+-- 写入outbox的公共函数，outbox为脚本的最后一个KEY（见 logic/manager/outbox.go）
+-- emit(...) 追加一条单key命令，emit_job(job) 追加一个结算任务
+local OUTBOX = KEYS[#KEYS]
+local function emit(...)
+	redis.call('RPUSH', OUTBOX, cjson.encode({cmd = {...}}))
+end
+local function emit_job(job)
+	emit('RPUSH', 'auction:{settlement}:pending', cjson.encode(job))
+end
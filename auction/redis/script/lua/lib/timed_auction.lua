@@ -0,0 +1,54 @@
+-- 拍卖脚本公共部分，KEYS: 拍卖hash、拍卖outbox，ARGV最后两个参数为结束时间索引和道具索引
+-- 脚本返回 {状态, 附加信息, 拍卖hash字段...}
+local END_KEY, ITEM_KEY = ARGV[#ARGV - 1], ARGV[#ARGV]
+local data = redis.call('HGETALL', KEYS[1])
+if #data == 0 then
+	return {'not_found', ''}
+end
+local a = {}
+for i = 1, #data, 2 do
+	a[data[i]] = data[i + 1]
+end
+if a.status ~= '0' then
+	return {'closed', ''}
+end
+
+local function result(status, extra)
+	local r = {status, extra}
+	local updated = redis.call('HGETALL', KEYS[1])
+	for i = 1, #updated do
+		table.insert(r, updated[i])
+	end
+	return r
+end
+
+-- 结束拍卖：有买家时道具交付买家、货款结算给卖家，否则道具退回卖家
+local function close_auction(winner, price, proceeds, status, now, currency, ttl)
+	local prefix = 'auction:timed:close:' .. a.auction_id
+	local receiver, reason = winner, 'auction_timed_win'
+	if winner == '' then
+		receiver, reason = a.seller_id, 'auction_timed_return'
+	end
+	local job = {
+		id = prefix .. ':item', user_id = receiver, item_id = a.item_id,
+		count = tonumber(a.quantity), reason = reason, attempts = 0
+	}
+	if a.item_unique_id ~= '' then
+		job.item_unique_id = a.item_unique_id
+		job.escrow_user_id = a.seller_id
+		job.item_type = tonumber(a.item_type)
+		job.properties = a.properties
+	end
+	emit_job(job)
+	if winner ~= '' and proceeds > 0 then
+		emit_job({
+			id = prefix .. ':proceeds', user_id = a.seller_id, item_id = currency, count = proceeds,
+			reason = 'auction_timed_sell', attempts = 0
+		})
+	end
+	redis.call('HSET', KEYS[1], 'status', status, 'winner_id', winner, 'final_price', price, 'close_time', now)
+	emit('ZREM', END_KEY, a.auction_id)
+	emit('ZREM', ITEM_KEY, a.auction_id)
+	emit('SREM', 'user:{' .. a.seller_id .. '}:timed_auctions', a.auction_id)
+	redis.call('EXPIRE', KEYS[1], ttl)
+end
@@ -0,0 +1,46 @@
+-- version: 1
+-- 挂买单：写入订单hash和订单状态，用户/全局求购列表、道具挂单索引和用户时间索引写入订单outbox
+-- KEYS[1] 订单hash，KEYS[2] 订单状态，KEYS[3] 订单outbox
+-- ARGV[1] 道具ID，ARGV[2] 数量，ARGV[3] 单价，ARGV[4] 创建时间，ARGV[5] 用户ID，ARGV[6] 订单ID，ARGV[7] 用户求购列表，
+-- ARGV[8] 过期时间，ARGV[9] 订单类型，ARGV[10] 托管货币，ARGV[11] 全局求购列表，ARGV[12] 用户时间索引，
+-- ARGV[13] 道具挂单索引，ARGV[14] 有挂单的道具集合
+-- include: outbox
+-- 将订单信息存入hash表（包含order_id字段）
+redis.call('HMSET', KEYS[1],
+	'order_id', ARGV[6],
+	'item_id', ARGV[1],
+	'quantity', ARGV[2],
+	'price', ARGV[3],
+	'create_time', ARGV[4],
+	'expire_time', ARGV[8],
+	'order_type', ARGV[9],
+	'fee_reserve', ARGV[10],
+	'user_id', ARGV[5]
+)
+
+-- 记录订单状态到新的Redis结构
+redis.call('HMSET', KEYS[2],
+	'order_id', ARGV[6],
+	'trade_direction', 'buy',
+	'status', '买',
+	'price', ARGV[3],
+	'quantity', ARGV[2],
+	'final_price', 0,
+	'final_quantity', 0,
+	'item_id', ARGV[1],
+	'tax', 0,
+	'create_time', ARGV[4],
+	'expire_time', ARGV[8],
+	'user_id', ARGV[5]
+)
+
+-- 将订单添加到用户的求购列表、全局求购列表和道具挂单索引
+emit('SADD', ARGV[7], KEYS[1])
+emit('SADD', ARGV[11], KEYS[1])
+emit('SADD', ARGV[13], KEYS[1])
+emit('SADD', ARGV[14], ARGV[1])
+
+-- 添加到用户交易时间排序集合（按用户维度的时间排序）
+emit('ZADD', ARGV[12], ARGV[4], ARGV[6])
+
+return 1
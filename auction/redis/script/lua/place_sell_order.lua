@@ -0,0 +1,46 @@
+-- version: 1
+-- 挂卖单：写入订单hash和订单状态，用户/全局出售列表、道具挂单索引和用户时间索引写入订单outbox
+-- KEYS[1] 订单hash，KEYS[2] 订单状态，KEYS[3] 订单outbox
+-- ARGV[1] 道具ID，ARGV[2] 数量，ARGV[3] 单价，ARGV[4] 道具信息，ARGV[5] 创建时间，ARGV[6] 用户ID，ARGV[7] 订单ID，
+-- ARGV[8] 用户出售列表，ARGV[9] 过期时间，ARGV[10] 订单类型，ARGV[11] 全局出售列表，ARGV[12] 用户时间索引，
+-- ARGV[13] 道具挂单索引，ARGV[14] 有挂单的道具集合
+-- include: outbox
+-- 将订单信息存入hash表（包含order_id字段）
+redis.call('HMSET', KEYS[1],
+	'order_id', ARGV[7],
+	'item_id', ARGV[1],
+	'quantity', ARGV[2],
+	'price', ARGV[3],
+	'item_info', ARGV[4],
+	'create_time', ARGV[5],
+	'expire_time', ARGV[9],
+	'order_type', ARGV[10],
+	'user_id', ARGV[6]
+)
+
+-- 记录订单状态到新的Redis结构
+redis.call('HMSET', KEYS[2],
+	'order_id', ARGV[7],
+	'trade_direction', 'sell',
+	'status', '卖',
+	'quantity', ARGV[2],
+	'price', ARGV[3],
+	'final_price', 0,
+	'final_quantity', 0,
+	'item_id', ARGV[1],
+	'tax', 0,
+	'create_time', ARGV[5],
+	'expire_time', ARGV[9],
+	'user_id', ARGV[6]
+)
+
+-- 将订单添加到用户的出售列表、全局出售列表和道具挂单索引
+emit('SADD', ARGV[8], KEYS[1])
+emit('SADD', ARGV[11], KEYS[1])
+emit('SADD', ARGV[13], KEYS[1])
+emit('SADD', ARGV[14], ARGV[1])
+
+-- 添加到用户交易时间排序集合（按用户维度的时间排序）
+emit('ZADD', ARGV[12], ARGV[5], ARGV[7])
+
+return 1
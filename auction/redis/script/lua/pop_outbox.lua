@@ -0,0 +1,7 @@
+-- version: 1
+-- 只在队首仍是刚投递的操作时弹出，避免并发投递时弹出未执行的操作
+-- KEYS[1] outbox，ARGV[1] 已投递的操作
+if redis.call('LINDEX', KEYS[1], 0) == ARGV[1] then
+	redis.call('LPOP', KEYS[1])
+end
+return 1
@@ -0,0 +1,41 @@
+-- version: 1
+-- 将一笔成交计入各周期K线，K线不存在时创建并加入索引，索引中移除超出保留时长的记录
+-- KEYS 各周期的K线索引（同一道具使用相同的hash tag）
+-- ARGV[1] 成交价，ARGV[2] 成交数量，ARGV[3] 成交时间，之后每个周期两个参数：周期秒数、保留时长
+local price = tonumber(ARGV[1])
+local quantity = tonumber(ARGV[2])
+local tradeTime = tonumber(ARGV[3])
+
+for i = 1, #KEYS do
+	local seconds = tonumber(ARGV[2 + i * 2])
+	local retention = tonumber(ARGV[3 + i * 2])
+	local openTime = tradeTime - (tradeTime % seconds)
+	local indexKey = KEYS[i]
+	local barKey = indexKey .. ':' .. openTime
+
+	if redis.call('EXISTS', barKey) == 0 then
+		redis.call('HMSET', barKey,
+			'open_time', openTime,
+			'open', price,
+			'high', price,
+			'low', price,
+			'close', price,
+			'volume', quantity,
+			'turnover', price * quantity
+		)
+		redis.call('ZADD', indexKey, openTime, openTime)
+		redis.call('ZREMRANGEBYSCORE', indexKey, '-inf', '(' .. (openTime - retention))
+	else
+		if price > tonumber(redis.call('HGET', barKey, 'high')) then
+			redis.call('HSET', barKey, 'high', price)
+		end
+		if price < tonumber(redis.call('HGET', barKey, 'low')) then
+			redis.call('HSET', barKey, 'low', price)
+		end
+		redis.call('HSET', barKey, 'close', price)
+		redis.call('HINCRBY', barKey, 'volume', quantity)
+		redis.call('HINCRBY', barKey, 'turnover', price * quantity)
+	end
+	redis.call('EXPIRE', barKey, retention + seconds)
+end
+return 1
@@ -0,0 +1,24 @@
+-- version: 1
+-- 把一笔成交计入一方用户的日汇总
+-- KEYS[1] 单日汇总，KEYS[2] 汇总日期
+local dayKey = KEYS[1]
+local daysKey = KEYS[2]
+local day = ARGV[1]
+local dayStart = ARGV[2]
+local ttl = tonumber(ARGV[3])
+local itemId = ARGV[4]
+local quantity = tonumber(ARGV[5])
+local amount = tonumber(ARGV[6])
+local fee = tonumber(ARGV[7])
+local direction = ARGV[8]
+
+redis.call('HINCRBY', dayKey, itemId .. '|' .. direction .. '_qty', quantity)
+redis.call('HINCRBY', dayKey, itemId .. '|' .. direction .. '_amount', amount)
+if fee > 0 then
+	redis.call('HINCRBY', dayKey, itemId .. '|' .. direction .. '_fee', fee)
+end
+redis.call('EXPIRE', dayKey, ttl)
+redis.call('ZADD', daysKey, dayStart, day)
+redis.call('ZREMRANGEBYSCORE', daysKey, '-inf', '(' .. (tonumber(dayStart) - ttl))
+redis.call('EXPIRE', daysKey, ttl)
+return 1
@@ -0,0 +1,18 @@
+-- version: 1
+-- 记录用户对道具行情的订阅：清理已过期的订阅，新订阅时检查数量上限，已订阅时续期
+-- KEYS[1] 用户订阅列表，ARGV[1] 道具ID，ARGV[2] 当前时间，ARGV[3] 过期时间，ARGV[4] 订阅数量上限
+-- 返回 1 订阅成功，0 超出上限
+local userSubsKey = KEYS[1]
+local itemId = ARGV[1]
+local now = tonumber(ARGV[2])
+local expireTime = tonumber(ARGV[3])
+local maxSubs = tonumber(ARGV[4])
+
+redis.call('ZREMRANGEBYSCORE', userSubsKey, '-inf', '(' .. now)
+if not redis.call('ZSCORE', userSubsKey, itemId) and redis.call('ZCARD', userSubsKey) >= maxSubs then
+	return 0
+end
+
+redis.call('ZADD', userSubsKey, expireTime, itemId)
+redis.call('EXPIRE', userSubsKey, expireTime - now)
+return 1
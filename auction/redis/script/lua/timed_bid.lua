@@ -0,0 +1,63 @@
+-- version: 1
+-- 限时拍卖出价：校验最低出价，退还被超过的出价，达到一口价时立即成交，临近结束时延长拍卖
+-- include: outbox
+-- include: timed_auction
+local bidder, price, now = ARGV[1], tonumber(ARGV[2]), tonumber(ARGV[6])
+if tonumber(a.end_time) <= now then
+	return {'closed', ''}
+end
+if a.seller_id == bidder then
+	return {'self_trade', ''}
+end
+local min_bid = tonumber(a.start_price)
+if a.bid_user_id ~= '' then
+	min_bid = tonumber(a.bid_price) + tonumber(a.min_increment)
+end
+local buyout = tonumber(a.buyout_price)
+if buyout > 0 and min_bid > buyout then
+	min_bid = buyout
+end
+if price < min_bid then
+	return {'too_low', tostring(min_bid)}
+end
+if buyout > 0 and price > buyout then
+	return {'changed', ''}
+end
+
+local prev = a.bid_user_id
+if prev ~= '' then
+	emit_job({
+		id = 'auction:timed:refund:' .. a.bid_id, user_id = prev, item_id = ARGV[7],
+		count = tonumber(a.bid_escrow), reason = 'auction_timed_bid_refund', attempts = 0
+	})
+end
+redis.call('HSET', KEYS[1],
+	'bid_user_id', bidder,
+	'bid_price', ARGV[2],
+	'bid_escrow', ARGV[3],
+	'bid_proceeds', ARGV[4],
+	'bid_id', ARGV[5],
+	'bid_time', ARGV[6]
+)
+redis.call('HINCRBY', KEYS[1], 'bid_count', 1)
+
+if buyout > 0 and price == buyout then
+	close_auction(bidder, price, tonumber(ARGV[4]), '1', now, ARGV[7], ARGV[11])
+	return result('bought', prev)
+end
+
+-- 防狙击：结束前窗口内的出价把结束时间推迟到出价后固定秒数，累计延长不超过上限
+local end_time = tonumber(a.end_time)
+if end_time - now < tonumber(ARGV[8]) then
+	local new_end = now + tonumber(ARGV[9])
+	local cap = tonumber(a.original_end_time) + tonumber(ARGV[10])
+	if new_end > cap then
+		new_end = cap
+	end
+	if new_end > end_time then
+		redis.call('HSET', KEYS[1], 'end_time', new_end)
+		emit('ZADD', END_KEY, new_end, a.auction_id)
+		emit('ZADD', ITEM_KEY, new_end, a.auction_id)
+	end
+end
+return result('ok', prev)
@@ -0,0 +1,12 @@
+-- version: 1
+-- 卖家取消限时拍卖：只能在无人出价时取消
+-- include: outbox
+-- include: timed_auction
+if a.seller_id ~= ARGV[1] then
+	return {'not_owner', ''}
+end
+if a.bid_user_id ~= '' then
+	return {'has_bids', ''}
+end
+close_auction('', 0, 0, '3', tonumber(ARGV[2]), ARGV[3], ARGV[4])
+return result('ok', '')
@@ -0,0 +1,17 @@
+-- version: 1
+-- 限时拍卖到期结算：有出价时成交给最高出价者，否则流拍（暂停交易期间有出价的拍卖保留到恢复后结算）
+-- include: outbox
+-- include: timed_auction
+local now = tonumber(ARGV[1])
+if tonumber(a.end_time) > now then
+	return {'not_ended', ''}
+end
+if a.bid_user_id ~= '' then
+	if ARGV[4] == '1' then
+		return {'halted', ''}
+	end
+	close_auction(a.bid_user_id, tonumber(a.bid_price), tonumber(a.bid_proceeds), '1', now, ARGV[2], ARGV[3])
+else
+	close_auction('', 0, 0, '2', now, ARGV[2], ARGV[3])
+end
+return result('ok', '')
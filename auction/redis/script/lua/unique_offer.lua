@@ -0,0 +1,50 @@
+-- version: 1
+-- 对唯一道具挂单出价：校验并替换最高出价，被超过的出价写入退还任务
+-- KEYS[1] 挂单hash，KEYS[2] 挂单outbox
+-- ARGV[1] 出价用户，ARGV[2] 出价，ARGV[3] 托管金额，ARGV[4] 卖家所得，ARGV[5] 出价ID，ARGV[6] 当前时间，ARGV[7] 最小价格单位，ARGV[8] 货币道具ID
+-- include: outbox
+local data = redis.call('HGETALL', KEYS[1])
+if #data == 0 then
+	return {'not_found', ''}
+end
+local l = {}
+for i = 1, #data, 2 do
+	l[data[i]] = data[i + 1]
+end
+if l.status ~= '0' or tonumber(l.expire_time) <= tonumber(ARGV[6]) then
+	return {'closed', ''}
+end
+if l.listing_type ~= '1' then
+	return {'wrong_type', ''}
+end
+if l.seller_id == ARGV[1] then
+	return {'self_trade', ''}
+end
+local price = tonumber(ARGV[2])
+local min_offer = tonumber(l.price)
+if l.offer_user_id ~= '' and tonumber(l.offer_price) + tonumber(ARGV[7]) > min_offer then
+	min_offer = tonumber(l.offer_price) + tonumber(ARGV[7])
+end
+if price < min_offer then
+	return {'too_low', tostring(min_offer)}
+end
+if l.offer_user_id ~= '' then
+	emit_job({
+		id = 'auction:unique:refund:' .. l.offer_id, user_id = l.offer_user_id, item_id = ARGV[8],
+		count = tonumber(l.offer_escrow), reason = 'auction_unique_offer_refund', attempts = 0
+	})
+end
+redis.call('HSET', KEYS[1],
+	'offer_user_id', ARGV[1],
+	'offer_price', ARGV[2],
+	'offer_escrow', ARGV[3],
+	'offer_proceeds', ARGV[4],
+	'offer_id', ARGV[5],
+	'offer_time', ARGV[6]
+)
+local result = {'ok', l.offer_user_id}
+local updated = redis.call('HGETALL', KEYS[1])
+for i = 1, #updated do
+	table.insert(result, updated[i])
+end
+return result
@@ -0,0 +1,38 @@
+-- version: 1
+-- 更新道具概况和排序索引，概况为空时从所有索引中移除
+-- 概况和索引使用相同的hash tag，KEYS[1]为概况key
+local entriesKey = KEYS[1]
+local prefix = ARGV[1]
+local itemId = ARGV[2]
+local category = ARGV[3]
+local oldCategory = ARGV[4]
+local entry = ARGV[5]
+local fields = {'ask', 'bid', 'volume', 'change'}
+
+if oldCategory ~= '' and oldCategory ~= category then
+	for _, field in ipairs(fields) do
+		redis.call('ZREM', prefix .. field .. ':cat:' .. oldCategory, itemId)
+	end
+end
+
+for i, field in ipairs(fields) do
+	local score = ARGV[5 + i]
+	local keys = {prefix .. field .. ':all'}
+	if category ~= '' then
+		table.insert(keys, prefix .. field .. ':cat:' .. category)
+	end
+	for _, key in ipairs(keys) do
+		if entry == '' or score == '' then
+			redis.call('ZREM', key, itemId)
+		else
+			redis.call('ZADD', key, score, itemId)
+		end
+	end
+end
+
+if entry == '' then
+	redis.call('HDEL', entriesKey, itemId)
+else
+	redis.call('HSET', entriesKey, itemId, entry)
+end
+return 1
@@ -0,0 +1,128 @@
+package script
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupMiniRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return mr, rdb
+}
+
+// 测试用例: 脚本版本号解析
+func TestRegistry_Version(t *testing.T) {
+	r := GetRegistry()
+	versions := map[string]int{FillOrder: 2}
+	for _, name := range []string{CheckIdempotency, PlaceSellOrder, PlaceBuyOrder, CancelSellOrder, CancelBuyOrder,
+		CloseOrder, AmendOrder, PopOutbox, FinishSettlementJob, RecordKline, RecordStatement, FraudFlow, UpdateMarketIndex,
+		SubscribeMarket, CreateUniqueListing, UniqueOffer, FinishUniqueListing, CreateTimedAuction, TimedBid, TimedClose, TimedCancel} {
+		versions[name] = 1
+	}
+	for name, version := range versions {
+		s := r.Get(name)
+		if assert.NotNil(t, s, name) {
+			assert.Equal(t, version, s.Version, name)
+			assert.Len(t, s.Sha, 40, name)
+		}
+	}
+
+	_, err := NewRegistry(fstest.MapFS{"a.lua": {Data: []byte("return 1\n")}})
+	assert.Error(t, err)
+	_, err = NewRegistry(fstest.MapFS{"a.lua": {Data: []byte("-- version: x\nreturn 1\n")}})
+	assert.Error(t, err)
+}
+
+// 测试用例: include 行展开为 lib 中的公共脚本，SHA按展开后的源码计算，lib 不存在时报错
+func TestRegistry_Include(t *testing.T) {
+	r, err := NewRegistry(fstest.MapFS{
+		"a.lua":          {Data: []byte("-- version: 1\n-- include: common\nreturn f()\n")},
+		"lib/common.lua": {Data: []byte("local function f() return 1 end\n")},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "-- version: 1\nlocal function f() return 1 end\nreturn f()\n", r.Get("a").Source)
+		assert.Len(t, r.scripts, 1)
+	}
+
+	_, err = NewRegistry(fstest.MapFS{"a.lua": {Data: []byte("-- version: 1\n-- include: missing\nreturn 1\n")}})
+	assert.Error(t, err)
+
+	// 写入outbox的脚本都已展开公共部分
+	assert.Contains(t, GetRegistry().Get(CloseOrder).Source, "local OUTBOX = KEYS[#KEYS]")
+	assert.NotContains(t, GetRegistry().Get(TimedBid).Source, "-- include:")
+}
+
+// 测试用例: 启动加载后使用EVALSHA执行，脚本缓存被清空后回退到EVAL
+func TestRegistry_LoadAndFallback(t *testing.T) {
+	ctx := context.Background()
+	_, rdb := setupMiniRedis(t)
+	r := GetRegistry()
+
+	assert.NoError(t, r.Load(ctx, rdb))
+	exists, err := rdb.ScriptExists(ctx, r.Get(CheckIdempotency).Sha, r.Get(FillOrder).Sha).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, true}, exists)
+
+	res, err := r.Run(ctx, rdb, CheckIdempotency, []string{"idem:1"}, 100).Result()
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+
+	assert.NoError(t, rdb.ScriptFlush(ctx).Err())
+	res, err = r.Run(ctx, rdb, CheckIdempotency, []string{"idem:1"}, 200).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"code", "1306", "msg", "processing", "timestamp", "100"}, res)
+
+	// 回退的EVAL会重新缓存脚本
+	exists, err = rdb.ScriptExists(ctx, r.Get(CheckIdempotency).Sha).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true}, exists)
+
+	assert.Error(t, r.Run(ctx, rdb, "missing", nil).Err())
+}
+
+// 测试用例: 成交落库，部分成交更新剩余数量，完全成交删除挂单并移出道具挂单索引，跨slot的写入追加到订单outbox，重复执行时不重复扣减
+func TestRegistry_FillOrder(t *testing.T) {
+	ctx := context.Background()
+	_, rdb := setupMiniRedis(t)
+
+	sellKeys := []string{"auction:sell:{s1}", "auction:order:{s1}:status", "auction:order:{s1}:transactions", "auction:order:{s1}:outbox"}
+	buyKeys := []string{"auction:buy:{b1}", "auction:order:{b1}:status", "auction:order:{b1}:transactions", "auction:order:{b1}:outbox"}
+	rdb.HSet(ctx, sellKeys[0], "user_id", "seller", "quantity", 5, "create_time", 10)
+	rdb.HSet(ctx, buyKeys[0], "user_id", "buyer", "quantity", 2, "create_time", 20, "fee_reserve", 6)
+
+	sellArgs := []interface{}{"sell", "s1", "t1", "item", 2, 100, 0, "seller", "gold",
+		"auction:sells", "user:{seller}:sells", "settlement", `{"cmd":["HSET","auction:transaction:t1","price","100"]}`}
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, Run(ctx, rdb, FillOrder, sellKeys, sellArgs...).Err())
+	}
+
+	// 卖单部分成交，重复执行不重复扣减
+	assert.Equal(t, "3", rdb.HGet(ctx, sellKeys[0], "quantity").Val())
+	assert.Equal(t, map[string]string{
+		"order_id": "s1", "trade_direction": "sell", "status": "卖", "final_price": "200", "final_quantity": "2",
+		"item_id": "item", "tax": "0", "create_time": "10", "user_id": "seller",
+	}, rdb.HGetAll(ctx, sellKeys[1]).Val())
+	assert.Equal(t, []string{`{"cmd":["HSET","auction:transaction:t1","price","100"]}`}, rdb.LRange(ctx, sellKeys[3], 0, -1).Val())
+
+	// 买单完全成交
+	buyArgs := []interface{}{"buy", "b1", "t1", "item", 2, 100, 4, "buyer", "gold", "auction:buys", "user:{buyer}:buys", "settlement"}
+	assert.NoError(t, Run(ctx, rdb, FillOrder, buyKeys, buyArgs...).Err())
+	assert.Equal(t, int64(0), rdb.Exists(ctx, buyKeys[0]).Val())
+	assert.Equal(t, "交易完成", rdb.HGet(ctx, buyKeys[1], "status").Val())
+	assert.Equal(t, "4", rdb.HGet(ctx, buyKeys[1], "tax").Val())
+	outbox := rdb.LRange(ctx, buyKeys[3], 0, -1).Val()
+	if assert.Len(t, outbox, 4) {
+		assert.Contains(t, outbox[0], `"RPUSH","settlement"`)
+		assert.Contains(t, outbox[0], "auction_fee_refund")
+		assert.Equal(t, `{"cmd":["SREM","user:{buyer}:buys","auction:buy:{b1}"]}`, outbox[1])
+		assert.Equal(t, `{"cmd":["SREM","auction:buys","auction:buy:{b1}"]}`, outbox[2])
+		assert.Equal(t, `{"cmd":["SREM","auction:item:{item}:buys","auction:buy:{b1}"]}`, outbox[3])
+	}
+}
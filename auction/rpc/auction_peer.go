@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"auction_module/config"
+	"auction_module/kitex_gen/auction_admin_service/auctionadminservice"
+	"auction_module/kitex_gen/auction_service/auctionservice"
+	"auction_module/rpc_middleware"
+	"sync"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/kitex-contrib/obs-opentelemetry/tracing"
+)
+
+// auctionPeers 其他auction实例的客户端，key为实例RPC地址
+var auctionPeers sync.Map
+
+// GetAuctionPeerClient 获取指定地址的auction实例客户端，用于将请求转发到道具撮合单元所在实例
+func GetAuctionPeerClient(addr string) (auctionservice.Client, error) {
+	if c, ok := auctionPeers.Load(addr); ok {
+		return c.(auctionservice.Client), nil
+	}
+
+	c, err := auctionservice.NewClient(
+		config.Get("auction_rpc.service_name").(string),
+		client.WithHostPorts(addr),
+		client.WithSuite(tracing.NewClientSuite()),
+		client.WithMiddleware(rpc_middleware.UserIdClientMiddleware),
+	)
+	if err != nil {
+		klog.Errorf("[AUCTION-RPC-PEER-INIT] Failed to initialize auction peer client, addr: %s, error: %s", addr, err.Error())
+		return nil, err
+	}
+
+	actual, _ := auctionPeers.LoadOrStore(addr, c)
+	return actual.(auctionservice.Client), nil
+}
+
+// auctionAdminPeers 其他auction实例的运维服务客户端，key为实例RPC地址
+var auctionAdminPeers sync.Map
+
+// GetAuctionAdminPeerClient 获取指定地址的auction实例运维服务客户端，用于将运维操作转发到道具撮合单元所在实例
+func GetAuctionAdminPeerClient(addr string) (auctionadminservice.Client, error) {
+	if c, ok := auctionAdminPeers.Load(addr); ok {
+		return c.(auctionadminservice.Client), nil
+	}
+
+	c, err := auctionadminservice.NewClient(
+		config.Get("auction_rpc.service_name").(string),
+		client.WithHostPorts(addr),
+		client.WithSuite(tracing.NewClientSuite()),
+		client.WithMiddleware(rpc_middleware.UserIdClientMiddleware),
+	)
+	if err != nil {
+		klog.Errorf("[AUCTION-RPC-PEER-INIT] Failed to initialize auction admin peer client, addr: %s, error: %s", addr, err.Error())
+		return nil, err
+	}
+
+	actual, _ := auctionAdminPeers.LoadOrStore(addr, c)
+	return actual.(auctionadminservice.Client), nil
+}
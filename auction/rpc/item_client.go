@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"auction_module/config"
+	"auction_module/etcd"
+	"auction_module/kitex_gen/item_internal_service/iteminternalservice"
+	"auction_module/kitex_gen/item_service/itemservice"
+	"auction_module/rpc_middleware"
+	"sync"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/kitex-contrib/obs-opentelemetry/tracing"
+)
+
+var (
+	ItemClient itemservice.Client
+	// ItemInternalClient 道具内部服务客户端，唯一道具实例的写回只在内部服务中提供
+	ItemInternalClient iteminternalservice.Client
+	onceItem           sync.Once
+)
+
+// InitItemClient 初始化道具服务客户端，用于托管和结算道具/货币
+func InitItemClient() (err error) {
+	onceItem.Do(func() {
+		ItemClient, err = itemservice.NewClient(
+			config.Get("item.service_name").(string),
+			client.WithResolver(etcd.GetEtcdResolver()),
+			client.WithSuite(tracing.NewClientSuite()),
+			client.WithMiddleware(rpc_middleware.UserIdClientMiddleware),
+		)
+		if err != nil {
+			klog.Error("[AUCTION-RPC-ITEM-INIT] Failed to initialize item client: ", err)
+			return
+		}
+		ItemInternalClient, err = iteminternalservice.NewClient(
+			config.Get("item.service_name").(string),
+			client.WithResolver(etcd.GetEtcdResolver()),
+			client.WithSuite(tracing.NewClientSuite()),
+			client.WithMiddleware(rpc_middleware.UserIdClientMiddleware),
+		)
+		if err != nil {
+			klog.Error("[AUCTION-RPC-ITEM-INIT] Failed to initialize item internal client: ", err)
+		}
+	})
+	return err
+}
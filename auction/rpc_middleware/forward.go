@@ -0,0 +1,21 @@
+package rpc_middleware
+
+import (
+	"context"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+)
+
+// forwardedMetaKey 标记请求已由其他实例转发，避免在实例间循环转发
+const forwardedMetaKey = "auctionForwarded"
+
+// SetForwardedToContext 标记请求为实例间转发（只传递一跳）
+func SetForwardedToContext(ctx context.Context) context.Context {
+	return metainfo.WithValue(ctx, forwardedMetaKey, "1")
+}
+
+// IsForwarded 判断请求是否来自其他实例的转发
+func IsForwarded(ctx context.Context) bool {
+	_, ok := metainfo.GetValue(ctx, forwardedMetaKey)
+	return ok
+}
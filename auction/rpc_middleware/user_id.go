@@ -26,3 +26,15 @@ func SetUserIdToContext(ctx context.Context, userId string) context.Context {
 	ctx = context.WithValue(ctx, userIdMetaKey, userId)
 	return metainfo.WithValue(ctx, userIdMetaKey, userId)
 }
+
+// UserIdClientMiddleware Kitex 客户端中间件，用于将 context 中的 userId 注入到 RPC 调用中
+func UserIdClientMiddleware(next endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, req, resp interface{}) error {
+		if v := ctx.Value(userIdMetaKey); v != nil {
+			if userId, ok := v.(string); ok && userId != "" {
+				ctx = metainfo.WithValue(ctx, userIdMetaKey, userId)
+			}
+		}
+		return next(ctx, req, resp)
+	}
+}
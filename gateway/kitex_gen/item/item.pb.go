@@ -38,6 +38,8 @@ type ItemInfo struct {
 	Properties string `protobuf:"bytes,4,opt,name=properties,proto3" json:"properties,omitempty"`
 	// 道具数量
 	Count int32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	// 过期时间（秒），0表示永久
+	ExpireAt int64 `protobuf:"varint,6,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
 }
 
 func (x *ItemInfo) Reset() {
@@ -107,6 +109,13 @@ func (x *ItemInfo) GetCount() int32 {
 	return 0
 }
 
+func (x *ItemInfo) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
 // 道具添加信息
 type ItemAddInfo struct {
 	state         protoimpl.MessageState
@@ -117,6 +126,8 @@ type ItemAddInfo struct {
 	ItemId int32 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 	// 道具数量
 	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// 指定过期时间（秒），0表示按道具配置的有效期
+	ExpireAt int64 `protobuf:"varint,3,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
 }
 
 func (x *ItemAddInfo) Reset() {
@@ -165,6 +176,13 @@ func (x *ItemAddInfo) GetCount() int32 {
 	return 0
 }
 
+func (x *ItemAddInfo) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
 // 道具删除信息
 type ItemDeleteInfo struct {
 	state         protoimpl.MessageState
@@ -369,6 +387,8 @@ type DeleteItemReq struct {
 	OperationReason string `protobuf:"bytes,2,opt,name=operation_reason,json=operationReason,proto3" json:"operation_reason,omitempty"`
 	// 幂等id
 	IdempotentId string `protobuf:"bytes,3,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"`
+	// 扣除用于交易托管（拍卖挂单），道具必须可交易且未绑定
+	ForTrade bool `protobuf:"varint,4,opt,name=for_trade,json=forTrade,proto3" json:"for_trade,omitempty"`
 }
 
 func (x *DeleteItemReq) Reset() {
@@ -424,6 +444,13 @@ func (x *DeleteItemReq) GetIdempotentId() string {
 	return ""
 }
 
+func (x *DeleteItemReq) GetForTrade() bool {
+	if x != nil {
+		return x.ForTrade
+	}
+	return false
+}
+
 // 删除道具响应
 type DeleteItemRsp struct {
 	state         protoimpl.MessageState
@@ -887,17 +914,24 @@ func (x *DeleteItemByIdRsp) GetMsg() string {
 	return ""
 }
 
-type AddItemRsp_Data struct {
+// 恢复道具请求（按托管记录写回唯一道具实例）
+type RestoreItemsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 道具信息列表
+	// 道具信息列表，只使用道具id和唯一id定位托管记录，写回的属性以托管记录为准
 	ItemInfoList []*ItemInfo `protobuf:"bytes,1,rep,name=item_info_list,json=itemInfoList,proto3" json:"item_info_list,omitempty"`
+	// 操作原因
+	OperationReason string `protobuf:"bytes,2,opt,name=operation_reason,json=operationReason,proto3" json:"operation_reason,omitempty"`
+	// 幂等id
+	IdempotentId string `protobuf:"bytes,3,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"`
+	// 托管道具的用户id（拍卖卖家），为空时为调用方用户
+	EscrowUserId string `protobuf:"bytes,4,opt,name=escrow_user_id,json=escrowUserId,proto3" json:"escrow_user_id,omitempty"`
 }
 
-func (x *AddItemRsp_Data) Reset() {
-	*x = AddItemRsp_Data{}
+func (x *RestoreItemsReq) Reset() {
+	*x = RestoreItemsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_item_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -905,13 +939,13 @@ func (x *AddItemRsp_Data) Reset() {
 	}
 }
 
-func (x *AddItemRsp_Data) String() string {
+func (x *RestoreItemsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddItemRsp_Data) ProtoMessage() {}
+func (*RestoreItemsReq) ProtoMessage() {}
 
-func (x *AddItemRsp_Data) ProtoReflect() protoreflect.Message {
+func (x *RestoreItemsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_item_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -923,29 +957,53 @@ func (x *AddItemRsp_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddItemRsp_Data.ProtoReflect.Descriptor instead.
-func (*AddItemRsp_Data) Descriptor() ([]byte, []int) {
-	return file_proto_item_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use RestoreItemsReq.ProtoReflect.Descriptor instead.
+func (*RestoreItemsReq) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *AddItemRsp_Data) GetItemInfoList() []*ItemInfo {
+func (x *RestoreItemsReq) GetItemInfoList() []*ItemInfo {
 	if x != nil {
 		return x.ItemInfoList
 	}
 	return nil
 }
 
-type GetAllItemsRsp_Data struct {
+func (x *RestoreItemsReq) GetOperationReason() string {
+	if x != nil {
+		return x.OperationReason
+	}
+	return ""
+}
+
+func (x *RestoreItemsReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+func (x *RestoreItemsReq) GetEscrowUserId() string {
+	if x != nil {
+		return x.EscrowUserId
+	}
+	return ""
+}
+
+// 恢复道具响应
+type RestoreItemsRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 道具信息列表
-	ItemInfoList []*ItemInfo `protobuf:"bytes,1,rep,name=item_info_list,json=itemInfoList,proto3" json:"item_info_list,omitempty"`
+	// 错误码
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"`
+	// 错误信息
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (x *GetAllItemsRsp_Data) Reset() {
-	*x = GetAllItemsRsp_Data{}
+func (x *RestoreItemsRsp) Reset() {
+	*x = RestoreItemsRsp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_item_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -953,13 +1011,13 @@ func (x *GetAllItemsRsp_Data) Reset() {
 	}
 }
 
-func (x *GetAllItemsRsp_Data) String() string {
+func (x *RestoreItemsRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllItemsRsp_Data) ProtoMessage() {}
+func (*RestoreItemsRsp) ProtoMessage() {}
 
-func (x *GetAllItemsRsp_Data) ProtoReflect() protoreflect.Message {
+func (x *RestoreItemsRsp) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_item_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -971,29 +1029,39 @@ func (x *GetAllItemsRsp_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllItemsRsp_Data.ProtoReflect.Descriptor instead.
-func (*GetAllItemsRsp_Data) Descriptor() ([]byte, []int) {
-	return file_proto_item_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use RestoreItemsRsp.ProtoReflect.Descriptor instead.
+func (*RestoreItemsRsp) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetAllItemsRsp_Data) GetItemInfoList() []*ItemInfo {
+func (x *RestoreItemsRsp) GetCode() common.ErrorCode {
 	if x != nil {
-		return x.ItemInfoList
+		return x.Code
 	}
-	return nil
+	return common.ErrorCode(0)
 }
 
-type GetItemRsp_Data struct {
+func (x *RestoreItemsRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// 道具转移信息
+type ItemTransferInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 道具信息
-	ItemInfo *ItemInfo `protobuf:"bytes,1,opt,name=item_info,json=itemInfo,proto3" json:"item_info,omitempty"`
+	// 道具唯一id
+	ItemUniqueId string `protobuf:"bytes,1,opt,name=item_unique_id,json=itemUniqueId,proto3" json:"item_unique_id,omitempty"`
+	// 转移数量
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *GetItemRsp_Data) Reset() {
-	*x = GetItemRsp_Data{}
+func (x *ItemTransferInfo) Reset() {
+	*x = ItemTransferInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_item_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1001,13 +1069,13 @@ func (x *GetItemRsp_Data) Reset() {
 	}
 }
 
-func (x *GetItemRsp_Data) String() string {
+func (x *ItemTransferInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetItemRsp_Data) ProtoMessage() {}
+func (*ItemTransferInfo) ProtoMessage() {}
 
-func (x *GetItemRsp_Data) ProtoReflect() protoreflect.Message {
+func (x *ItemTransferInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_item_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1019,216 +1087,2360 @@ func (x *GetItemRsp_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetItemRsp_Data.ProtoReflect.Descriptor instead.
-func (*GetItemRsp_Data) Descriptor() ([]byte, []int) {
-	return file_proto_item_proto_rawDescGZIP(), []int{10, 0}
+// Deprecated: Use ItemTransferInfo.ProtoReflect.Descriptor instead.
+func (*ItemTransferInfo) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetItemRsp_Data) GetItemInfo() *ItemInfo {
+func (x *ItemTransferInfo) GetItemUniqueId() string {
 	if x != nil {
-		return x.ItemInfo
+		return x.ItemUniqueId
 	}
-	return nil
+	return ""
 }
 
-var File_proto_item_proto protoreflect.FileDescriptor
+func (x *ItemTransferInfo) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
 
-var file_proto_item_proto_rawDesc = []byte{
-	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x1a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x01, 0x0a,
-	0x08, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65,
-	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d,
-	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d,
-	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x74, 0x65,
-	0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3c, 0x0a, 0x0b, 0x49,
-	0x74, 0x65, 0x6d, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74,
-	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65,
-	0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4c, 0x0a, 0x0e, 0x49, 0x74, 0x65,
-	0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0e, 0x69,
-	0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x0d, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x61,
-	0x64, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0b, 0x69, 0x74, 0x65, 0x6d, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a,
-	0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d,
-	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xae, 0x01,
-	0x0a, 0x0a, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x74,
-	0x65, 0x6d, 0x52, 0x73, 0x70, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x1a, 0x3c, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x9f,
-	0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
-	0x12, 0x3e, 0x0a, 0x10, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x74, 0x65,
-	0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69,
-	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x22, 0x48, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73,
-	0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x22, 0xb6, 0x01, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x73, 0x70, 0x12,
-	0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x73, 0x70, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3c, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x34, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49,
-	0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66,
-	0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x74, 0x65,
-	0x6d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x0a, 0x47, 0x65,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
-	0x67, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73,
-	0x70, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x33, 0x0a, 0x04,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49,
-	0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66,
-	0x6f, 0x22, 0x43, 0x0a, 0x12, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
-	0x79, 0x49, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x12, 0x42, 0x0a, 0x10,
-	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74,
-	0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x49, 0x64, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69,
-	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x22, 0x4c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x79,
-	0x49, 0x64, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x42, 0x20,
-	0x5a, 0x1e, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x61, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2f, 0x6b, 0x69, 0x74, 0x65, 0x78, 0x5f, 0x67, 0x65, 0x6e, 0x2f, 0x69, 0x74, 0x65, 0x6d,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// 道具转移请求（从一个用户转移到另一个用户，同一幂等id只会转入或退还一次）
+type TransferItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 转出用户id，为空时使用调用方用户id
+	FromUserId string `protobuf:"bytes,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	// 转入用户id
+	ToUserId string `protobuf:"bytes,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	// 道具转移信息列表
+	ItemTransferList []*ItemTransferInfo `protobuf:"bytes,3,rep,name=item_transfer_list,json=itemTransferList,proto3" json:"item_transfer_list,omitempty"`
+	// 操作原因
+	OperationReason string `protobuf:"bytes,4,opt,name=operation_reason,json=operationReason,proto3" json:"operation_reason,omitempty"`
+	// 幂等id
+	IdempotentId string `protobuf:"bytes,5,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"`
 }
 
-var (
-	file_proto_item_proto_rawDescOnce sync.Once
-	file_proto_item_proto_rawDescData = file_proto_item_proto_rawDesc
-)
+func (x *TransferItemsReq) Reset() {
+	*x = TransferItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_item_proto_rawDescGZIP() []byte {
-	file_proto_item_proto_rawDescOnce.Do(func() {
-		file_proto_item_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_item_proto_rawDescData)
-	})
-	return file_proto_item_proto_rawDescData
+func (x *TransferItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_item_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
-var file_proto_item_proto_goTypes = []interface{}{
-	(*ItemInfo)(nil),            // 0: item.ItemInfo
-	(*ItemAddInfo)(nil),         // 1: item.ItemAddInfo
-	(*ItemDeleteInfo)(nil),      // 2: item.ItemDeleteInfo
-	(*AddItemReq)(nil),          // 3: item.AddItemReq
-	(*AddItemRsp)(nil),          // 4: item.AddItemRsp
-	(*DeleteItemReq)(nil),       // 5: item.DeleteItemReq
-	(*DeleteItemRsp)(nil),       // 6: item.DeleteItemRsp
-	(*GetAllItemsReq)(nil),      // 7: item.GetAllItemsReq
-	(*GetAllItemsRsp)(nil),      // 8: item.GetAllItemsRsp
-	(*GetItemReq)(nil),          // 9: item.GetItemReq
-	(*GetItemRsp)(nil),          // 10: item.GetItemRsp
-	(*ItemDeleteByIdInfo)(nil),  // 11: item.ItemDeleteByIdInfo
-	(*DeleteItemByIdReq)(nil),   // 12: item.DeleteItemByIdReq
-	(*DeleteItemByIdRsp)(nil),   // 13: item.DeleteItemByIdRsp
-	(*AddItemRsp_Data)(nil),     // 14: item.AddItemRsp.Data
-	(*GetAllItemsRsp_Data)(nil), // 15: item.GetAllItemsRsp.Data
-	(*GetItemRsp_Data)(nil),     // 16: item.GetItemRsp.Data
-	(common.ErrorCode)(0),       // 17: common.ErrorCode
+func (*TransferItemsReq) ProtoMessage() {}
+
+func (x *TransferItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_item_proto_depIdxs = []int32{
-	1,  // 0: item.AddItemReq.item_add_list:type_name -> item.ItemAddInfo
-	17, // 1: item.AddItemRsp.code:type_name -> common.ErrorCode
-	14, // 2: item.AddItemRsp.data:type_name -> item.AddItemRsp.Data
-	2,  // 3: item.DeleteItemReq.item_delete_list:type_name -> item.ItemDeleteInfo
-	17, // 4: item.DeleteItemRsp.code:type_name -> common.ErrorCode
-	17, // 5: item.GetAllItemsRsp.code:type_name -> common.ErrorCode
-	15, // 6: item.GetAllItemsRsp.data:type_name -> item.GetAllItemsRsp.Data
-	17, // 7: item.GetItemRsp.code:type_name -> common.ErrorCode
-	16, // 8: item.GetItemRsp.data:type_name -> item.GetItemRsp.Data
-	11, // 9: item.DeleteItemByIdReq.item_delete_list:type_name -> item.ItemDeleteByIdInfo
-	17, // 10: item.DeleteItemByIdRsp.code:type_name -> common.ErrorCode
-	0,  // 11: item.AddItemRsp.Data.item_info_list:type_name -> item.ItemInfo
-	0,  // 12: item.GetAllItemsRsp.Data.item_info_list:type_name -> item.ItemInfo
-	0,  // 13: item.GetItemRsp.Data.item_info:type_name -> item.ItemInfo
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+
+// Deprecated: Use TransferItemsReq.ProtoReflect.Descriptor instead.
+func (*TransferItemsReq) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{17}
 }
 
-func init() { file_proto_item_proto_init() }
-func file_proto_item_proto_init() {
-	if File_proto_item_proto != nil {
-		return
+func (x *TransferItemsReq) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_item_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ItemInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *TransferItemsReq) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *TransferItemsReq) GetItemTransferList() []*ItemTransferInfo {
+	if x != nil {
+		return x.ItemTransferList
+	}
+	return nil
+}
+
+func (x *TransferItemsReq) GetOperationReason() string {
+	if x != nil {
+		return x.OperationReason
+	}
+	return ""
+}
+
+func (x *TransferItemsReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+// 道具转移响应
+type TransferItemsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 错误码
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"`
+	// 错误信息
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// 数据
+	Data *TransferItemsRsp_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *TransferItemsRsp) Reset() {
+	*x = TransferItemsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferItemsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferItemsRsp) ProtoMessage() {}
+
+func (x *TransferItemsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proto_item_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ItemAddInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferItemsRsp.ProtoReflect.Descriptor instead.
+func (*TransferItemsRsp) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TransferItemsRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *TransferItemsRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *TransferItemsRsp) GetData() *TransferItemsRsp_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 道具流水记录
+type ItemLedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 流水id
+	LedgerId string `protobuf:"bytes,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	// 用户id
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 道具id
+	ItemId int32 `protobuf:"varint,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// 道具唯一id
+	ItemUniqueId string `protobuf:"bytes,4,opt,name=item_unique_id,json=itemUniqueId,proto3" json:"item_unique_id,omitempty"`
+	// 数量变化，增加为正，减少为负
+	Delta int32 `protobuf:"varint,5,opt,name=delta,proto3" json:"delta,omitempty"`
+	// 变化后的数量
+	Balance int32 `protobuf:"varint,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	// 操作原因
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 来源服务
+	Source string `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
+	// 幂等id
+	IdempotentId string `protobuf:"bytes,9,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"`
+	// 链路追踪id
+	TraceId string `protobuf:"bytes,10,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	// 变化时间（秒）
+	Time int64 `protobuf:"varint,11,opt,name=time,proto3" json:"time,omitempty"`
+	// 是否为邮箱中的变化（溢出转入邮箱为正，领取、到期移除为负）
+	Mailbox bool `protobuf:"varint,12,opt,name=mailbox,proto3" json:"mailbox,omitempty"`
+}
+
+func (x *ItemLedgerEntry) Reset() {
+	*x = ItemLedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemLedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemLedgerEntry) ProtoMessage() {}
+
+func (x *ItemLedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemLedgerEntry.ProtoReflect.Descriptor instead.
+func (*ItemLedgerEntry) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ItemLedgerEntry) GetLedgerId() string {
+	if x != nil {
+		return x.LedgerId
+	}
+	return ""
+}
+
+func (x *ItemLedgerEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ItemLedgerEntry) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *ItemLedgerEntry) GetItemUniqueId() string {
+	if x != nil {
+		return x.ItemUniqueId
+	}
+	return ""
+}
+
+func (x *ItemLedgerEntry) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *ItemLedgerEntry) GetBalance() int32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *ItemLedgerEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ItemLedgerEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ItemLedgerEntry) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+func (x *ItemLedgerEntry) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *ItemLedgerEntry) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *ItemLedgerEntry) GetMailbox() bool {
+	if x != nil {
+		return x.Mailbox
+	}
+	return false
+}
+
+// 查询道具流水请求
+type GetItemLedgerReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 起始时间（秒，包含），0表示不限
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// 结束时间（秒，包含），0表示不限
+	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// 按道具id过滤，0表示不过滤
+	ItemId int32 `protobuf:"varint,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// 按道具唯一id过滤，为空表示不过滤
+	ItemUniqueId string `protobuf:"bytes,4,opt,name=item_unique_id,json=itemUniqueId,proto3" json:"item_unique_id,omitempty"`
+	// 返回条数，默认100，最多500
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 分页游标，传入上一页返回的next_cursor
+	Cursor string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *GetItemLedgerReq) Reset() {
+	*x = GetItemLedgerReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemLedgerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemLedgerReq) ProtoMessage() {}
+
+func (x *GetItemLedgerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemLedgerReq.ProtoReflect.Descriptor instead.
+func (*GetItemLedgerReq) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetItemLedgerReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetItemLedgerReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *GetItemLedgerReq) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *GetItemLedgerReq) GetItemUniqueId() string {
+	if x != nil {
+		return x.ItemUniqueId
+	}
+	return ""
+}
+
+func (x *GetItemLedgerReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetItemLedgerReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// 查询道具流水响应
+type GetItemLedgerRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 错误码
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"`
+	// 错误信息
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// 数据
+	Data *GetItemLedgerRsp_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetItemLedgerRsp) Reset() {
+	*x = GetItemLedgerRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemLedgerRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemLedgerRsp) ProtoMessage() {}
+
+func (x *GetItemLedgerRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemLedgerRsp.ProtoReflect.Descriptor instead.
+func (*GetItemLedgerRsp) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetItemLedgerRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *GetItemLedgerRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetItemLedgerRsp) GetData() *GetItemLedgerRsp_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 邮箱中的道具（背包容量或堆叠上限不足时转入）
+type MailboxItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 邮件id
+	MailId string `protobuf:"bytes,1,opt,name=mail_id,json=mailId,proto3" json:"mail_id,omitempty"`
+	// 道具信息，数量为邮箱中剩余的数量
+	ItemInfo *ItemInfo `protobuf:"bytes,2,opt,name=item_info,json=itemInfo,proto3" json:"item_info,omitempty"`
+	// 转入邮箱时的操作原因
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 转入邮箱的时间（秒）
+	Time int64 `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	// 领取截止时间（秒），到期后未领取的道具被移除
+	Deadline int64 `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (x *MailboxItem) Reset() {
+	*x = MailboxItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailboxItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailboxItem) ProtoMessage() {}
+
+func (x *MailboxItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailboxItem.ProtoReflect.Descriptor instead.
+func (*MailboxItem) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MailboxItem) GetMailId() string {
+	if x != nil {
+		return x.MailId
+	}
+	return ""
+}
+
+func (x *MailboxItem) GetItemInfo() *ItemInfo {
+	if x != nil {
+		return x.ItemInfo
+	}
+	return nil
+}
+
+func (x *MailboxItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MailboxItem) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *MailboxItem) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+// 查询邮箱请求
+type GetMailboxReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMailboxReq) Reset() {
+	*x = GetMailboxReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMailboxReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMailboxReq) ProtoMessage() {}
+
+func (x *GetMailboxReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMailboxReq.ProtoReflect.Descriptor instead.
+func (*GetMailboxReq) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{23}
+}
+
+// 查询邮箱响应
+type GetMailboxRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 错误码
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"`
+	// 错误信息
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// 数据
+	Data *GetMailboxRsp_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetMailboxRsp) Reset() {
+	*x = GetMailboxRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMailboxRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMailboxRsp) ProtoMessage() {}
+
+func (x *GetMailboxRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMailboxRsp.ProtoReflect.Descriptor instead.
+func (*GetMailboxRsp) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetMailboxRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *GetMailboxRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetMailboxRsp) GetData() *GetMailboxRsp_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 领取邮箱道具请求
+type ClaimMailboxReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 领取的邮件id，为空表示全部领取
+	MailIdList []string `protobuf:"bytes,1,rep,name=mail_id_list,json=mailIdList,proto3" json:"mail_id_list,omitempty"`
+	// 幂等id
+	IdempotentId string `protobuf:"bytes,2,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"`
+}
+
+func (x *ClaimMailboxReq) Reset() {
+	*x = ClaimMailboxReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimMailboxReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMailboxReq) ProtoMessage() {}
+
+func (x *ClaimMailboxReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMailboxReq.ProtoReflect.Descriptor instead.
+func (*ClaimMailboxReq) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ClaimMailboxReq) GetMailIdList() []string {
+	if x != nil {
+		return x.MailIdList
+	}
+	return nil
+}
+
+func (x *ClaimMailboxReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+// 领取邮箱道具响应
+type ClaimMailboxRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 错误码
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"`
+	// 错误信息
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// 数据
+	Data *ClaimMailboxRsp_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ClaimMailboxRsp) Reset() {
+	*x = ClaimMailboxRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimMailboxRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMailboxRsp) ProtoMessage() {}
+
+func (x *ClaimMailboxRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMailboxRsp.ProtoReflect.Descriptor instead.
+func (*ClaimMailboxRsp) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ClaimMailboxRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ClaimMailboxRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ClaimMailboxRsp) GetData() *ClaimMailboxRsp_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 道具过期通知（服务器推送）
+type ItemExpiredNtf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 已过期并被移除的道具
+	ItemInfoList []*ItemInfo `protobuf:"bytes,1,rep,name=item_info_list,json=itemInfoList,proto3" json:"item_info_list,omitempty"`
+}
+
+func (x *ItemExpiredNtf) Reset() {
+	*x = ItemExpiredNtf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemExpiredNtf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemExpiredNtf) ProtoMessage() {}
+
+func (x *ItemExpiredNtf) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemExpiredNtf.ProtoReflect.Descriptor instead.
+func (*ItemExpiredNtf) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ItemExpiredNtf) GetItemInfoList() []*ItemInfo {
+	if x != nil {
+		return x.ItemInfoList
+	}
+	return nil
+}
+
+// 使用道具请求
+type UseItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 道具唯一id
+	ItemUniqueId string `protobuf:"bytes,1,opt,name=item_unique_id,json=itemUniqueId,proto3" json:"item_unique_id,omitempty"`
+	// 使用数量
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// 操作原因
+	OperationReason string `protobuf:"bytes,3,opt,name=operation_reason,json=operationReason,proto3" json:"operation_reason,omitempty"`
+	// 幂等id，道具消耗与所有效果共用；效果执行失败时道具已消耗，使用相同幂等id重试只补发效果
+	IdempotentId string `protobuf:"bytes,4,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"`
+}
+
+func (x *UseItemReq) Reset() {
+	*x = UseItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UseItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseItemReq) ProtoMessage() {}
+
+func (x *UseItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseItemReq.ProtoReflect.Descriptor instead.
+func (*UseItemReq) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UseItemReq) GetItemUniqueId() string {
+	if x != nil {
+		return x.ItemUniqueId
+	}
+	return ""
+}
+
+func (x *UseItemReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UseItemReq) GetOperationReason() string {
+	if x != nil {
+		return x.OperationReason
+	}
+	return ""
+}
+
+func (x *UseItemReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+// 使用道具响应
+type UseItemRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 错误码
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"`
+	// 错误信息
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// 数据
+	Data *UseItemRsp_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UseItemRsp) Reset() {
+	*x = UseItemRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UseItemRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseItemRsp) ProtoMessage() {}
+
+func (x *UseItemRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseItemRsp.ProtoReflect.Descriptor instead.
+func (*UseItemRsp) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UseItemRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *UseItemRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *UseItemRsp) GetData() *UseItemRsp_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 查询已解锁皮肤请求
+type GetSkinsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSkinsReq) Reset() {
+	*x = GetSkinsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSkinsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkinsReq) ProtoMessage() {}
+
+func (x *GetSkinsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkinsReq.ProtoReflect.Descriptor instead.
+func (*GetSkinsReq) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{30}
+}
+
+// 查询已解锁皮肤响应
+type GetSkinsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 错误码
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"`
+	// 错误信息
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// 数据
+	Data *GetSkinsRsp_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetSkinsRsp) Reset() {
+	*x = GetSkinsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSkinsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkinsRsp) ProtoMessage() {}
+
+func (x *GetSkinsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkinsRsp.ProtoReflect.Descriptor instead.
+func (*GetSkinsRsp) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetSkinsRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *GetSkinsRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetSkinsRsp) GetData() *GetSkinsRsp_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 按掉落表发放奖励请求
+type GrantRewardsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 掉落表id
+	TableId string `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	// 抽取次数
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// 操作原因
+	OperationReason string `protobuf:"bytes,3,opt,name=operation_reason,json=operationReason,proto3" json:"operation_reason,omitempty"`
+	// 幂等id，重试时返回首次抽取的结果，不会重复发放或重复累计保底
+	IdempotentId string `protobuf:"bytes,4,opt,name=idempotent_id,json=idempotentId,proto3" json:"idempotent_id,omitempty"`
+}
+
+func (x *GrantRewardsReq) Reset() {
+	*x = GrantRewardsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantRewardsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRewardsReq) ProtoMessage() {}
+
+func (x *GrantRewardsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRewardsReq.ProtoReflect.Descriptor instead.
+func (*GrantRewardsReq) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GrantRewardsReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *GrantRewardsReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GrantRewardsReq) GetOperationReason() string {
+	if x != nil {
+		return x.OperationReason
+	}
+	return ""
+}
+
+func (x *GrantRewardsReq) GetIdempotentId() string {
+	if x != nil {
+		return x.IdempotentId
+	}
+	return ""
+}
+
+// 按掉落表发放奖励响应
+type GrantRewardsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 错误码
+	Code common.ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=common.ErrorCode" json:"code,omitempty"`
+	// 错误信息
+	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// 数据
+	Data *GrantRewardsRsp_Data `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GrantRewardsRsp) Reset() {
+	*x = GrantRewardsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantRewardsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRewardsRsp) ProtoMessage() {}
+
+func (x *GrantRewardsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRewardsRsp.ProtoReflect.Descriptor instead.
+func (*GrantRewardsRsp) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GrantRewardsRsp) GetCode() common.ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *GrantRewardsRsp) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GrantRewardsRsp) GetData() *GrantRewardsRsp_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AddItemRsp_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 道具信息列表
+	ItemInfoList []*ItemInfo `protobuf:"bytes,1,rep,name=item_info_list,json=itemInfoList,proto3" json:"item_info_list,omitempty"`
+	// 超出背包容量或堆叠上限、转入邮箱的道具
+	MailboxList []*ItemAddInfo `protobuf:"bytes,2,rep,name=mailbox_list,json=mailboxList,proto3" json:"mailbox_list,omitempty"`
+}
+
+func (x *AddItemRsp_Data) Reset() {
+	*x = AddItemRsp_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddItemRsp_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddItemRsp_Data) ProtoMessage() {}
+
+func (x *AddItemRsp_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddItemRsp_Data.ProtoReflect.Descriptor instead.
+func (*AddItemRsp_Data) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *AddItemRsp_Data) GetItemInfoList() []*ItemInfo {
+	if x != nil {
+		return x.ItemInfoList
+	}
+	return nil
+}
+
+func (x *AddItemRsp_Data) GetMailboxList() []*ItemAddInfo {
+	if x != nil {
+		return x.MailboxList
+	}
+	return nil
+}
+
+type GetAllItemsRsp_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 道具信息列表
+	ItemInfoList []*ItemInfo `protobuf:"bytes,1,rep,name=item_info_list,json=itemInfoList,proto3" json:"item_info_list,omitempty"`
+}
+
+func (x *GetAllItemsRsp_Data) Reset() {
+	*x = GetAllItemsRsp_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllItemsRsp_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllItemsRsp_Data) ProtoMessage() {}
+
+func (x *GetAllItemsRsp_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllItemsRsp_Data.ProtoReflect.Descriptor instead.
+func (*GetAllItemsRsp_Data) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *GetAllItemsRsp_Data) GetItemInfoList() []*ItemInfo {
+	if x != nil {
+		return x.ItemInfoList
+	}
+	return nil
+}
+
+type GetItemRsp_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 道具信息
+	ItemInfo *ItemInfo `protobuf:"bytes,1,opt,name=item_info,json=itemInfo,proto3" json:"item_info,omitempty"`
+}
+
+func (x *GetItemRsp_Data) Reset() {
+	*x = GetItemRsp_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemRsp_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemRsp_Data) ProtoMessage() {}
+
+func (x *GetItemRsp_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemRsp_Data.ProtoReflect.Descriptor instead.
+func (*GetItemRsp_Data) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *GetItemRsp_Data) GetItemInfo() *ItemInfo {
+	if x != nil {
+		return x.ItemInfo
+	}
+	return nil
+}
+
+type TransferItemsRsp_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 转入用户转移后的道具信息列表
+	ItemInfoList []*ItemInfo `protobuf:"bytes,1,rep,name=item_info_list,json=itemInfoList,proto3" json:"item_info_list,omitempty"`
+}
+
+func (x *TransferItemsRsp_Data) Reset() {
+	*x = TransferItemsRsp_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferItemsRsp_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferItemsRsp_Data) ProtoMessage() {}
+
+func (x *TransferItemsRsp_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferItemsRsp_Data.ProtoReflect.Descriptor instead.
+func (*TransferItemsRsp_Data) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *TransferItemsRsp_Data) GetItemInfoList() []*ItemInfo {
+	if x != nil {
+		return x.ItemInfoList
+	}
+	return nil
+}
+
+type GetItemLedgerRsp_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 流水列表，按时间倒序
+	LedgerList []*ItemLedgerEntry `protobuf:"bytes,1,rep,name=ledger_list,json=ledgerList,proto3" json:"ledger_list,omitempty"`
+	// 下一页游标，为空表示没有更多
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetItemLedgerRsp_Data) Reset() {
+	*x = GetItemLedgerRsp_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemLedgerRsp_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemLedgerRsp_Data) ProtoMessage() {}
+
+func (x *GetItemLedgerRsp_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemLedgerRsp_Data.ProtoReflect.Descriptor instead.
+func (*GetItemLedgerRsp_Data) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *GetItemLedgerRsp_Data) GetLedgerList() []*ItemLedgerEntry {
+	if x != nil {
+		return x.LedgerList
+	}
+	return nil
+}
+
+func (x *GetItemLedgerRsp_Data) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetMailboxRsp_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 邮箱中的道具，按转入时间排序
+	MailList []*MailboxItem `protobuf:"bytes,1,rep,name=mail_list,json=mailList,proto3" json:"mail_list,omitempty"`
+}
+
+func (x *GetMailboxRsp_Data) Reset() {
+	*x = GetMailboxRsp_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMailboxRsp_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMailboxRsp_Data) ProtoMessage() {}
+
+func (x *GetMailboxRsp_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMailboxRsp_Data.ProtoReflect.Descriptor instead.
+func (*GetMailboxRsp_Data) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *GetMailboxRsp_Data) GetMailList() []*MailboxItem {
+	if x != nil {
+		return x.MailList
+	}
+	return nil
+}
+
+type ClaimMailboxRsp_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 领取后的道具信息列表
+	ItemInfoList []*ItemInfo `protobuf:"bytes,1,rep,name=item_info_list,json=itemInfoList,proto3" json:"item_info_list,omitempty"`
+	// 背包容量或堆叠上限不足、仍留在邮箱中的道具
+	MailList []*MailboxItem `protobuf:"bytes,2,rep,name=mail_list,json=mailList,proto3" json:"mail_list,omitempty"`
+}
+
+func (x *ClaimMailboxRsp_Data) Reset() {
+	*x = ClaimMailboxRsp_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimMailboxRsp_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMailboxRsp_Data) ProtoMessage() {}
+
+func (x *ClaimMailboxRsp_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMailboxRsp_Data.ProtoReflect.Descriptor instead.
+func (*ClaimMailboxRsp_Data) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *ClaimMailboxRsp_Data) GetItemInfoList() []*ItemInfo {
+	if x != nil {
+		return x.ItemInfoList
+	}
+	return nil
+}
+
+func (x *ClaimMailboxRsp_Data) GetMailList() []*MailboxItem {
+	if x != nil {
+		return x.MailList
+	}
+	return nil
+}
+
+type UseItemRsp_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 使用的道具id
+	ItemId int32 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// 消耗的数量
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// 获得的道具信息列表
+	ItemInfoList []*ItemInfo `protobuf:"bytes,3,rep,name=item_info_list,json=itemInfoList,proto3" json:"item_info_list,omitempty"`
+	// 超出容量或堆叠上限、转入邮箱的道具
+	MailboxList []*ItemAddInfo `protobuf:"bytes,4,rep,name=mailbox_list,json=mailboxList,proto3" json:"mailbox_list,omitempty"`
+	// 增加的经验
+	AddExp int64 `protobuf:"varint,5,opt,name=add_exp,json=addExp,proto3" json:"add_exp,omitempty"`
+	// 解锁的皮肤id列表
+	SkinIdList []int32 `protobuf:"varint,6,rep,packed,name=skin_id_list,json=skinIdList,proto3" json:"skin_id_list,omitempty"`
+}
+
+func (x *UseItemRsp_Data) Reset() {
+	*x = UseItemRsp_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UseItemRsp_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseItemRsp_Data) ProtoMessage() {}
+
+func (x *UseItemRsp_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseItemRsp_Data.ProtoReflect.Descriptor instead.
+func (*UseItemRsp_Data) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *UseItemRsp_Data) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *UseItemRsp_Data) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UseItemRsp_Data) GetItemInfoList() []*ItemInfo {
+	if x != nil {
+		return x.ItemInfoList
+	}
+	return nil
+}
+
+func (x *UseItemRsp_Data) GetMailboxList() []*ItemAddInfo {
+	if x != nil {
+		return x.MailboxList
+	}
+	return nil
+}
+
+func (x *UseItemRsp_Data) GetAddExp() int64 {
+	if x != nil {
+		return x.AddExp
+	}
+	return 0
+}
+
+func (x *UseItemRsp_Data) GetSkinIdList() []int32 {
+	if x != nil {
+		return x.SkinIdList
+	}
+	return nil
+}
+
+type GetSkinsRsp_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 已解锁的皮肤id列表，升序
+	SkinIdList []int32 `protobuf:"varint,1,rep,packed,name=skin_id_list,json=skinIdList,proto3" json:"skin_id_list,omitempty"`
+}
+
+func (x *GetSkinsRsp_Data) Reset() {
+	*x = GetSkinsRsp_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSkinsRsp_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkinsRsp_Data) ProtoMessage() {}
+
+func (x *GetSkinsRsp_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkinsRsp_Data.ProtoReflect.Descriptor instead.
+func (*GetSkinsRsp_Data) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *GetSkinsRsp_Data) GetSkinIdList() []int32 {
+	if x != nil {
+		return x.SkinIdList
+	}
+	return nil
+}
+
+type GrantRewardsRsp_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 抽中的奖励
+	RewardList []*ItemAddInfo `protobuf:"bytes,1,rep,name=reward_list,json=rewardList,proto3" json:"reward_list,omitempty"`
+	// 发放后的道具信息列表
+	ItemInfoList []*ItemInfo `protobuf:"bytes,2,rep,name=item_info_list,json=itemInfoList,proto3" json:"item_info_list,omitempty"`
+	// 超出容量或堆叠上限、转入邮箱的道具
+	MailboxList []*ItemAddInfo `protobuf:"bytes,3,rep,name=mailbox_list,json=mailboxList,proto3" json:"mailbox_list,omitempty"`
+}
+
+func (x *GrantRewardsRsp_Data) Reset() {
+	*x = GrantRewardsRsp_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_item_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantRewardsRsp_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRewardsRsp_Data) ProtoMessage() {}
+
+func (x *GrantRewardsRsp_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_item_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRewardsRsp_Data.ProtoReflect.Descriptor instead.
+func (*GrantRewardsRsp_Data) Descriptor() ([]byte, []int) {
+	return file_proto_item_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *GrantRewardsRsp_Data) GetRewardList() []*ItemAddInfo {
+	if x != nil {
+		return x.RewardList
+	}
+	return nil
+}
+
+func (x *GrantRewardsRsp_Data) GetItemInfoList() []*ItemInfo {
+	if x != nil {
+		return x.ItemInfoList
+	}
+	return nil
+}
+
+func (x *GrantRewardsRsp_Data) GetMailboxList() []*ItemAddInfo {
+	if x != nil {
+		return x.MailboxList
+	}
+	return nil
+}
+
+var File_proto_item_proto protoreflect.FileDescriptor
+
+var file_proto_item_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x1a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x01, 0x0a,
+	0x08, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x74, 0x65,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x22, 0x59, 0x0a, 0x0b, 0x49, 0x74, 0x65, 0x6d,
+	0x41, 0x64, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x41, 0x74, 0x22, 0x4c, 0x0a, 0x0e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69,
+	0x74, 0x65, 0x6d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x93, 0x01, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
+	0x12, 0x35, 0x0a, 0x0d, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49,
+	0x74, 0x65, 0x6d, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x69, 0x74, 0x65, 0x6d,
+	0x41, 0x64, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xe4, 0x01, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12,
+	0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x69, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x72, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x34, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x74, 0x65,
+	0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d,
+	0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0c, 0x6d, 0x61, 0x69, 0x6c,
+	0x62, 0x6f, 0x78, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xbc,
+	0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
+	0x12, 0x3e, 0x0a, 0x10, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x74, 0x65,
+	0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x64, 0x65, 0x22, 0x48, 0x0a,
+	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x12, 0x25,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x22, 0xb6, 0x01, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x73, 0x70, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x3c, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x0e,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x32, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
+	0x12, 0x24, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x29,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69,
+	0x74, 0x65, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x33, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x2b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x43,
+	0x0a, 0x12, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x49, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x12, 0x42, 0x0a, 0x10, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x79, 0x49, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x69,
+	0x74, 0x65, 0x6d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4c, 0x0a,
+	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xbd, 0x01, 0x0a, 0x0f,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x34, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49,
+	0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66,
+	0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x0f, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x73, 0x70, 0x12, 0x25,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x4e, 0x0a, 0x10, 0x49, 0x74, 0x65, 0x6d, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0e, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0c,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x12,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x10, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0xba, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x73, 0x70, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x1a, 0x3c, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x0e, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0xd4, 0x02, 0x0a, 0x0f, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d,
+	0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x22, 0xb9, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0xdd, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x52, 0x73, 0x70, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x1a, 0x5f, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x0b, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0x9b, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x09, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x65,
+	0x71, 0x22, 0xae, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78,
+	0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2c, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x74, 0x65,
+	0x6d, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x73, 0x70, 0x2e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x36, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x2e, 0x0a, 0x09, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x4d, 0x61, 0x69,
+	0x6c, 0x62, 0x6f, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x6d, 0x61, 0x69, 0x6c, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x58, 0x0a, 0x0f, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x69, 0x6c, 0x62,
+	0x6f, 0x78, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x69, 0x64,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x69,
+	0x6c, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xe8, 0x01, 0x0a,
+	0x0f, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x73, 0x70,
+	0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x73, 0x70, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x6c, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x34, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x74, 0x65, 0x6d,
+	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x6d, 0x61, 0x69, 0x6c, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x74, 0x65,
+	0x6d, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x6d,
+	0x61, 0x69, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x46, 0x0a, 0x0e, 0x49, 0x74, 0x65, 0x6d, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x4e, 0x74, 0x66, 0x12, 0x34, 0x0a, 0x0e, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x98, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x24,
+	0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xcf, 0x02, 0x0a, 0x0a, 0x55,
+	0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x73, 0x70, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xdc, 0x01,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x69,
+	0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0c, 0x6d,
+	0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x64, 0x64,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x61, 0x64, 0x64, 0x45, 0x78, 0x70, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x6b,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x6b, 0x69, 0x6e, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x0d, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x53, 0x6b, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x22, 0x9c, 0x01, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x53, 0x6b, 0x69, 0x6e, 0x73, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6b, 0x69,
+	0x6e, 0x73, 0x52, 0x73, 0x70, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x1a, 0x28, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x6b, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a,
+	0x73, 0x6b, 0x69, 0x6e, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x0f, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0xa3, 0x02, 0x0a, 0x0f, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2e, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x74, 0x65,
+	0x6d, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x73,
+	0x70, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xa6, 0x01, 0x0a,
+	0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x74, 0x65,
+	0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0e, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x34, 0x0a, 0x0c, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f,
+	0x78, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x20, 0x5a, 0x1e, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x61,
+	0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x6b, 0x69, 0x74, 0x65, 0x78, 0x5f, 0x67,
+	0x65, 0x6e, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_item_proto_rawDescOnce sync.Once
+	file_proto_item_proto_rawDescData = file_proto_item_proto_rawDesc
+)
+
+func file_proto_item_proto_rawDescGZIP() []byte {
+	file_proto_item_proto_rawDescOnce.Do(func() {
+		file_proto_item_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_item_proto_rawDescData)
+	})
+	return file_proto_item_proto_rawDescData
+}
+
+var file_proto_item_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_proto_item_proto_goTypes = []interface{}{
+	(*ItemInfo)(nil),              // 0: item.ItemInfo
+	(*ItemAddInfo)(nil),           // 1: item.ItemAddInfo
+	(*ItemDeleteInfo)(nil),        // 2: item.ItemDeleteInfo
+	(*AddItemReq)(nil),            // 3: item.AddItemReq
+	(*AddItemRsp)(nil),            // 4: item.AddItemRsp
+	(*DeleteItemReq)(nil),         // 5: item.DeleteItemReq
+	(*DeleteItemRsp)(nil),         // 6: item.DeleteItemRsp
+	(*GetAllItemsReq)(nil),        // 7: item.GetAllItemsReq
+	(*GetAllItemsRsp)(nil),        // 8: item.GetAllItemsRsp
+	(*GetItemReq)(nil),            // 9: item.GetItemReq
+	(*GetItemRsp)(nil),            // 10: item.GetItemRsp
+	(*ItemDeleteByIdInfo)(nil),    // 11: item.ItemDeleteByIdInfo
+	(*DeleteItemByIdReq)(nil),     // 12: item.DeleteItemByIdReq
+	(*DeleteItemByIdRsp)(nil),     // 13: item.DeleteItemByIdRsp
+	(*RestoreItemsReq)(nil),       // 14: item.RestoreItemsReq
+	(*RestoreItemsRsp)(nil),       // 15: item.RestoreItemsRsp
+	(*ItemTransferInfo)(nil),      // 16: item.ItemTransferInfo
+	(*TransferItemsReq)(nil),      // 17: item.TransferItemsReq
+	(*TransferItemsRsp)(nil),      // 18: item.TransferItemsRsp
+	(*ItemLedgerEntry)(nil),       // 19: item.ItemLedgerEntry
+	(*GetItemLedgerReq)(nil),      // 20: item.GetItemLedgerReq
+	(*GetItemLedgerRsp)(nil),      // 21: item.GetItemLedgerRsp
+	(*MailboxItem)(nil),           // 22: item.MailboxItem
+	(*GetMailboxReq)(nil),         // 23: item.GetMailboxReq
+	(*GetMailboxRsp)(nil),         // 24: item.GetMailboxRsp
+	(*ClaimMailboxReq)(nil),       // 25: item.ClaimMailboxReq
+	(*ClaimMailboxRsp)(nil),       // 26: item.ClaimMailboxRsp
+	(*ItemExpiredNtf)(nil),        // 27: item.ItemExpiredNtf
+	(*UseItemReq)(nil),            // 28: item.UseItemReq
+	(*UseItemRsp)(nil),            // 29: item.UseItemRsp
+	(*GetSkinsReq)(nil),           // 30: item.GetSkinsReq
+	(*GetSkinsRsp)(nil),           // 31: item.GetSkinsRsp
+	(*GrantRewardsReq)(nil),       // 32: item.GrantRewardsReq
+	(*GrantRewardsRsp)(nil),       // 33: item.GrantRewardsRsp
+	(*AddItemRsp_Data)(nil),       // 34: item.AddItemRsp.Data
+	(*GetAllItemsRsp_Data)(nil),   // 35: item.GetAllItemsRsp.Data
+	(*GetItemRsp_Data)(nil),       // 36: item.GetItemRsp.Data
+	(*TransferItemsRsp_Data)(nil), // 37: item.TransferItemsRsp.Data
+	(*GetItemLedgerRsp_Data)(nil), // 38: item.GetItemLedgerRsp.Data
+	(*GetMailboxRsp_Data)(nil),    // 39: item.GetMailboxRsp.Data
+	(*ClaimMailboxRsp_Data)(nil),  // 40: item.ClaimMailboxRsp.Data
+	(*UseItemRsp_Data)(nil),       // 41: item.UseItemRsp.Data
+	(*GetSkinsRsp_Data)(nil),      // 42: item.GetSkinsRsp.Data
+	(*GrantRewardsRsp_Data)(nil),  // 43: item.GrantRewardsRsp.Data
+	(common.ErrorCode)(0),         // 44: common.ErrorCode
+}
+var file_proto_item_proto_depIdxs = []int32{
+	1,  // 0: item.AddItemReq.item_add_list:type_name -> item.ItemAddInfo
+	44, // 1: item.AddItemRsp.code:type_name -> common.ErrorCode
+	34, // 2: item.AddItemRsp.data:type_name -> item.AddItemRsp.Data
+	2,  // 3: item.DeleteItemReq.item_delete_list:type_name -> item.ItemDeleteInfo
+	44, // 4: item.DeleteItemRsp.code:type_name -> common.ErrorCode
+	44, // 5: item.GetAllItemsRsp.code:type_name -> common.ErrorCode
+	35, // 6: item.GetAllItemsRsp.data:type_name -> item.GetAllItemsRsp.Data
+	44, // 7: item.GetItemRsp.code:type_name -> common.ErrorCode
+	36, // 8: item.GetItemRsp.data:type_name -> item.GetItemRsp.Data
+	11, // 9: item.DeleteItemByIdReq.item_delete_list:type_name -> item.ItemDeleteByIdInfo
+	44, // 10: item.DeleteItemByIdRsp.code:type_name -> common.ErrorCode
+	0,  // 11: item.RestoreItemsReq.item_info_list:type_name -> item.ItemInfo
+	44, // 12: item.RestoreItemsRsp.code:type_name -> common.ErrorCode
+	16, // 13: item.TransferItemsReq.item_transfer_list:type_name -> item.ItemTransferInfo
+	44, // 14: item.TransferItemsRsp.code:type_name -> common.ErrorCode
+	37, // 15: item.TransferItemsRsp.data:type_name -> item.TransferItemsRsp.Data
+	44, // 16: item.GetItemLedgerRsp.code:type_name -> common.ErrorCode
+	38, // 17: item.GetItemLedgerRsp.data:type_name -> item.GetItemLedgerRsp.Data
+	0,  // 18: item.MailboxItem.item_info:type_name -> item.ItemInfo
+	44, // 19: item.GetMailboxRsp.code:type_name -> common.ErrorCode
+	39, // 20: item.GetMailboxRsp.data:type_name -> item.GetMailboxRsp.Data
+	44, // 21: item.ClaimMailboxRsp.code:type_name -> common.ErrorCode
+	40, // 22: item.ClaimMailboxRsp.data:type_name -> item.ClaimMailboxRsp.Data
+	0,  // 23: item.ItemExpiredNtf.item_info_list:type_name -> item.ItemInfo
+	44, // 24: item.UseItemRsp.code:type_name -> common.ErrorCode
+	41, // 25: item.UseItemRsp.data:type_name -> item.UseItemRsp.Data
+	44, // 26: item.GetSkinsRsp.code:type_name -> common.ErrorCode
+	42, // 27: item.GetSkinsRsp.data:type_name -> item.GetSkinsRsp.Data
+	44, // 28: item.GrantRewardsRsp.code:type_name -> common.ErrorCode
+	43, // 29: item.GrantRewardsRsp.data:type_name -> item.GrantRewardsRsp.Data
+	0,  // 30: item.AddItemRsp.Data.item_info_list:type_name -> item.ItemInfo
+	1,  // 31: item.AddItemRsp.Data.mailbox_list:type_name -> item.ItemAddInfo
+	0,  // 32: item.GetAllItemsRsp.Data.item_info_list:type_name -> item.ItemInfo
+	0,  // 33: item.GetItemRsp.Data.item_info:type_name -> item.ItemInfo
+	0,  // 34: item.TransferItemsRsp.Data.item_info_list:type_name -> item.ItemInfo
+	19, // 35: item.GetItemLedgerRsp.Data.ledger_list:type_name -> item.ItemLedgerEntry
+	22, // 36: item.GetMailboxRsp.Data.mail_list:type_name -> item.MailboxItem
+	0,  // 37: item.ClaimMailboxRsp.Data.item_info_list:type_name -> item.ItemInfo
+	22, // 38: item.ClaimMailboxRsp.Data.mail_list:type_name -> item.MailboxItem
+	0,  // 39: item.UseItemRsp.Data.item_info_list:type_name -> item.ItemInfo
+	1,  // 40: item.UseItemRsp.Data.mailbox_list:type_name -> item.ItemAddInfo
+	1,  // 41: item.GrantRewardsRsp.Data.reward_list:type_name -> item.ItemAddInfo
+	0,  // 42: item.GrantRewardsRsp.Data.item_info_list:type_name -> item.ItemInfo
+	1,  // 43: item.GrantRewardsRsp.Data.mailbox_list:type_name -> item.ItemAddInfo
+	44, // [44:44] is the sub-list for method output_type
+	44, // [44:44] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
+}
+
+func init() { file_proto_item_proto_init() }
+func file_proto_item_proto_init() {
+	if File_proto_item_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_item_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemAddInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemDeleteInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddItemReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddItemRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteItemReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteItemRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		file_proto_item_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ItemDeleteInfo); i {
+		file_proto_item_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllItemsRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1239,8 +3451,8 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddItemReq); i {
+		file_proto_item_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1251,8 +3463,8 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddItemRsp); i {
+		file_proto_item_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1263,8 +3475,8 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteItemReq); i {
+		file_proto_item_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemDeleteByIdInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1275,8 +3487,8 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteItemRsp); i {
+		file_proto_item_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteItemByIdReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1287,8 +3499,8 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllItemsReq); i {
+		file_proto_item_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteItemByIdRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1299,8 +3511,8 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllItemsRsp); i {
+		file_proto_item_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreItemsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1311,8 +3523,8 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetItemReq); i {
+		file_proto_item_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreItemsRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1323,8 +3535,8 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetItemRsp); i {
+		file_proto_item_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemTransferInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1335,8 +3547,8 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ItemDeleteByIdInfo); i {
+		file_proto_item_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferItemsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1347,8 +3559,8 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteItemByIdReq); i {
+		file_proto_item_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferItemsRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1359,8 +3571,8 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteItemByIdRsp); i {
+		file_proto_item_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemLedgerEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1371,7 +3583,175 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_item_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemLedgerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemLedgerRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailboxItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMailboxReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMailboxRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimMailboxReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimMailboxRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemExpiredNtf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UseItemReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UseItemRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSkinsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSkinsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantRewardsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantRewardsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddItemRsp_Data); i {
 			case 0:
 				return &v.state
@@ -1383,7 +3763,7 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_item_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAllItemsRsp_Data); i {
 			case 0:
 				return &v.state
@@ -1395,7 +3775,7 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
-		file_proto_item_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_item_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetItemRsp_Data); i {
 			case 0:
 				return &v.state
@@ -1407,6 +3787,90 @@ func file_proto_item_proto_init() {
 				return nil
 			}
 		}
+		file_proto_item_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferItemsRsp_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemLedgerRsp_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMailboxRsp_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimMailboxRsp_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UseItemRsp_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSkinsRsp_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_item_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantRewardsRsp_Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1414,7 +3878,7 @@ func file_proto_item_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_item_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -27,7 +27,7 @@ var file_proto_item_service_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x69, 0x74, 0x65, 0x6d,
 	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x69, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xeb, 0x01, 0x0a, 0x0b, 0x49,
+	0x69, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xdc, 0x04, 0x0a, 0x0b, 0x49,
 	0x74, 0x65, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x61, 0x64,
 	0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x41, 0x64,
 	0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e,
@@ -42,36 +42,83 @@ var file_proto_item_service_proto_rawDesc = []byte{
 	0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x74,
 	0x65, 0x6d, 0x12, 0x10, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65,
 	0x6d, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x22, 0x00, 0x42, 0x28, 0x5a, 0x26, 0x67, 0x61, 0x74, 0x65,
-	0x5f, 0x77, 0x61, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x6b, 0x69, 0x74, 0x65,
-	0x78, 0x5f, 0x67, 0x65, 0x6e, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x12, 0x17, 0x2e,
+	0x69, 0x74, 0x65, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x42,
+	0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x79, 0x49, 0x64, 0x52, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0f, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x69,
+	0x74, 0x65, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x74,
+	0x65, 0x6d, 0x12, 0x10, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x73, 0x70, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f,
+	0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x12, 0x13, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x69,
+	0x74, 0x65, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x6d, 0x61, 0x69,
+	0x6c, 0x62, 0x6f, 0x78, 0x12, 0x15, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x69, 0x74,
+	0x65, 0x6d, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x52,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x09, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x6b, 0x69, 0x6e,
+	0x73, 0x12, 0x11, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6b, 0x69, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x6b, 0x69, 0x6e, 0x73, 0x52, 0x73, 0x70, 0x22, 0x00, 0x42, 0x28, 0x5a, 0x26, 0x67, 0x61, 0x74,
+	0x65, 0x5f, 0x77, 0x61, 0x79, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x6b, 0x69, 0x74,
+	0x65, 0x78, 0x5f, 0x67, 0x65, 0x6e, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_proto_item_service_proto_goTypes = []interface{}{
-	(*item.AddItemReq)(nil),     // 0: item.AddItemReq
-	(*item.DeleteItemReq)(nil),  // 1: item.DeleteItemReq
-	(*item.GetAllItemsReq)(nil), // 2: item.GetAllItemsReq
-	(*item.GetItemReq)(nil),     // 3: item.GetItemReq
-	(*item.AddItemRsp)(nil),     // 4: item.AddItemRsp
-	(*item.DeleteItemRsp)(nil),  // 5: item.DeleteItemRsp
-	(*item.GetAllItemsRsp)(nil), // 6: item.GetAllItemsRsp
-	(*item.GetItemRsp)(nil),     // 7: item.GetItemRsp
+	(*item.AddItemReq)(nil),        // 0: item.AddItemReq
+	(*item.DeleteItemReq)(nil),     // 1: item.DeleteItemReq
+	(*item.GetAllItemsReq)(nil),    // 2: item.GetAllItemsReq
+	(*item.GetItemReq)(nil),        // 3: item.GetItemReq
+	(*item.DeleteItemByIdReq)(nil), // 4: item.DeleteItemByIdReq
+	(*item.GetItemLedgerReq)(nil),  // 5: item.GetItemLedgerReq
+	(*item.UseItemReq)(nil),        // 6: item.UseItemReq
+	(*item.GetMailboxReq)(nil),     // 7: item.GetMailboxReq
+	(*item.ClaimMailboxReq)(nil),   // 8: item.ClaimMailboxReq
+	(*item.GetSkinsReq)(nil),       // 9: item.GetSkinsReq
+	(*item.AddItemRsp)(nil),        // 10: item.AddItemRsp
+	(*item.DeleteItemRsp)(nil),     // 11: item.DeleteItemRsp
+	(*item.GetAllItemsRsp)(nil),    // 12: item.GetAllItemsRsp
+	(*item.GetItemRsp)(nil),        // 13: item.GetItemRsp
+	(*item.DeleteItemByIdRsp)(nil), // 14: item.DeleteItemByIdRsp
+	(*item.GetItemLedgerRsp)(nil),  // 15: item.GetItemLedgerRsp
+	(*item.UseItemRsp)(nil),        // 16: item.UseItemRsp
+	(*item.GetMailboxRsp)(nil),     // 17: item.GetMailboxRsp
+	(*item.ClaimMailboxRsp)(nil),   // 18: item.ClaimMailboxRsp
+	(*item.GetSkinsRsp)(nil),       // 19: item.GetSkinsRsp
 }
 var file_proto_item_service_proto_depIdxs = []int32{
-	0, // 0: item_service.ItemService.add_item:input_type -> item.AddItemReq
-	1, // 1: item_service.ItemService.delete_item:input_type -> item.DeleteItemReq
-	2, // 2: item_service.ItemService.get_all_items:input_type -> item.GetAllItemsReq
-	3, // 3: item_service.ItemService.get_item:input_type -> item.GetItemReq
-	4, // 4: item_service.ItemService.add_item:output_type -> item.AddItemRsp
-	5, // 5: item_service.ItemService.delete_item:output_type -> item.DeleteItemRsp
-	6, // 6: item_service.ItemService.get_all_items:output_type -> item.GetAllItemsRsp
-	7, // 7: item_service.ItemService.get_item:output_type -> item.GetItemRsp
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: item_service.ItemService.add_item:input_type -> item.AddItemReq
+	1,  // 1: item_service.ItemService.delete_item:input_type -> item.DeleteItemReq
+	2,  // 2: item_service.ItemService.get_all_items:input_type -> item.GetAllItemsReq
+	3,  // 3: item_service.ItemService.get_item:input_type -> item.GetItemReq
+	4,  // 4: item_service.ItemService.delete_item_by_id:input_type -> item.DeleteItemByIdReq
+	5,  // 5: item_service.ItemService.get_item_ledger:input_type -> item.GetItemLedgerReq
+	6,  // 6: item_service.ItemService.use_item:input_type -> item.UseItemReq
+	7,  // 7: item_service.ItemService.get_mailbox:input_type -> item.GetMailboxReq
+	8,  // 8: item_service.ItemService.claim_mailbox:input_type -> item.ClaimMailboxReq
+	9,  // 9: item_service.ItemService.get_skins:input_type -> item.GetSkinsReq
+	10, // 10: item_service.ItemService.add_item:output_type -> item.AddItemRsp
+	11, // 11: item_service.ItemService.delete_item:output_type -> item.DeleteItemRsp
+	12, // 12: item_service.ItemService.get_all_items:output_type -> item.GetAllItemsRsp
+	13, // 13: item_service.ItemService.get_item:output_type -> item.GetItemRsp
+	14, // 14: item_service.ItemService.delete_item_by_id:output_type -> item.DeleteItemByIdRsp
+	15, // 15: item_service.ItemService.get_item_ledger:output_type -> item.GetItemLedgerRsp
+	16, // 16: item_service.ItemService.use_item:output_type -> item.UseItemRsp
+	17, // 17: item_service.ItemService.get_mailbox:output_type -> item.GetMailboxRsp
+	18, // 18: item_service.ItemService.claim_mailbox:output_type -> item.ClaimMailboxRsp
+	19, // 19: item_service.ItemService.get_skins:output_type -> item.GetSkinsRsp
+	10, // [10:20] is the sub-list for method output_type
+	0,  // [0:10] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_proto_item_service_proto_init() }
@@ -107,4 +154,10 @@ type ItemService interface {
 	DeleteItem(ctx context.Context, req *item.DeleteItemReq) (res *item.DeleteItemRsp, err error)
 	GetAllItems(ctx context.Context, req *item.GetAllItemsReq) (res *item.GetAllItemsRsp, err error)
 	GetItem(ctx context.Context, req *item.GetItemReq) (res *item.GetItemRsp, err error)
+	DeleteItemById(ctx context.Context, req *item.DeleteItemByIdReq) (res *item.DeleteItemByIdRsp, err error)
+	GetItemLedger(ctx context.Context, req *item.GetItemLedgerReq) (res *item.GetItemLedgerRsp, err error)
+	UseItem(ctx context.Context, req *item.UseItemReq) (res *item.UseItemRsp, err error)
+	GetMailbox(ctx context.Context, req *item.GetMailboxReq) (res *item.GetMailboxRsp, err error)
+	ClaimMailbox(ctx context.Context, req *item.ClaimMailboxReq) (res *item.ClaimMailboxRsp, err error)
+	GetSkins(ctx context.Context, req *item.GetSkinsReq) (res *item.GetSkinsRsp, err error)
 }
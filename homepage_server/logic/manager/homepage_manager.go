@@ -8,6 +8,8 @@ import (
 	"homepage_server/kitex_gen/common"
 	"homepage_server/kitex_gen/homepage"
 	"homepage_server/redis"
+
+	"github.com/cloudwego/kitex/pkg/klog"
 )
 
 type HomepageManager struct {
@@ -54,11 +56,41 @@ func (x *HomepageManager) GetRoleInfo(ctx context.Context, req *homepage.GetRole
 	return resp, nil
 }
 
+// UpdateRoleExp 增加角色经验，由其他服务调用（如使用经验道具），相同幂等id重复调用只增加一次
 func (x *HomepageManager) UpdateRoleExp(ctx context.Context, req *homepage.UpdateRoleExpReq) (resp *homepage.UpdateRoleExpRsp, err error) {
 	resp = &homepage.UpdateRoleExpRsp{
 		Code: common.ErrorCode_OK,
 		Msg:  "success",
 	}
 
+	if req.UserId == "" || req.AddExp <= 0 {
+		resp.Code = common.ErrorCode_FAILED
+		resp.Msg = "invalid userId or addExp"
+		return resp, nil
+	}
+
+	// 幂等键以经验key作为hash tag，与经验key位于同一个slot，集群模式下也能在一个脚本内完成
+	expKey := fmt.Sprintf("%s:%s:experience", redis_role_info_key, req.UserId)
+	idempotentKey := fmt.Sprintf("{%s}:idempotent:%s", expKey, req.IdempotentId)
+	luaScript := `
+		if ARGV[2] ~= '' and not redis.call('set', KEYS[2], 1, 'NX', 'EX', 604800) then
+			return tonumber(redis.call('get', KEYS[1]) or 0)
+		end
+		return redis.call('incrby', KEYS[1], ARGV[1])
+	`
+	expVal, err := redis.GetRedis().Eval(ctx, luaScript, []string{expKey, idempotentKey}, req.AddExp, req.IdempotentId).Int64()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[HOMEPAGE-UPDATE-ROLE-EXP-ERROR] userId: %s, addExp: %d, error: %v", req.UserId, req.AddExp, err)
+		resp.Code = common.ErrorCode_FAILED
+		resp.Msg = err.Error()
+		return resp, nil
+	}
+
+	resp.Data = &homepage.UpdateRoleExpRsp_Data{
+		RoleInfo: &homepage.RoleInfo{
+			Level:      int32(expVal/100) + 1,
+			Experience: expVal,
+		},
+	}
 	return resp, nil
 }
@@ -32,6 +32,10 @@ type HomepageService struct {
 var (
 	homepage_srv      HomepageService
 	once_homepage_srv sync.Once
+	// rpcOnlyMethods 只允许其他服务通过RPC调用、不对客户端HTTP开放的方法
+	rpcOnlyMethods = map[string]bool{
+		"update_role_exp": true,
+	}
 )
 
 func GetHomepageService() IService {
@@ -98,7 +102,7 @@ func (s *HomepageService) ginRoute(ctx *gin.Context) {
 	methodName := ctx.Param("method")
 
 	info, ok := s.ServiceInfo.Methods[methodName]
-	if !ok {
+	if !ok || rpcOnlyMethods[methodName] {
 		klog.CtxErrorf(ctx, "[HOMEPAGE-SVR-METHOD-NOT-FOUND] not found: %s", ctx.FullPath())
 		return
 	}
@@ -113,7 +117,7 @@ func (s *HomepageService) ginRoute2(ctx *gin.Context) {
 	methodName := ctx.Param("method")
 
 	info, ok := s.ServiceInfo.Methods[methodName]
-	if !ok {
+	if !ok || rpcOnlyMethods[methodName] {
 		klog.CtxErrorf(ctx, "[HOMEPAGE-SVR-METHOD-NOT-FOUND] not found: %s", ctx.FullPath())
 		return
 	}
@@ -6,8 +6,16 @@ del go.sum 2>nul
 rmdir /s /q kitex_gen 2>nul
 
 mkdir kitex_gen\auction_service\auctionservice
+mkdir kitex_gen\auction_admin_service\auctionadminservice
+mkdir kitex_gen\gateway_service\gatewayservice
+mkdir kitex_gen\item_service\itemservice
+mkdir kitex_gen\item_internal_service\iteminternalservice
 
 .\bin\kitex -module auction_module -type protobuf -no-fast-api proto/auction_service.proto
+.\bin\kitex -module auction_module -type protobuf -no-fast-api proto/auction_admin_service.proto
+.\bin\kitex -module auction_module -type protobuf -no-fast-api proto/gateway_service.proto
+.\bin\kitex -module auction_module -type protobuf -no-fast-api proto/item_service.proto
+.\bin\kitex -module auction_module -type protobuf -no-fast-api proto/item_internal_service.proto
 
 rmdir /s /q ..\auction\kitex_gen 2>nul
 move .\kitex_gen ..\auction\
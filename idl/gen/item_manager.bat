@@ -6,8 +6,16 @@ del go.sum 2>nul
 rmdir /s /q kitex_gen 2>nul
 
 mkdir kitex_gen\item_service\itemservice
+mkdir kitex_gen\item_admin_service\itemadminservice
+mkdir kitex_gen\item_internal_service\iteminternalservice
+mkdir kitex_gen\gateway_service\gatewayservice
+mkdir kitex_gen\homepage_service\homepageservice
 
 .\bin\kitex -module item_manager -type protobuf -no-fast-api proto/item_service.proto
+.\bin\kitex -module item_manager -type protobuf -no-fast-api proto/item_admin_service.proto
+.\bin\kitex -module item_manager -type protobuf -no-fast-api proto/item_internal_service.proto
+.\bin\kitex -module item_manager -type protobuf -no-fast-api proto/gateway_service.proto
+.\bin\kitex -module item_manager -type protobuf -no-fast-api proto/homepage_service.proto
 
 rmdir /s /q ..\item_manager\kitex_gen 2>nul
 move .\kitex_gen ..\item_manager\
@@ -44,6 +44,48 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"delete_item_by_id": kitex.NewMethodInfo(
+		deleteItemByIdHandler,
+		newDeleteItemByIdArgs,
+		newDeleteItemByIdResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"get_item_ledger": kitex.NewMethodInfo(
+		getItemLedgerHandler,
+		newGetItemLedgerArgs,
+		newGetItemLedgerResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"use_item": kitex.NewMethodInfo(
+		useItemHandler,
+		newUseItemArgs,
+		newUseItemResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"get_mailbox": kitex.NewMethodInfo(
+		getMailboxHandler,
+		newGetMailboxArgs,
+		newGetMailboxResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"claim_mailbox": kitex.NewMethodInfo(
+		claimMailboxHandler,
+		newClaimMailboxArgs,
+		newClaimMailboxResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"get_skins": kitex.NewMethodInfo(
+		getSkinsHandler,
+		newGetSkinsArgs,
+		newGetSkinsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 }
 
 var (
@@ -554,51 +596,777 @@ func (p *GetItemResult) GetResult() interface{} {
 	return p.Success
 }
 
-type kClient struct {
-	c client.Client
+func deleteItemByIdHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(item.DeleteItemByIdReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(item_service.ItemService).DeleteItemById(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteItemByIdArgs:
+		success, err := handler.(item_service.ItemService).DeleteItemById(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteItemByIdResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteItemByIdArgs() interface{} {
+	return &DeleteItemByIdArgs{}
 }
 
-func newServiceClient(c client.Client) *kClient {
-	return &kClient{
-		c: c,
+func newDeleteItemByIdResult() interface{} {
+	return &DeleteItemByIdResult{}
+}
+
+type DeleteItemByIdArgs struct {
+	Req *item.DeleteItemByIdReq
+}
+
+func (p *DeleteItemByIdArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
 	}
+	return proto.Marshal(p.Req)
 }
 
-func (p *kClient) AddItem(ctx context.Context, Req *item.AddItemReq) (r *item.AddItemRsp, err error) {
-	var _args AddItemArgs
-	_args.Req = Req
-	var _result AddItemResult
-	if err = p.c.Call(ctx, "add_item", &_args, &_result); err != nil {
-		return
+func (p *DeleteItemByIdArgs) Unmarshal(in []byte) error {
+	msg := new(item.DeleteItemByIdReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	p.Req = msg
+	return nil
 }
 
-func (p *kClient) DeleteItem(ctx context.Context, Req *item.DeleteItemReq) (r *item.DeleteItemRsp, err error) {
-	var _args DeleteItemArgs
-	_args.Req = Req
-	var _result DeleteItemResult
-	if err = p.c.Call(ctx, "delete_item", &_args, &_result); err != nil {
-		return
+var DeleteItemByIdArgs_Req_DEFAULT *item.DeleteItemByIdReq
+
+func (p *DeleteItemByIdArgs) GetReq() *item.DeleteItemByIdReq {
+	if !p.IsSetReq() {
+		return DeleteItemByIdArgs_Req_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return p.Req
 }
 
-func (p *kClient) GetAllItems(ctx context.Context, Req *item.GetAllItemsReq) (r *item.GetAllItemsRsp, err error) {
-	var _args GetAllItemsArgs
-	_args.Req = Req
-	var _result GetAllItemsResult
-	if err = p.c.Call(ctx, "get_all_items", &_args, &_result); err != nil {
-		return
+func (p *DeleteItemByIdArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteItemByIdArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteItemByIdResult struct {
+	Success *item.DeleteItemByIdRsp
+}
+
+var DeleteItemByIdResult_Success_DEFAULT *item.DeleteItemByIdRsp
+
+func (p *DeleteItemByIdResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
 	}
-	return _result.GetSuccess(), nil
+	return proto.Marshal(p.Success)
 }
 
-func (p *kClient) GetItem(ctx context.Context, Req *item.GetItemReq) (r *item.GetItemRsp, err error) {
-	var _args GetItemArgs
-	_args.Req = Req
-	var _result GetItemResult
-	if err = p.c.Call(ctx, "get_item", &_args, &_result); err != nil {
+func (p *DeleteItemByIdResult) Unmarshal(in []byte) error {
+	msg := new(item.DeleteItemByIdRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteItemByIdResult) GetSuccess() *item.DeleteItemByIdRsp {
+	if !p.IsSetSuccess() {
+		return DeleteItemByIdResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteItemByIdResult) SetSuccess(x interface{}) {
+	p.Success = x.(*item.DeleteItemByIdRsp)
+}
+
+func (p *DeleteItemByIdResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteItemByIdResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getItemLedgerHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(item.GetItemLedgerReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(item_service.ItemService).GetItemLedger(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetItemLedgerArgs:
+		success, err := handler.(item_service.ItemService).GetItemLedger(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetItemLedgerResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetItemLedgerArgs() interface{} {
+	return &GetItemLedgerArgs{}
+}
+
+func newGetItemLedgerResult() interface{} {
+	return &GetItemLedgerResult{}
+}
+
+type GetItemLedgerArgs struct {
+	Req *item.GetItemLedgerReq
+}
+
+func (p *GetItemLedgerArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetItemLedgerArgs) Unmarshal(in []byte) error {
+	msg := new(item.GetItemLedgerReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetItemLedgerArgs_Req_DEFAULT *item.GetItemLedgerReq
+
+func (p *GetItemLedgerArgs) GetReq() *item.GetItemLedgerReq {
+	if !p.IsSetReq() {
+		return GetItemLedgerArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetItemLedgerArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetItemLedgerArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetItemLedgerResult struct {
+	Success *item.GetItemLedgerRsp
+}
+
+var GetItemLedgerResult_Success_DEFAULT *item.GetItemLedgerRsp
+
+func (p *GetItemLedgerResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetItemLedgerResult) Unmarshal(in []byte) error {
+	msg := new(item.GetItemLedgerRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetItemLedgerResult) GetSuccess() *item.GetItemLedgerRsp {
+	if !p.IsSetSuccess() {
+		return GetItemLedgerResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetItemLedgerResult) SetSuccess(x interface{}) {
+	p.Success = x.(*item.GetItemLedgerRsp)
+}
+
+func (p *GetItemLedgerResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetItemLedgerResult) GetResult() interface{} {
+	return p.Success
+}
+
+func useItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(item.UseItemReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(item_service.ItemService).UseItem(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UseItemArgs:
+		success, err := handler.(item_service.ItemService).UseItem(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UseItemResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUseItemArgs() interface{} {
+	return &UseItemArgs{}
+}
+
+func newUseItemResult() interface{} {
+	return &UseItemResult{}
+}
+
+type UseItemArgs struct {
+	Req *item.UseItemReq
+}
+
+func (p *UseItemArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UseItemArgs) Unmarshal(in []byte) error {
+	msg := new(item.UseItemReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UseItemArgs_Req_DEFAULT *item.UseItemReq
+
+func (p *UseItemArgs) GetReq() *item.UseItemReq {
+	if !p.IsSetReq() {
+		return UseItemArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UseItemArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UseItemArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UseItemResult struct {
+	Success *item.UseItemRsp
+}
+
+var UseItemResult_Success_DEFAULT *item.UseItemRsp
+
+func (p *UseItemResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UseItemResult) Unmarshal(in []byte) error {
+	msg := new(item.UseItemRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UseItemResult) GetSuccess() *item.UseItemRsp {
+	if !p.IsSetSuccess() {
+		return UseItemResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UseItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*item.UseItemRsp)
+}
+
+func (p *UseItemResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UseItemResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getMailboxHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(item.GetMailboxReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(item_service.ItemService).GetMailbox(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetMailboxArgs:
+		success, err := handler.(item_service.ItemService).GetMailbox(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetMailboxResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetMailboxArgs() interface{} {
+	return &GetMailboxArgs{}
+}
+
+func newGetMailboxResult() interface{} {
+	return &GetMailboxResult{}
+}
+
+type GetMailboxArgs struct {
+	Req *item.GetMailboxReq
+}
+
+func (p *GetMailboxArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetMailboxArgs) Unmarshal(in []byte) error {
+	msg := new(item.GetMailboxReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetMailboxArgs_Req_DEFAULT *item.GetMailboxReq
+
+func (p *GetMailboxArgs) GetReq() *item.GetMailboxReq {
+	if !p.IsSetReq() {
+		return GetMailboxArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetMailboxArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetMailboxArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetMailboxResult struct {
+	Success *item.GetMailboxRsp
+}
+
+var GetMailboxResult_Success_DEFAULT *item.GetMailboxRsp
+
+func (p *GetMailboxResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetMailboxResult) Unmarshal(in []byte) error {
+	msg := new(item.GetMailboxRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetMailboxResult) GetSuccess() *item.GetMailboxRsp {
+	if !p.IsSetSuccess() {
+		return GetMailboxResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetMailboxResult) SetSuccess(x interface{}) {
+	p.Success = x.(*item.GetMailboxRsp)
+}
+
+func (p *GetMailboxResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetMailboxResult) GetResult() interface{} {
+	return p.Success
+}
+
+func claimMailboxHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(item.ClaimMailboxReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(item_service.ItemService).ClaimMailbox(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ClaimMailboxArgs:
+		success, err := handler.(item_service.ItemService).ClaimMailbox(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ClaimMailboxResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newClaimMailboxArgs() interface{} {
+	return &ClaimMailboxArgs{}
+}
+
+func newClaimMailboxResult() interface{} {
+	return &ClaimMailboxResult{}
+}
+
+type ClaimMailboxArgs struct {
+	Req *item.ClaimMailboxReq
+}
+
+func (p *ClaimMailboxArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ClaimMailboxArgs) Unmarshal(in []byte) error {
+	msg := new(item.ClaimMailboxReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ClaimMailboxArgs_Req_DEFAULT *item.ClaimMailboxReq
+
+func (p *ClaimMailboxArgs) GetReq() *item.ClaimMailboxReq {
+	if !p.IsSetReq() {
+		return ClaimMailboxArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ClaimMailboxArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ClaimMailboxArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ClaimMailboxResult struct {
+	Success *item.ClaimMailboxRsp
+}
+
+var ClaimMailboxResult_Success_DEFAULT *item.ClaimMailboxRsp
+
+func (p *ClaimMailboxResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ClaimMailboxResult) Unmarshal(in []byte) error {
+	msg := new(item.ClaimMailboxRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ClaimMailboxResult) GetSuccess() *item.ClaimMailboxRsp {
+	if !p.IsSetSuccess() {
+		return ClaimMailboxResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ClaimMailboxResult) SetSuccess(x interface{}) {
+	p.Success = x.(*item.ClaimMailboxRsp)
+}
+
+func (p *ClaimMailboxResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ClaimMailboxResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getSkinsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(item.GetSkinsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(item_service.ItemService).GetSkins(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetSkinsArgs:
+		success, err := handler.(item_service.ItemService).GetSkins(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetSkinsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetSkinsArgs() interface{} {
+	return &GetSkinsArgs{}
+}
+
+func newGetSkinsResult() interface{} {
+	return &GetSkinsResult{}
+}
+
+type GetSkinsArgs struct {
+	Req *item.GetSkinsReq
+}
+
+func (p *GetSkinsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetSkinsArgs) Unmarshal(in []byte) error {
+	msg := new(item.GetSkinsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetSkinsArgs_Req_DEFAULT *item.GetSkinsReq
+
+func (p *GetSkinsArgs) GetReq() *item.GetSkinsReq {
+	if !p.IsSetReq() {
+		return GetSkinsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetSkinsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetSkinsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetSkinsResult struct {
+	Success *item.GetSkinsRsp
+}
+
+var GetSkinsResult_Success_DEFAULT *item.GetSkinsRsp
+
+func (p *GetSkinsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetSkinsResult) Unmarshal(in []byte) error {
+	msg := new(item.GetSkinsRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetSkinsResult) GetSuccess() *item.GetSkinsRsp {
+	if !p.IsSetSuccess() {
+		return GetSkinsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetSkinsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*item.GetSkinsRsp)
+}
+
+func (p *GetSkinsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetSkinsResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) AddItem(ctx context.Context, Req *item.AddItemReq) (r *item.AddItemRsp, err error) {
+	var _args AddItemArgs
+	_args.Req = Req
+	var _result AddItemResult
+	if err = p.c.Call(ctx, "add_item", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteItem(ctx context.Context, Req *item.DeleteItemReq) (r *item.DeleteItemRsp, err error) {
+	var _args DeleteItemArgs
+	_args.Req = Req
+	var _result DeleteItemResult
+	if err = p.c.Call(ctx, "delete_item", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetAllItems(ctx context.Context, Req *item.GetAllItemsReq) (r *item.GetAllItemsRsp, err error) {
+	var _args GetAllItemsArgs
+	_args.Req = Req
+	var _result GetAllItemsResult
+	if err = p.c.Call(ctx, "get_all_items", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetItem(ctx context.Context, Req *item.GetItemReq) (r *item.GetItemRsp, err error) {
+	var _args GetItemArgs
+	_args.Req = Req
+	var _result GetItemResult
+	if err = p.c.Call(ctx, "get_item", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteItemById(ctx context.Context, Req *item.DeleteItemByIdReq) (r *item.DeleteItemByIdRsp, err error) {
+	var _args DeleteItemByIdArgs
+	_args.Req = Req
+	var _result DeleteItemByIdResult
+	if err = p.c.Call(ctx, "delete_item_by_id", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetItemLedger(ctx context.Context, Req *item.GetItemLedgerReq) (r *item.GetItemLedgerRsp, err error) {
+	var _args GetItemLedgerArgs
+	_args.Req = Req
+	var _result GetItemLedgerResult
+	if err = p.c.Call(ctx, "get_item_ledger", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UseItem(ctx context.Context, Req *item.UseItemReq) (r *item.UseItemRsp, err error) {
+	var _args UseItemArgs
+	_args.Req = Req
+	var _result UseItemResult
+	if err = p.c.Call(ctx, "use_item", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetMailbox(ctx context.Context, Req *item.GetMailboxReq) (r *item.GetMailboxRsp, err error) {
+	var _args GetMailboxArgs
+	_args.Req = Req
+	var _result GetMailboxResult
+	if err = p.c.Call(ctx, "get_mailbox", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ClaimMailbox(ctx context.Context, Req *item.ClaimMailboxReq) (r *item.ClaimMailboxRsp, err error) {
+	var _args ClaimMailboxArgs
+	_args.Req = Req
+	var _result ClaimMailboxResult
+	if err = p.c.Call(ctx, "claim_mailbox", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetSkins(ctx context.Context, Req *item.GetSkinsReq) (r *item.GetSkinsRsp, err error) {
+	var _args GetSkinsArgs
+	_args.Req = Req
+	var _result GetSkinsResult
+	if err = p.c.Call(ctx, "get_skins", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
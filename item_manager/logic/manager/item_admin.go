@@ -0,0 +1,63 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	common_config "item_manager/config"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/item"
+	"item_manager/kitex_gen/item_admin"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// 道具运维接口：注册为独立的ItemAdminService，网关只生成了ItemService的客户端，玩家请求无法到达
+
+// checkOperator 校验操作人，配置了item_admin.operators时只允许名单内的操作人
+func checkOperator(operatorId string) error {
+	if operatorId == "" {
+		return fmt.Errorf("operator_id is empty")
+	}
+	operators, _ := common_config.Get("item_admin.operators").([]interface{})
+	if len(operators) == 0 {
+		return nil
+	}
+	for _, op := range operators {
+		if fmt.Sprintf("%v", op) == operatorId {
+			return nil
+		}
+	}
+	return fmt.Errorf("operator %s is not allowed", operatorId)
+}
+
+// AdminGetItemLedger 客服、运维工具查询指定用户的道具流水，查询条件与GetItemLedger一致
+func (m *ItemManager) AdminGetItemLedger(ctx context.Context, req *item_admin.AdminGetItemLedgerReq) (resp *item_admin.AdminGetItemLedgerRsp, err error) {
+	if err := checkOperator(req.GetOperatorId()); err != nil {
+		return &item_admin.AdminGetItemLedgerRsp{
+			Code: common.ErrorCode_ITEM_ADMIN_DENIED,
+			Msg:  err.Error(),
+		}, nil
+	}
+	if req.GetUserId() == "" {
+		return &item_admin.AdminGetItemLedgerRsp{
+			Code: common.ErrorCode_FAILED,
+			Msg:  "user_id is empty",
+		}, nil
+	}
+	query := req.GetQuery()
+	if query == nil {
+		query = &item.GetItemLedgerReq{}
+	}
+
+	klog.CtxInfof(ctx, "[ITEM-ADMIN-LEDGER-GET] operator: %s, userId: %s", req.OperatorId, req.UserId)
+	ledger := m.queryLedger(ctx, req.UserId, query)
+	resp = &item_admin.AdminGetItemLedgerRsp{
+		Code: ledger.Code,
+		Msg:  ledger.Msg,
+	}
+	if ledger.Data != nil {
+		resp.LedgerList = ledger.Data.LedgerList
+		resp.NextCursor = ledger.Data.NextCursor
+	}
+	return resp, nil
+}
@@ -0,0 +1,191 @@
+package manager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// 背包溢出处理方式
+const (
+	OVERFLOW_REJECT = "reject" // 超出容量或堆叠上限时整单拒绝
+	OVERFLOW_MAIL   = "mail"   // 超出部分转入用户邮箱
+)
+
+// 道具类型，使用道具时按类型分发效果
+const (
+	ITEM_TYPE_EQUIPMENT  int32 = 1 // 装备
+	ITEM_TYPE_MATERIAL   int32 = 2 // 材料
+	ITEM_TYPE_CURRENCY   int32 = 3 // 货币
+	ITEM_TYPE_CONSUMABLE int32 = 4 // 消耗品（效果在战斗内生效，不能通过use_item使用）
+	ITEM_TYPE_LOOT_BOX   int32 = 5 // 宝箱：按掉落表发放道具
+	ITEM_TYPE_EXP        int32 = 6 // 经验道具：增加角色经验
+	ITEM_TYPE_SKIN       int32 = 7 // 皮肤兑换券：解锁坦克皮肤
+)
+
+// BagConfig 背包分类配置
+type BagConfig struct {
+	Category string `json:"category"` // 分类名
+	Capacity int    `json:"capacity"` // 格子数上限，0表示不限
+	Overflow string `json:"overflow"` // 溢出处理方式：reject / mail
+}
+
+// ItemDisplay 道具展示信息，仅透传给客户端
+type ItemDisplay struct {
+	Name        string `json:"name"`
+	Icon        string `json:"icon"`
+	Description string `json:"description"`
+}
+
+// ItemConfig 道具配置
+type ItemConfig struct {
+	ItemId        int             `json:"item_id"`
+	IsUnique      int             `json:"is_unique"`
+	ItemType      int32           `json:"item_type"`      // 道具类型
+	Category      string          `json:"category"`       // 所属背包分类，必须在bags中配置
+	MaxStack      int             `json:"max_stack"`      // 单个道具实例的数量上限，0表示不限
+	Tradable      bool            `json:"tradable"`       // 是否可交易
+	Bindable      bool            `json:"bindable"`       // 获得后是否绑定
+	ExpireSeconds int64           `json:"expire_seconds"` // 获得后的有效期（秒），0表示永久
+	Effect        json.RawMessage `json:"effect"`         // 使用效果参数，格式由道具类型对应的效果决定
+	Display       ItemDisplay     `json:"display"`
+}
+
+// itemCatalog 道具配置文件 etc/item.json 的结构
+type itemCatalog struct {
+	Bags       []*BagConfig  `json:"bags"`
+	Items      []*ItemConfig `json:"items"`
+	LootTables []*LootTable  `json:"loot_tables"`
+}
+
+// validate 检查配置的完整性，任何一项不合法都拒绝启动
+func (c *itemCatalog) validate() error {
+	if len(c.Items) == 0 {
+		return errors.New("no item configured")
+	}
+
+	bags := make(map[string]*BagConfig, len(c.Bags))
+	for _, bag := range c.Bags {
+		if bag.Category == "" {
+			return errors.New("bag category is empty")
+		}
+		if _, exists := bags[bag.Category]; exists {
+			return fmt.Errorf("duplicate bag category: %s", bag.Category)
+		}
+		if bag.Capacity < 0 {
+			return fmt.Errorf("bag %s: invalid capacity %d", bag.Category, bag.Capacity)
+		}
+		if bag.Overflow != OVERFLOW_REJECT && bag.Overflow != OVERFLOW_MAIL {
+			return fmt.Errorf("bag %s: invalid overflow %q", bag.Category, bag.Overflow)
+		}
+		bags[bag.Category] = bag
+	}
+
+	ids := make(map[int]*ItemConfig, len(c.Items))
+	for _, config := range c.Items {
+		if config.ItemId <= 0 {
+			return fmt.Errorf("invalid item_id: %d", config.ItemId)
+		}
+		if _, exists := ids[config.ItemId]; exists {
+			return fmt.Errorf("duplicate item_id: %d", config.ItemId)
+		}
+		ids[config.ItemId] = config
+
+		if config.IsUnique != 0 && config.IsUnique != 1 {
+			return fmt.Errorf("item %d: invalid is_unique %d", config.ItemId, config.IsUnique)
+		}
+		if config.ItemType <= 0 {
+			return fmt.Errorf("item %d: invalid item_type %d", config.ItemId, config.ItemType)
+		}
+		if _, exists := bags[config.Category]; !exists {
+			return fmt.Errorf("item %d: unknown category %q", config.ItemId, config.Category)
+		}
+		if config.MaxStack < 0 {
+			return fmt.Errorf("item %d: invalid max_stack %d", config.ItemId, config.MaxStack)
+		}
+		if config.ExpireSeconds < 0 {
+			return fmt.Errorf("item %d: invalid expire_seconds %d", config.ItemId, config.ExpireSeconds)
+		}
+	}
+
+	tables, err := validateLootTables(c.LootTables, ids)
+	if err != nil {
+		return err
+	}
+
+	// 效果参数可能引用其他道具和掉落表，在所有道具读取后校验
+	for _, config := range c.Items {
+		if effect, exists := itemEffects[config.ItemType]; exists {
+			if err := effect.Validate(config, ids, tables); err != nil {
+				return fmt.Errorf("item %d: invalid effect: %w", config.ItemId, err)
+			}
+		}
+	}
+	return nil
+}
+
+// properties 新建道具实例时写入的属性JSON
+func (c *ItemConfig) properties() string {
+	data, _ := json.Marshal(map[string]interface{}{
+		"item_id":   c.ItemId,
+		"is_unique": c.IsUnique,
+		"category":  c.Category,
+		"tradable":  c.Tradable,
+		"bindable":  c.Bindable,
+	})
+	return string(data)
+}
+
+func (m *ItemManager) loadItemConfigs() error {
+	// 从项目根目录读取配置文件
+	configPath := "etc/item.json"
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		// 如果当前目录找不到，尝试从上级目录查找
+		configPath = "../etc/item.json"
+		data, err = os.ReadFile(configPath)
+		if err != nil {
+			configPath = "../../etc/item.json"
+			data, err = os.ReadFile(configPath)
+			if err != nil {
+				return fmt.Errorf("failed to read config file: %w", err)
+			}
+		}
+	}
+
+	var catalog itemCatalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	if err := catalog.validate(); err != nil {
+		return fmt.Errorf("invalid config %s: %w", configPath, err)
+	}
+
+	bags := make(map[string]*BagConfig, len(catalog.Bags))
+	for _, bag := range catalog.Bags {
+		bags[bag.Category] = bag
+	}
+	bagsJSON, err := json.Marshal(bags)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bag config: %w", err)
+	}
+
+	lootTables := make(map[string]*LootTable, len(catalog.LootTables))
+	for _, table := range catalog.LootTables {
+		lootTables[table.TableId] = table
+	}
+
+	for _, config := range catalog.Items {
+		m.itemConfigs[config.ItemId] = config
+	}
+	m.bagConfigs = bags
+	m.bagConfigsJSON = string(bagsJSON)
+	m.lootTables = lootTables
+
+	klog.Infof("[ITEM-MANAGER-LOAD-CONFIG-SUCCESS] Loaded %d item configs, %d bags, %d loot tables", len(m.itemConfigs), len(m.bagConfigs), len(m.lootTables))
+	return nil
+}
@@ -0,0 +1,74 @@
+package manager
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestItemCatalog_Validate 测试道具配置校验
+func TestItemCatalog_Validate(t *testing.T) {
+	newCatalog := func() *itemCatalog {
+		return &itemCatalog{
+			Bags: []*BagConfig{{Category: "material", Capacity: 10, Overflow: OVERFLOW_REJECT}},
+			Items: []*ItemConfig{
+				{ItemId: 1, ItemType: 2, Category: "material", MaxStack: 99},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(c *itemCatalog)
+		wantErr string
+	}{
+		{name: "合法配置", modify: func(c *itemCatalog) {}},
+		{name: "重复道具id", modify: func(c *itemCatalog) {
+			c.Items = append(c.Items, &ItemConfig{ItemId: 1, ItemType: 2, Category: "material"})
+		}, wantErr: "duplicate item_id"},
+		{name: "未配置的分类", modify: func(c *itemCatalog) { c.Items[0].Category = "equipment" }, wantErr: "unknown category"},
+		{name: "缺少道具类型", modify: func(c *itemCatalog) { c.Items[0].ItemType = 0 }, wantErr: "invalid item_type"},
+		{name: "负数堆叠上限", modify: func(c *itemCatalog) { c.Items[0].MaxStack = -1 }, wantErr: "invalid max_stack"},
+		{name: "负数有效期", modify: func(c *itemCatalog) { c.Items[0].ExpireSeconds = -1 }, wantErr: "invalid expire_seconds"},
+		{name: "非法溢出策略", modify: func(c *itemCatalog) { c.Bags[0].Overflow = "drop" }, wantErr: "invalid overflow"},
+		{name: "宝箱引用未配置的掉落表", modify: func(c *itemCatalog) {
+			c.Items = append(c.Items, &ItemConfig{ItemId: 2, ItemType: ITEM_TYPE_LOOT_BOX, Category: "material",
+				Effect: []byte(`{"table_id":"box"}`)})
+		}, wantErr: "unknown loot table"},
+		{name: "掉落未配置的道具", modify: func(c *itemCatalog) {
+			c.LootTables = []*LootTable{{TableId: "box", Rolls: 1, Entries: []*LootEntry{{ItemId: 3, Count: 1, Weight: 1}}}}
+		}, wantErr: "unknown item"},
+		{name: "嵌套掉落表成环", modify: func(c *itemCatalog) {
+			c.LootTables = []*LootTable{
+				{TableId: "a", Rolls: 1, Entries: []*LootEntry{{TableId: "b", Count: 1, Weight: 1}}},
+				{TableId: "b", Rolls: 1, Entries: []*LootEntry{{ItemId: 1, Count: 1, Weight: 1}, {TableId: "a", Count: 1, Weight: 1}}},
+			}
+		}, wantErr: "cycle"},
+		{name: "保底缺少保底项", modify: func(c *itemCatalog) {
+			c.LootTables = []*LootTable{{TableId: "box", Rolls: 1, Pity: &LootPity{Threshold: 5},
+				Entries: []*LootEntry{{ItemId: 1, Count: 1, Weight: 1}}}}
+		}, wantErr: "pity"},
+		{name: "经验道具缺少经验", modify: func(c *itemCatalog) {
+			c.Items = append(c.Items, &ItemConfig{ItemId: 2, ItemType: ITEM_TYPE_EXP, Category: "material", Effect: []byte(`{}`)})
+		}, wantErr: "invalid exp"},
+		{name: "重复分类", modify: func(c *itemCatalog) {
+			c.Bags = append(c.Bags, &BagConfig{Category: "material", Overflow: OVERFLOW_MAIL})
+		}, wantErr: "duplicate bag category"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newCatalog()
+			tt.modify(c)
+			err := c.validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validate() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/homepage"
+	"item_manager/rpc"
+	"strconv"
+)
+
+// SKIN_KEY_SUFFIX 用户道具前缀下已解锁的皮肤集合
+const SKIN_KEY_SUFFIX = "skins"
+
+// lootBoxParams 宝箱效果参数：每开一个宝箱按掉落表抽取一次
+type lootBoxParams struct {
+	TableId string `json:"table_id"`
+}
+
+// lootBoxEffect 开宝箱，抽中的道具通过AddItem发放
+type lootBoxEffect struct{}
+
+func (e *lootBoxEffect) Validate(config *ItemConfig, items map[int]*ItemConfig, tables map[string]*LootTable) error {
+	var params lootBoxParams
+	if err := json.Unmarshal(config.Effect, &params); err != nil {
+		return err
+	}
+	if _, exists := tables[params.TableId]; !exists {
+		return fmt.Errorf("unknown loot table %q", params.TableId)
+	}
+	return nil
+}
+
+func (e *lootBoxEffect) Check(ctx context.Context, use *itemUse) error {
+	return nil
+}
+
+func (e *lootBoxEffect) Apply(ctx context.Context, use *itemUse) error {
+	var params lootBoxParams
+	if err := json.Unmarshal(use.config.Effect, &params); err != nil {
+		return err
+	}
+
+	_, resp, err := use.m.grantLoot(ctx, params.TableId, int(use.count), use.reason, use.subIdempotentId("loot"))
+	if err != nil {
+		return err
+	}
+	if resp.Code != common.ErrorCode_OK {
+		return &effectError{code: resp.Code, msg: resp.Msg}
+	}
+	use.data.ItemInfoList = append(use.data.ItemInfoList, resp.Data.ItemInfoList...)
+	use.data.MailboxList = append(use.data.MailboxList, resp.Data.MailboxList...)
+	return nil
+}
+
+// expParams 经验道具效果参数：每个道具增加的经验
+type expParams struct {
+	Exp int64 `json:"exp"`
+}
+
+// roleExpGranter 角色经验发放接口，默认调用主页服务，测试时可替换
+type roleExpGranter interface {
+	GrantExp(ctx context.Context, userId string, exp int64, idempotentId string) error
+}
+
+// homepageExpGranter 基于主页服务UpdateRoleExp RPC的经验发放
+type homepageExpGranter struct{}
+
+var expGranter roleExpGranter = &homepageExpGranter{}
+
+func (g *homepageExpGranter) GrantExp(ctx context.Context, userId string, exp int64, idempotentId string) error {
+	if rpc.HomepageClient == nil {
+		return fmt.Errorf("homepage client not initialized")
+	}
+	rsp, err := rpc.HomepageClient.UpdateRoleExp(ctx, &homepage.UpdateRoleExpReq{
+		UserId:       userId,
+		AddExp:       exp,
+		IdempotentId: idempotentId,
+	})
+	if err != nil {
+		return err
+	}
+	if rsp.GetCode() != common.ErrorCode_OK {
+		return fmt.Errorf("update role exp failed: code=%d", rsp.GetCode())
+	}
+	return nil
+}
+
+// expEffect 增加角色经验
+type expEffect struct{}
+
+func (e *expEffect) Validate(config *ItemConfig, items map[int]*ItemConfig, tables map[string]*LootTable) error {
+	var params expParams
+	if err := json.Unmarshal(config.Effect, &params); err != nil {
+		return err
+	}
+	if params.Exp <= 0 {
+		return fmt.Errorf("invalid exp %d", params.Exp)
+	}
+	return nil
+}
+
+func (e *expEffect) Check(ctx context.Context, use *itemUse) error {
+	return nil
+}
+
+func (e *expEffect) Apply(ctx context.Context, use *itemUse) error {
+	var params expParams
+	if err := json.Unmarshal(use.config.Effect, &params); err != nil {
+		return err
+	}
+	exp := params.Exp * int64(use.count)
+	if err := expGranter.GrantExp(ctx, use.userId, exp, use.subIdempotentId("exp")); err != nil {
+		return err
+	}
+	use.data.AddExp = exp
+	return nil
+}
+
+// skinParams 皮肤兑换券效果参数
+type skinParams struct {
+	SkinId int32 `json:"skin_id"`
+}
+
+// skinEffect 解锁坦克皮肤，已解锁的皮肤记录在用户的皮肤集合中
+type skinEffect struct{}
+
+func (e *skinEffect) Validate(config *ItemConfig, items map[int]*ItemConfig, tables map[string]*LootTable) error {
+	var params skinParams
+	if err := json.Unmarshal(config.Effect, &params); err != nil {
+		return err
+	}
+	if params.SkinId <= 0 {
+		return fmt.Errorf("invalid skin_id %d", params.SkinId)
+	}
+	return nil
+}
+
+func (e *skinEffect) Check(ctx context.Context, use *itemUse) error {
+	if use.count != 1 {
+		return errors.New("skin voucher can only be used one at a time")
+	}
+	return nil
+}
+
+// UnlockTarget 是否已解锁由消耗脚本检查，与消耗在同一次执行中完成
+func (e *skinEffect) UnlockTarget(use *itemUse) (string, string, error) {
+	var params skinParams
+	if err := json.Unmarshal(use.config.Effect, &params); err != nil {
+		return "", "", err
+	}
+	return SKIN_KEY_SUFFIX, strconv.Itoa(int(params.SkinId)), nil
+}
+
+func (e *skinEffect) Apply(ctx context.Context, use *itemUse) error {
+	var params skinParams
+	if err := json.Unmarshal(use.config.Effect, &params); err != nil {
+		return err
+	}
+	// 消耗脚本已写入解锁集合，这里再写一次覆盖升级前已消耗、未解锁的重试；集合写入天然幂等
+	if err := use.m.rdb.SAdd(ctx, use.m.getUserKey(use.userId)+SKIN_KEY_SUFFIX, params.SkinId).Err(); err != nil {
+		return err
+	}
+	use.data.SkinIdList = append(use.data.SkinIdList, params.SkinId)
+	return nil
+}
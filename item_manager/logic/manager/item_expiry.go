@@ -0,0 +1,301 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/gate_way"
+	"item_manager/kitex_gen/item"
+	"item_manager/redis/script"
+	"item_manager/rpc"
+	"strings"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// 限时道具：实例的expire_at记录在道具hash中，并登记到用户的过期索引（item:user:{userId}:expiry，ZSET，score为过期时间）。
+// 已过期但尚未移除的道具对查询和扣除不可见；修改背包的脚本先在脚本内移除调用方的到期道具，
+// 扫描协程定期移除其余用户的到期道具；移除时记录流水并通知用户。
+// 全局到期索引（item_svr:expiry:due，ZSET，member为userId，score为该用户最早的过期时间）记录需要扫描的用户：
+// 过期索引与用户道具在同一slot，全局索引在其他slot，由写入过期索引的脚本执行后在脚本外更新（只会提前，不会推后），
+// 扫描协程只处理已到期的用户，处理后按用户剩余最早的过期时间重新登记。
+// 非唯一的限时道具按过期时间分桶（唯一id为 道具id@过期时间），过期时间不同的道具不会叠加到同一实例
+
+const (
+	EXPIRY_KEY_SUFFIX         = "expiry"                             // 用户道具前缀下的过期索引
+	EXPIRY_SWEEPER_LOCK_KEY   = "item_svr:expiry:sweeper"            // 扫描协程的互斥锁，多实例只有一个在扫描
+	EXPIRY_DUE_KEY            = "item_svr:expiry:due"                // 全局到期索引
+	EXPIRY_DUE_BACKFILL_KEY   = "item_svr:expiry:due_backfilled"     // 已从存量过期索引回填全局到期索引的标记
+	defaultExpirySweepSeconds = 30                                   // 未配置时的扫描间隔（秒）
+	defaultExpirySweepBatch   = 100                                  // 未配置时单次脚本最多移除的实例数
+	expiryScanCount           = 500                                  // 回填时SCAN每批返回的key数
+	expiryDueBatch            = 100                                  // 每批从全局到期索引取出的用户数
+	ledgerSourceExpiry        = "item_expiry"                        // 过期移除流水的来源
+	ledgerReasonExpired       = "expired"                            // 过期移除流水的原因
+	expiryUserKeyPattern      = "item:user:{*}:" + EXPIRY_KEY_SUFFIX // 回填时扫描过期索引的匹配模式
+)
+
+// itemNotifier 用户消息推送接口，默认通过网关推送，测试时可替换
+type itemNotifier interface {
+	Notify(ctx context.Context, userId string, msg proto.Message) error
+}
+
+// gatewayNotifier 基于网关UserMsg RPC的推送实现
+type gatewayNotifier struct{}
+
+var notifier itemNotifier = &gatewayNotifier{}
+
+func (n *gatewayNotifier) Notify(ctx context.Context, userId string, msg proto.Message) error {
+	if rpc.GatewayClient == nil {
+		return fmt.Errorf("gateway client not initialized")
+	}
+	anyMsg, err := anypb.New(msg)
+	if err != nil {
+		return err
+	}
+	rsp, err := rpc.GatewayClient.UserMsg(ctx, &gate_way.UserMsgReq{
+		Id:  userId,
+		Msg: anyMsg,
+	})
+	if err != nil {
+		return err
+	}
+	if rsp.GetCode() != common.ErrorCode_OK {
+		return fmt.Errorf("push user msg failed: code=%d", rsp.GetCode())
+	}
+	return nil
+}
+
+// resolveExpireAt 计算新获得道具的过期时间：指定时必须晚于当前时间，否则按配置的有效期计算，0表示永久
+func resolveExpireAt(config *ItemConfig, expireAt int64, now int64) (int64, error) {
+	if expireAt != 0 {
+		if expireAt <= now {
+			return 0, fmt.Errorf("expire_at must be later than now")
+		}
+		return expireAt, nil
+	}
+	if config.ExpireSeconds > 0 {
+		return now + config.ExpireSeconds, nil
+	}
+	return 0, nil
+}
+
+// stackUniqueId 非唯一道具的实例id：永久道具使用itemId，限时道具使用 itemId@过期时间
+func stackUniqueId(itemId int32, expireAt int64) string {
+	if expireAt > 0 {
+		return fmt.Sprintf("%d@%d", itemId, expireAt)
+	}
+	return fmt.Sprintf("%d", itemId)
+}
+
+// expiryLedgerMeta 过期移除流水的公共字段，传给在脚本内移除到期道具的脚本
+func expiryLedgerMeta(ctx context.Context) string {
+	return encodeLedgerMeta(ledgerReasonExpired, ledgerSourceExpiry, "", traceIdOf(ctx))
+}
+
+// expiredFromResult 解析脚本返回的被移除道具（results）和邮件数（mails）
+func expiredFromResult(resultMap map[string]interface{}) ([]*item.ItemInfo, int) {
+	results, _ := jsonArray(resultMap["results"])
+	expired := make([]*item.ItemInfo, 0, len(results))
+	for _, r := range results {
+		if m, ok := r.(map[string]interface{}); ok {
+			expired = append(expired, itemInfoFromResult(m))
+		}
+	}
+	mails, _ := jsonArray(resultMap["mails"])
+	return expired, len(mails)
+}
+
+// notifyExpired 记录并通知用户被移除的到期道具
+func notifyExpired(ctx context.Context, userId string, expired []*item.ItemInfo, expiredMails int) {
+	if expiredMails > 0 {
+		klog.CtxInfof(ctx, "[ITEM-EXPIRY-MAIL-REMOVED] userId: %s, count: %d", userId, expiredMails)
+	}
+	if len(expired) == 0 {
+		return
+	}
+
+	klog.CtxInfof(ctx, "[ITEM-EXPIRY-REMOVED] userId: %s, count: %d", userId, len(expired))
+	// 推送失败不影响移除结果，客户端重新拉取背包时同样看不到过期道具
+	if err := notifier.Notify(ctx, userId, &item.ItemExpiredNtf{ItemInfoList: expired}); err != nil {
+		klog.CtxWarnf(ctx, "[ITEM-EXPIRY-NOTIFY-ERROR] userId: %s, error: %v", userId, err)
+	}
+}
+
+// notifyScriptExpired 通知修改背包的脚本在执行前顺带移除的到期道具，response为脚本返回的结果
+func notifyScriptExpired(ctx context.Context, userId string, response map[string]interface{}) {
+	if expiredMap, ok := response["expired"].(map[string]interface{}); ok {
+		expired, expiredMails := expiredFromResult(expiredMap)
+		notifyExpired(ctx, userId, expired, expiredMails)
+	}
+}
+
+// expireUserItems 分批移除一个用户所有已到期的道具并通知用户，返回移除的实例数
+func (m *ItemManager) expireUserItems(ctx context.Context, userId string) (int, error) {
+	userKey := m.getUserKey(userId)
+	batch := configInt("item_expiry.sweep_batch", defaultExpirySweepBatch)
+	meta := expiryLedgerMeta(ctx)
+
+	expired := make([]*item.ItemInfo, 0)
+	expiredMails := 0
+	for {
+		val, err := script.Run(ctx, m.rdb, script.ExpireItems, []string{userKey}, time.Now().Unix(), meta, batch).Result()
+		if err != nil {
+			return len(expired), err
+		}
+		var response map[string]interface{}
+		if err := json.Unmarshal([]byte(val.(string)), &response); err != nil {
+			return len(expired), err
+		}
+		items, mails := expiredFromResult(response)
+		expired = append(expired, items...)
+		expiredMails += mails
+		if more, _ := response["more"].(bool); !more {
+			break
+		}
+	}
+	notifyExpired(ctx, userId, expired, expiredMails)
+	return len(expired), nil
+}
+
+// nextExpiry 返回用户过期索引中最早的过期时间，索引为空时ok为false
+func (m *ItemManager) nextExpiry(ctx context.Context, userId string) (next int64, ok bool, err error) {
+	first, err := m.rdb.ZRangeWithScores(ctx, m.getUserKey(userId)+EXPIRY_KEY_SUFFIX, 0, 0).Result()
+	if err != nil || len(first) == 0 {
+		return 0, false, err
+	}
+	return int64(first[0].Score), true, nil
+}
+
+// scheduleExpiry 按用户最早的过期时间登记到全局到期索引（只会提前已登记的时间），在写入过期索引的脚本执行后调用
+func (m *ItemManager) scheduleExpiry(ctx context.Context, userId string) {
+	next, ok, err := m.nextExpiry(ctx, userId)
+	if err == nil && ok {
+		err = m.rdb.ZAddLT(ctx, EXPIRY_DUE_KEY, redis.Z{Score: float64(next), Member: userId}).Err()
+	}
+	if err != nil {
+		// 登记失败时到期道具仍对查询不可见，用户下次修改背包时在脚本内移除
+		klog.CtxWarnf(ctx, "[ITEM-EXPIRY-SCHEDULE-ERROR] userId: %s, error: %v", userId, err)
+	}
+}
+
+// rescheduleExpiry 扫描处理完用户后按剩余最早的过期时间重新登记，没有限时道具时移出全局到期索引
+func (m *ItemManager) rescheduleExpiry(ctx context.Context, userId string) error {
+	next, ok, err := m.nextExpiry(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if ok {
+		err = m.rdb.ZAdd(ctx, EXPIRY_DUE_KEY, redis.Z{Score: float64(next), Member: userId}).Err()
+	} else {
+		err = m.rdb.ZRem(ctx, EXPIRY_DUE_KEY, userId).Err()
+	}
+	if err != nil {
+		return err
+	}
+	// 读取和写入之间其他请求可能写入了更早的过期时间，其登记可能被上面的写入覆盖，重新读取一次补登
+	m.scheduleExpiry(ctx, userId)
+	return nil
+}
+
+// RunExpirySweeper 定期移除所有用户的到期道具，ctx取消后退出；多实例通过Redis锁保证同一时间只有一个实例扫描
+func (m *ItemManager) RunExpirySweeper(ctx context.Context) {
+	interval := time.Duration(configInt("item_expiry.sweep_interval", defaultExpirySweepSeconds)) * time.Second
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	token := IdClient.Generate().String()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ok, err := m.rdb.SetNX(ctx, EXPIRY_SWEEPER_LOCK_KEY, token, 2*interval).Result()
+		if err != nil || !ok {
+			continue
+		}
+		// 扫描期间持续续期，扫描超过锁的有效期时不会有第二个实例同时扫描；锁丢失时停止扫描
+		sweepCtx, stop := m.holdLock(ctx, EXPIRY_SWEEPER_LOCK_KEY, token, 2*interval)
+		if err := m.sweepExpiredItems(sweepCtx); err != nil {
+			klog.CtxErrorf(ctx, "[ITEM-EXPIRY-SWEEP-ERROR] sweep expired items error: %v", err)
+		}
+		stop()
+		m.releaseLock(ctx, EXPIRY_SWEEPER_LOCK_KEY, token)
+	}
+}
+
+// sweepExpiredItems 从全局到期索引中取出已到期的用户，移除其到期道具后重新登记
+func (m *ItemManager) sweepExpiredItems(ctx context.Context) error {
+	if err := m.backfillExpiryDue(ctx); err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	retryAt := now + int64(configInt("item_expiry.sweep_interval", defaultExpirySweepSeconds))
+	for {
+		userIds, err := m.rdb.ZRangeByScore(ctx, EXPIRY_DUE_KEY, &redis.ZRangeBy{
+			Min:   "-inf",
+			Max:   fmt.Sprint(now),
+			Count: expiryDueBatch,
+		}).Result()
+		if err != nil {
+			return err
+		}
+		if len(userIds) == 0 {
+			return nil
+		}
+		for _, userId := range userIds {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if _, err := m.expireUserItems(ctx, userId); err != nil {
+				klog.CtxErrorf(ctx, "[ITEM-EXPIRY-SWEEP-USER-ERROR] userId: %s, error: %v", userId, err)
+				// 推迟到下一轮扫描重试，避免本轮反复取到同一用户
+				m.rdb.ZAddXX(ctx, EXPIRY_DUE_KEY, redis.Z{Score: float64(retryAt), Member: userId})
+				continue
+			}
+			if err := m.rescheduleExpiry(ctx, userId); err != nil {
+				klog.CtxErrorf(ctx, "[ITEM-EXPIRY-RESCHEDULE-ERROR] userId: %s, error: %v", userId, err)
+				m.rdb.ZAddXX(ctx, EXPIRY_DUE_KEY, redis.Z{Score: float64(retryAt), Member: userId})
+			}
+		}
+	}
+}
+
+// backfillExpiryDue 首次扫描时遍历存量的用户过期索引登记到全局到期索引，完成后写入标记，之后不再遍历
+func (m *ItemManager) backfillExpiryDue(ctx context.Context) error {
+	done, err := m.rdb.Exists(ctx, EXPIRY_DUE_BACKFILL_KEY).Result()
+	if err != nil || done == 1 {
+		return err
+	}
+
+	scan := func(ctx context.Context, client redis.Cmdable) error {
+		iter := client.Scan(ctx, 0, expiryUserKeyPattern, expiryScanCount).Iterator()
+		for iter.Next(ctx) {
+			if _, rest, ok := strings.Cut(iter.Val(), "{"); ok {
+				userId, _, _ := strings.Cut(rest, "}")
+				m.scheduleExpiry(ctx, userId)
+			}
+		}
+		return iter.Err()
+	}
+	if cluster, ok := m.rdb.(*redis.ClusterClient); ok {
+		err = cluster.ForEachMaster(ctx, func(ctx context.Context, client *redis.Client) error {
+			return scan(ctx, client)
+		})
+	} else {
+		err = scan(ctx, m.rdb)
+	}
+	if err != nil {
+		return err
+	}
+	klog.CtxInfof(ctx, "[ITEM-EXPIRY-BACKFILL] expiry due index backfilled")
+	return m.rdb.Set(ctx, EXPIRY_DUE_BACKFILL_KEY, time.Now().Unix(), 0).Err()
+}
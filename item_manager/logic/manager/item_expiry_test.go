@@ -0,0 +1,235 @@
+package manager
+
+import (
+	"context"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/item"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+// recordNotifier 记录推送的消息，用于测试过期通知
+type recordNotifier struct {
+	msgs map[string][]proto.Message
+}
+
+func (n *recordNotifier) Notify(ctx context.Context, userId string, msg proto.Message) error {
+	n.msgs[userId] = append(n.msgs[userId], msg)
+	return nil
+}
+
+// TestItemManager_ExpireItems 测试限时道具的过期时间计算、分桶存放、查询隐藏、脚本内移除、过期扫描与通知
+func TestItemManager_ExpireItems(t *testing.T) {
+	const userId = "test_user_expiry"
+	ctx := context.WithValue(context.Background(), "userId", userId)
+	m := GetItemManager()
+	cleanup := func() {
+		for _, pattern := range []string{"item:user:{" + userId + "}:*", "idempotent:{" + userId + "}:*"} {
+			if keys := m.rdb.Keys(ctx, pattern).Val(); len(keys) > 0 {
+				m.rdb.Del(ctx, keys...)
+			}
+		}
+		m.rdb.ZRem(ctx, EXPIRY_DUE_KEY, userId)
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+
+	recorder := &recordNotifier{msgs: make(map[string][]proto.Message)}
+	saved := notifier
+	notifier = recorder
+	t.Cleanup(func() { notifier = saved })
+
+	now := time.Now().Unix()
+	// 道具10配置有效期1天，请求中指定的过期时间优先
+	resp, _ := m.AddItem(ctx, &item.AddItemReq{
+		ItemAddList:  []*item.ItemAddInfo{{ItemId: 10, Count: 1}, {ItemId: 4, Count: 2, ExpireAt: now + 3600}, {ItemId: 6, Count: 1}},
+		IdempotentId: "expiry_001",
+	})
+	if resp.Code != common.ErrorCode_OK {
+		t.Fatalf("AddItem failed: %v, %s", resp.Code, resp.Msg)
+	}
+	expireAt := make(map[int32]int64)
+	uniqueIds := make(map[int32]string)
+	for _, info := range resp.Data.ItemInfoList {
+		expireAt[info.ItemId] = info.ExpireAt
+		uniqueIds[info.ItemId] = info.ItemUniqueId
+	}
+	if expireAt[10] < now+86400 || expireAt[4] != now+3600 || expireAt[6] != 0 {
+		t.Fatalf("Unexpected expire_at: %v", expireAt)
+	}
+	if resp, _ := m.AddItem(ctx, &item.AddItemReq{
+		ItemAddList: []*item.ItemAddInfo{{ItemId: 4, Count: 1, ExpireAt: now - 1}}, IdempotentId: "expiry_002",
+	}); resp.Code != common.ErrorCode_ITEM_ADD_FAILED {
+		t.Errorf("Expected ITEM_ADD_FAILED for past expire_at, got %v", resp.Code)
+	}
+
+	// 过期时间不同的同种道具分桶存放，不会叠加到已有实例上
+	later, _ := m.AddItem(ctx, &item.AddItemReq{
+		ItemAddList: []*item.ItemAddInfo{{ItemId: 4, Count: 1, ExpireAt: now + 7200}}, IdempotentId: "expiry_003",
+	})
+	if later.Code != common.ErrorCode_OK || later.Data.ItemInfoList[0].ItemUniqueId == uniqueIds[4] || later.Data.ItemInfoList[0].Count != 1 {
+		t.Fatalf("Expected a separate instance, got %v", later)
+	}
+	laterId := later.Data.ItemInfoList[0].ItemUniqueId
+
+	// 模拟道具4已到期：到期后移除前查询不可见
+	userKey := m.getUserKey(userId)
+	m.rdb.HSet(ctx, userKey+uniqueIds[4], "expire_at", now-1)
+	m.rdb.ZAdd(ctx, userKey+EXPIRY_KEY_SUFFIX, redis.Z{Score: float64(now - 1), Member: uniqueIds[4]})
+	all, _ := m.GetAllItems(ctx, &item.GetAllItemsReq{})
+	if len(all.Data.ItemInfoList) != 3 {
+		t.Fatalf("Expected 3 visible items, got %v", all.Data.ItemInfoList)
+	}
+	if resp, _ := m.GetItem(ctx, &item.GetItemReq{ItemUniqueId: uniqueIds[4]}); resp.Code != common.ErrorCode_ITEM_NOT_FOUND {
+		t.Errorf("Expected ITEM_NOT_FOUND for expired item, got %v", resp.Code)
+	}
+
+	// 添加道具时在脚本内先移除到期道具并通知
+	if resp, _ := m.AddItem(ctx, &item.AddItemReq{
+		ItemAddList: []*item.ItemAddInfo{{ItemId: 6, Count: 1}}, IdempotentId: "expiry_004",
+	}); resp.Code != common.ErrorCode_OK {
+		t.Fatalf("AddItem failed: %v, %s", resp.Code, resp.Msg)
+	}
+	if m.rdb.Exists(ctx, userKey+uniqueIds[4]).Val() != 0 || m.rdb.SIsMember(ctx, userKey+"items", uniqueIds[4]).Val() {
+		t.Errorf("Expired item should be removed")
+	}
+	msgs := recorder.msgs[userId]
+	if len(msgs) != 1 {
+		t.Fatalf("Expected 1 notification, got %d", len(msgs))
+	}
+	if ntf := msgs[0].(*item.ItemExpiredNtf); len(ntf.ItemInfoList) != 1 || ntf.ItemInfoList[0].ItemId != 4 || ntf.ItemInfoList[0].Count != 2 {
+		t.Errorf("Unexpected notification: %v", ntf)
+	}
+	ledger, _ := m.GetItemLedger(ctx, &item.GetItemLedgerReq{ItemId: 4, Limit: 1})
+	if e := ledger.Data.LedgerList[0]; e.Delta != -2 || e.Balance != 0 || e.Reason != ledgerReasonExpired || e.Source != ledgerSourceExpiry {
+		t.Errorf("Unexpected expiry ledger entry: %v", e)
+	}
+
+	// 扫描协程移除其余到期道具
+	m.rdb.HSet(ctx, userKey+laterId, "expire_at", now-1)
+	m.rdb.ZAdd(ctx, userKey+EXPIRY_KEY_SUFFIX, redis.Z{Score: float64(now - 1), Member: laterId})
+	m.scheduleExpiry(ctx, userId)
+	if err := m.sweepExpiredItems(ctx); err != nil {
+		t.Fatalf("sweepExpiredItems failed: %v", err)
+	}
+	if m.rdb.Exists(ctx, userKey+laterId).Val() != 0 || len(recorder.msgs[userId]) != 2 {
+		t.Errorf("Expected swept item and 2 notifications, got %d", len(recorder.msgs[userId]))
+	}
+
+	// 没有到期道具时不再推送
+	if err := m.sweepExpiredItems(ctx); err != nil {
+		t.Fatalf("sweepExpiredItems failed: %v", err)
+	}
+	if len(recorder.msgs[userId]) != 2 {
+		t.Errorf("Expected no new notification, got %d", len(recorder.msgs[userId]))
+	}
+}
+
+// TestItemManager_ExpiryDueIndex 测试全局到期索引：获得限时道具时登记，扫描只处理到期用户并按剩余最早过期时间重新登记，存量数据回填
+func TestItemManager_ExpiryDueIndex(t *testing.T) {
+	const userId = "test_user_expiry_due"
+	const legacyUserId = "test_user_expiry_legacy"
+	ctx := context.WithValue(context.Background(), "userId", userId)
+	m := GetItemManager()
+	cleanup := func() {
+		for _, id := range []string{userId, legacyUserId} {
+			for _, pattern := range []string{"item:user:{" + id + "}:*", "idempotent:{" + id + "}:*"} {
+				if keys := m.rdb.Keys(ctx, pattern).Val(); len(keys) > 0 {
+					m.rdb.Del(ctx, keys...)
+				}
+			}
+			m.rdb.ZRem(ctx, EXPIRY_DUE_KEY, id)
+		}
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+
+	saved := notifier
+	notifier = &recordNotifier{msgs: make(map[string][]proto.Message)}
+	t.Cleanup(func() { notifier = saved })
+
+	now := time.Now().Unix()
+	resp, _ := m.AddItem(ctx, &item.AddItemReq{
+		ItemAddList:  []*item.ItemAddInfo{{ItemId: 4, Count: 1, ExpireAt: now + 3600}, {ItemId: 4, Count: 1, ExpireAt: now + 7200}},
+		IdempotentId: "due_001",
+	})
+	if resp.Code != common.ErrorCode_OK {
+		t.Fatalf("AddItem failed: %v, %s", resp.Code, resp.Msg)
+	}
+	// 登记最早的过期时间
+	if score, err := m.rdb.ZScore(ctx, EXPIRY_DUE_KEY, userId).Result(); err != nil || int64(score) != now+3600 {
+		t.Fatalf("Expected due score %d, got %v, %v", now+3600, score, err)
+	}
+
+	// 最早的实例到期：扫描后移除该实例，并按剩余实例的过期时间重新登记
+	firstId := stackUniqueId(4, now+3600)
+	userKey := m.getUserKey(userId)
+	m.rdb.HSet(ctx, userKey+firstId, "expire_at", now-1)
+	m.rdb.ZAdd(ctx, userKey+EXPIRY_KEY_SUFFIX, redis.Z{Score: float64(now - 1), Member: firstId})
+	m.scheduleExpiry(ctx, userId)
+	if err := m.sweepExpiredItems(ctx); err != nil {
+		t.Fatalf("sweepExpiredItems failed: %v", err)
+	}
+	if m.rdb.Exists(ctx, userKey+firstId).Val() != 0 {
+		t.Errorf("Expected expired instance removed")
+	}
+	if score, err := m.rdb.ZScore(ctx, EXPIRY_DUE_KEY, userId).Result(); err != nil || int64(score) != now+7200 {
+		t.Errorf("Expected due score rescheduled to %d, got %v, %v", now+7200, score, err)
+	}
+
+	// 没有限时道具后移出全局到期索引
+	m.rdb.Del(ctx, userKey+EXPIRY_KEY_SUFFIX)
+	m.rdb.ZAdd(ctx, EXPIRY_DUE_KEY, redis.Z{Score: float64(now - 1), Member: userId})
+	if err := m.sweepExpiredItems(ctx); err != nil {
+		t.Fatalf("sweepExpiredItems failed: %v", err)
+	}
+	if err := m.rdb.ZScore(ctx, EXPIRY_DUE_KEY, userId).Err(); err != redis.Nil {
+		t.Errorf("Expected user removed from due index, got %v", err)
+	}
+
+	// 存量的过期索引没有登记时，首次扫描回填后移除到期道具
+	legacyKey := m.getUserKey(legacyUserId)
+	legacyId := stackUniqueId(4, now-1)
+	m.rdb.HSet(ctx, legacyKey+legacyId, "item_id", 4, "count", 1, "expire_at", now-1)
+	m.rdb.ZAdd(ctx, legacyKey+EXPIRY_KEY_SUFFIX, redis.Z{Score: float64(now - 1), Member: legacyId})
+	m.rdb.Del(ctx, EXPIRY_DUE_BACKFILL_KEY)
+	if err := m.sweepExpiredItems(ctx); err != nil {
+		t.Fatalf("sweepExpiredItems failed: %v", err)
+	}
+	if m.rdb.Exists(ctx, legacyKey+legacyId).Val() != 0 || m.rdb.Exists(ctx, EXPIRY_DUE_BACKFILL_KEY).Val() != 1 {
+		t.Errorf("Expected legacy expiry index backfilled and swept")
+	}
+}
+
+// TestItemManager_HoldLock 测试后台任务执行期间续期锁，锁被其他实例持有后取消任务
+func TestItemManager_HoldLock(t *testing.T) {
+	ctx := context.Background()
+	m := GetItemManager()
+	const key = "item_svr:test:hold_lock"
+	ttl := 300 * time.Millisecond
+	t.Cleanup(func() { m.rdb.Del(ctx, key) })
+
+	// 初始有效期较长，续期后有效期被重置为ttl
+	m.rdb.Set(ctx, key, "token_a", time.Minute)
+	lockCtx, stop := m.holdLock(ctx, key, "token_a", ttl)
+	defer stop()
+
+	time.Sleep(ttl / 2)
+	if pttl := m.rdb.PTTL(ctx, key).Val(); lockCtx.Err() != nil || pttl <= 0 || pttl > ttl {
+		t.Fatalf("Expected lock extended with ttl %v, got pttl %v, err: %v", ttl, pttl, lockCtx.Err())
+	}
+
+	m.rdb.Set(ctx, key, "token_b", 0)
+	select {
+	case <-lockCtx.Done():
+	case <-time.After(2 * ttl):
+		t.Fatalf("Expected lock context cancelled after lock lost")
+	}
+	stop()
+	if m.rdb.Get(ctx, key).Val() != "token_b" {
+		t.Errorf("Lock held by another instance should not be touched")
+	}
+}
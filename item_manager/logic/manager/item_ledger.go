@@ -0,0 +1,397 @@
+package manager
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	common_config "item_manager/config"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/item"
+	"item_manager/redis/script"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 道具流水：每次道具变化由脚本在同一次执行中追加到用户的流水Stream（item:user:{userId}:ledger），
+// 归档协程定期把新流水写入文件，已归档且超过保留天数的流水从Redis裁剪
+
+const (
+	LEDGER_KEY_SUFFIX           = "ledger"                             // 用户道具前缀下的流水Stream
+	LEDGER_ARCHIVED_KEY_SUFFIX  = "ledger:archived"                    // 用户道具前缀下已归档的最后一条流水id
+	LEDGER_ARCHIVER_LOCK_KEY    = "item_svr:ledger:archiver"           // 归档协程的互斥锁，多实例只有一个在归档
+	defaultLedgerArchiveDir     = "ledger"                             // 未配置时的归档目录
+	defaultLedgerArchiveSeconds = 60                                   // 未配置时的归档间隔（秒）
+	defaultLedgerRetentionDays  = 30                                   // 未配置时Redis中保留的天数
+	defaultLedgerQueryLimit     = 100                                  // 查询流水默认返回条数
+	maxLedgerQueryLimit         = 500                                  // 查询流水最多返回条数
+	ledgerBatchSize             = 500                                  // 归档、查询时每批读取的流水条数
+	ledgerSourceHttp            = "http"                               // 通过HTTP网关直接调用时的来源
+	ledgerUserKeyPattern        = "item:user:{*}:" + LEDGER_KEY_SUFFIX // 扫描流水Stream的匹配模式
+)
+
+// configInt 读取整数配置，未配置或非法时返回默认值
+func configInt(key string, def int) int {
+	if v, ok := common_config.Get(key).(int); ok && v > 0 {
+		return v
+	}
+	return def
+}
+
+// ledgerArchiveDir 流水归档目录
+func ledgerArchiveDir() string {
+	if v, ok := common_config.Get("item_ledger.archive_dir").(string); ok && v != "" {
+		return v
+	}
+	return defaultLedgerArchiveDir
+}
+
+// ledgerMeta 同一次操作的所有流水共用的字段，作为脚本参数传入
+func ledgerMeta(ctx context.Context, reason string, idempotentId string) string {
+	source := ledgerSourceHttp
+	if ri := rpcinfo.GetRPCInfo(ctx); ri != nil && ri.From() != nil && ri.From().ServiceName() != "" {
+		source = ri.From().ServiceName()
+	}
+	return encodeLedgerMeta(reason, source, idempotentId, traceIdOf(ctx))
+}
+
+// traceIdOf 当前请求的trace id，未开启链路追踪时为空
+func traceIdOf(ctx context.Context) string {
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		return sc.TraceID().String()
+	}
+	return ""
+}
+
+// encodeLedgerMeta 编码流水公共字段，脚本要求各字段都存在
+func encodeLedgerMeta(reason, source, idempotentId, traceId string) string {
+	data, _ := json.Marshal(map[string]string{
+		"reason":        reason,
+		"source":        source,
+		"idempotent_id": idempotentId,
+		"trace_id":      traceId,
+	})
+	return string(data)
+}
+
+// ledgerEntry 把Stream中的一条流水转换为ItemLedgerEntry
+func ledgerEntry(userId string, msg redis.XMessage) *item.ItemLedgerEntry {
+	field := func(name string) string {
+		v, _ := msg.Values[name].(string)
+		return v
+	}
+	itemId, _ := strconv.Atoi(field("item_id"))
+	delta, _ := strconv.Atoi(field("delta"))
+	balance, _ := strconv.Atoi(field("balance"))
+	ms, _, _ := strings.Cut(msg.ID, "-")
+	millis, _ := strconv.ParseInt(ms, 10, 64)
+	return &item.ItemLedgerEntry{
+		LedgerId:     msg.ID,
+		UserId:       userId,
+		ItemId:       int32(itemId),
+		ItemUniqueId: field("item_unique_id"),
+		Delta:        int32(delta),
+		Balance:      int32(balance),
+		Reason:       field("reason"),
+		Source:       field("source"),
+		IdempotentId: field("idempotent_id"),
+		TraceId:      field("trace_id"),
+		Time:         millis / 1000,
+		Mailbox:      field("mailbox") == "1",
+	}
+}
+
+// GetItemLedger 按时间倒序查询调用方用户的道具流水，只包含Redis中保留的部分，更早的流水在归档文件中
+func (m *ItemManager) GetItemLedger(ctx context.Context, req *item.GetItemLedgerReq) (resp *item.GetItemLedgerRsp, err error) {
+	return m.queryLedger(ctx, ctx.Value("userId").(string), req), nil
+}
+
+// queryLedger 按时间倒序查询指定用户的道具流水
+func (m *ItemManager) queryLedger(ctx context.Context, userId string, req *item.GetItemLedgerReq) *item.GetItemLedgerRsp {
+	ledgerKey := m.getUserKey(userId) + LEDGER_KEY_SUFFIX
+
+	klog.CtxInfof(ctx, "[ITEM-LEDGER-GET-START] userId: %s, startTime: %d, endTime: %d, itemId: %d, itemUniqueId: %s, cursor: %s",
+		userId, req.StartTime, req.EndTime, req.ItemId, req.ItemUniqueId, req.Cursor)
+
+	if req.StartTime < 0 || req.EndTime < 0 || (req.EndTime > 0 && req.EndTime < req.StartTime) {
+		return &item.GetItemLedgerRsp{
+			Code: common.ErrorCode_FAILED,
+			Msg:  "Invalid time range",
+		}
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultLedgerQueryLimit
+	}
+	if limit > maxLedgerQueryLimit {
+		limit = maxLedgerQueryLimit
+	}
+
+	// Stream id的毫秒部分即写入时间，时间范围直接转换为id范围
+	start := "-"
+	if req.StartTime > 0 {
+		start = strconv.FormatInt(req.StartTime*1000, 10)
+	}
+	end := "+"
+	if req.EndTime > 0 {
+		end = strconv.FormatInt(req.EndTime*1000+999, 10)
+	}
+	if req.Cursor != "" {
+		end = "(" + req.Cursor
+	}
+
+	entries := make([]*item.ItemLedgerEntry, 0, limit)
+	nextCursor := ""
+	for len(entries) < limit {
+		msgs, err := m.rdb.XRevRangeN(ctx, ledgerKey, end, start, ledgerBatchSize).Result()
+		if err != nil {
+			klog.CtxErrorf(ctx, "[ITEM-LEDGER-GET-REDIS-ERROR] userId: %s, error: %v", userId, err)
+			return &item.GetItemLedgerRsp{
+				Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+				Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+			}
+		}
+		for _, msg := range msgs {
+			entry := ledgerEntry(userId, msg)
+			if (req.ItemId != 0 && entry.ItemId != req.ItemId) || (req.ItemUniqueId != "" && entry.ItemUniqueId != req.ItemUniqueId) {
+				continue
+			}
+			entries = append(entries, entry)
+			if len(entries) == limit {
+				nextCursor = msg.ID
+				break
+			}
+		}
+		if len(msgs) < ledgerBatchSize {
+			break
+		}
+		end = "(" + msgs[len(msgs)-1].ID
+	}
+
+	klog.CtxInfof(ctx, "[ITEM-LEDGER-GET-SUCCESS] userId: %s, count: %d, nextCursor: %s", userId, len(entries), nextCursor)
+
+	return &item.GetItemLedgerRsp{
+		Code: common.ErrorCode_OK,
+		Msg:  "success",
+		Data: &item.GetItemLedgerRsp_Data{
+			LedgerList: entries,
+			NextCursor: nextCursor,
+		},
+	}
+}
+
+// ledgerSink 流水归档目标
+type ledgerSink interface {
+	// Write 持久化一批流水，返回nil后这批流水才会被记为已归档
+	Write(ctx context.Context, entries []*item.ItemLedgerEntry) error
+}
+
+// fileLedgerSink 按天写入JSON Lines文件（ledger-YYYYMMDD.jsonl）。
+// 归档锁在实例间轮换，多实例部署时目录必须是所有实例挂载的同一份共享存储，否则归档会分散在各实例本地
+type fileLedgerSink struct {
+	mu  sync.Mutex
+	dir string
+}
+
+func newFileLedgerSink(dir string) *fileLedgerSink {
+	return &fileLedgerSink{dir: dir}
+}
+
+func (s *fileLedgerSink) Write(ctx context.Context, entries []*item.ItemLedgerEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return err
+	}
+	files := make(map[string]*os.File)
+	writers := make(map[string]*bufio.Writer)
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+
+	for _, entry := range entries {
+		name := filepath.Join(s.dir, "ledger-"+time.Unix(entry.Time, 0).Format("20060102")+".jsonl")
+		w, ok := writers[name]
+		if !ok {
+			f, err := os.OpenFile(name, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+			if err != nil {
+				return err
+			}
+			files[name] = f
+			w = bufio.NewWriter(f)
+			writers[name] = w
+		}
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		w.Write(data)
+		w.WriteByte('\n')
+	}
+
+	for name, w := range writers {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if err := files[name].Sync(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunLedgerArchiver 定期归档流水，ctx取消后退出；多实例通过Redis锁保证同一时间只有一个实例归档
+func (m *ItemManager) RunLedgerArchiver(ctx context.Context) {
+	interval := time.Duration(configInt("item_ledger.archive_interval", defaultLedgerArchiveSeconds)) * time.Second
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	token := IdClient.Generate().String()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ok, err := m.rdb.SetNX(ctx, LEDGER_ARCHIVER_LOCK_KEY, token, 2*interval).Result()
+		if err != nil || !ok {
+			continue
+		}
+		if err := m.archiveLedger(ctx); err != nil {
+			klog.CtxErrorf(ctx, "[ITEM-LEDGER-ARCHIVE-ERROR] archive ledger error: %v", err)
+		}
+		m.releaseLock(ctx, LEDGER_ARCHIVER_LOCK_KEY, token)
+	}
+}
+
+// holdLock 在后台任务执行期间定期续期Redis锁，返回的ctx在锁丢失（续期失败或已被其他实例持有）时取消；
+// 任务结束后调用stop停止续期
+func (m *ItemManager) holdLock(ctx context.Context, key string, token string, ttl time.Duration) (context.Context, func()) {
+	lockCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-lockCtx.Done():
+				return
+			case <-ticker.C:
+			}
+			held, err := script.Run(lockCtx, m.rdb, script.ExtendLock, []string{key}, token, ttl.Milliseconds()).Int()
+			if lockCtx.Err() != nil {
+				return
+			}
+			if err != nil || held == 0 {
+				klog.CtxWarnf(ctx, "[ITEM-LOCK-LOST] key: %s, held: %d, error: %v", key, held, err)
+				cancel()
+				return
+			}
+		}
+	}()
+	return lockCtx, func() {
+		cancel()
+		<-done
+	}
+}
+
+// releaseLock 释放后台任务的Redis锁，只删除自己持有的锁
+func (m *ItemManager) releaseLock(ctx context.Context, key string, token string) {
+	releaseScript := `
+		if redis.call('get', KEYS[1]) == ARGV[1] then
+			return redis.call('del', KEYS[1])
+		end
+		return 0
+	`
+	m.rdb.Eval(context.WithoutCancel(ctx), releaseScript, []string{key}, token)
+}
+
+// archiveLedger 归档所有用户的新流水
+func (m *ItemManager) archiveLedger(ctx context.Context) error {
+	scan := func(ctx context.Context, client redis.Cmdable) error {
+		iter := client.Scan(ctx, 0, ledgerUserKeyPattern, ledgerBatchSize).Iterator()
+		for iter.Next(ctx) {
+			if err := m.archiveStream(ctx, iter.Val()); err != nil {
+				klog.CtxErrorf(ctx, "[ITEM-LEDGER-ARCHIVE-STREAM-ERROR] key: %s, error: %v", iter.Val(), err)
+			}
+		}
+		return iter.Err()
+	}
+	// 集群模式下SCAN只扫描单个节点，需要逐个主节点扫描
+	if cluster, ok := m.rdb.(*redis.ClusterClient); ok {
+		return cluster.ForEachMaster(ctx, func(ctx context.Context, client *redis.Client) error {
+			return scan(ctx, client)
+		})
+	}
+	return scan(ctx, m.rdb)
+}
+
+// archiveStream 把一个用户上次归档之后的流水写入归档目标，再裁剪已归档且超过保留天数的流水
+func (m *ItemManager) archiveStream(ctx context.Context, ledgerKey string) error {
+	userKey := strings.TrimSuffix(ledgerKey, LEDGER_KEY_SUFFIX)
+	archivedKey := userKey + LEDGER_ARCHIVED_KEY_SUFFIX
+	userId := ""
+	if _, rest, ok := strings.Cut(userKey, "{"); ok {
+		userId, _, _ = strings.Cut(rest, "}")
+	}
+
+	archived, err := m.rdb.Get(ctx, archivedKey).Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+	for {
+		start := "-"
+		if archived != "" {
+			start = "(" + archived
+		}
+		msgs, err := m.rdb.XRangeN(ctx, ledgerKey, start, "+", ledgerBatchSize).Result()
+		if err != nil {
+			return err
+		}
+		if len(msgs) == 0 {
+			break
+		}
+
+		entries := make([]*item.ItemLedgerEntry, 0, len(msgs))
+		for _, msg := range msgs {
+			entries = append(entries, ledgerEntry(userId, msg))
+		}
+		if err := m.ledgerSink.Write(ctx, entries); err != nil {
+			return err
+		}
+		archived = msgs[len(msgs)-1].ID
+		if err := m.rdb.Set(ctx, archivedKey, archived, 0).Err(); err != nil {
+			return err
+		}
+		if len(msgs) < ledgerBatchSize {
+			break
+		}
+	}
+	if archived == "" {
+		return nil
+	}
+
+	// 只裁剪已归档的流水：保留已归档的最后一条及之后的流水，以及保留期内的流水
+	retention := time.Duration(configInt("item_ledger.retention_days", defaultLedgerRetentionDays)) * 24 * time.Hour
+	cutoff := time.Now().Add(-retention).UnixMilli()
+	minId := strconv.FormatInt(cutoff, 10)
+	archivedMs, _, _ := strings.Cut(archived, "-")
+	if ms, _ := strconv.ParseInt(archivedMs, 10, 64); ms < cutoff {
+		minId = archived
+	}
+	return m.rdb.XTrimMinID(ctx, ledgerKey, minId).Err()
+}
@@ -0,0 +1,100 @@
+package manager
+
+import (
+	"context"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/item"
+	"item_manager/kitex_gen/item_admin"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// memoryLedgerSink 记录写入的流水，用于测试归档
+type memoryLedgerSink struct {
+	entries []*item.ItemLedgerEntry
+}
+
+func (s *memoryLedgerSink) Write(ctx context.Context, entries []*item.ItemLedgerEntry) error {
+	s.entries = append(s.entries, entries...)
+	return nil
+}
+
+// TestItemManager_ArchiveLedger 测试流水归档与裁剪
+func TestItemManager_ArchiveLedger(t *testing.T) {
+	ctx := context.Background()
+	m := GetItemManager()
+	userKey := m.getUserKey("test_user_archive")
+	ledgerKey := userKey + LEDGER_KEY_SUFFIX
+	cleanup := func() { m.rdb.Del(ctx, ledgerKey, userKey+LEDGER_ARCHIVED_KEY_SUFFIX) }
+	cleanup()
+	t.Cleanup(cleanup)
+
+	sink := &memoryLedgerSink{}
+	saved := m.ledgerSink
+	m.ledgerSink = sink
+	t.Cleanup(func() { m.ledgerSink = saved })
+
+	// 一条超过保留期的流水和一条新流水
+	m.rdb.Eval(ctx, `
+		redis.call('xadd', KEYS[1], '1000-0', 'item_id', 6, 'item_unique_id', '6', 'delta', 5, 'balance', 5, 'reason', 'old')
+		redis.call('xadd', KEYS[1], '*', 'item_id', 6, 'item_unique_id', '6', 'delta', -1, 'balance', 4, 'reason', 'new')
+	`, []string{ledgerKey})
+
+	if err := m.archiveStream(ctx, ledgerKey); err != nil {
+		t.Fatalf("archiveStream failed: %v", err)
+	}
+	if len(sink.entries) != 2 || sink.entries[0].Reason != "old" || sink.entries[1].UserId != "test_user_archive" {
+		t.Fatalf("Unexpected archived entries: %v", sink.entries)
+	}
+	// 已归档且超过保留期的流水被裁剪，保留期内的流水保留
+	if n := m.rdb.XLen(ctx, ledgerKey).Val(); n != 1 {
+		t.Errorf("Expected 1 entry after trim, got %d", n)
+	}
+
+	// 再次归档不会重复写入
+	if err := m.archiveStream(ctx, ledgerKey); err != nil {
+		t.Fatalf("archiveStream failed: %v", err)
+	}
+	if len(sink.entries) != 2 {
+		t.Errorf("Expected no new archived entries, got %d", len(sink.entries))
+	}
+}
+
+// TestItemManager_AdminGetItemLedger 测试运维按用户id查询流水及操作人校验
+func TestItemManager_AdminGetItemLedger(t *testing.T) {
+	ctx := context.Background()
+	m := GetItemManager()
+	ledgerKey := m.getUserKey("test_user_admin_ledger") + LEDGER_KEY_SUFFIX
+	cleanup := func() { m.rdb.Del(ctx, ledgerKey) }
+	cleanup()
+	t.Cleanup(cleanup)
+
+	m.rdb.Eval(ctx, `
+		redis.call('xadd', KEYS[1], '*', 'item_id', 6, 'item_unique_id', '6', 'delta', 5, 'balance', 5, 'reason', 'grant')
+		redis.call('xadd', KEYS[1], '*', 'item_id', 7, 'item_unique_id', '7', 'delta', 1, 'balance', 1, 'reason', 'grant')
+	`, []string{ledgerKey})
+
+	resp, err := m.AdminGetItemLedger(ctx, &item_admin.AdminGetItemLedgerReq{
+		OperatorId: "support_1",
+		UserId:     "test_user_admin_ledger",
+		Query:      &item.GetItemLedgerReq{ItemId: 6},
+	})
+	if err != nil || resp.Code != common.ErrorCode_OK {
+		t.Fatalf("AdminGetItemLedger failed: %v, %v", err, resp)
+	}
+	if len(resp.LedgerList) != 1 || resp.LedgerList[0].UserId != "test_user_admin_ledger" || resp.LedgerList[0].ItemId != 6 {
+		t.Errorf("Unexpected ledger: %v", resp.LedgerList)
+	}
+
+	// 缺少用户id或操作人不在名单内时拒绝
+	if resp, _ := m.AdminGetItemLedger(ctx, &item_admin.AdminGetItemLedgerReq{OperatorId: "support_1"}); resp.Code != common.ErrorCode_FAILED {
+		t.Errorf("Expected FAILED without user_id, got %v", resp.Code)
+	}
+	viper.Set("item_admin.operators", []interface{}{"support_2"})
+	t.Cleanup(func() { viper.Set("item_admin.operators", []interface{}{}) })
+	resp, _ = m.AdminGetItemLedger(ctx, &item_admin.AdminGetItemLedgerReq{OperatorId: "support_1", UserId: "test_user_admin_ledger"})
+	if resp.Code != common.ErrorCode_ITEM_ADMIN_DENIED {
+		t.Errorf("Expected ITEM_ADMIN_DENIED, got %v", resp.Code)
+	}
+}
@@ -0,0 +1,356 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/item"
+	"item_manager/redis/script"
+	"math/rand/v2"
+	"strconv"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// 掉落表：按权重抽取道具或嵌套的掉落表，支持必掉项和按用户累计的保底。
+// 抽取结果与保底计数一起按幂等id保存，重试时直接使用首次抽取的结果
+
+const (
+	PITY_KEY_SUFFIX    = "pity"       // 用户道具前缀下的保底计数hash，field为掉落表id
+	maxLootSaveRetries = 3            // 保底计数被并发修改时重新抽取的次数
+	lootRollKeySuffix  = ":loot_roll" // 抽取结果幂等键的后缀，与发放道具的幂等键区分
+)
+
+// LootEntry 掉落表中的一项，item_id与table_id二选一
+type LootEntry struct {
+	ItemId  int    `json:"item_id"`  // 掉落的道具
+	TableId string `json:"table_id"` // 嵌套的掉落表，抽中后再按该表抽取
+	Count   int32  `json:"count"`    // 掉落数量，嵌套表为抽取次数
+	Weight  int    `json:"weight"`   // 权重，必掉项不需要
+	Pity    bool   `json:"pity"`     // 是否为保底项
+}
+
+// LootPity 保底配置：连续threshold-1次未抽中保底项时，下一次只在保底项中抽取
+type LootPity struct {
+	Threshold int `json:"threshold"`
+}
+
+// LootTable 掉落表配置
+type LootTable struct {
+	TableId    string       `json:"table_id"`
+	Rolls      int          `json:"rolls"`      // 每次抽取按权重抽取的次数
+	Entries    []*LootEntry `json:"entries"`    // 按权重抽取的项
+	Guaranteed []*LootEntry `json:"guaranteed"` // 每次抽取必掉的项
+	Pity       *LootPity    `json:"pity"`       // 保底配置，为空表示没有保底
+}
+
+// validateLootTables 检查掉落表的引用和权重，嵌套不能成环
+func validateLootTables(tables []*LootTable, items map[int]*ItemConfig) (map[string]*LootTable, error) {
+	byId := make(map[string]*LootTable, len(tables))
+	for _, table := range tables {
+		if table.TableId == "" {
+			return nil, errors.New("loot table id is empty")
+		}
+		if _, exists := byId[table.TableId]; exists {
+			return nil, fmt.Errorf("duplicate loot table: %s", table.TableId)
+		}
+		byId[table.TableId] = table
+	}
+
+	for _, table := range tables {
+		if table.Rolls < 0 || (table.Rolls > 0 && len(table.Entries) == 0) || (table.Rolls == 0 && len(table.Guaranteed) == 0) {
+			return nil, fmt.Errorf("loot table %s: invalid rolls %d", table.TableId, table.Rolls)
+		}
+		pityEntries := 0
+		for _, entry := range append(append([]*LootEntry{}, table.Entries...), table.Guaranteed...) {
+			if (entry.ItemId == 0) == (entry.TableId == "") {
+				return nil, fmt.Errorf("loot table %s: entry needs exactly one of item_id and table_id", table.TableId)
+			}
+			if entry.Count <= 0 {
+				return nil, fmt.Errorf("loot table %s: invalid count %d", table.TableId, entry.Count)
+			}
+			if entry.ItemId != 0 {
+				config, exists := items[entry.ItemId]
+				if !exists {
+					return nil, fmt.Errorf("loot table %s: unknown item %d", table.TableId, entry.ItemId)
+				}
+				if config.IsUnique == 1 && entry.Count != 1 {
+					return nil, fmt.Errorf("loot table %s: unique item %d count must be 1", table.TableId, entry.ItemId)
+				}
+			} else if _, exists := byId[entry.TableId]; !exists {
+				return nil, fmt.Errorf("loot table %s: unknown table %s", table.TableId, entry.TableId)
+			}
+		}
+		for _, entry := range table.Entries {
+			if entry.Weight <= 0 {
+				return nil, fmt.Errorf("loot table %s: invalid weight %d", table.TableId, entry.Weight)
+			}
+			if entry.Pity {
+				pityEntries++
+			}
+		}
+		if table.Pity != nil && (table.Pity.Threshold <= 1 || pityEntries == 0) {
+			return nil, fmt.Errorf("loot table %s: pity needs threshold > 1 and at least one pity entry", table.TableId)
+		}
+	}
+
+	// 深度优先检查嵌套成环
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(tables))
+	var visit func(tableId string) error
+	visit = func(tableId string) error {
+		switch state[tableId] {
+		case visiting:
+			return fmt.Errorf("loot table %s: nested tables form a cycle", tableId)
+		case visited:
+			return nil
+		}
+		state[tableId] = visiting
+		table := byId[tableId]
+		for _, entry := range append(append([]*LootEntry{}, table.Entries...), table.Guaranteed...) {
+			if entry.TableId != "" {
+				if err := visit(entry.TableId); err != nil {
+					return err
+				}
+			}
+		}
+		state[tableId] = visited
+		return nil
+	}
+	for _, table := range tables {
+		if err := visit(table.TableId); err != nil {
+			return nil, err
+		}
+	}
+	return byId, nil
+}
+
+// newLootRng 以用户和幂等id为种子的随机数生成器，同一请求总是得到相同的随机序列
+func newLootRng(userId string, idempotentId string) *rand.Rand {
+	h := fnv.New64a()
+	h.Write([]byte(userId + ":" + idempotentId))
+	seed := h.Sum64()
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// lootRoller 一次抽取的状态，不访问Redis，保底计数由调用方读取和保存
+type lootRoller struct {
+	tables  map[string]*LootTable
+	items   map[int]*ItemConfig
+	rng     *rand.Rand
+	pity    map[string]int // 各掉落表连续未抽中保底项的次数
+	touched map[string]int // 本次抽取读取过的保底计数的初始值
+	rewards []*item.ItemAddInfo
+	merged  map[int]*item.ItemAddInfo
+}
+
+func newLootRoller(tables map[string]*LootTable, items map[int]*ItemConfig, rng *rand.Rand, pity map[string]int) *lootRoller {
+	return &lootRoller{
+		tables:  tables,
+		items:   items,
+		rng:     rng,
+		pity:    pity,
+		touched: make(map[string]int),
+		rewards: make([]*item.ItemAddInfo, 0),
+		merged:  make(map[int]*item.ItemAddInfo),
+	}
+}
+
+// roll 按掉落表抽取times次
+func (r *lootRoller) roll(tableId string, times int) {
+	table := r.tables[tableId]
+	for i := 0; i < times; i++ {
+		for _, entry := range table.Guaranteed {
+			r.drop(entry)
+		}
+		for j := 0; j < table.Rolls; j++ {
+			r.drop(r.pick(table))
+		}
+	}
+}
+
+// pick 按权重抽取一项，达到保底次数时只在保底项中抽取
+func (r *lootRoller) pick(table *LootTable) *LootEntry {
+	candidates := table.Entries
+	if table.Pity != nil {
+		if _, exists := r.touched[table.TableId]; !exists {
+			r.touched[table.TableId] = r.pity[table.TableId]
+		}
+		if r.pity[table.TableId]+1 >= table.Pity.Threshold {
+			candidates = make([]*LootEntry, 0, len(table.Entries))
+			for _, entry := range table.Entries {
+				if entry.Pity {
+					candidates = append(candidates, entry)
+				}
+			}
+		}
+	}
+
+	total := 0
+	for _, entry := range candidates {
+		total += entry.Weight
+	}
+	n := r.rng.IntN(total)
+	picked := candidates[len(candidates)-1]
+	for _, entry := range candidates {
+		if n < entry.Weight {
+			picked = entry
+			break
+		}
+		n -= entry.Weight
+	}
+
+	if table.Pity != nil {
+		if picked.Pity {
+			r.pity[table.TableId] = 0
+		} else {
+			r.pity[table.TableId]++
+		}
+	}
+	return picked
+}
+
+// drop 发放一项：道具加入奖励列表，嵌套表继续抽取。非唯一道具合并数量，唯一道具每次单独发放
+func (r *lootRoller) drop(entry *LootEntry) {
+	if entry.TableId != "" {
+		r.roll(entry.TableId, int(entry.Count))
+		return
+	}
+	if add, exists := r.merged[entry.ItemId]; exists {
+		add.Count += entry.Count
+		return
+	}
+	add := &item.ItemAddInfo{ItemId: int32(entry.ItemId), Count: entry.Count}
+	r.rewards = append(r.rewards, add)
+	if r.items[entry.ItemId].IsUnique != 1 {
+		r.merged[entry.ItemId] = add
+	}
+}
+
+// rollLoot 抽取掉落表并保存结果和保底计数，同一幂等id重复调用返回首次抽取的结果
+func (m *ItemManager) rollLoot(ctx context.Context, userId string, tableId string, times int, idempotentId string) ([]*item.ItemAddInfo, error) {
+	userKey := m.getUserKey(userId)
+	pityKey := userKey + PITY_KEY_SUFFIX
+	rollKey := fmt.Sprintf("idempotent:{%s}:%s%s", userId, idempotentId, lootRollKeySuffix)
+
+	for attempt := 0; attempt < maxLootSaveRetries; attempt++ {
+		saved, err := m.rdb.HGetAll(ctx, pityKey).Result()
+		if err != nil {
+			return nil, err
+		}
+		pity := make(map[string]int, len(saved))
+		for id, v := range saved {
+			pity[id], _ = strconv.Atoi(v)
+		}
+
+		roller := newLootRoller(m.lootTables, m.itemConfigs, newLootRng(userId, idempotentId), pity)
+		roller.roll(tableId, times)
+
+		after := make(map[string]int, len(roller.touched))
+		for id := range roller.touched {
+			after[id] = roller.pity[id]
+		}
+		rewardsJSON, _ := json.Marshal(roller.rewards)
+		beforeJSON, _ := json.Marshal(roller.touched)
+		afterJSON, _ := json.Marshal(after)
+
+		val, err := script.Run(ctx, m.rdb, script.SaveLootRoll, []string{pityKey, rollKey},
+			string(rewardsJSON), string(beforeJSON), string(afterJSON)).Result()
+		if err != nil {
+			return nil, err
+		}
+		var response map[string]interface{}
+		if err := json.Unmarshal([]byte(val.(string)), &response); err != nil {
+			return nil, err
+		}
+		if response["success"] != true {
+			klog.CtxWarnf(ctx, "[ITEM-LOOT-PITY-CHANGED] userId: %s, tableId: %s, attempt: %d", userId, tableId, attempt)
+			continue
+		}
+
+		// 使用保存的结果，重试时与首次抽取一致
+		list, _ := jsonArray(response["rewards"])
+		rewards := make([]*item.ItemAddInfo, 0, len(list))
+		for _, r := range list {
+			if rewardMap, ok := r.(map[string]interface{}); ok {
+				itemId, _ := rewardMap["item_id"].(float64)
+				count, _ := rewardMap["count"].(float64)
+				rewards = append(rewards, &item.ItemAddInfo{ItemId: int32(itemId), Count: int32(count)})
+			}
+		}
+		return rewards, nil
+	}
+	return nil, fmt.Errorf("pity counters changed concurrently")
+}
+
+// grantLoot 抽取掉落表并通过AddItem发放，抽取结果与发放使用同一个幂等id
+func (m *ItemManager) grantLoot(ctx context.Context, tableId string, times int, reason string, idempotentId string) ([]*item.ItemAddInfo, *item.AddItemRsp, error) {
+	userId := ctx.Value("userId").(string)
+	rewards, err := m.rollLoot(ctx, userId, tableId, times, idempotentId)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := m.AddItem(ctx, &item.AddItemReq{
+		ItemAddList:     rewards,
+		OperationReason: reason,
+		IdempotentId:    idempotentId,
+	})
+	return rewards, resp, err
+}
+
+// GrantRewards 按掉落表抽取奖励并发放给调用方用户，供PvE结算、每日登录、排行榜奖励等使用
+func (m *ItemManager) GrantRewards(ctx context.Context, req *item.GrantRewardsReq) (resp *item.GrantRewardsRsp, err error) {
+	userId := ctx.Value("userId").(string)
+
+	klog.CtxInfof(ctx, "[ITEM-GRANT-REWARDS-START] userId: %s, tableId: %s, count: %d, idempotentId: %s",
+		userId, req.TableId, req.Count, req.IdempotentId)
+
+	if req.Count <= 0 || req.IdempotentId == "" {
+		klog.CtxErrorf(ctx, "[ITEM-GRANT-REWARDS-INVALID] userId: %s, tableId: %s, count: %d", userId, req.TableId, req.Count)
+		return &item.GrantRewardsRsp{
+			Code: common.ErrorCode_FAILED,
+			Msg:  "Invalid count or idempotent id",
+		}, nil
+	}
+	if _, exists := m.lootTables[req.TableId]; !exists {
+		klog.CtxWarnf(ctx, "[ITEM-GRANT-REWARDS-TABLE-NOT-FOUND] userId: %s, tableId: %s", userId, req.TableId)
+		return &item.GrantRewardsRsp{
+			Code: common.ErrorCode_ITEM_LOOT_TABLE_NOT_FOUND,
+			Msg:  fmt.Sprintf("Loot table not found: %s", req.TableId),
+		}, nil
+	}
+
+	rewards, addResp, err := m.grantLoot(ctx, req.TableId, int(req.Count), req.OperationReason, req.IdempotentId)
+	if err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-GRANT-REWARDS-REDIS-ERROR] userId: %s, tableId: %s, error: %v", userId, req.TableId, err)
+		return &item.GrantRewardsRsp{
+			Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+			Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+		}, nil
+	}
+	if addResp.Code != common.ErrorCode_OK {
+		klog.CtxWarnf(ctx, "[ITEM-GRANT-REWARDS-ADD-FAIL] userId: %s, tableId: %s, code: %v, msg: %s", userId, req.TableId, addResp.Code, addResp.Msg)
+		return &item.GrantRewardsRsp{
+			Code: addResp.Code,
+			Msg:  addResp.Msg,
+		}, nil
+	}
+
+	klog.CtxInfof(ctx, "[ITEM-GRANT-REWARDS-SUCCESS] userId: %s, tableId: %s, rewards: %v", userId, req.TableId, rewards)
+
+	return &item.GrantRewardsRsp{
+		Code: common.ErrorCode_OK,
+		Msg:  "success",
+		Data: &item.GrantRewardsRsp_Data{
+			RewardList:   rewards,
+			ItemInfoList: addResp.Data.ItemInfoList,
+			MailboxList:  addResp.Data.MailboxList,
+		},
+	}, nil
+}
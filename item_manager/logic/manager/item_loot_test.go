@@ -0,0 +1,92 @@
+package manager
+
+import (
+	"context"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/item"
+	"reflect"
+	"testing"
+)
+
+// TestLootRoller 测试掉落抽取：相同种子结果一致、必掉项、嵌套表和保底
+func TestLootRoller(t *testing.T) {
+	items := map[int]*ItemConfig{1: {ItemId: 1, IsUnique: 1}, 2: {ItemId: 2}, 6: {ItemId: 6}}
+	tables := map[string]*LootTable{
+		"box": {TableId: "box", Rolls: 1, Pity: &LootPity{Threshold: 3},
+			Guaranteed: []*LootEntry{{ItemId: 2, Count: 10}},
+			Entries:    []*LootEntry{{ItemId: 6, Count: 1, Weight: 1000000}, {TableId: "rare", Count: 1, Weight: 1, Pity: true}}},
+		"rare": {TableId: "rare", Rolls: 1, Entries: []*LootEntry{{ItemId: 1, Count: 1, Weight: 1}}},
+	}
+	roll := func(idempotentId string, pity map[string]int, times int) *lootRoller {
+		r := newLootRoller(tables, items, newLootRng("u1", idempotentId), pity)
+		r.roll("box", times)
+		return r
+	}
+
+	if a, b := roll("same", map[string]int{}, 5), roll("same", map[string]int{}, 5); !reflect.DeepEqual(a.rewards, b.rewards) {
+		t.Errorf("Same seed should roll same rewards: %v, %v", a.rewards, b.rewards)
+	}
+
+	r := roll("pity", map[string]int{}, 2)
+	if len(r.rewards) != 2 || r.rewards[0].ItemId != 2 || r.rewards[0].Count != 20 || r.rewards[1].ItemId != 6 || r.rewards[1].Count != 2 {
+		t.Fatalf("Expected merged guaranteed and common drops, got %v", r.rewards)
+	}
+	if r.pity["box"] != 2 || r.touched["box"] != 0 {
+		t.Fatalf("Unexpected pity counters: %v, touched: %v", r.pity, r.touched)
+	}
+
+	// 第三次抽取达到保底，只能抽中嵌套的稀有表，计数清零
+	r = roll("pity", map[string]int{"box": 2}, 1)
+	if len(r.rewards) != 2 || r.rewards[1].ItemId != 1 || r.pity["box"] != 0 || r.touched["box"] != 2 {
+		t.Errorf("Expected pity drop, got %v, pity: %v", r.rewards, r.pity)
+	}
+}
+
+// TestItemManager_GrantRewards 测试按掉落表发放奖励：重试不重复发放和计数，未配置的掉落表
+func TestItemManager_GrantRewards(t *testing.T) {
+	const userId = "test_user_loot"
+	ctx := context.WithValue(context.Background(), "userId", userId)
+	m := GetItemManager()
+	cleanup := func() {
+		for _, pattern := range []string{"item:user:{" + userId + "}:*", "idempotent:{" + userId + "}:*"} {
+			if keys := m.rdb.Keys(ctx, pattern).Val(); len(keys) > 0 {
+				m.rdb.Del(ctx, keys...)
+			}
+		}
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+
+	if resp, _ := m.GrantRewards(ctx, &item.GrantRewardsReq{TableId: "unknown", Count: 1, IdempotentId: "loot_unknown"}); resp.Code != common.ErrorCode_ITEM_LOOT_TABLE_NOT_FOUND {
+		t.Errorf("Expected ITEM_LOOT_TABLE_NOT_FOUND, got %v", resp.Code)
+	}
+
+	req := &item.GrantRewardsReq{TableId: "supply_box", Count: 3, OperationReason: "pve_settle", IdempotentId: "loot_001"}
+	resp, _ := m.GrantRewards(ctx, req)
+	if resp.Code != common.ErrorCode_OK || len(resp.Data.RewardList) == 0 {
+		t.Fatalf("GrantRewards failed: %v, %s", resp.Code, resp.Msg)
+	}
+	pityKey := m.getUserKey(userId) + PITY_KEY_SUFFIX
+	pity := m.rdb.HGet(ctx, pityKey, "supply_box").Val()
+	if pity == "" {
+		t.Fatalf("Pity counter should be saved")
+	}
+	items, _ := m.GetAllItems(ctx, &item.GetAllItemsReq{})
+
+	retry, _ := m.GrantRewards(ctx, req)
+	if retry.Code != common.ErrorCode_OK || !reflect.DeepEqual(retry.Data.RewardList, resp.Data.RewardList) {
+		t.Fatalf("Retry returned different rewards: %v, want %v", retry.Data, resp.Data.RewardList)
+	}
+	if m.rdb.HGet(ctx, pityKey, "supply_box").Val() != pity {
+		t.Errorf("Retry should not update pity counter")
+	}
+	if after, _ := m.GetAllItems(ctx, &item.GetAllItemsReq{}); len(after.Data.ItemInfoList) != len(items.Data.ItemInfoList) {
+		t.Errorf("Retry should not grant again: %d items, want %d", len(after.Data.ItemInfoList), len(items.Data.ItemInfoList))
+	}
+
+	// 必掉的金币每次都会发放
+	daily, _ := m.GrantRewards(ctx, &item.GrantRewardsReq{TableId: "daily_login", Count: 1, IdempotentId: "loot_daily"})
+	if daily.Code != common.ErrorCode_OK || daily.Data.RewardList[0].ItemId != 2 || daily.Data.RewardList[0].Count != 200 {
+		t.Errorf("Expected guaranteed gold, got %v", daily.Data)
+	}
+}
@@ -0,0 +1,163 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/item"
+	"item_manager/redis/script"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// 邮箱：添加道具时超出背包容量或堆叠上限、分类溢出策略为mail的部分转入用户邮箱（item:user:{userId}:mailbox，
+// hash，field为邮件id）。转入和领取都记录邮箱流水；邮件登记在过期索引中，超过领取截止时间未领取的由过期扫描移除
+
+const (
+	MAILBOX_KEY_SUFFIX          = "mailbox"       // 用户道具前缀下的邮箱
+	defaultMailboxRetentionDays = 30              // 未配置时邮件的领取期限（天）
+	ledgerReasonClaimMailbox    = "claim_mailbox" // 领取邮箱道具的流水原因
+)
+
+// mailboxRetentionSeconds 邮件的领取期限（秒），限时道具的领取截止时间不晚于道具本身的过期时间
+func mailboxRetentionSeconds() int {
+	return configInt("item_mailbox.retention_days", defaultMailboxRetentionDays) * 86400
+}
+
+// mailboxItemFromResult 把脚本返回或邮箱中保存的邮件转换为MailboxItem
+func mailboxItemFromResult(mailMap map[string]interface{}) *item.MailboxItem {
+	mailId, _ := mailMap["mail_id"].(string)
+	reason, _ := mailMap["reason"].(string)
+	sendTime, _ := mailMap["time"].(float64)
+	deadline, _ := mailMap["deadline"].(float64)
+	return &item.MailboxItem{
+		MailId:   mailId,
+		ItemInfo: itemInfoFromResult(mailMap),
+		Reason:   reason,
+		Time:     int64(sendTime),
+		Deadline: int64(deadline),
+	}
+}
+
+// GetMailbox 查询调用方邮箱中未到领取截止时间的道具，按转入顺序返回
+func (m *ItemManager) GetMailbox(ctx context.Context, req *item.GetMailboxReq) (resp *item.GetMailboxRsp, err error) {
+	userId := ctx.Value("userId").(string)
+	mailboxKey := m.getUserKey(userId) + MAILBOX_KEY_SUFFIX
+
+	mails, err := m.rdb.HGetAll(ctx, mailboxKey).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-MAILBOX-GET-REDIS-ERROR] userId: %s, error: %v", userId, err)
+		return &item.GetMailboxRsp{
+			Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+			Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+		}, nil
+	}
+
+	now := time.Now().Unix()
+	mailList := make([]*item.MailboxItem, 0, len(mails))
+	for mailId, raw := range mails {
+		var mailMap map[string]interface{}
+		if err := json.Unmarshal([]byte(raw), &mailMap); err != nil {
+			klog.CtxErrorf(ctx, "[ITEM-MAILBOX-GET-UNMARSHAL-ERROR] userId: %s, mailId: %s, error: %v", userId, mailId, err)
+			continue
+		}
+		if mail := mailboxItemFromResult(mailMap); mail.Deadline > now {
+			mailList = append(mailList, mail)
+		}
+	}
+	sort.Slice(mailList, func(i, j int) bool {
+		a, _ := strconv.ParseInt(mailList[i].MailId, 10, 64)
+		b, _ := strconv.ParseInt(mailList[j].MailId, 10, 64)
+		return a < b
+	})
+
+	klog.CtxInfof(ctx, "[ITEM-MAILBOX-GET-SUCCESS] userId: %s, mailCount: %d", userId, len(mailList))
+
+	return &item.GetMailboxRsp{
+		Code: common.ErrorCode_OK,
+		Msg:  "success",
+		Data: &item.GetMailboxRsp_Data{
+			MailList: mailList,
+		},
+	}, nil
+}
+
+// ClaimMailbox 领取调用方邮箱中的道具（指定邮件或全部），背包放不下的部分留在邮箱并在响应中返回
+func (m *ItemManager) ClaimMailbox(ctx context.Context, req *item.ClaimMailboxReq) (resp *item.ClaimMailboxRsp, err error) {
+	userId := ctx.Value("userId").(string)
+	userKey := m.getUserKey(userId)
+
+	klog.CtxInfof(ctx, "[ITEM-MAILBOX-CLAIM-START] userId: %s, mailIds: %v, idempotentId: %s", userId, req.MailIdList, req.IdempotentId)
+
+	if req.IdempotentId == "" {
+		return &item.ClaimMailboxRsp{
+			Code: common.ErrorCode_FAILED,
+			Msg:  "Idempotent id required",
+		}, nil
+	}
+	for _, mailId := range req.MailIdList {
+		if _, err := strconv.ParseUint(mailId, 10, 64); err != nil {
+			return &item.ClaimMailboxRsp{
+				Code: common.ErrorCode_FAILED,
+				Msg:  fmt.Sprintf("Invalid mail id: %s", mailId),
+			}, nil
+		}
+	}
+
+	mailIds := req.MailIdList
+	if mailIds == nil {
+		mailIds = []string{}
+	}
+	mailIdsJSON, _ := json.Marshal(mailIds)
+	idempotentKey := fmt.Sprintf("idempotent:{%s}:%s", userId, req.IdempotentId)
+	val, err := script.Run(ctx, m.rdb, script.ClaimMailbox, []string{userKey, idempotentKey},
+		string(mailIdsJSON), m.bagConfigsJSON, time.Now().Unix(), ledgerMeta(ctx, ledgerReasonClaimMailbox, req.IdempotentId), expiryLedgerMeta(ctx)).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-MAILBOX-CLAIM-REDIS-ERROR] userId: %s, error: %v", userId, err)
+		return &item.ClaimMailboxRsp{
+			Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+			Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+		}, nil
+	}
+
+	var response map[string]interface{}
+	if err := json.Unmarshal([]byte(val.(string)), &response); err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-MAILBOX-CLAIM-UNMARSHAL-ERROR] userId: %s, error: %v", userId, err)
+		return &item.ClaimMailboxRsp{
+			Code: common.ErrorCode_ITEM_JSON_ERROR,
+			Msg:  fmt.Sprintf("JSON unmarshaling failed: %v", err),
+		}, nil
+	}
+	notifyScriptExpired(ctx, userId, response)
+	m.scheduleExpiry(ctx, userId)
+
+	results, _ := jsonArray(response["results"])
+	itemInfoList := make([]*item.ItemInfo, 0, len(results))
+	for _, r := range results {
+		if resultMap, ok := r.(map[string]interface{}); ok {
+			itemInfoList = append(itemInfoList, itemInfoFromResult(resultMap))
+		}
+	}
+	mails, _ := jsonArray(response["mails"])
+	mailList := make([]*item.MailboxItem, 0, len(mails))
+	for _, r := range mails {
+		if mailMap, ok := r.(map[string]interface{}); ok {
+			mailList = append(mailList, mailboxItemFromResult(mailMap))
+		}
+	}
+
+	klog.CtxInfof(ctx, "[ITEM-MAILBOX-CLAIM-SUCCESS] userId: %s, claimedCount: %d, remainingCount: %d", userId, len(itemInfoList), len(mailList))
+
+	return &item.ClaimMailboxRsp{
+		Code: common.ErrorCode_OK,
+		Msg:  "success",
+		Data: &item.ClaimMailboxRsp_Data{
+			ItemInfoList: itemInfoList,
+			MailList:     mailList,
+		},
+	}, nil
+}
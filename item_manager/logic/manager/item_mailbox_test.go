@@ -0,0 +1,67 @@
+package manager
+
+import (
+	"context"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/item"
+	"testing"
+)
+
+// TestItemManager_ClaimMailbox 测试溢出转入邮箱后查询、领取，背包放不下的部分留在邮箱，转入和领取都有邮箱流水
+func TestItemManager_ClaimMailbox(t *testing.T) {
+	const userId = "test_user_mailbox"
+	ctx := context.WithValue(context.Background(), "userId", userId)
+	m := GetItemManager()
+	cleanup := func() {
+		for _, pattern := range []string{"item:user:{" + userId + "}:*", "idempotent:{" + userId + "}:*"} {
+			if keys := m.rdb.Keys(ctx, pattern).Val(); len(keys) > 0 {
+				m.rdb.Del(ctx, keys...)
+			}
+		}
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+
+	// 道具4为消耗品，堆叠上限99，超出部分转入邮箱
+	resp, _ := m.AddItem(ctx, &item.AddItemReq{ItemAddList: []*item.ItemAddInfo{{ItemId: 4, Count: 120}}, IdempotentId: "mail_add"})
+	if resp.Code != common.ErrorCode_OK || len(resp.Data.MailboxList) != 1 || resp.Data.MailboxList[0].Count != 21 {
+		t.Fatalf("Expected 21 items in mailbox, got %v", resp)
+	}
+	ledger, _ := m.GetItemLedger(ctx, &item.GetItemLedgerReq{ItemId: 4, Limit: 1})
+	if e := ledger.Data.LedgerList[0]; !e.Mailbox || e.Delta != 21 {
+		t.Errorf("Expected mailbox ledger entry, got %v", e)
+	}
+
+	mailbox, _ := m.GetMailbox(ctx, &item.GetMailboxReq{})
+	if mailbox.Code != common.ErrorCode_OK || len(mailbox.Data.MailList) != 1 {
+		t.Fatalf("Expected 1 mail, got %v", mailbox)
+	}
+	mail := mailbox.Data.MailList[0]
+	if mail.ItemInfo.ItemId != 4 || mail.ItemInfo.Count != 21 || mail.Deadline <= mail.Time {
+		t.Errorf("Unexpected mail: %v", mail)
+	}
+
+	// 堆叠已满，领取不到任何道具，邮件保留
+	claim, _ := m.ClaimMailbox(ctx, &item.ClaimMailboxReq{IdempotentId: "mail_claim_1"})
+	if claim.Code != common.ErrorCode_OK || len(claim.Data.ItemInfoList) != 0 || len(claim.Data.MailList) != 1 || claim.Data.MailList[0].ItemInfo.Count != 21 {
+		t.Fatalf("Expected nothing claimed, got %v", claim)
+	}
+
+	// 使用10个后领取10个，剩余11个留在邮箱
+	if del, _ := m.DeleteItem(ctx, &item.DeleteItemReq{ItemDeleteList: []*item.ItemDeleteInfo{{ItemUniqueId: "4", Count: 10}}, IdempotentId: "mail_del"}); del.Code != common.ErrorCode_OK {
+		t.Fatalf("DeleteItem failed: %v", del.Code)
+	}
+	claim, _ = m.ClaimMailbox(ctx, &item.ClaimMailboxReq{MailIdList: []string{mail.MailId}, IdempotentId: "mail_claim_2"})
+	if claim.Code != common.ErrorCode_OK || len(claim.Data.ItemInfoList) != 1 || claim.Data.ItemInfoList[0].Count != 99 ||
+		len(claim.Data.MailList) != 1 || claim.Data.MailList[0].ItemInfo.Count != 11 {
+		t.Fatalf("Expected partial claim, got %v", claim)
+	}
+	ledger, _ = m.GetItemLedger(ctx, &item.GetItemLedgerReq{ItemId: 4, Limit: 2})
+	if e := ledger.Data.LedgerList; !e[0].Mailbox || e[0].Delta != -10 || e[0].Balance != 11 || e[1].Mailbox || e[1].Delta != 10 || e[1].Balance != 99 {
+		t.Errorf("Expected bag and mailbox ledger entries, got %v", e)
+	}
+
+	if bad, _ := m.ClaimMailbox(ctx, &item.ClaimMailboxReq{MailIdList: []string{"x"}, IdempotentId: "mail_claim_3"}); bad.Code != common.ErrorCode_FAILED {
+		t.Errorf("Expected FAILED for invalid mail id, got %v", bad.Code)
+	}
+}
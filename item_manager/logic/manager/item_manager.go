@@ -7,9 +7,10 @@ import (
 	"item_manager/kitex_gen/common"
 	"item_manager/kitex_gen/item"
 	common_redis "item_manager/redis"
-	"os"
+	"item_manager/redis/script"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/bwmarrin/snowflake"
 	"github.com/cloudwego/kitex/pkg/klog"
@@ -18,16 +19,17 @@ import (
 
 const (
 	ITEM_KEY_PREFIX = "item:user:{%s}:"
+	// escrowRetention 已写回的托管记录保留时间，与幂等结果缓存时间一致
+	escrowRetention = 7 * 24 * time.Hour
 )
 
-type ItemConfig struct {
-	ItemId   int `json:"item_id"`
-	IsUnique int `json:"is_unique"`
-}
-
 type ItemManager struct {
-	rdb         redis.UniversalClient
-	itemConfigs map[int]*ItemConfig
+	rdb            redis.UniversalClient
+	itemConfigs    map[int]*ItemConfig
+	bagConfigs     map[string]*BagConfig
+	bagConfigsJSON string // 传给添加道具脚本的背包分类配置
+	lootTables     map[string]*LootTable
+	ledgerSink     ledgerSink
 }
 
 var (
@@ -56,49 +58,54 @@ func GetItemManager() *ItemManager {
 		itemManager = &ItemManager{
 			rdb:         common_redis.GetRedis(),
 			itemConfigs: make(map[int]*ItemConfig),
+			ledgerSink:  newFileLedgerSink(ledgerArchiveDir()),
 		}
 
-		// 读取道具配置文件
+		// 读取道具配置文件，配置不合法时拒绝启动
 		if err := itemManager.loadItemConfigs(); err != nil {
-			klog.Errorf("[ITEM-MANAGER-LOAD-CONFIG-ERROR] Failed to load item config: %v", err)
+			klog.Fatalf("[ITEM-MANAGER-LOAD-CONFIG-ERROR] Failed to load item config: %v", err)
 		}
 	})
 	return itemManager
 }
 
-func (m *ItemManager) loadItemConfigs() error {
-	// 从项目根目录读取配置文件
-	configPath := "etc/item.json"
+func (m *ItemManager) getUserKey(userId string) string {
+	return fmt.Sprintf(ITEM_KEY_PREFIX, userId)
+}
 
-	data, err := os.ReadFile(configPath)
-	if err != nil {
-		// 如果当前目录找不到，尝试从上级目录查找
-		configPath = "../etc/item.json"
-		data, err = os.ReadFile(configPath)
-		if err != nil {
-			configPath = "../../etc/item.json"
-			data, err = os.ReadFile(configPath)
-			if err != nil {
-				return fmt.Errorf("failed to read config file: %w", err)
-			}
+// itemInfoFromResult 把脚本返回的道具记录转换为ItemInfo，数值字段可能是字符串或数字
+func itemInfoFromResult(resultMap map[string]interface{}) *item.ItemInfo {
+	number := func(v interface{}) float64 {
+		switch v := v.(type) {
+		case string:
+			n, _ := strconv.ParseFloat(v, 64)
+			return n
+		case float64:
+			return v
 		}
+		return 0
+	}
+	uniqueId, _ := resultMap["item_unique_id"].(string)
+	properties, _ := resultMap["properties"].(string)
+	return &item.ItemInfo{
+		ItemId:       int32(number(resultMap["item_id"])),
+		ItemUniqueId: uniqueId,
+		ItemType:     int32(number(resultMap["item_type"])),
+		Properties:   properties,
+		Count:        int32(number(resultMap["count"])),
+		ExpireAt:     int64(number(resultMap["expire_at"])),
 	}
-
-	var configs []*ItemConfig
-	if err := json.Unmarshal(data, &configs); err != nil {
-		return fmt.Errorf("failed to unmarshal config: %w", err)
-	}
-
-	for _, config := range configs {
-		m.itemConfigs[config.ItemId] = config
-	}
-
-	klog.Infof("[ITEM-MANAGER-LOAD-CONFIG-SUCCESS] Loaded %d item configs", len(m.itemConfigs))
-	return nil
 }
 
-func (m *ItemManager) getUserKey(userId string) string {
-	return fmt.Sprintf(ITEM_KEY_PREFIX, userId)
+// jsonArray 解析脚本返回的列表，cjson把空表编码为{}，按空列表处理
+func jsonArray(v interface{}) ([]interface{}, bool) {
+	switch list := v.(type) {
+	case []interface{}:
+		return list, true
+	case map[string]interface{}:
+		return nil, len(list) == 0
+	}
+	return nil, false
 }
 
 func (m *ItemManager) AddItem(ctx context.Context, req *item.AddItemReq) (resp *item.AddItemRsp, err error) {
@@ -107,56 +114,7 @@ func (m *ItemManager) AddItem(ctx context.Context, req *item.AddItemReq) (resp *
 
 	klog.CtxInfof(ctx, "[ITEM-ADD-START] userId: %s, itemCount: %d, idempotentId: %s", userId, len(req.ItemAddList), req.IdempotentId)
 
-	luaScript := `
-		local user_key = KEYS[1]
-		local idempotent_key = KEYS[2]
-		local item_data = cjson.decode(ARGV[1])
-		
-		local cached_result = redis.call('get', idempotent_key)
-		if cached_result then
-			return cached_result
-		end
-		
-		local results = {}
-		local user_items_set_key = user_key .. 'items'
-		
-		for i, item in ipairs(item_data) do
-			local item_unique_id = item.item_unique_id
-			local item_key = user_key .. item_unique_id
-			
-			local exists = redis.call('exists', item_key)
-			if exists == 1 then
-				-- 道具已存在，增加数量
-				local current_count = tonumber(redis.call('hget', item_key, 'count'))
-				local new_count = current_count + item.count
-				redis.call('hset', item_key, 'count', new_count)
-				-- 构建返回数据
-				local result = {
-					item_id = tonumber(redis.call('hget', item_key, 'item_id')),
-					item_unique_id = redis.call('hget', item_key, 'item_unique_id'),
-					item_type = tonumber(redis.call('hget', item_key, 'item_type')),
-					properties = redis.call('hget', item_key, 'properties'),
-					count = new_count
-				}
-				table.insert(results, result)
-			else
-				-- 道具不存在，创建新道具
-				redis.call('hset', item_key, 'item_id', item.item_id)
-				redis.call('hset', item_key, 'item_unique_id', item.item_unique_id)
-				redis.call('hset', item_key, 'item_type', item.item_type)
-				redis.call('hset', item_key, 'properties', item.properties)
-				redis.call('hset', item_key, 'count', item.count)
-				redis.call('sadd', user_items_set_key, item_unique_id)
-				table.insert(results, item)
-			end
-		end
-		
-		-- 确保results是数组格式，即使是空表
-		local result_json = cjson.encode({success = true, results = results})
-		redis.call('set', idempotent_key, result_json, 'EX', 604800)
-		return result_json
-	`
-
+	now := time.Now().Unix()
 	items := make([]map[string]interface{}, 0, len(req.ItemAddList))
 	for _, itemAdd := range req.ItemAddList {
 		// 获取道具配置
@@ -171,6 +129,24 @@ func (m *ItemManager) AddItem(ctx context.Context, req *item.AddItemReq) (resp *
 			}, nil
 		}
 
+		if itemAdd.Count <= 0 {
+			klog.CtxErrorf(ctx, "[ITEM-ADD-INVALID-COUNT] userId: %s, itemId: %d, count: %d", userId, itemId, itemAdd.Count)
+			return &item.AddItemRsp{
+				Code: common.ErrorCode_ITEM_ADD_FAILED,
+				Msg:  fmt.Sprintf("Invalid count for itemId: %d", itemId),
+			}, nil
+		}
+
+		// 限时道具的过期时间，请求中指定时覆盖配置的有效期
+		expireAt, err := resolveExpireAt(config, itemAdd.ExpireAt, now)
+		if err != nil {
+			klog.CtxErrorf(ctx, "[ITEM-ADD-INVALID-EXPIRE] userId: %s, itemId: %d, expireAt: %d", userId, itemId, itemAdd.ExpireAt)
+			return &item.AddItemRsp{
+				Code: common.ErrorCode_ITEM_ADD_FAILED,
+				Msg:  fmt.Sprintf("Invalid expire_at for itemId: %d", itemId),
+			}, nil
+		}
+
 		// 根据IsUnique属性生成uniqueid
 		var uniqueId string
 		if config.IsUnique == 1 {
@@ -178,21 +154,21 @@ func (m *ItemManager) AddItem(ctx context.Context, req *item.AddItemReq) (resp *
 			uniqueId = IdClient.Generate().String()
 			klog.CtxInfof(ctx, "[ITEM-ADD-GENERATE-UNIQUEID] userId: %s, itemId: %d, generated uniqueId: %s", userId, itemId, uniqueId)
 		} else {
-			// 非唯一道具，使用itemId作为uniqueId
-			uniqueId = fmt.Sprintf("%d", itemAdd.ItemId)
+			// 非唯一道具，使用itemId作为uniqueId，限时道具按过期时间分桶
+			uniqueId = stackUniqueId(itemAdd.ItemId, expireAt)
 			klog.CtxInfof(ctx, "[ITEM-ADD-NON-UNIQUE] userId: %s, itemId: %d, IsUnique: %d", userId, itemId, config.IsUnique)
 		}
 
-		// 从配置中获取道具类型和属性
-		itemType := int32(itemId) // 使用itemId作为类型
-		properties := fmt.Sprintf(`{"item_id": %d, "is_unique": %d}`, itemId, config.IsUnique)
-
+		// 道具类型、属性、分类与堆叠上限均来自配置，由脚本校验容量
 		itemMap := map[string]interface{}{
 			"item_id":        itemAdd.ItemId,
 			"item_unique_id": uniqueId,
-			"item_type":      itemType,
-			"properties":     properties,
+			"item_type":      config.ItemType,
+			"properties":     config.properties(),
+			"category":       config.Category,
+			"max_stack":      config.MaxStack,
 			"count":          itemAdd.Count,
+			"expire_at":      expireAt,
 		}
 		items = append(items, itemMap)
 	}
@@ -208,9 +184,9 @@ func (m *ItemManager) AddItem(ctx context.Context, req *item.AddItemReq) (resp *
 
 	idempotentKey := fmt.Sprintf("idempotent:{%s}:%s", userId, req.IdempotentId)
 	keys := []string{userKey, idempotentKey}
-	args := []interface{}{string(itemsJSON)}
+	args := []interface{}{string(itemsJSON), m.bagConfigsJSON, now, ledgerMeta(ctx, req.OperationReason, req.IdempotentId), mailboxRetentionSeconds(), expiryLedgerMeta(ctx)}
 
-	val, err := m.rdb.Eval(ctx, luaScript, keys, args...).Result()
+	val, err := script.Run(ctx, m.rdb, script.AddItem, keys, args...).Result()
 	if err != nil {
 		klog.CtxErrorf(ctx, "[ITEM-ADD-REDIS-ERROR] userId: %s, error: %v", userId, err)
 		return &item.AddItemRsp{
@@ -227,16 +203,29 @@ func (m *ItemManager) AddItem(ctx context.Context, req *item.AddItemReq) (resp *
 			Msg:  fmt.Sprintf("JSON unmarshaling failed: %v", err),
 		}, nil
 	}
+	notifyScriptExpired(ctx, userId, response)
+	m.scheduleExpiry(ctx, userId)
 
 	if !response["success"].(bool) {
 		errorMsg := response["error"].(string)
 		klog.CtxWarnf(ctx, "[ITEM-ADD-FAIL] userId: %s, error: %s", userId, errorMsg)
 
-		if errorMsg == "duplicate idempotent request" {
+		switch errorMsg {
+		case "duplicate idempotent request":
 			return &item.AddItemRsp{
 				Code: common.ErrorCode_ITEM_IDEMPOTENT_DUPLICATE,
 				Msg:  "Duplicate idempotent request",
 			}, nil
+		case "bag full":
+			return &item.AddItemRsp{
+				Code: common.ErrorCode_ITEM_BAG_FULL,
+				Msg:  "Bag full",
+			}, nil
+		case "stack limit exceeded":
+			return &item.AddItemRsp{
+				Code: common.ErrorCode_ITEM_STACK_LIMIT,
+				Msg:  "Stack limit exceeded",
+			}, nil
 		}
 
 		return &item.AddItemRsp{
@@ -246,7 +235,7 @@ func (m *ItemManager) AddItem(ctx context.Context, req *item.AddItemReq) (resp *
 	}
 
 	resultsValue := response["results"]
-	results, ok := resultsValue.([]interface{})
+	results, ok := jsonArray(resultsValue)
 	if !ok {
 		klog.CtxErrorf(ctx, "[ITEM-ADD-RESULTS-TYPE-ERROR] userId: %s, results is not array: %T", userId, resultsValue)
 		return &item.AddItemRsp{
@@ -263,49 +252,36 @@ func (m *ItemManager) AddItem(ctx context.Context, req *item.AddItemReq) (resp *
 			continue
 		}
 
-		var itemId, itemType, count float64
-
-		// 处理item_id字段
-		switch v := resultMap["item_id"].(type) {
-		case string:
-			itemId, _ = strconv.ParseFloat(v, 64)
-		case float64:
-			itemId = v
-		}
-
-		// 处理item_type字段
-		switch v := resultMap["item_type"].(type) {
-		case string:
-			itemType, _ = strconv.ParseFloat(v, 64)
-		case float64:
-			itemType = v
-		}
-
-		// 处理count字段
-		switch v := resultMap["count"].(type) {
-		case string:
-			count, _ = strconv.ParseFloat(v, 64)
-		case float64:
-			count = v
-		}
+		resultItemInfos = append(resultItemInfos, itemInfoFromResult(resultMap))
+	}
 
-		itemInfo := &item.ItemInfo{
-			ItemId:       int32(itemId),
-			ItemUniqueId: resultMap["item_unique_id"].(string),
-			ItemType:     int32(itemType),
-			Properties:   resultMap["properties"].(string),
-			Count:        int32(count),
+	// 超出容量或堆叠上限、已转入邮箱的道具，由claim_mailbox领取
+	mails, _ := jsonArray(response["mailbox"])
+	mailboxList := make([]*item.ItemAddInfo, 0, len(mails))
+	for _, r := range mails {
+		mailMap, ok := r.(map[string]interface{})
+		if !ok {
+			continue
 		}
-		resultItemInfos = append(resultItemInfos, itemInfo)
+		itemId, _ := mailMap["item_id"].(float64)
+		count, _ := mailMap["count"].(float64)
+		mailboxList = append(mailboxList, &item.ItemAddInfo{
+			ItemId: int32(itemId),
+			Count:  int32(count),
+		})
+	}
+	if len(mailboxList) > 0 {
+		klog.CtxWarnf(ctx, "[ITEM-ADD-OVERFLOW-MAIL] userId: %s, mailbox: %v", userId, mailboxList)
 	}
 
-	klog.CtxInfof(ctx, "[ITEM-ADD-SUCCESS] userId: %s, addedCount: %d", userId, len(resultItemInfos))
+	klog.CtxInfof(ctx, "[ITEM-ADD-SUCCESS] userId: %s, addedCount: %d, mailCount: %d", userId, len(resultItemInfos), len(mailboxList))
 
 	return &item.AddItemRsp{
 		Code: common.ErrorCode_OK,
 		Msg:  "success",
 		Data: &item.AddItemRsp_Data{
 			ItemInfoList: resultItemInfos,
+			MailboxList:  mailboxList,
 		},
 	}, nil
 }
@@ -316,66 +292,6 @@ func (m *ItemManager) DeleteItem(ctx context.Context, req *item.DeleteItemReq) (
 
 	klog.CtxInfof(ctx, "[ITEM-DELETE-START] userId: %s, deleteCount: %d, idempotentId: %s", userId, len(req.ItemDeleteList), req.IdempotentId)
 
-	luaScript := `
-		local user_key = KEYS[1]
-		local idempotent_key = KEYS[2]
-		local delete_data = cjson.decode(ARGV[1])
-		
-		local cached_result = redis.call('get', idempotent_key)
-		if cached_result then
-			return cached_result
-		end
-		
-		local user_items_set_key = user_key .. 'items'
-		
-		-- 第一阶段：检查所有道具数量是否足够
-		for i, delete_item in ipairs(delete_data) do
-			local item_unique_id = delete_item.item_unique_id
-			local delete_count = tonumber(delete_item.count)
-			local item_key = user_key .. item_unique_id
-			
-			local exists = redis.call('exists', item_key)
-			if exists == 1 then
-				local current_count = tonumber(redis.call('hget', item_key, 'count'))
-				
-				if delete_count > current_count then
-					-- 删除数量大于现有数量，删除失败
-					local result_json = cjson.encode({success = false, error = 'delete count exceeds available count'})
-					redis.call('set', idempotent_key, result_json, 'EX', 604800)
-					return result_json
-				end
-			else
-				-- 道具不存在，删除失败
-				local result_json = cjson.encode({success = false, error = 'item not found'})
-				redis.call('set', idempotent_key, result_json, 'EX', 604800)
-				return result_json
-			end
-		end
-		
-		-- 第二阶段：执行删除操作
-		for i, delete_item in ipairs(delete_data) do
-			local item_unique_id = delete_item.item_unique_id
-			local delete_count = tonumber(delete_item.count)
-			local item_key = user_key .. item_unique_id
-			
-			local current_count = tonumber(redis.call('hget', item_key, 'count'))
-			
-			if delete_count == current_count then
-				-- 删除数量等于现有数量，删除整个道具
-				redis.call('del', item_key)
-				redis.call('srem', user_items_set_key, item_unique_id)
-			else
-				-- 删除数量小于现有数量，减少数量
-				local new_count = current_count - delete_count
-				redis.call('hset', item_key, 'count', new_count)
-			end
-		end
-		
-		local result_json = cjson.encode({success = true})
-		redis.call('set', idempotent_key, result_json, 'EX', 604800)
-		return result_json
-	`
-
 	deleteData := make([]map[string]interface{}, 0, len(req.ItemDeleteList))
 	for _, deleteItem := range req.ItemDeleteList {
 		deleteData = append(deleteData, map[string]interface{}{
@@ -397,9 +313,13 @@ func (m *ItemManager) DeleteItem(ctx context.Context, req *item.DeleteItemReq) (
 
 	idempotentKey := fmt.Sprintf("idempotent:{%s}:%s", userId, req.IdempotentId)
 	keys := []string{userKey, idempotentKey}
-	args := []interface{}{string(deleteDataJSON)}
+	forTrade := 0
+	if req.ForTrade {
+		forTrade = 1
+	}
+	args := []interface{}{string(deleteDataJSON), ledgerMeta(ctx, req.OperationReason, req.IdempotentId), time.Now().Unix(), forTrade}
 
-	val, err := m.rdb.Eval(ctx, luaScript, keys, args...).Result()
+	val, err := script.Run(ctx, m.rdb, script.DeleteItem, keys, args...).Result()
 	if err != nil {
 		klog.CtxErrorf(ctx, "[ITEM-DELETE-REDIS-ERROR] userId: %s, error: %v", userId, err)
 		return &item.DeleteItemRsp{
@@ -421,11 +341,22 @@ func (m *ItemManager) DeleteItem(ctx context.Context, req *item.DeleteItemReq) (
 		errorMsg := response["error"].(string)
 		klog.CtxWarnf(ctx, "[ITEM-DELETE-FAIL] userId: %s, error: %s", userId, errorMsg)
 
-		if errorMsg == "duplicate idempotent request" {
+		switch errorMsg {
+		case "duplicate idempotent request":
 			return &item.DeleteItemRsp{
 				Code: common.ErrorCode_ITEM_IDEMPOTENT_DUPLICATE,
 				Msg:  "Duplicate idempotent request",
 			}, nil
+		case "item not tradable":
+			return &item.DeleteItemRsp{
+				Code: common.ErrorCode_ITEM_NOT_TRADABLE,
+				Msg:  "Item not tradable",
+			}, nil
+		case "item bound":
+			return &item.DeleteItemRsp{
+				Code: common.ErrorCode_ITEM_BOUND,
+				Msg:  "Item bound",
+			}, nil
 		}
 
 		return &item.DeleteItemRsp{
@@ -448,30 +379,8 @@ func (m *ItemManager) GetAllItems(ctx context.Context, req *item.GetAllItemsReq)
 
 	klog.CtxInfof(ctx, "[ITEM-GET-ALL-START] userId: %s", userId)
 
-	luaScript := `
-		local user_key = KEYS[1]
-		local user_items_set_key = user_key .. 'items'
-		local results = {}
-		
-		local item_ids = redis.call('smembers', user_items_set_key)
-		for i, item_id in ipairs(item_ids) do
-			local item_key = user_key .. item_id
-			local exists = redis.call('exists', item_key)
-			if exists == 1 then
-				local item_data = redis.call('hgetall', item_key)
-				local item = {}
-				for j = 1, #item_data, 2 do
-					item[item_data[j]] = item_data[j+1]
-				end
-				table.insert(results, item)
-			end
-		end
-		
-		return cjson.encode({success = true, results = results})
-	`
-
 	keys := []string{userKey}
-	val, err := m.rdb.Eval(ctx, luaScript, keys).Result()
+	val, err := script.Run(ctx, m.rdb, script.GetAllItems, keys, time.Now().Unix()).Result()
 	if err != nil {
 		klog.CtxErrorf(ctx, "[ITEM-GET-ALL-REDIS-ERROR] userId: %s, error: %v", userId, err)
 		return &item.GetAllItemsRsp{
@@ -499,7 +408,7 @@ func (m *ItemManager) GetAllItems(ctx context.Context, req *item.GetAllItemsReq)
 	}
 
 	resultsValue := response["results"]
-	results, ok := resultsValue.([]interface{})
+	results, ok := jsonArray(resultsValue)
 	if !ok {
 		klog.CtxErrorf(ctx, "[ITEM-GET-ALL-RESULTS-TYPE-ERROR] userId: %s, results is not array: %T", userId, resultsValue)
 		return &item.GetAllItemsRsp{
@@ -516,40 +425,7 @@ func (m *ItemManager) GetAllItems(ctx context.Context, req *item.GetAllItemsReq)
 			continue
 		}
 
-		var itemId, itemType, count float64
-
-		// 处理item_id字段
-		switch v := resultMap["item_id"].(type) {
-		case string:
-			itemId, _ = strconv.ParseFloat(v, 64)
-		case float64:
-			itemId = v
-		}
-
-		// 处理item_type字段
-		switch v := resultMap["item_type"].(type) {
-		case string:
-			itemType, _ = strconv.ParseFloat(v, 64)
-		case float64:
-			itemType = v
-		}
-
-		// 处理count字段
-		switch v := resultMap["count"].(type) {
-		case string:
-			count, _ = strconv.ParseFloat(v, 64)
-		case float64:
-			count = v
-		}
-
-		itemInfo := &item.ItemInfo{
-			ItemId:       int32(itemId),
-			ItemUniqueId: resultMap["item_unique_id"].(string),
-			ItemType:     int32(itemType),
-			Properties:   resultMap["properties"].(string),
-			Count:        int32(count),
-		}
-		resultItemInfos = append(resultItemInfos, itemInfo)
+		resultItemInfos = append(resultItemInfos, itemInfoFromResult(resultMap))
 	}
 
 	klog.CtxInfof(ctx, "[ITEM-GET-ALL-SUCCESS] userId: %s, itemCount: %d", userId, len(resultItemInfos))
@@ -573,8 +449,10 @@ func (m *ItemManager) GetItem(ctx context.Context, req *item.GetItemReq) (resp *
 	luaScript := `
 		local item_key = KEYS[1]
 		local exists = redis.call('exists', item_key)
+		local expire_at = tonumber(redis.call('hget', item_key, 'expire_at') or 0)
 		
-		if exists == 0 then
+		-- 已过期未移除的道具视为不存在
+		if exists == 0 or (expire_at > 0 and expire_at <= tonumber(ARGV[1])) then
 			return cjson.encode({success = false, error = 'item not found'})
 		end
 		
@@ -589,7 +467,7 @@ func (m *ItemManager) GetItem(ctx context.Context, req *item.GetItemReq) (resp *
 	`
 
 	keys := []string{itemKey}
-	val, err := m.rdb.Eval(ctx, luaScript, keys).Result()
+	val, err := m.rdb.Eval(ctx, luaScript, keys, time.Now().Unix()).Result()
 	if err != nil {
 		klog.CtxErrorf(ctx, "[ITEM-GET-SINGLE-REDIS-ERROR] userId: %s, itemUniqueId: %s, error: %v", userId, req.ItemUniqueId, err)
 		return &item.GetItemRsp{
@@ -624,40 +502,7 @@ func (m *ItemManager) GetItem(ctx context.Context, req *item.GetItemReq) (resp *
 		}, nil
 	}
 
-	resultMap := response["result"].(map[string]interface{})
-	var itemId, itemType, count float64
-
-	// 处理item_id字段
-	switch v := resultMap["item_id"].(type) {
-	case string:
-		itemId, _ = strconv.ParseFloat(v, 64)
-	case float64:
-		itemId = v
-	}
-
-	// 处理item_type字段
-	switch v := resultMap["item_type"].(type) {
-	case string:
-		itemType, _ = strconv.ParseFloat(v, 64)
-	case float64:
-		itemType = v
-	}
-
-	// 处理count字段
-	switch v := resultMap["count"].(type) {
-	case string:
-		count, _ = strconv.ParseFloat(v, 64)
-	case float64:
-		count = v
-	}
-
-	itemInfo := &item.ItemInfo{
-		ItemId:       int32(itemId),
-		ItemUniqueId: resultMap["item_unique_id"].(string),
-		ItemType:     int32(itemType),
-		Properties:   resultMap["properties"].(string),
-		Count:        int32(count),
-	}
+	itemInfo := itemInfoFromResult(response["result"].(map[string]interface{}))
 
 	klog.CtxInfof(ctx, "[ITEM-GET-SINGLE-SUCCESS] userId: %s, itemUniqueId: %s, itemId: %d", userId, req.ItemUniqueId, itemInfo.ItemId)
 
@@ -700,11 +545,10 @@ func (m *ItemManager) DeleteItemById(ctx context.Context, req *item.DeleteItemBy
 			}, nil
 		}
 
-		// 非唯一道具，使用itemId作为uniqueId
-		itemUniqueId := fmt.Sprintf("%d", deleteItem.ItemId)
+		// 非唯一道具按道具id扣除，由脚本依次扣除各过期分桶和永久实例
 		deleteData = append(deleteData, map[string]interface{}{
-			"item_unique_id": itemUniqueId,
-			"count":          int(deleteItem.Count),
+			"item_id": deleteItem.ItemId,
+			"count":   int(deleteItem.Count),
 		})
 	}
 
@@ -719,71 +563,12 @@ func (m *ItemManager) DeleteItemById(ctx context.Context, req *item.DeleteItemBy
 		}, nil
 	}
 
-	luaScript := `
-		local user_key = KEYS[1]
-		local idempotent_key = KEYS[2]
-		local delete_data = cjson.decode(ARGV[1])
-		
-		local cached_result = redis.call('get', idempotent_key)
-		if cached_result then
-			return cached_result
-		end
-		
-		local user_items_set_key = user_key .. 'items'
-		
-		-- 第一阶段：检查所有道具数量是否足够
-		for i, delete_item in ipairs(delete_data) do
-			local item_unique_id = delete_item.item_unique_id
-			local delete_count = tonumber(delete_item.count)
-			local item_key = user_key .. item_unique_id
-			
-			local exists = redis.call('exists', item_key)
-			if exists == 1 then
-				local current_count = tonumber(redis.call('hget', item_key, 'count'))
-				
-				if delete_count > current_count then
-					-- 删除数量大于现有数量，删除失败
-					local result_json = cjson.encode({success = false, error = 'delete count exceeds available count'})
-					redis.call('set', idempotent_key, result_json, 'EX', 604800)
-					return result_json
-				end
-			else
-				-- 道具不存在，删除失败
-				local result_json = cjson.encode({success = false, error = 'item not found'})
-				redis.call('set', idempotent_key, result_json, 'EX', 604800)
-				return result_json
-			end
-		end
-		
-		-- 第二阶段：执行删除操作
-		for i, delete_item in ipairs(delete_data) do
-			local item_unique_id = delete_item.item_unique_id
-			local delete_count = tonumber(delete_item.count)
-			local item_key = user_key .. item_unique_id
-			
-			local current_count = tonumber(redis.call('hget', item_key, 'count'))
-			
-			if delete_count == current_count then
-				-- 删除数量等于现有数量，删除整个道具
-				redis.call('del', item_key)
-				redis.call('srem', user_items_set_key, item_unique_id)
-			else
-				-- 删除数量小于现有数量，减少数量
-				local new_count = current_count - delete_count
-				redis.call('hset', item_key, 'count', new_count)
-			end
-		end
-		
-		local result_json = cjson.encode({success = true})
-		redis.call('set', idempotent_key, result_json, 'EX', 604800)
-		return result_json
-	`
-
 	idempotentKey := fmt.Sprintf("idempotent:{%s}:%s", userId, req.IdempotentId)
 	keys := []string{userKey, idempotentKey}
-	args := []interface{}{string(deleteDataJSON)}
+	args := []interface{}{string(deleteDataJSON), ledgerMeta(ctx, req.OperationReason, req.IdempotentId), time.Now().Unix()}
 
-	val, err := m.rdb.Eval(ctx, luaScript, keys, args...).Result()
+	// 与按唯一id删除使用同一个扣除脚本
+	val, err := script.Run(ctx, m.rdb, script.DeleteItem, keys, args...).Result()
 	if err != nil {
 		klog.CtxErrorf(ctx, "[ITEM-DELETE-BY-ID-REDIS-ERROR] userId: %s, error: %v", userId, err)
 		return &item.DeleteItemByIdRsp{
@@ -825,3 +610,173 @@ func (m *ItemManager) DeleteItemById(ctx context.Context, req *item.DeleteItemBy
 		Msg:  "success",
 	}, nil
 }
+
+// escrowRecord 交易托管时保存的唯一道具实例，见delete_item脚本
+type escrowRecord struct {
+	Success    bool   `json:"success"`
+	Error      string `json:"error"`
+	ItemType   int32  `json:"item_type"`
+	Properties string `json:"properties"`
+	ExpireAt   int64  `json:"expire_at"`
+}
+
+// escrowKey 唯一道具实例的托管记录，与托管用户的道具在同一个slot
+func (m *ItemManager) escrowKey(userId string, itemUniqueId string) string {
+	return m.getUserKey(userId) + "escrow:" + itemUniqueId
+}
+
+// claimEscrow 认领托管记录，一条记录只能被一次写回认领，同一幂等id重试时再次认领成功
+func (m *ItemManager) claimEscrow(ctx context.Context, escrowKey string, itemId int32, idempotentId string) (*escrowRecord, error) {
+	val, err := script.Run(ctx, m.rdb, script.ClaimEscrow, []string{escrowKey}, itemId, idempotentId).Text()
+	if err != nil {
+		return nil, err
+	}
+	record := &escrowRecord{}
+	if err := json.Unmarshal([]byte(val), record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// RestoreItems 将交易托管的唯一道具实例写回用户背包，用于拍卖行撤单退还和成交交付。
+// 只能写回托管记录中的实例，属性和过期时间以托管记录为准，不信任调用方传入的道具数据；
+// 仅在内部服务中提供，写回同样受背包容量和堆叠上限限制
+func (m *ItemManager) RestoreItems(ctx context.Context, req *item.RestoreItemsReq) (resp *item.RestoreItemsRsp, err error) {
+	userId := ctx.Value("userId").(string)
+	userKey := m.getUserKey(userId)
+	escrowUserId := req.EscrowUserId
+	if escrowUserId == "" {
+		escrowUserId = userId
+	}
+
+	klog.CtxInfof(ctx, "[ITEM-RESTORE-START] userId: %s, escrowUserId: %s, restoreCount: %d, idempotentId: %s", userId, escrowUserId, len(req.ItemInfoList), req.IdempotentId)
+
+	restoreData := make([]map[string]interface{}, 0, len(req.ItemInfoList))
+	escrowKeys := make([]string, 0, len(req.ItemInfoList))
+	for _, info := range req.ItemInfoList {
+		itemId := int(info.ItemId)
+		config, exists := m.itemConfigs[itemId]
+		if !exists {
+			klog.CtxErrorf(ctx, "[ITEM-RESTORE-CONFIG-NOT-FOUND] userId: %s, itemId: %d, config not found", userId, itemId)
+			return &item.RestoreItemsRsp{
+				Code: common.ErrorCode_ITEM_ADD_FAILED,
+				Msg:  fmt.Sprintf("Item config not found for itemId: %d", itemId),
+			}, nil
+		}
+
+		// 只有唯一道具实例有托管记录
+		if config.IsUnique != 1 || info.ItemUniqueId == "" {
+			klog.CtxErrorf(ctx, "[ITEM-RESTORE-INVALID] userId: %s, itemId: %d, itemUniqueId: %s", userId, itemId, info.ItemUniqueId)
+			return &item.RestoreItemsRsp{
+				Code: common.ErrorCode_ITEM_ADD_FAILED,
+				Msg:  "Only escrowed unique items can be restored",
+			}, nil
+		}
+
+		escrowKey := m.escrowKey(escrowUserId, info.ItemUniqueId)
+		record, err := m.claimEscrow(ctx, escrowKey, info.ItemId, req.IdempotentId)
+		if err != nil {
+			klog.CtxErrorf(ctx, "[ITEM-RESTORE-REDIS-ERROR] userId: %s, escrowKey: %s, error: %v", userId, escrowKey, err)
+			return &item.RestoreItemsRsp{
+				Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+				Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+			}, nil
+		}
+		if !record.Success {
+			klog.CtxWarnf(ctx, "[ITEM-RESTORE-ESCROW-MISMATCH] userId: %s, escrowKey: %s, itemId: %d, error: %s", userId, escrowKey, itemId, record.Error)
+			return &item.RestoreItemsRsp{
+				Code: common.ErrorCode_ITEM_ESCROW_MISMATCH,
+				Msg:  record.Error,
+			}, nil
+		}
+		escrowKeys = append(escrowKeys, escrowKey)
+
+		restoreData = append(restoreData, map[string]interface{}{
+			"item_id":        info.ItemId,
+			"item_unique_id": info.ItemUniqueId,
+			"item_type":      record.ItemType,
+			"properties":     record.Properties,
+			"category":       config.Category,
+			"max_stack":      config.MaxStack,
+			"count":          1,
+			"is_unique":      config.IsUnique,
+			"expire_at":      record.ExpireAt,
+		})
+	}
+
+	restoreDataJSON, err := json.Marshal(restoreData)
+	if err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-RESTORE-JSON-ERROR] userId: %s, error: %v", userId, err)
+		return &item.RestoreItemsRsp{
+			Code: common.ErrorCode_ITEM_JSON_ERROR,
+			Msg:  fmt.Sprintf("JSON marshaling failed: %v", err),
+		}, nil
+	}
+
+	idempotentKey := fmt.Sprintf("idempotent:{%s}:%s", userId, req.IdempotentId)
+	keys := []string{userKey, idempotentKey}
+	args := []interface{}{string(restoreDataJSON), m.bagConfigsJSON, ledgerMeta(ctx, req.OperationReason, req.IdempotentId), restoreModeRestore,
+		time.Now().Unix(), expiryLedgerMeta(ctx)}
+
+	val, err := script.Run(ctx, m.rdb, script.RestoreItems, keys, args...).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-RESTORE-REDIS-ERROR] userId: %s, error: %v", userId, err)
+		return &item.RestoreItemsRsp{
+			Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+			Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+		}, nil
+	}
+
+	var response map[string]interface{}
+	if err := json.Unmarshal([]byte(val.(string)), &response); err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-RESTORE-UNMARSHAL-ERROR] userId: %s, error: %v", userId, err)
+		return &item.RestoreItemsRsp{
+			Code: common.ErrorCode_ITEM_JSON_ERROR,
+			Msg:  fmt.Sprintf("JSON unmarshaling failed: %v", err),
+		}, nil
+	}
+	notifyScriptExpired(ctx, userId, response)
+	m.scheduleExpiry(ctx, userId)
+
+	if !response["success"].(bool) {
+		errorMsg := response["error"].(string)
+		klog.CtxWarnf(ctx, "[ITEM-RESTORE-FAIL] userId: %s, error: %s", userId, errorMsg)
+
+		switch errorMsg {
+		case "item already exists":
+			return &item.RestoreItemsRsp{
+				Code: common.ErrorCode_ITEM_ALREADY_EXISTS,
+				Msg:  "Item already exists",
+			}, nil
+		case "bag full":
+			return &item.RestoreItemsRsp{
+				Code: common.ErrorCode_ITEM_BAG_FULL,
+				Msg:  "Bag full",
+			}, nil
+		case "stack limit exceeded":
+			return &item.RestoreItemsRsp{
+				Code: common.ErrorCode_ITEM_STACK_LIMIT,
+				Msg:  "Stack limit exceeded",
+			}, nil
+		}
+
+		return &item.RestoreItemsRsp{
+			Code: common.ErrorCode_ITEM_ADD_FAILED,
+			Msg:  errorMsg,
+		}, nil
+	}
+
+	// 已写回的托管记录保留到幂等结果过期，期间同一幂等id的重试仍能认领并返回缓存结果
+	for _, escrowKey := range escrowKeys {
+		if err := m.rdb.Expire(ctx, escrowKey, escrowRetention).Err(); err != nil {
+			klog.CtxWarnf(ctx, "[ITEM-RESTORE-ESCROW-EXPIRE-ERROR] userId: %s, escrowKey: %s, error: %v", userId, escrowKey, err)
+		}
+	}
+
+	klog.CtxInfof(ctx, "[ITEM-RESTORE-SUCCESS] userId: %s, restoredCount: %d", userId, len(restoreData))
+
+	return &item.RestoreItemsRsp{
+		Code: common.ErrorCode_OK,
+		Msg:  "success",
+	}, nil
+}
@@ -10,6 +10,9 @@ import (
 	common_redis "item_manager/redis"
 	"os"
 	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/spf13/viper"
 )
 
 const (
@@ -20,8 +23,13 @@ var testCtx context.Context
 
 // TestMain 在所有测试执行前初始化环境，执行后清理环境
 func TestMain(m *testing.M) {
-	// 设置环境变量
-	os.Setenv("CONF_PATH", "../../etc")
+	// 未指定配置文件时使用仓库内的测试配置
+	if os.Getenv(common_config.CONF_ENV_PATH) == "" {
+		os.Setenv(common_config.CONF_ENV_PATH, "../../etc")
+	}
+	if os.Getenv(common_config.CONF_ENV_FILE) == "" {
+		os.Setenv(common_config.CONF_ENV_FILE, "server-test.yaml")
+	}
 
 	// 加载配置
 	if err := common_config.LoadConfig(); err != nil {
@@ -29,6 +37,17 @@ func TestMain(m *testing.M) {
 		os.Exit(1)
 	}
 
+	// 默认连接进程内的miniredis，不依赖外部Redis；ITEM_TEST_REDIS=1 时连接配置中的Redis
+	if os.Getenv("ITEM_TEST_REDIS") != "1" {
+		mr, err := miniredis.Run()
+		if err != nil {
+			fmt.Printf("Failed to start miniredis: %v\n", err)
+			os.Exit(1)
+		}
+		viper.Set("redis.addrs", []interface{}{mr.Addr()})
+		viper.Set("redis.password", "")
+	}
+
 	// 测试Redis连接
 	rdb := common_redis.GetRedis()
 	testCtx = context.WithValue(context.Background(), "userId", testUserId)
@@ -694,3 +713,289 @@ func TestItemManager_DeleteItemById(t *testing.T) {
 		}
 	})
 }
+
+// TestItemManager_AddItemLimits 测试堆叠上限与背包溢出处理
+func TestItemManager_AddItemLimits(t *testing.T) {
+	const limitUserId = "test_user_limit"
+	ctx := context.WithValue(context.Background(), "userId", limitUserId)
+	rdb := common_redis.GetRedis()
+	cleanup := func() {
+		for _, pattern := range []string{"item:user:{" + limitUserId + "}:*", "idempotent:{" + limitUserId + "}:*"} {
+			if keys := rdb.Keys(ctx, pattern).Val(); len(keys) > 0 {
+				rdb.Del(ctx, keys...)
+			}
+		}
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+	m := manager.GetItemManager()
+
+	// 道具6为材料，堆叠上限999，分类溢出策略为reject
+	resp, err := m.AddItem(ctx, &item.AddItemReq{
+		ItemAddList:  []*item.ItemAddInfo{{ItemId: 6, Count: 1000}},
+		IdempotentId: "limit_001",
+	})
+	if err != nil || resp.Code != common.ErrorCode_ITEM_STACK_LIMIT {
+		t.Fatalf("Expected ITEM_STACK_LIMIT, got %v, err: %v", resp.GetCode(), err)
+	}
+	if rdb.Exists(ctx, fmt.Sprintf("item:user:{%s}:6", limitUserId)).Val() != 0 {
+		t.Errorf("Rejected add should not write item")
+	}
+
+	// 道具4为消耗品，堆叠上限99，超出部分转入邮箱
+	resp, err = m.AddItem(ctx, &item.AddItemReq{
+		ItemAddList:  []*item.ItemAddInfo{{ItemId: 4, Count: 100}},
+		IdempotentId: "limit_002",
+	})
+	if err != nil || resp.Code != common.ErrorCode_OK {
+		t.Fatalf("Expected OK, got %v, err: %v", resp.GetCode(), err)
+	}
+	if len(resp.Data.ItemInfoList) != 1 || resp.Data.ItemInfoList[0].Count != 99 || resp.Data.ItemInfoList[0].ItemType != 4 {
+		t.Errorf("Expected 99 items of type 4 in bag, got %v", resp.Data.ItemInfoList)
+	}
+	if len(resp.Data.MailboxList) != 1 || resp.Data.MailboxList[0].ItemId != 4 || resp.Data.MailboxList[0].Count != 1 {
+		t.Errorf("Expected 1 item in mailbox, got %v", resp.Data.MailboxList)
+	}
+
+	// 已满堆叠的道具再次添加，全部转入邮箱
+	resp, err = m.AddItem(ctx, &item.AddItemReq{
+		ItemAddList:  []*item.ItemAddInfo{{ItemId: 4, Count: 5}},
+		IdempotentId: "limit_003",
+	})
+	if err != nil || resp.Code != common.ErrorCode_OK {
+		t.Fatalf("Expected OK, got %v, err: %v", resp.GetCode(), err)
+	}
+	if len(resp.Data.ItemInfoList) != 0 || len(resp.Data.MailboxList) != 1 || resp.Data.MailboxList[0].Count != 5 {
+		t.Errorf("Expected all items in mailbox, got %v, %v", resp.Data.ItemInfoList, resp.Data.MailboxList)
+	}
+	if n := rdb.HLen(ctx, fmt.Sprintf("item:user:{%s}:mailbox", limitUserId)).Val(); n != 2 {
+		t.Errorf("Expected 2 mails, got %d", n)
+	}
+}
+
+// TestItemManager_TransferItems 测试两个用户之间转移道具
+func TestItemManager_TransferItems(t *testing.T) {
+	const fromUserId, toUserId = "test_user_transfer_from", "test_user_transfer_to"
+	fromCtx := context.WithValue(context.Background(), "userId", fromUserId)
+	rdb := common_redis.GetRedis()
+	cleanup := func() {
+		for _, userId := range []string{fromUserId, toUserId} {
+			for _, pattern := range []string{"item:user:{" + userId + "}:*", "idempotent:{" + userId + "}:*"} {
+				if keys := rdb.Keys(fromCtx, pattern).Val(); len(keys) > 0 {
+					rdb.Del(fromCtx, keys...)
+				}
+			}
+		}
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+	m := manager.GetItemManager()
+
+	// 道具6可交易，道具10不可交易
+	addResp, err := m.AddItem(fromCtx, &item.AddItemReq{
+		ItemAddList:  []*item.ItemAddInfo{{ItemId: 6, Count: 10}, {ItemId: 10, Count: 1}, {ItemId: 1, Count: 1}},
+		IdempotentId: "transfer_add_001",
+	})
+	if err != nil || addResp.Code != common.ErrorCode_OK {
+		t.Fatalf("AddItem failed: %v, err: %v", addResp.GetCode(), err)
+	}
+	// 道具10为限时道具，唯一id带过期分桶
+	timedUniqueId := addResp.Data.ItemInfoList[1].ItemUniqueId
+	uniqueId := addResp.Data.ItemInfoList[2].ItemUniqueId
+
+	tests := []struct {
+		name     string
+		req      *item.TransferItemsReq
+		wantCode common.ErrorCode
+	}{
+		{
+			name: "转出用户与调用方不一致",
+			req: &item.TransferItemsReq{FromUserId: toUserId, ToUserId: fromUserId, IdempotentId: "transfer_001",
+				ItemTransferList: []*item.ItemTransferInfo{{ItemUniqueId: "6", Count: 1}}},
+			wantCode: common.ErrorCode_ITEM_TRANSFER_FAILED,
+		},
+		{
+			name: "不可交易道具",
+			req: &item.TransferItemsReq{ToUserId: toUserId, IdempotentId: "transfer_002",
+				ItemTransferList: []*item.ItemTransferInfo{{ItemUniqueId: timedUniqueId, Count: 1}}},
+			wantCode: common.ErrorCode_ITEM_NOT_TRADABLE,
+		},
+		{
+			name: "道具不存在",
+			req: &item.TransferItemsReq{ToUserId: toUserId, IdempotentId: "transfer_003",
+				ItemTransferList: []*item.ItemTransferInfo{{ItemUniqueId: "8", Count: 1}}},
+			wantCode: common.ErrorCode_ITEM_NOT_FOUND,
+		},
+		{
+			name: "正常转移唯一道具和堆叠道具",
+			req: &item.TransferItemsReq{FromUserId: fromUserId, ToUserId: toUserId, IdempotentId: "transfer_004",
+				ItemTransferList: []*item.ItemTransferInfo{{ItemUniqueId: uniqueId, Count: 1}, {ItemUniqueId: "6", Count: 3}, {ItemUniqueId: "6", Count: 1}}},
+			wantCode: common.ErrorCode_OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := m.TransferItems(fromCtx, tt.req)
+			if err != nil || resp.Code != tt.wantCode {
+				t.Fatalf("Expected code %v, got %v, msg: %s, err: %v", tt.wantCode, resp.GetCode(), resp.GetMsg(), err)
+			}
+		})
+	}
+
+	toCtx := context.WithValue(context.Background(), "userId", toUserId)
+	getResp, err := m.GetItem(toCtx, &item.GetItemReq{ItemUniqueId: "6"})
+	if err != nil || getResp.Code != common.ErrorCode_OK || getResp.Data.ItemInfo.Count != 4 {
+		t.Errorf("Expected receiver to have 4 of item 6, got %v", getResp)
+	}
+	if getResp, _ = m.GetItem(toCtx, &item.GetItemReq{ItemUniqueId: uniqueId}); getResp.Code != common.ErrorCode_OK {
+		t.Errorf("Expected receiver to have unique item %s, got %v", uniqueId, getResp.Code)
+	}
+	if getResp, _ = m.GetItem(fromCtx, &item.GetItemReq{ItemUniqueId: "6"}); getResp.Code != common.ErrorCode_OK || getResp.Data.ItemInfo.Count != 6 {
+		t.Errorf("Expected sender to keep 6 of item 6, got %v", getResp)
+	}
+}
+
+// TestItemManager_RestoreItems 测试按托管记录写回唯一道具实例
+func TestItemManager_RestoreItems(t *testing.T) {
+	const sellerId, buyerId = "test_user_restore_seller", "test_user_restore_buyer"
+	sellerCtx := context.WithValue(context.Background(), "userId", sellerId)
+	buyerCtx := context.WithValue(context.Background(), "userId", buyerId)
+	rdb := common_redis.GetRedis()
+	cleanup := func() {
+		for _, userId := range []string{sellerId, buyerId} {
+			for _, pattern := range []string{"item:user:{" + userId + "}:*", "idempotent:{" + userId + "}:*"} {
+				if keys := rdb.Keys(sellerCtx, pattern).Val(); len(keys) > 0 {
+					rdb.Del(sellerCtx, keys...)
+				}
+			}
+		}
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+	m := manager.GetItemManager()
+
+	addResp, err := m.AddItem(sellerCtx, &item.AddItemReq{
+		ItemAddList:  []*item.ItemAddInfo{{ItemId: 1, Count: 1}},
+		IdempotentId: "restore_add_001",
+	})
+	if err != nil || addResp.Code != common.ErrorCode_OK {
+		t.Fatalf("AddItem failed: %v, err: %v", addResp.GetCode(), err)
+	}
+	escrowed := addResp.Data.ItemInfoList[0]
+	uniqueId := escrowed.ItemUniqueId
+
+	// 未托管的实例不能写回，调用方不能凭空创建唯一道具
+	forged := &item.ItemInfo{ItemId: 1, ItemUniqueId: "forged_unique_id", Properties: `{"attack":9999}`, Count: 1}
+	restoreResp, err := m.RestoreItems(buyerCtx, &item.RestoreItemsReq{
+		ItemInfoList: []*item.ItemInfo{forged}, EscrowUserId: sellerId, IdempotentId: "restore_001",
+	})
+	if err != nil || restoreResp.Code != common.ErrorCode_ITEM_ESCROW_MISMATCH {
+		t.Fatalf("Expected ITEM_ESCROW_MISMATCH for forged item, got %v, err: %v", restoreResp.GetCode(), err)
+	}
+
+	deleteResp, err := m.DeleteItem(sellerCtx, &item.DeleteItemReq{
+		ItemDeleteList: []*item.ItemDeleteInfo{{ItemUniqueId: uniqueId, Count: 1}},
+		IdempotentId:   "restore_escrow_001",
+		ForTrade:       true,
+	})
+	if err != nil || deleteResp.Code != common.ErrorCode_OK {
+		t.Fatalf("Escrow failed: %v, err: %v", deleteResp.GetCode(), err)
+	}
+
+	// 道具id与托管记录不一致
+	restoreResp, _ = m.RestoreItems(buyerCtx, &item.RestoreItemsReq{
+		ItemInfoList: []*item.ItemInfo{{ItemId: 3, ItemUniqueId: uniqueId, Count: 1}}, EscrowUserId: sellerId, IdempotentId: "restore_002",
+	})
+	if restoreResp.Code != common.ErrorCode_ITEM_ESCROW_MISMATCH {
+		t.Fatalf("Expected mismatched item id to be rejected, got %v", restoreResp.Code)
+	}
+
+	// 写回托管的实例，调用方传入的属性被忽略
+	tampered := &item.ItemInfo{ItemId: 1, ItemUniqueId: uniqueId, Properties: `{"attack":9999}`, Count: 1}
+	for i := 0; i < 2; i++ {
+		restoreResp, err = m.RestoreItems(buyerCtx, &item.RestoreItemsReq{
+			ItemInfoList: []*item.ItemInfo{tampered}, EscrowUserId: sellerId, IdempotentId: "restore_003",
+		})
+		if err != nil || restoreResp.Code != common.ErrorCode_OK {
+			t.Fatalf("Restore attempt %d failed: %v, msg: %s, err: %v", i, restoreResp.GetCode(), restoreResp.GetMsg(), err)
+		}
+	}
+	getResp, _ := m.GetItem(buyerCtx, &item.GetItemReq{ItemUniqueId: uniqueId})
+	if getResp.Code != common.ErrorCode_OK || getResp.Data.ItemInfo.Properties != escrowed.Properties {
+		t.Fatalf("Expected buyer to receive escrowed properties %s, got %v", escrowed.Properties, getResp)
+	}
+
+	// 同一托管记录不能被另一次写回再次认领
+	restoreResp, _ = m.RestoreItems(sellerCtx, &item.RestoreItemsReq{
+		ItemInfoList: []*item.ItemInfo{{ItemId: 1, ItemUniqueId: uniqueId, Count: 1}}, IdempotentId: "restore_004",
+	})
+	if restoreResp.Code != common.ErrorCode_ITEM_ESCROW_MISMATCH {
+		t.Fatalf("Expected second restore to be rejected, got %v", restoreResp.Code)
+	}
+}
+
+// TestItemManager_GetItemLedger 测试道具流水记录与查询
+func TestItemManager_GetItemLedger(t *testing.T) {
+	const ledgerUserId = "test_user_ledger"
+	ctx := context.WithValue(context.Background(), "userId", ledgerUserId)
+	rdb := common_redis.GetRedis()
+	cleanup := func() {
+		for _, pattern := range []string{"item:user:{" + ledgerUserId + "}:*", "idempotent:{" + ledgerUserId + "}:*"} {
+			if keys := rdb.Keys(ctx, pattern).Val(); len(keys) > 0 {
+				rdb.Del(ctx, keys...)
+			}
+		}
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+	m := manager.GetItemManager()
+
+	if resp, _ := m.AddItem(ctx, &item.AddItemReq{
+		ItemAddList: []*item.ItemAddInfo{{ItemId: 6, Count: 5}}, OperationReason: "quest_reward", IdempotentId: "ledger_001",
+	}); resp.Code != common.ErrorCode_OK {
+		t.Fatalf("AddItem failed: %v", resp.Code)
+	}
+	if resp, _ := m.DeleteItemById(ctx, &item.DeleteItemByIdReq{
+		ItemDeleteList: []*item.ItemDeleteByIdInfo{{ItemId: 6, Count: 2}}, OperationReason: "craft", IdempotentId: "ledger_002",
+	}); resp.Code != common.ErrorCode_OK {
+		t.Fatalf("DeleteItemById failed: %v", resp.Code)
+	}
+	if resp, _ := m.AddItem(ctx, &item.AddItemReq{
+		ItemAddList: []*item.ItemAddInfo{{ItemId: 4, Count: 1}}, OperationReason: "shop", IdempotentId: "ledger_003",
+	}); resp.Code != common.ErrorCode_OK {
+		t.Fatalf("AddItem failed: %v", resp.Code)
+	}
+
+	// 按道具过滤，按时间倒序返回
+	resp, err := m.GetItemLedger(ctx, &item.GetItemLedgerReq{ItemId: 6})
+	if err != nil || resp.Code != common.ErrorCode_OK {
+		t.Fatalf("GetItemLedger failed: %v, err: %v", resp.GetCode(), err)
+	}
+	entries := resp.Data.LedgerList
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries for item 6, got %d", len(entries))
+	}
+	if e := entries[0]; e.Delta != -2 || e.Balance != 3 || e.Reason != "craft" || e.IdempotentId != "ledger_002" || e.UserId != ledgerUserId {
+		t.Errorf("Unexpected latest entry: %v", e)
+	}
+	if e := entries[1]; e.Delta != 5 || e.Balance != 5 || e.Reason != "quest_reward" || e.ItemUniqueId != "6" || e.Time == 0 {
+		t.Errorf("Unexpected first entry: %v", e)
+	}
+
+	// 分页
+	resp, _ = m.GetItemLedger(ctx, &item.GetItemLedgerReq{Limit: 2})
+	if len(resp.Data.LedgerList) != 2 || resp.Data.LedgerList[0].ItemId != 4 || resp.Data.NextCursor == "" {
+		t.Fatalf("Unexpected first page: %v, cursor: %s", resp.Data.LedgerList, resp.Data.NextCursor)
+	}
+	resp, _ = m.GetItemLedger(ctx, &item.GetItemLedgerReq{Limit: 2, Cursor: resp.Data.NextCursor})
+	if len(resp.Data.LedgerList) != 1 || resp.Data.LedgerList[0].Delta != 5 || resp.Data.NextCursor != "" {
+		t.Errorf("Unexpected second page: %v, cursor: %s", resp.Data.LedgerList, resp.Data.NextCursor)
+	}
+
+	// 时间范围之外没有流水
+	resp, _ = m.GetItemLedger(ctx, &item.GetItemLedgerReq{StartTime: 1, EndTime: 2})
+	if len(resp.Data.LedgerList) != 0 {
+		t.Errorf("Expected no entries in time range, got %d", len(resp.Data.LedgerList))
+	}
+}
@@ -0,0 +1,366 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/item"
+	"item_manager/redis/script"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/redis/go-redis/v9"
+)
+
+// 转移道具：两个用户的key位于不同的hash tag，转移分两步完成，每步只访问一个用户的key。
+// 第一步在转出方扣除道具并记录待转入记录（item:user:{from}:transfer_out），第二步在转入方写入，
+// 转入被拒绝时退还转出方。待完成的转移登记在全局ZSET中，进程中途退出时由恢复协程继续
+
+const (
+	TRANSFER_OUT_KEY_SUFFIX       = "transfer_out"              // 转出方待转入记录（hash，field为幂等键）
+	TRANSFER_PENDING_KEY          = "item_svr:transfer:pending" // 待完成转移的登记（ZSET，score为登记时间）
+	defaultTransferRecoverSeconds = 10                          // 未配置时的恢复间隔（秒），登记超过该时间的转移由恢复协程完成
+	transferRecoverBatch          = 100                         // 恢复协程每批处理的转移数
+	ledgerReasonTransferRefund    = "transfer_refund"           // 转入被拒绝、退还转出方的流水原因
+	restoreModeRestore            = "restore"                   // restore_items写回模式，见脚本说明
+	restoreModeTransferIn         = "transfer_in"
+	restoreModeRefund             = "refund"
+)
+
+// TransferItems 在两个用户之间转移道具，用于拍卖结算、赠送和邮件附件。
+// 转出用户默认为调用方，指定时必须与调用方一致；转移的道具必须可交易且未绑定，转入方超出容量或堆叠上限时退还转出方并返回错误
+func (m *ItemManager) TransferItems(ctx context.Context, req *item.TransferItemsReq) (resp *item.TransferItemsRsp, err error) {
+	userId := ctx.Value("userId").(string)
+	fromUserId := req.FromUserId
+	if fromUserId == "" {
+		fromUserId = userId
+	}
+
+	klog.CtxInfof(ctx, "[ITEM-TRANSFER-START] userId: %s, from: %s, to: %s, transferCount: %d, idempotentId: %s",
+		userId, fromUserId, req.ToUserId, len(req.ItemTransferList), req.IdempotentId)
+
+	if fromUserId != userId || req.ToUserId == "" || req.ToUserId == fromUserId || len(req.ItemTransferList) == 0 || req.IdempotentId == "" {
+		klog.CtxErrorf(ctx, "[ITEM-TRANSFER-INVALID] userId: %s, from: %s, to: %s, transferCount: %d",
+			userId, fromUserId, req.ToUserId, len(req.ItemTransferList))
+		return &item.TransferItemsRsp{
+			Code: common.ErrorCode_ITEM_TRANSFER_FAILED,
+			Msg:  "Invalid transfer users, items or idempotent id",
+		}, nil
+	}
+
+	fromKey := m.getUserKey(fromUserId)
+
+	// 合并同一实例的转移数量，保持首次出现的顺序
+	counts := make(map[string]int32, len(req.ItemTransferList))
+	uniqueIds := make([]string, 0, len(req.ItemTransferList))
+	for _, transfer := range req.ItemTransferList {
+		if transfer.ItemUniqueId == "" || transfer.Count <= 0 {
+			klog.CtxErrorf(ctx, "[ITEM-TRANSFER-INVALID] userId: %s, itemUniqueId: %s, count: %d", userId, transfer.ItemUniqueId, transfer.Count)
+			return &item.TransferItemsRsp{
+				Code: common.ErrorCode_ITEM_TRANSFER_FAILED,
+				Msg:  "Invalid item unique id or count",
+			}, nil
+		}
+		if _, exists := counts[transfer.ItemUniqueId]; !exists {
+			uniqueIds = append(uniqueIds, transfer.ItemUniqueId)
+		}
+		counts[transfer.ItemUniqueId] += transfer.Count
+	}
+
+	// 实例的item_id写入后不再变化，先读出以查找配置，数量与存在性由脚本原子校验
+	cmds := make([]*redis.StringCmd, len(uniqueIds))
+	_, err = m.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, uniqueId := range uniqueIds {
+			cmds[i] = pipe.HGet(ctx, fromKey+uniqueId, "item_id")
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		klog.CtxErrorf(ctx, "[ITEM-TRANSFER-REDIS-ERROR] userId: %s, error: %v", userId, err)
+		return &item.TransferItemsRsp{
+			Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+			Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+		}, nil
+	}
+
+	transferData := make([]map[string]interface{}, 0, len(uniqueIds))
+	for i, uniqueId := range uniqueIds {
+		raw, err := cmds[i].Result()
+		if err == redis.Nil {
+			klog.CtxWarnf(ctx, "[ITEM-TRANSFER-NOT-FOUND] userId: %s, itemUniqueId: %s", userId, uniqueId)
+			return &item.TransferItemsRsp{
+				Code: common.ErrorCode_ITEM_NOT_FOUND,
+				Msg:  "Item not found",
+			}, nil
+		}
+		itemId, _ := strconv.Atoi(raw)
+		config, exists := m.itemConfigs[itemId]
+		if !exists {
+			klog.CtxErrorf(ctx, "[ITEM-TRANSFER-CONFIG-NOT-FOUND] userId: %s, itemId: %s, config not found", userId, raw)
+			return &item.TransferItemsRsp{
+				Code: common.ErrorCode_ITEM_TRANSFER_FAILED,
+				Msg:  fmt.Sprintf("Item config not found for itemId: %s", raw),
+			}, nil
+		}
+		if !config.Tradable {
+			klog.CtxWarnf(ctx, "[ITEM-TRANSFER-NOT-TRADABLE] userId: %s, itemId: %d, itemUniqueId: %s", userId, itemId, uniqueId)
+			return &item.TransferItemsRsp{
+				Code: common.ErrorCode_ITEM_NOT_TRADABLE,
+				Msg:  fmt.Sprintf("Item not tradable: %d", itemId),
+			}, nil
+		}
+		if config.Bindable {
+			klog.CtxWarnf(ctx, "[ITEM-TRANSFER-BOUND] userId: %s, itemId: %d, itemUniqueId: %s", userId, itemId, uniqueId)
+			return &item.TransferItemsRsp{
+				Code: common.ErrorCode_ITEM_BOUND,
+				Msg:  fmt.Sprintf("Item bound: %d", itemId),
+			}, nil
+		}
+
+		transferData = append(transferData, map[string]interface{}{
+			"item_unique_id": uniqueId,
+			"count":          counts[uniqueId],
+			"is_unique":      config.IsUnique,
+			"category":       config.Category,
+			"max_stack":      config.MaxStack,
+		})
+	}
+
+	transferDataJSON, err := json.Marshal(transferData)
+	if err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-TRANSFER-JSON-ERROR] userId: %s, error: %v", userId, err)
+		return &item.TransferItemsRsp{
+			Code: common.ErrorCode_ITEM_JSON_ERROR,
+			Msg:  fmt.Sprintf("JSON marshaling failed: %v", err),
+		}, nil
+	}
+
+	idempotentKey := fmt.Sprintf("idempotent:{%s}:%s", fromUserId, req.IdempotentId)
+	// 先登记再扣除，进程在扣除后退出时恢复协程能找到待转入记录
+	pendingMember := transferPendingMember(fromUserId, idempotentKey)
+	if err := m.rdb.ZAdd(ctx, TRANSFER_PENDING_KEY, redis.Z{Score: float64(time.Now().Unix()), Member: pendingMember}).Err(); err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-TRANSFER-REDIS-ERROR] userId: %s, error: %v", userId, err)
+		return &item.TransferItemsRsp{
+			Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+			Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+		}, nil
+	}
+
+	keys := []string{fromKey, idempotentKey}
+	args := []interface{}{string(transferDataJSON), req.ToUserId, ledgerMeta(ctx, req.OperationReason, req.IdempotentId), time.Now().Unix(), expiryLedgerMeta(ctx)}
+	val, err := script.Run(ctx, m.rdb, script.TransferItems, keys, args...).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-TRANSFER-REDIS-ERROR] userId: %s, error: %v", userId, err)
+		return &item.TransferItemsRsp{
+			Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+			Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+		}, nil
+	}
+
+	var response map[string]interface{}
+	if err := json.Unmarshal([]byte(val.(string)), &response); err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-TRANSFER-UNMARSHAL-ERROR] userId: %s, error: %v", userId, err)
+		return &item.TransferItemsRsp{
+			Code: common.ErrorCode_ITEM_JSON_ERROR,
+			Msg:  fmt.Sprintf("JSON unmarshaling failed: %v", err),
+		}, nil
+	}
+	notifyScriptExpired(ctx, fromUserId, response)
+
+	// 已扣除未完成（首次执行或上次在中途退出）时继续转入，否则为已缓存的最终结果
+	if pending, _ := response["pending"].(bool); pending {
+		if response, err = m.finishTransfer(ctx, fromUserId, idempotentKey, val.(string)); err != nil {
+			klog.CtxErrorf(ctx, "[ITEM-TRANSFER-FINISH-ERROR] userId: %s, from: %s, to: %s, error: %v", userId, fromUserId, req.ToUserId, err)
+			return &item.TransferItemsRsp{
+				Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+				Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+			}, nil
+		}
+	} else if err := m.rdb.ZRem(ctx, TRANSFER_PENDING_KEY, pendingMember).Err(); err != nil {
+		klog.CtxWarnf(ctx, "[ITEM-TRANSFER-PENDING-REMOVE-ERROR] userId: %s, error: %v", userId, err)
+	}
+
+	if !response["success"].(bool) {
+		errorMsg := response["error"].(string)
+		klog.CtxWarnf(ctx, "[ITEM-TRANSFER-FAIL] userId: %s, from: %s, to: %s, error: %s", userId, fromUserId, req.ToUserId, errorMsg)
+
+		code := common.ErrorCode_ITEM_TRANSFER_FAILED
+		switch errorMsg {
+		case "item not found":
+			code = common.ErrorCode_ITEM_NOT_FOUND
+		case "item already exists":
+			code = common.ErrorCode_ITEM_ALREADY_EXISTS
+		case "item bound":
+			code = common.ErrorCode_ITEM_BOUND
+		case "bag full":
+			code = common.ErrorCode_ITEM_BAG_FULL
+		case "stack limit exceeded":
+			code = common.ErrorCode_ITEM_STACK_LIMIT
+		}
+		return &item.TransferItemsRsp{
+			Code: code,
+			Msg:  errorMsg,
+		}, nil
+	}
+
+	results, ok := jsonArray(response["results"])
+	if !ok {
+		klog.CtxErrorf(ctx, "[ITEM-TRANSFER-RESULTS-TYPE-ERROR] userId: %s, results is not array: %T", userId, response["results"])
+		return &item.TransferItemsRsp{
+			Code: common.ErrorCode_ITEM_JSON_ERROR,
+			Msg:  "Invalid results format",
+		}, nil
+	}
+
+	resultItemInfos := make([]*item.ItemInfo, 0, len(results))
+	for _, r := range results {
+		if resultMap, ok := r.(map[string]interface{}); ok {
+			resultItemInfos = append(resultItemInfos, itemInfoFromResult(resultMap))
+		}
+	}
+
+	klog.CtxInfof(ctx, "[ITEM-TRANSFER-SUCCESS] userId: %s, from: %s, to: %s, transferCount: %d", userId, fromUserId, req.ToUserId, len(resultItemInfos))
+
+	return &item.TransferItemsRsp{
+		Code: common.ErrorCode_OK,
+		Msg:  "success",
+		Data: &item.TransferItemsRsp_Data{
+			ItemInfoList: resultItemInfos,
+		},
+	}, nil
+}
+
+// pendingTransfer 转出方扣除后记录的待转入道具
+type pendingTransfer struct {
+	ToUserId string            `json:"to_user_id"`
+	Items    []json.RawMessage `json:"items"`
+	Meta     string            `json:"meta"` // 转出时的流水公共字段，转入和退还沿用
+}
+
+// transferPendingMember 待完成转移在全局登记中的member
+func transferPendingMember(fromUserId string, idempotentKey string) string {
+	data, _ := json.Marshal(map[string]string{"from_user_id": fromUserId, "idempotent_key": idempotentKey})
+	return string(data)
+}
+
+// finishTransfer 完成已扣除的转移：在转入方写入道具，转入失败时退还转出方，然后清除待转入记录并缓存最终结果。
+// 转入结果会被缓存，并发执行的协程得到相同的转入结果，不会出现既转入又退还；任一步出错时保留记录由恢复协程重试
+func (m *ItemManager) finishTransfer(ctx context.Context, fromUserId string, idempotentKey string, pendingJSON string) (map[string]interface{}, error) {
+	var pending pendingTransfer
+	if err := json.Unmarshal([]byte(pendingJSON), &pending); err != nil {
+		return nil, err
+	}
+	itemsJSON, _ := json.Marshal(pending.Items)
+
+	// 转入方的幂等键包含转出用户，不同用户使用相同幂等id时不会冲突
+	creditKey := fmt.Sprintf("idempotent:{%s}:transfer:%s:%s", pending.ToUserId, fromUserId, idempotentKey)
+	credit, err := m.runRestore(ctx, pending.ToUserId, creditKey, string(itemsJSON), pending.Meta, restoreModeTransferIn)
+	if err != nil {
+		return nil, err
+	}
+
+	final := credit
+	if credit["success"] != true {
+		var meta map[string]string
+		if err := json.Unmarshal([]byte(pending.Meta), &meta); err != nil {
+			return nil, err
+		}
+		refundMeta := encodeLedgerMeta(ledgerReasonTransferRefund, meta["source"], meta["idempotent_id"], meta["trace_id"])
+		refund, err := m.runRestore(ctx, fromUserId, idempotentKey+":refund", string(itemsJSON), refundMeta, restoreModeRefund)
+		if err != nil {
+			return nil, err
+		}
+		if refund["success"] != true {
+			return nil, fmt.Errorf("refund transfer failed: %v", refund["error"])
+		}
+		klog.CtxWarnf(ctx, "[ITEM-TRANSFER-REFUNDED] from: %s, to: %s, error: %v", fromUserId, pending.ToUserId, credit["error"])
+	}
+
+	finalJSON, _ := json.Marshal(final)
+	val, err := script.Run(ctx, m.rdb, script.CompleteTransfer, []string{m.getUserKey(fromUserId), idempotentKey}, string(finalJSON)).Result()
+	if err != nil {
+		return nil, err
+	}
+	var response map[string]interface{}
+	if err := json.Unmarshal([]byte(val.(string)), &response); err != nil {
+		return nil, err
+	}
+	if err := m.rdb.ZRem(ctx, TRANSFER_PENDING_KEY, transferPendingMember(fromUserId, idempotentKey)).Err(); err != nil {
+		klog.CtxWarnf(ctx, "[ITEM-TRANSFER-PENDING-REMOVE-ERROR] from: %s, error: %v", fromUserId, err)
+	}
+	return response, nil
+}
+
+// runRestore 执行restore_items脚本，返回脚本结果
+func (m *ItemManager) runRestore(ctx context.Context, userId string, idempotentKey string, itemsJSON string, meta string, mode string) (map[string]interface{}, error) {
+	keys := []string{m.getUserKey(userId), idempotentKey}
+	val, err := script.Run(ctx, m.rdb, script.RestoreItems, keys, itemsJSON, m.bagConfigsJSON, meta, mode,
+		time.Now().Unix(), expiryLedgerMeta(ctx)).Result()
+	if err != nil {
+		return nil, err
+	}
+	var response map[string]interface{}
+	if err := json.Unmarshal([]byte(val.(string)), &response); err != nil {
+		return nil, err
+	}
+	notifyScriptExpired(ctx, userId, response)
+	m.scheduleExpiry(ctx, userId)
+	return response, nil
+}
+
+// RunTransferRecovery 定期完成登记时间超过恢复间隔仍未完成的转移（进程在扣除和转入之间退出时遗留），ctx取消后退出。
+// 转入和退还都按幂等键执行，多实例同时恢复同一转移是安全的
+func (m *ItemManager) RunTransferRecovery(ctx context.Context) {
+	interval := time.Duration(configInt("item_transfer.recover_interval", defaultTransferRecoverSeconds)) * time.Second
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := m.recoverTransfers(ctx, time.Now().Add(-interval)); err != nil {
+			klog.CtxErrorf(ctx, "[ITEM-TRANSFER-RECOVER-ERROR] recover transfers error: %v", err)
+		}
+	}
+}
+
+// recoverTransfers 完成登记时间早于before的转移
+func (m *ItemManager) recoverTransfers(ctx context.Context, before time.Time) error {
+	members, err := m.rdb.ZRangeByScore(ctx, TRANSFER_PENDING_KEY, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(before.Unix(), 10),
+		Count: transferRecoverBatch,
+	}).Result()
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		var entry map[string]string
+		if err := json.Unmarshal([]byte(member), &entry); err != nil {
+			klog.CtxErrorf(ctx, "[ITEM-TRANSFER-RECOVER-INVALID] member: %s, error: %v", member, err)
+			m.rdb.ZRem(ctx, TRANSFER_PENDING_KEY, member)
+			continue
+		}
+		fromUserId, idempotentKey := entry["from_user_id"], entry["idempotent_key"]
+		pendingJSON, err := m.rdb.HGet(ctx, m.getUserKey(fromUserId)+TRANSFER_OUT_KEY_SUFFIX, idempotentKey).Result()
+		if err == redis.Nil {
+			// 未扣除（扣除失败）或已完成
+			m.rdb.ZRem(ctx, TRANSFER_PENDING_KEY, member)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := m.finishTransfer(ctx, fromUserId, idempotentKey, pendingJSON); err != nil {
+			klog.CtxErrorf(ctx, "[ITEM-TRANSFER-RECOVER-ERROR] from: %s, idempotentKey: %s, error: %v", fromUserId, idempotentKey, err)
+			continue
+		}
+		klog.CtxInfof(ctx, "[ITEM-TRANSFER-RECOVERED] from: %s, idempotentKey: %s", fromUserId, idempotentKey)
+	}
+	return nil
+}
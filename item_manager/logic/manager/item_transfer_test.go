@@ -0,0 +1,85 @@
+package manager
+
+import (
+	"context"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/item"
+	"item_manager/redis/script"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// TestItemManager_TransferRefundAndRecover 测试转入被拒绝时退还转出方，以及扣除后中断的转移由恢复协程完成
+func TestItemManager_TransferRefundAndRecover(t *testing.T) {
+	const fromUserId, toUserId = "test_user_transfer_refund_from", "test_user_transfer_refund_to"
+	fromCtx := context.WithValue(context.Background(), "userId", fromUserId)
+	toCtx := context.WithValue(context.Background(), "userId", toUserId)
+	m := GetItemManager()
+	cleanup := func() {
+		for _, userId := range []string{fromUserId, toUserId} {
+			for _, pattern := range []string{"item:user:{" + userId + "}:*", "idempotent:{" + userId + "}:*"} {
+				if keys := m.rdb.Keys(fromCtx, pattern).Val(); len(keys) > 0 {
+					m.rdb.Del(fromCtx, keys...)
+				}
+			}
+		}
+		m.rdb.Del(fromCtx, TRANSFER_PENDING_KEY)
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+
+	// 道具4堆叠上限99
+	if resp, _ := m.AddItem(fromCtx, &item.AddItemReq{ItemAddList: []*item.ItemAddInfo{{ItemId: 4, Count: 10}}, IdempotentId: "refund_add"}); resp.Code != common.ErrorCode_OK {
+		t.Fatalf("AddItem failed: %v", resp.Code)
+	}
+	if resp, _ := m.AddItem(toCtx, &item.AddItemReq{ItemAddList: []*item.ItemAddInfo{{ItemId: 4, Count: 98}}, IdempotentId: "refund_add"}); resp.Code != common.ErrorCode_OK {
+		t.Fatalf("AddItem failed: %v", resp.Code)
+	}
+
+	req := &item.TransferItemsReq{ToUserId: toUserId, IdempotentId: "refund_001", ItemTransferList: []*item.ItemTransferInfo{{ItemUniqueId: "4", Count: 5}}}
+	for i := 0; i < 2; i++ {
+		if resp, _ := m.TransferItems(fromCtx, req); resp.Code != common.ErrorCode_ITEM_STACK_LIMIT {
+			t.Fatalf("Expected ITEM_STACK_LIMIT, got %v", resp.Code)
+		}
+	}
+	if count := m.rdb.HGet(fromCtx, m.getUserKey(fromUserId)+"4", "count").Val(); count != "10" {
+		t.Errorf("Sender should be refunded, count: %s", count)
+	}
+	if count := m.rdb.HGet(fromCtx, m.getUserKey(toUserId)+"4", "count").Val(); count != "98" {
+		t.Errorf("Receiver should be unchanged, count: %s", count)
+	}
+	ledger, _ := m.GetItemLedger(fromCtx, &item.GetItemLedgerReq{ItemId: 4, Limit: 1})
+	if e := ledger.Data.LedgerList[0]; e.Delta != 5 || e.Balance != 10 || e.Reason != ledgerReasonTransferRefund {
+		t.Errorf("Unexpected refund ledger entry: %v", e)
+	}
+
+	// 模拟扣除后进程退出：只执行第一步
+	idempotentKey := "idempotent:{" + fromUserId + "}:recover_001"
+	member := transferPendingMember(fromUserId, idempotentKey)
+	m.rdb.ZAdd(fromCtx, TRANSFER_PENDING_KEY, redis.Z{Score: float64(time.Now().Add(-time.Minute).Unix()), Member: member})
+	items := `[{"item_unique_id":"4","count":1,"is_unique":0,"category":"consumable","max_stack":99}]`
+	if err := script.Run(fromCtx, m.rdb, script.TransferItems, []string{m.getUserKey(fromUserId), idempotentKey},
+		items, toUserId, ledgerMeta(fromCtx, "gift", "recover_001"), time.Now().Unix(), expiryLedgerMeta(fromCtx)).Err(); err != nil {
+		t.Fatalf("debit failed: %v", err)
+	}
+
+	if err := m.recoverTransfers(fromCtx, time.Now()); err != nil {
+		t.Fatalf("recoverTransfers failed: %v", err)
+	}
+	if count := m.rdb.HGet(fromCtx, m.getUserKey(toUserId)+"4", "count").Val(); count != "99" {
+		t.Errorf("Receiver should be credited by recovery, count: %s", count)
+	}
+	if m.rdb.HLen(fromCtx, m.getUserKey(fromUserId)+TRANSFER_OUT_KEY_SUFFIX).Val() != 0 || m.rdb.ZScore(fromCtx, TRANSFER_PENDING_KEY, member).Err() != redis.Nil {
+		t.Errorf("Recovered transfer should be cleared")
+	}
+	resp, _ := m.TransferItems(fromCtx, &item.TransferItemsReq{ToUserId: toUserId, IdempotentId: "recover_001",
+		ItemTransferList: []*item.ItemTransferInfo{{ItemUniqueId: "4", Count: 1}}})
+	if resp.Code != common.ErrorCode_OK || len(resp.Data.ItemInfoList) != 1 || resp.Data.ItemInfoList[0].Count != 99 {
+		t.Errorf("Retry should return recovered result, got %v, %v", resp.Code, resp.Data)
+	}
+	if count := m.rdb.HGet(fromCtx, m.getUserKey(fromUserId)+"4", "count").Val(); count != "9" {
+		t.Errorf("Retry should not debit again, count: %s", count)
+	}
+}
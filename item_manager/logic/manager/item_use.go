@@ -0,0 +1,271 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/item"
+	"item_manager/redis/script"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/redis/go-redis/v9"
+)
+
+// itemEffect 道具使用效果，按道具类型注册到itemEffects
+type itemEffect interface {
+	// Validate 加载配置时校验道具的效果参数，items和tables为全部道具配置和掉落表
+	Validate(config *ItemConfig, items map[int]*ItemConfig, tables map[string]*LootTable) error
+	// Check 消耗道具前检查能否使用，返回错误时不消耗道具
+	Check(ctx context.Context, use *itemUse) error
+	// Apply 道具消耗后执行效果，重试时会以相同的幂等id再次执行，必须保证重复执行不会重复发放
+	Apply(ctx context.Context, use *itemUse) error
+}
+
+// unlockEffect 解锁类效果：消耗脚本在同一次执行中检查解锁集合并写入，已解锁时不消耗道具
+type unlockEffect interface {
+	// UnlockTarget 返回解锁集合在用户道具前缀下的后缀和要解锁的成员
+	UnlockTarget(use *itemUse) (string, string, error)
+}
+
+// itemEffects 道具类型到使用效果的映射，新增效果在此注册
+var itemEffects = map[int32]itemEffect{
+	ITEM_TYPE_LOOT_BOX: &lootBoxEffect{},
+	ITEM_TYPE_EXP:      &expEffect{},
+	ITEM_TYPE_SKIN:     &skinEffect{},
+}
+
+// itemUse 一次道具使用的上下文，效果把结果写入data
+type itemUse struct {
+	m            *ItemManager
+	userId       string
+	config       *ItemConfig
+	count        int32
+	reason       string
+	idempotentId string
+	data         *item.UseItemRsp_Data
+}
+
+// subIdempotentId 效果内发放使用的幂等id，由使用的幂等id派生，重试时保持不变
+func (u *itemUse) subIdempotentId(kind string) string {
+	return u.idempotentId + ":" + kind
+}
+
+// effectError 效果执行失败时返回给调用方的错误码
+type effectError struct {
+	code common.ErrorCode
+	msg  string
+}
+
+func (e *effectError) Error() string {
+	return e.msg
+}
+
+// UseItem 使用道具：先原子地消耗道具，再按道具类型执行效果。消耗和效果内的所有发放共用请求的幂等id，
+// 效果执行失败时道具已消耗，使用相同幂等id重试会跳过消耗、只补发未完成的效果
+func (m *ItemManager) UseItem(ctx context.Context, req *item.UseItemReq) (resp *item.UseItemRsp, err error) {
+	userId := ctx.Value("userId").(string)
+	userKey := m.getUserKey(userId)
+
+	klog.CtxInfof(ctx, "[ITEM-USE-START] userId: %s, itemUniqueId: %s, count: %d, idempotentId: %s",
+		userId, req.ItemUniqueId, req.Count, req.IdempotentId)
+
+	if req.ItemUniqueId == "" || req.Count <= 0 || req.IdempotentId == "" {
+		klog.CtxErrorf(ctx, "[ITEM-USE-INVALID] userId: %s, itemUniqueId: %s, count: %d", userId, req.ItemUniqueId, req.Count)
+		return &item.UseItemRsp{
+			Code: common.ErrorCode_FAILED,
+			Msg:  "Invalid item unique id, count or idempotent id",
+		}, nil
+	}
+
+	reason := req.OperationReason
+	if reason == "" {
+		reason = "use_item"
+	}
+	idempotentKey := fmt.Sprintf("idempotent:{%s}:%s", userId, req.IdempotentId)
+
+	// 只缓存成功的消耗结果：幂等键存在说明是重试，道具可能已被消耗完，跳过使用前检查
+	replay, err := m.rdb.Exists(ctx, idempotentKey).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-USE-REDIS-ERROR] userId: %s, error: %v", userId, err)
+		return &item.UseItemRsp{
+			Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+			Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+		}, nil
+	}
+	unlockSuffix, unlockMember := "", ""
+	if replay == 0 {
+		raw, err := m.rdb.HGet(ctx, userKey+req.ItemUniqueId, "item_id").Result()
+		if err == redis.Nil {
+			klog.CtxWarnf(ctx, "[ITEM-USE-NOT-FOUND] userId: %s, itemUniqueId: %s", userId, req.ItemUniqueId)
+			return &item.UseItemRsp{
+				Code: common.ErrorCode_ITEM_NOT_FOUND,
+				Msg:  "Item not found",
+			}, nil
+		}
+		if err != nil {
+			klog.CtxErrorf(ctx, "[ITEM-USE-REDIS-ERROR] userId: %s, error: %v", userId, err)
+			return &item.UseItemRsp{
+				Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+				Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+			}, nil
+		}
+		itemId, _ := strconv.Atoi(raw)
+		config, exists := m.itemConfigs[itemId]
+		var effect itemEffect
+		if exists {
+			effect = itemEffects[config.ItemType]
+		}
+		if effect == nil {
+			klog.CtxWarnf(ctx, "[ITEM-USE-NOT-USABLE] userId: %s, itemId: %d", userId, itemId)
+			return &item.UseItemRsp{
+				Code: common.ErrorCode_ITEM_NOT_USABLE,
+				Msg:  fmt.Sprintf("Item not usable: %d", itemId),
+			}, nil
+		}
+		use := &itemUse{m: m, userId: userId, config: config, count: req.Count, reason: reason, idempotentId: req.IdempotentId}
+		if err := effect.Check(ctx, use); err != nil {
+			klog.CtxWarnf(ctx, "[ITEM-USE-CHECK-FAIL] userId: %s, itemId: %d, error: %v", userId, itemId, err)
+			return &item.UseItemRsp{
+				Code: common.ErrorCode_ITEM_NOT_USABLE,
+				Msg:  err.Error(),
+			}, nil
+		}
+		if unlock, ok := effect.(unlockEffect); ok {
+			if unlockSuffix, unlockMember, err = unlock.UnlockTarget(use); err != nil {
+				klog.CtxErrorf(ctx, "[ITEM-USE-CHECK-FAIL] userId: %s, itemId: %d, error: %v", userId, itemId, err)
+				return &item.UseItemRsp{
+					Code: common.ErrorCode_ITEM_NOT_USABLE,
+					Msg:  err.Error(),
+				}, nil
+			}
+		}
+	}
+
+	keys := []string{userKey, idempotentKey}
+	args := []interface{}{req.ItemUniqueId, req.Count, ledgerMeta(ctx, reason, req.IdempotentId), time.Now().Unix(), expiryLedgerMeta(ctx),
+		unlockSuffix, unlockMember}
+	val, err := script.Run(ctx, m.rdb, script.UseItem, keys, args...).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-USE-REDIS-ERROR] userId: %s, error: %v", userId, err)
+		return &item.UseItemRsp{
+			Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+			Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+		}, nil
+	}
+
+	var response map[string]interface{}
+	if err := json.Unmarshal([]byte(val.(string)), &response); err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-USE-UNMARSHAL-ERROR] userId: %s, error: %v", userId, err)
+		return &item.UseItemRsp{
+			Code: common.ErrorCode_ITEM_JSON_ERROR,
+			Msg:  fmt.Sprintf("JSON unmarshaling failed: %v", err),
+		}, nil
+	}
+	notifyScriptExpired(ctx, userId, response)
+
+	if !response["success"].(bool) {
+		errorMsg := response["error"].(string)
+		klog.CtxWarnf(ctx, "[ITEM-USE-FAIL] userId: %s, itemUniqueId: %s, error: %s", userId, req.ItemUniqueId, errorMsg)
+
+		code := common.ErrorCode_ITEM_DELETE_FAILED
+		switch errorMsg {
+		case "item not found":
+			code = common.ErrorCode_ITEM_NOT_FOUND
+		case "already unlocked":
+			code = common.ErrorCode_ITEM_NOT_USABLE
+		}
+		return &item.UseItemRsp{
+			Code: code,
+			Msg:  errorMsg,
+		}, nil
+	}
+
+	// 以消耗结果中的道具和数量执行效果，重试时与首次使用一致
+	itemId := int(response["item_id"].(float64))
+	count := int32(response["count"].(float64))
+	config, exists := m.itemConfigs[itemId]
+	if !exists {
+		klog.CtxErrorf(ctx, "[ITEM-USE-CONFIG-NOT-FOUND] userId: %s, itemId: %d, config not found", userId, itemId)
+		return &item.UseItemRsp{
+			Code: common.ErrorCode_ITEM_USE_FAILED,
+			Msg:  fmt.Sprintf("Item config not found for itemId: %d", itemId),
+		}, nil
+	}
+	effect, usable := itemEffects[config.ItemType]
+	if !usable {
+		klog.CtxErrorf(ctx, "[ITEM-USE-EFFECT-NOT-FOUND] userId: %s, itemId: %d, itemType: %d", userId, itemId, config.ItemType)
+		return &item.UseItemRsp{
+			Code: common.ErrorCode_ITEM_USE_FAILED,
+			Msg:  fmt.Sprintf("Item effect not found for itemId: %d", itemId),
+		}, nil
+	}
+
+	use := &itemUse{
+		m:            m,
+		userId:       userId,
+		config:       config,
+		count:        count,
+		reason:       reason,
+		idempotentId: req.IdempotentId,
+		data:         &item.UseItemRsp_Data{ItemId: int32(itemId), Count: count},
+	}
+	if err := effect.Apply(ctx, use); err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-USE-EFFECT-ERROR] userId: %s, itemId: %d, idempotentId: %s, error: %v", userId, itemId, req.IdempotentId, err)
+		code := common.ErrorCode_ITEM_USE_FAILED
+		var effectErr *effectError
+		if errors.As(err, &effectErr) {
+			code = effectErr.code
+		}
+		return &item.UseItemRsp{
+			Code: code,
+			Msg:  err.Error(),
+		}, nil
+	}
+
+	klog.CtxInfof(ctx, "[ITEM-USE-SUCCESS] userId: %s, itemId: %d, count: %d, granted: %d, exp: %d, skins: %v",
+		userId, itemId, count, len(use.data.ItemInfoList), use.data.AddExp, use.data.SkinIdList)
+
+	return &item.UseItemRsp{
+		Code: common.ErrorCode_OK,
+		Msg:  "success",
+		Data: use.data,
+	}, nil
+}
+
+// GetSkins 查询调用方已解锁的皮肤（皮肤兑换券写入的皮肤集合），按皮肤id升序返回
+func (m *ItemManager) GetSkins(ctx context.Context, req *item.GetSkinsReq) (resp *item.GetSkinsRsp, err error) {
+	userId := ctx.Value("userId").(string)
+
+	members, err := m.rdb.SMembers(ctx, m.getUserKey(userId)+SKIN_KEY_SUFFIX).Result()
+	if err != nil {
+		klog.CtxErrorf(ctx, "[ITEM-SKINS-GET-REDIS-ERROR] userId: %s, error: %v", userId, err)
+		return &item.GetSkinsRsp{
+			Code: common.ErrorCode_ITEM_REDIS_OPERATION_ERROR,
+			Msg:  fmt.Sprintf("Redis operation failed: %v", err),
+		}, nil
+	}
+
+	skinIds := make([]int32, 0, len(members))
+	for _, member := range members {
+		skinId, err := strconv.Atoi(member)
+		if err != nil {
+			klog.CtxErrorf(ctx, "[ITEM-SKINS-GET-INVALID] userId: %s, skinId: %s", userId, member)
+			continue
+		}
+		skinIds = append(skinIds, int32(skinId))
+	}
+	sort.Slice(skinIds, func(i, j int) bool { return skinIds[i] < skinIds[j] })
+
+	return &item.GetSkinsRsp{
+		Code: common.ErrorCode_OK,
+		Msg:  "success",
+		Data: &item.GetSkinsRsp_Data{
+			SkinIdList: skinIds,
+		},
+	}, nil
+}
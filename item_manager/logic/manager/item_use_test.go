@@ -0,0 +1,169 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"item_manager/kitex_gen/common"
+	"item_manager/kitex_gen/item"
+	"sync"
+	"testing"
+)
+
+// recordExpGranter 按幂等id记录发放的经验，可模拟主页服务失败
+type recordExpGranter struct {
+	granted map[string]int64
+	fail    bool
+}
+
+func (g *recordExpGranter) GrantExp(ctx context.Context, userId string, exp int64, idempotentId string) error {
+	if g.fail {
+		return errors.New("homepage unavailable")
+	}
+	if _, exists := g.granted[idempotentId]; !exists {
+		g.granted[idempotentId] = exp
+	}
+	return nil
+}
+
+// TestItemManager_UseItem 测试使用道具：按类型分发效果、不可使用的道具和失败后重试
+func TestItemManager_UseItem(t *testing.T) {
+	const userId = "test_user_use"
+	ctx := context.WithValue(context.Background(), "userId", userId)
+	m := GetItemManager()
+	cleanup := func() {
+		for _, pattern := range []string{"item:user:{" + userId + "}:*", "idempotent:{" + userId + "}:*"} {
+			if keys := m.rdb.Keys(ctx, pattern).Val(); len(keys) > 0 {
+				m.rdb.Del(ctx, keys...)
+			}
+		}
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+
+	granter := &recordExpGranter{granted: make(map[string]int64)}
+	saved := expGranter
+	expGranter = granter
+	t.Cleanup(func() { expGranter = saved })
+
+	added, _ := m.AddItem(ctx, &item.AddItemReq{
+		ItemAddList:  []*item.ItemAddInfo{{ItemId: 10, Count: 3}, {ItemId: 11, Count: 2}, {ItemId: 12, Count: 2}, {ItemId: 6, Count: 1}},
+		IdempotentId: "use_add",
+	})
+	if added.Code != common.ErrorCode_OK {
+		t.Fatalf("AddItem failed: %v, %s", added.Code, added.Msg)
+	}
+	// 道具10为限时道具，唯一id带过期分桶
+	expItemId := added.Data.ItemInfoList[0].ItemUniqueId
+
+	t.Run("没有使用效果的道具", func(t *testing.T) {
+		resp, _ := m.UseItem(ctx, &item.UseItemReq{ItemUniqueId: "6", Count: 1, IdempotentId: "use_material"})
+		if resp.Code != common.ErrorCode_ITEM_NOT_USABLE {
+			t.Errorf("Expected ITEM_NOT_USABLE, got %v", resp.Code)
+		}
+	})
+
+	t.Run("经验道具失败后重试只发放一次", func(t *testing.T) {
+		granter.fail = true
+		resp, _ := m.UseItem(ctx, &item.UseItemReq{ItemUniqueId: expItemId, Count: 2, IdempotentId: "use_exp"})
+		if resp.Code != common.ErrorCode_ITEM_USE_FAILED {
+			t.Fatalf("Expected ITEM_USE_FAILED, got %v", resp.Code)
+		}
+		if count := m.rdb.HGet(ctx, m.getUserKey(userId)+expItemId, "count").Val(); count != "1" {
+			t.Fatalf("Item should be consumed before effect, count: %s", count)
+		}
+
+		granter.fail = false
+		for i := 0; i < 2; i++ {
+			resp, _ = m.UseItem(ctx, &item.UseItemReq{ItemUniqueId: expItemId, Count: 2, IdempotentId: "use_exp"})
+			if resp.Code != common.ErrorCode_OK || resp.Data.AddExp != 200 {
+				t.Fatalf("Retry failed: %v, %v", resp.Code, resp.Data)
+			}
+		}
+		if count := m.rdb.HGet(ctx, m.getUserKey(userId)+expItemId, "count").Val(); count != "1" {
+			t.Errorf("Retry should not consume again, count: %s", count)
+		}
+		if len(granter.granted) != 1 || granter.granted["use_exp:exp"] != 200 {
+			t.Errorf("Unexpected granted exp: %v", granter.granted)
+		}
+	})
+
+	t.Run("开宝箱重试得到相同的道具", func(t *testing.T) {
+		resp, _ := m.UseItem(ctx, &item.UseItemReq{ItemUniqueId: "11", Count: 2, IdempotentId: "use_box"})
+		if resp.Code != common.ErrorCode_OK {
+			t.Fatalf("UseItem failed: %v, %s", resp.Code, resp.Msg)
+		}
+		granted := int32(0)
+		for _, info := range resp.Data.ItemInfoList {
+			granted += info.Count
+		}
+		if len(resp.Data.ItemInfoList) == 0 || granted == 0 {
+			t.Fatalf("Expected granted items, got %v", resp.Data.ItemInfoList)
+		}
+
+		retry, _ := m.UseItem(ctx, &item.UseItemReq{ItemUniqueId: "11", Count: 2, IdempotentId: "use_box"})
+		if retry.Code != common.ErrorCode_OK || len(retry.Data.ItemInfoList) != len(resp.Data.ItemInfoList) {
+			t.Fatalf("Retry returned different result: %v", retry.Data)
+		}
+		for i, info := range retry.Data.ItemInfoList {
+			if info.ItemUniqueId != resp.Data.ItemInfoList[i].ItemUniqueId || info.Count != resp.Data.ItemInfoList[i].Count {
+				t.Errorf("Retry granted different item: %v, want %v", info, resp.Data.ItemInfoList[i])
+			}
+		}
+	})
+
+	t.Run("皮肤兑换券不能重复解锁", func(t *testing.T) {
+		skins, _ := m.GetSkins(ctx, &item.GetSkinsReq{})
+		if skins.Code != common.ErrorCode_OK || len(skins.Data.SkinIdList) != 0 {
+			t.Fatalf("Expected no skins before use, got %v, %v", skins.Code, skins.Data)
+		}
+
+		resp, _ := m.UseItem(ctx, &item.UseItemReq{ItemUniqueId: "12", Count: 1, IdempotentId: "use_skin"})
+		if resp.Code != common.ErrorCode_OK || len(resp.Data.SkinIdList) != 1 || resp.Data.SkinIdList[0] != 101 {
+			t.Fatalf("UseItem failed: %v, %v", resp.Code, resp.Data)
+		}
+		// 解锁的皮肤可以通过GetSkins查询
+		skins, _ = m.GetSkins(ctx, &item.GetSkinsReq{})
+		if skins.Code != common.ErrorCode_OK || len(skins.Data.SkinIdList) != 1 || skins.Data.SkinIdList[0] != 101 {
+			t.Fatalf("GetSkins after use: %v, %v", skins.Code, skins.Data)
+		}
+		resp, _ = m.UseItem(ctx, &item.UseItemReq{ItemUniqueId: "12", Count: 1, IdempotentId: "use_skin_again"})
+		if resp.Code != common.ErrorCode_ITEM_NOT_USABLE {
+			t.Errorf("Expected ITEM_NOT_USABLE for unlocked skin, got %v", resp.Code)
+		}
+		if count := m.rdb.HGet(ctx, m.getUserKey(userId)+"12", "count").Val(); count != "1" {
+			t.Errorf("Rejected use should not consume voucher, count: %s", count)
+		}
+	})
+
+	t.Run("并发使用皮肤兑换券只消耗一张", func(t *testing.T) {
+		userKey := m.getUserKey(userId)
+		m.rdb.Del(ctx, userKey+SKIN_KEY_SUFFIX)
+		m.rdb.HSet(ctx, userKey+"12", "count", 2)
+
+		var wg sync.WaitGroup
+		codes := make([]common.ErrorCode, 4)
+		for i := range codes {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				resp, _ := m.UseItem(ctx, &item.UseItemReq{ItemUniqueId: "12", Count: 1, IdempotentId: fmt.Sprintf("use_skin_race_%d", i)})
+				codes[i] = resp.Code
+			}(i)
+		}
+		wg.Wait()
+
+		ok := 0
+		for _, code := range codes {
+			if code == common.ErrorCode_OK {
+				ok++
+			}
+		}
+		if ok != 1 {
+			t.Errorf("Expected exactly one successful use, got codes %v", codes)
+		}
+		if count := m.rdb.HGet(ctx, userKey+"12", "count").Val(); count != "1" {
+			t.Errorf("Expected one voucher consumed, count: %s", count)
+		}
+	})
+}
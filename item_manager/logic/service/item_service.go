@@ -7,6 +7,9 @@ import (
 	"item_manager/etcd"
 	item_http "item_manager/http"
 	"item_manager/kitex_gen/item"
+	"item_manager/kitex_gen/item_admin"
+	"item_manager/kitex_gen/item_admin_service/itemadminservice"
+	"item_manager/kitex_gen/item_internal_service/iteminternalservice"
 	"item_manager/kitex_gen/item_service/itemservice"
 	"item_manager/logic/manager"
 	"item_manager/rpc_middleware"
@@ -80,6 +83,10 @@ func (s *ItemService) ListenAndServe(ctx context.Context) {
 
 	ser := NewKitexServer()
 	itemservice.RegisterService(ser, s)
+	// 运维服务与玩家服务共用端口，仅供客服、运维工具调用，不经过网关和HTTP路由
+	itemadminservice.RegisterService(ser, s)
+	// 内部服务仅供拍卖行等后端服务调用，HTTP路由只分发ItemService的方法
+	iteminternalservice.RegisterService(ser, s)
 
 	go func() {
 		if err := ser.Run(); err != nil {
@@ -127,3 +134,39 @@ func (x *ItemService) GetItem(ctx context.Context, req *item.GetItemReq) (resp *
 func (x *ItemService) DeleteItemById(ctx context.Context, req *item.DeleteItemByIdReq) (resp *item.DeleteItemByIdRsp, err error) {
 	return manager.GetItemManager().DeleteItemById(ctx, req)
 }
+
+func (x *ItemService) RestoreItems(ctx context.Context, req *item.RestoreItemsReq) (resp *item.RestoreItemsRsp, err error) {
+	return manager.GetItemManager().RestoreItems(ctx, req)
+}
+
+func (x *ItemService) TransferItems(ctx context.Context, req *item.TransferItemsReq) (resp *item.TransferItemsRsp, err error) {
+	return manager.GetItemManager().TransferItems(ctx, req)
+}
+
+func (x *ItemService) GetItemLedger(ctx context.Context, req *item.GetItemLedgerReq) (resp *item.GetItemLedgerRsp, err error) {
+	return manager.GetItemManager().GetItemLedger(ctx, req)
+}
+
+func (x *ItemService) AdminGetItemLedger(ctx context.Context, req *item_admin.AdminGetItemLedgerReq) (resp *item_admin.AdminGetItemLedgerRsp, err error) {
+	return manager.GetItemManager().AdminGetItemLedger(ctx, req)
+}
+
+func (x *ItemService) UseItem(ctx context.Context, req *item.UseItemReq) (resp *item.UseItemRsp, err error) {
+	return manager.GetItemManager().UseItem(ctx, req)
+}
+
+func (x *ItemService) GrantRewards(ctx context.Context, req *item.GrantRewardsReq) (resp *item.GrantRewardsRsp, err error) {
+	return manager.GetItemManager().GrantRewards(ctx, req)
+}
+
+func (x *ItemService) GetMailbox(ctx context.Context, req *item.GetMailboxReq) (resp *item.GetMailboxRsp, err error) {
+	return manager.GetItemManager().GetMailbox(ctx, req)
+}
+
+func (x *ItemService) ClaimMailbox(ctx context.Context, req *item.ClaimMailboxReq) (resp *item.ClaimMailboxRsp, err error) {
+	return manager.GetItemManager().ClaimMailbox(ctx, req)
+}
+
+func (x *ItemService) GetSkins(ctx context.Context, req *item.GetSkinsReq) (resp *item.GetSkinsRsp, err error) {
+	return manager.GetItemManager().GetSkins(ctx, req)
+}
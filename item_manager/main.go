@@ -6,8 +6,11 @@ import (
 	"os/signal"
 	"syscall"
 	common_config "item_manager/config"
+	"item_manager/logic/manager"
 	"item_manager/logic/service"
 	common_redis "item_manager/redis"
+	"item_manager/redis/script"
+	"item_manager/rpc"
 	"item_manager/tracer"
 
 	"github.com/cloudwego/kitex/pkg/klog"
@@ -21,7 +24,20 @@ func main() {
 	defer cancel()
 	common_config.LoadConfig()
 	tracer.InitTracer(common_config.Get("item_rpc.service_name").(string), common_config.Get("tracer.address").(string))
-	common_redis.GetRedis()
+	if err := script.Load(ctx, common_redis.GetRedis()); err != nil {
+		panic(err)
+	}
+	if err := rpc.InitGateWayClient(); err != nil {
+		panic(err)
+	}
+	if err := rpc.InitHomepageClient(); err != nil {
+		panic(err)
+	}
+	// 启动时加载并校验道具配置，配置错误直接退出
+	manager.GetItemManager()
+	go manager.GetItemManager().RunLedgerArchiver(ctx)
+	go manager.GetItemManager().RunExpirySweeper(ctx)
+	go manager.GetItemManager().RunTransferRecovery(ctx)
 	service.GetItemService().ListenAndServe(ctx)
 
 	quit := make(chan os.Signal, 1)
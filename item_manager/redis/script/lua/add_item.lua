@@ -0,0 +1,174 @@
+-- version: 6
+-- 批量添加道具：先按背包分类容量和道具堆叠上限规划入包数量，再写入；
+-- 超出部分按分类配置整单拒绝或转入邮箱，成功结果按幂等键缓存。
+-- 限时道具写入expire_at并登记到用户的过期索引（ZSET），由过期扫描移除；非唯一的限时道具按过期时间分桶
+-- （唯一id为 道具id@过期时间），只与过期时间相同的实例叠加，叠加不改变实例的过期时间。写入前先移除调用方已到期的道具
+-- 邮箱为hash（field为邮件id，value为道具信息JSON），邮件以mail:<邮件id>登记到过期索引，领取截止后由过期扫描移除
+-- KEYS[1] 用户道具前缀，KEYS[2] 幂等键
+-- ARGV[1] 道具列表JSON，ARGV[2] 背包分类配置JSON，ARGV[3] 当前时间戳，ARGV[4] 流水公共字段JSON，ARGV[5] 邮箱保留时长（秒），
+-- ARGV[6] 过期移除流水的公共字段JSON
+-- include: expire_due
+local user_key = KEYS[1]
+local idempotent_key = KEYS[2]
+local item_data = cjson.decode(ARGV[1])
+local bags = cjson.decode(ARGV[2])
+local now = tonumber(ARGV[3])
+local ledger_meta = cjson.decode(ARGV[4])
+local mailbox_retention = tonumber(ARGV[5])
+
+local cached_result = redis.call('get', idempotent_key)
+if cached_result then
+	return cached_result
+end
+
+-- 过期实例不能占用格子；移除结果不缓存，只在本次返回中用于通知
+local expired = expire_due(user_key, now, cjson.decode(ARGV[6]))
+
+local user_items_set_key = user_key .. 'items'
+local mailbox_key = user_key .. 'mailbox'
+local expiry_key = user_key .. 'expiry'
+
+-- 写入道具流水（用户道具前缀下的Redis Stream），与道具变化在同一脚本内原子完成；mailbox为1时是邮箱中的变化
+local function ledger(user_key, item_id, item_unique_id, delta, balance, mailbox)
+	redis.call('xadd', user_key .. 'ledger', '*',
+		'item_id', item_id,
+		'item_unique_id', item_unique_id,
+		'delta', delta,
+		'balance', balance,
+		'reason', ledger_meta.reason,
+		'source', ledger_meta.source,
+		'idempotent_id', ledger_meta.idempotent_id,
+		'trace_id', ledger_meta.trace_id,
+		'mailbox', mailbox or 0)
+end
+
+-- 统计各分类已占用的格子数，未记录分类的旧道具不占用容量
+local used = {}
+local item_ids = redis.call('smembers', user_items_set_key)
+for i, item_id in ipairs(item_ids) do
+	local category = redis.call('hget', user_key .. item_id, 'category')
+	if category then
+		used[category] = (used[category] or 0) + 1
+	end
+end
+
+-- 第一阶段：规划每个道具的入包数量与溢出数量，不修改任何数据
+local planned = {}
+local adds = {}
+local mailbox = {}
+for i, item in ipairs(item_data) do
+	local item_key = user_key .. item.item_unique_id
+	local bag = bags[item.category]
+	item.expire_at = item.expire_at or 0
+
+	local current = planned[item.item_unique_id]
+	if current == nil and redis.call('exists', item_key) == 1 then
+		current = tonumber(redis.call('hget', item_key, 'count'))
+	end
+	local error_msg = 'stack limit exceeded'
+	if current == nil then
+		-- 新实例需要占用一个格子
+		if bag.capacity > 0 and (used[item.category] or 0) >= bag.capacity then
+			error_msg = 'bag full'
+		else
+			used[item.category] = (used[item.category] or 0) + 1
+			current = 0
+		end
+	end
+
+	local accept = 0
+	if current ~= nil then
+		accept = item.count
+		if item.max_stack > 0 and current + accept > item.max_stack then
+			accept = math.max(item.max_stack - current, 0)
+		end
+		planned[item.item_unique_id] = current + accept
+	end
+
+	local overflow = item.count - accept
+	if overflow > 0 then
+		if bag.overflow ~= 'mail' then
+			-- 拒绝结果不缓存，腾出空间后可使用同一幂等id重试
+			return cjson.encode({success = false, error = error_msg, expired = expired})
+		end
+		table.insert(mailbox, {item = item, count = overflow})
+	end
+	if accept > 0 then
+		table.insert(adds, {item = item, count = accept})
+	end
+end
+
+-- 第二阶段：写入道具，已存在的道具累加数量，不存在的创建
+local results = {}
+for i, add in ipairs(adds) do
+	local item = add.item
+	local item_key = user_key .. item.item_unique_id
+
+	if redis.call('exists', item_key) == 1 then
+		local new_count = redis.call('hincrby', item_key, 'count', add.count)
+		ledger(user_key, item.item_id, item.item_unique_id, add.count, new_count)
+		table.insert(results, {
+			item_id = tonumber(redis.call('hget', item_key, 'item_id')),
+			item_unique_id = redis.call('hget', item_key, 'item_unique_id'),
+			item_type = tonumber(redis.call('hget', item_key, 'item_type')),
+			properties = redis.call('hget', item_key, 'properties'),
+			count = new_count,
+			expire_at = tonumber(redis.call('hget', item_key, 'expire_at') or 0)
+		})
+	else
+		redis.call('hset', item_key,
+			'item_id', item.item_id,
+			'item_unique_id', item.item_unique_id,
+			'item_type', item.item_type,
+			'properties', item.properties,
+			'category', item.category,
+			'count', add.count)
+		redis.call('sadd', user_items_set_key, item.item_unique_id)
+		if item.expire_at > 0 then
+			redis.call('hset', item_key, 'expire_at', item.expire_at)
+			redis.call('zadd', expiry_key, item.expire_at, item.item_unique_id)
+		end
+		ledger(user_key, item.item_id, item.item_unique_id, add.count, add.count)
+		table.insert(results, {
+			item_id = item.item_id,
+			item_unique_id = item.item_unique_id,
+			item_type = item.item_type,
+			properties = item.properties,
+			count = add.count,
+			expire_at = item.expire_at
+		})
+	end
+end
+
+-- 溢出的道具转入邮箱，由claim_mailbox领取；领取截止时间不晚于道具本身的过期时间
+local mails = {}
+for i, mail in ipairs(mailbox) do
+	local item = mail.item
+	local mail_id = tostring(redis.call('incr', mailbox_key .. ':seq'))
+	local deadline = now + mailbox_retention
+	if item.expire_at > 0 and item.expire_at < deadline then
+		deadline = item.expire_at
+	end
+	redis.call('hset', mailbox_key, mail_id, cjson.encode({
+		mail_id = mail_id,
+		item_id = item.item_id,
+		item_unique_id = item.item_unique_id,
+		item_type = item.item_type,
+		properties = item.properties,
+		category = item.category,
+		max_stack = item.max_stack,
+		expire_at = item.expire_at,
+		count = mail.count,
+		reason = ledger_meta.reason,
+		time = now,
+		deadline = deadline
+	}))
+	redis.call('zadd', expiry_key, deadline, 'mail:' .. mail_id)
+	ledger(user_key, item.item_id, item.item_unique_id, mail.count, mail.count, 1)
+	table.insert(mails, {mail_id = mail_id, item_id = item.item_id, count = mail.count})
+end
+
+local result = {success = true, results = results, mailbox = mails}
+redis.call('set', idempotent_key, cjson.encode(result), 'EX', 604800)
+result.expired = expired
+return cjson.encode(result)
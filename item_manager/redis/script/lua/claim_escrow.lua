@@ -0,0 +1,24 @@
+-- version: 1
+-- 写回托管的唯一道具实例前认领托管记录：记录必须存在且道具id一致，一条记录只能被一次写回（幂等id）认领，
+-- 同一幂等id重试时再次认领成功。返回托管时的道具属性，写回以此为准
+-- KEYS[1] 托管记录键，ARGV[1] 道具id，ARGV[2] 写回的幂等id
+local escrow_key = KEYS[1]
+
+if redis.call('exists', escrow_key) == 0 then
+	return cjson.encode({success = false, error = 'escrow not found'})
+end
+if redis.call('hget', escrow_key, 'item_id') ~= ARGV[1] then
+	return cjson.encode({success = false, error = 'escrow item mismatch'})
+end
+local restore_id = redis.call('hget', escrow_key, 'restore_id')
+if restore_id and restore_id ~= ARGV[2] then
+	return cjson.encode({success = false, error = 'escrow already restored'})
+end
+redis.call('hset', escrow_key, 'restore_id', ARGV[2])
+
+return cjson.encode({
+	success = true,
+	item_type = tonumber(redis.call('hget', escrow_key, 'item_type')),
+	properties = redis.call('hget', escrow_key, 'properties'),
+	expire_at = tonumber(redis.call('hget', escrow_key, 'expire_at'))
+})
@@ -0,0 +1,135 @@
+-- version: 2
+-- 领取邮箱中的道具：按邮件id顺序放入背包，受背包分类容量和堆叠上限限制，放不下的部分留在邮箱并更新剩余数量。
+-- 领取前先移除调用方已到期的道具和邮件。背包和邮箱的变化各记一条流水，成功结果按幂等键缓存
+-- KEYS[1] 用户道具前缀，KEYS[2] 幂等键
+-- ARGV[1] 邮件id列表JSON（空列表表示全部），ARGV[2] 背包分类配置JSON，ARGV[3] 当前时间戳，ARGV[4] 流水公共字段JSON，
+-- ARGV[5] 过期移除流水的公共字段JSON
+-- include: expire_due
+local user_key = KEYS[1]
+local idempotent_key = KEYS[2]
+local mail_ids = cjson.decode(ARGV[1])
+local bags = cjson.decode(ARGV[2])
+local now = tonumber(ARGV[3])
+local ledger_meta = cjson.decode(ARGV[4])
+
+local cached_result = redis.call('get', idempotent_key)
+if cached_result then
+	return cached_result
+end
+
+local expired = expire_due(user_key, now, cjson.decode(ARGV[5]))
+
+local user_items_set_key = user_key .. 'items'
+local mailbox_key = user_key .. 'mailbox'
+local expiry_key = user_key .. 'expiry'
+
+local function ledger(item_id, item_unique_id, delta, balance, mailbox)
+	redis.call('xadd', user_key .. 'ledger', '*',
+		'item_id', item_id,
+		'item_unique_id', item_unique_id,
+		'delta', delta,
+		'balance', balance,
+		'reason', ledger_meta.reason,
+		'source', ledger_meta.source,
+		'idempotent_id', ledger_meta.idempotent_id,
+		'trace_id', ledger_meta.trace_id,
+		'mailbox', mailbox)
+end
+
+-- 统计各分类已占用的格子数，未记录分类的旧道具不占用容量
+local used = {}
+for i, item_id in ipairs(redis.call('smembers', user_items_set_key)) do
+	local category = redis.call('hget', user_key .. item_id, 'category')
+	if category then
+		used[category] = (used[category] or 0) + 1
+	end
+end
+
+if #mail_ids == 0 then
+	mail_ids = redis.call('hkeys', mailbox_key)
+end
+table.sort(mail_ids, function(a, b) return tonumber(a) < tonumber(b) end)
+
+local claimed = {}
+local claimed_order = {}
+local remaining = {}
+for i, mail_id in ipairs(mail_ids) do
+	local raw = redis.call('hget', mailbox_key, mail_id)
+	local mail = raw and cjson.decode(raw)
+	if mail then
+		local item_key = user_key .. mail.item_unique_id
+		local current = nil
+		if redis.call('exists', item_key) == 1 then
+			current = tonumber(redis.call('hget', item_key, 'count'))
+		elseif bags[mail.category].capacity == 0 or (used[mail.category] or 0) < bags[mail.category].capacity then
+			current = 0
+		end
+
+		local accept = 0
+		if current ~= nil then
+			accept = mail.count
+			if mail.max_stack > 0 and current + accept > mail.max_stack then
+				accept = math.max(mail.max_stack - current, 0)
+			end
+		end
+
+		if accept > 0 then
+			local balance
+			if current == 0 then
+				redis.call('hset', item_key,
+					'item_id', mail.item_id,
+					'item_unique_id', mail.item_unique_id,
+					'item_type', mail.item_type,
+					'properties', mail.properties,
+					'category', mail.category,
+					'count', accept)
+				redis.call('sadd', user_items_set_key, mail.item_unique_id)
+				if mail.expire_at > 0 then
+					redis.call('hset', item_key, 'expire_at', mail.expire_at)
+					redis.call('zadd', expiry_key, mail.expire_at, mail.item_unique_id)
+				end
+				used[mail.category] = (used[mail.category] or 0) + 1
+				balance = accept
+			else
+				-- 邮件中的道具与背包中同一唯一id的实例过期时间相同，直接叠加
+				balance = redis.call('hincrby', item_key, 'count', accept)
+			end
+			mail.count = mail.count - accept
+			ledger(mail.item_id, mail.item_unique_id, accept, balance, 0)
+			ledger(mail.item_id, mail.item_unique_id, -accept, mail.count, 1)
+			if not claimed[mail.item_unique_id] then
+				claimed[mail.item_unique_id] = true
+				table.insert(claimed_order, mail.item_unique_id)
+			end
+		end
+
+		if mail.count == 0 then
+			redis.call('hdel', mailbox_key, mail_id)
+			redis.call('zrem', expiry_key, 'mail:' .. mail_id)
+		else
+			if accept > 0 then
+				redis.call('hset', mailbox_key, mail_id, cjson.encode(mail))
+			end
+			table.insert(remaining, mail)
+		end
+	end
+end
+
+-- 返回领取后背包中的道具实例
+local results = {}
+for i, item_unique_id in ipairs(claimed_order) do
+	local item_key = user_key .. item_unique_id
+	table.insert(results, {
+		item_id = tonumber(redis.call('hget', item_key, 'item_id')),
+		item_unique_id = item_unique_id,
+		item_type = tonumber(redis.call('hget', item_key, 'item_type')),
+		properties = redis.call('hget', item_key, 'properties'),
+		count = tonumber(redis.call('hget', item_key, 'count')),
+		expire_at = tonumber(redis.call('hget', item_key, 'expire_at') or 0)
+	})
+end
+
+local result = {success = true, results = results, mails = remaining}
+redis.call('set', idempotent_key, cjson.encode(result), 'EX', 604800)
+result.expired = expired
+return cjson.encode(result)
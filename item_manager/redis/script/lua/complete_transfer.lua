@@ -0,0 +1,17 @@
+-- version: 1
+-- 转移道具最后一步：清除转出方的待转入记录，并把最终结果写入幂等键，之后的重试直接返回该结果。
+-- 记录已被清除时说明转移已由其他协程完成，返回已缓存的结果
+-- KEYS[1] 转出用户道具前缀，KEYS[2] 幂等键
+-- ARGV[1] 最终结果JSON
+local transfer_out_key = KEYS[1] .. 'transfer_out'
+local idempotent_key = KEYS[2]
+
+if redis.call('hdel', transfer_out_key, idempotent_key) == 0 then
+	local cached_result = redis.call('get', idempotent_key)
+	if cached_result then
+		return cached_result
+	end
+end
+
+redis.call('set', idempotent_key, ARGV[1], 'EX', 604800)
+return ARGV[1]
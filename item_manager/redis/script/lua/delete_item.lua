@@ -0,0 +1,178 @@
+-- version: 6
+-- 批量扣除道具：先检查全部数量是否足够再扣除，结果按幂等键缓存
+-- 已过期未移除的道具视为不存在；用于交易托管时按实例属性检查道具可交易且未绑定，
+-- 托管的唯一道具实例写入托管记录（用户道具前缀 + escrow:唯一id），写回时以托管记录为准。
+-- 扣除项指定item_id时按道具id扣除非唯一道具：从最早过期的分桶（道具id@过期时间）开始，最后扣除永久实例
+-- KEYS[1] 用户道具前缀，KEYS[2] 幂等键，ARGV[1] 扣除列表JSON，ARGV[2] 流水公共字段JSON，ARGV[3] 当前时间戳，ARGV[4] 是否用于交易托管（1/0）
+local user_key = KEYS[1]
+local idempotent_key = KEYS[2]
+local delete_data = cjson.decode(ARGV[1])
+local ledger_meta = cjson.decode(ARGV[2])
+local now = tonumber(ARGV[3])
+local for_trade = ARGV[4] == '1'
+
+local cached_result = redis.call('get', idempotent_key)
+if cached_result then
+	return cached_result
+end
+
+local user_items_set_key = user_key .. 'items'
+
+-- 写入道具流水，与扣除在同一脚本内完成
+local function ledger(user_key, item_id, item_unique_id, delta, balance)
+	redis.call('xadd', user_key .. 'ledger', '*',
+		'item_id', item_id,
+		'item_unique_id', item_unique_id,
+		'delta', delta,
+		'balance', balance,
+		'reason', ledger_meta.reason,
+		'source', ledger_meta.source,
+		'idempotent_id', ledger_meta.idempotent_id,
+		'trace_id', ledger_meta.trace_id)
+end
+
+-- 实例属性JSON，缺失或不是对象时按空属性处理
+local function item_properties(item_key)
+	local ok, properties = pcall(cjson.decode, redis.call('hget', item_key, 'properties') or '{}')
+	if ok and type(properties) == 'table' then
+		return properties
+	end
+	return {}
+end
+
+-- 唯一道具实例的唯一id不是道具id，也不是限时分桶（道具id@过期时间）
+local function is_unique_instance(item_id, item_unique_id)
+	return item_unique_id ~= item_id and not string.find(item_unique_id, '@', 1, true)
+end
+
+-- 保存托管记录，同一实例再次托管时覆盖上一次（已写回）的记录
+local function save_escrow(item_key, item_id, item_unique_id)
+	local escrow_key = user_key .. 'escrow:' .. item_unique_id
+	redis.call('del', escrow_key)
+	redis.call('hset', escrow_key,
+		'item_id', item_id,
+		'item_type', redis.call('hget', item_key, 'item_type'),
+		'properties', redis.call('hget', item_key, 'properties'),
+		'expire_at', redis.call('hget', item_key, 'expire_at') or 0,
+		'escrow_id', ledger_meta.idempotent_id)
+end
+
+-- 按道具id扣除的项展开为各实例的扣除，返回展开结果和可扣除的总数
+local function expand_by_item_id(item_id, count)
+	local bucket_prefix = item_id .. '@'
+	local instances = {}
+	for i, item_unique_id in ipairs(redis.call('smembers', user_items_set_key)) do
+		if item_unique_id == item_id or string.sub(item_unique_id, 1, #bucket_prefix) == bucket_prefix then
+			local expire_at = tonumber(redis.call('hget', user_key .. item_unique_id, 'expire_at') or 0)
+			if expire_at == 0 or expire_at > now then
+				table.insert(instances, {item_unique_id = item_unique_id, expire_at = expire_at})
+			end
+		end
+	end
+	table.sort(instances, function(a, b)
+		if a.expire_at == 0 or b.expire_at == 0 then
+			return b.expire_at == 0 and a.expire_at ~= 0
+		end
+		return a.expire_at < b.expire_at
+	end)
+
+	local expanded = {}
+	local available = 0
+	for i, instance in ipairs(instances) do
+		local instance_count = tonumber(redis.call('hget', user_key .. instance.item_unique_id, 'count'))
+		local take = math.min(count - available, instance_count)
+		if take > 0 then
+			table.insert(expanded, {item_unique_id = instance.item_unique_id, count = take})
+		end
+		available = available + instance_count
+	end
+	return expanded, available
+end
+
+local expanded_data = {}
+for i, delete_item in ipairs(delete_data) do
+	if delete_item.item_id then
+		local count = tonumber(delete_item.count)
+		local expanded, available = expand_by_item_id(tostring(delete_item.item_id), count)
+		if available < count then
+			local error_msg = 'delete count exceeds available count'
+			if available == 0 then
+				error_msg = 'item not found'
+			end
+			local result_json = cjson.encode({success = false, error = error_msg})
+			redis.call('set', idempotent_key, result_json, 'EX', 604800)
+			return result_json
+		end
+		for j, instance in ipairs(expanded) do
+			table.insert(expanded_data, instance)
+		end
+	else
+		table.insert(expanded_data, delete_item)
+	end
+end
+delete_data = expanded_data
+
+-- 第一阶段：检查所有道具数量是否足够
+for i, delete_item in ipairs(delete_data) do
+	local item_unique_id = delete_item.item_unique_id
+	local delete_count = tonumber(delete_item.count)
+	local item_key = user_key .. item_unique_id
+
+	local exists = redis.call('exists', item_key)
+	local expire_at = tonumber(redis.call('hget', item_key, 'expire_at') or 0)
+	if exists == 1 and (expire_at == 0 or expire_at > now) then
+		local current_count = tonumber(redis.call('hget', item_key, 'count'))
+		local properties = item_properties(item_key)
+
+		if for_trade and (properties.bindable == true or properties.tradable == false) then
+			local error_msg = 'item not tradable'
+			if properties.bindable == true then
+				error_msg = 'item bound'
+			end
+			local result_json = cjson.encode({success = false, error = error_msg})
+			redis.call('set', idempotent_key, result_json, 'EX', 604800)
+			return result_json
+		end
+		if delete_count > current_count then
+			-- 删除数量大于现有数量，删除失败
+			local result_json = cjson.encode({success = false, error = 'delete count exceeds available count'})
+			redis.call('set', idempotent_key, result_json, 'EX', 604800)
+			return result_json
+		end
+	else
+		-- 道具不存在，删除失败
+		local result_json = cjson.encode({success = false, error = 'item not found'})
+		redis.call('set', idempotent_key, result_json, 'EX', 604800)
+		return result_json
+	end
+end
+
+-- 第二阶段：执行删除操作
+for i, delete_item in ipairs(delete_data) do
+	local item_unique_id = delete_item.item_unique_id
+	local delete_count = tonumber(delete_item.count)
+	local item_key = user_key .. item_unique_id
+
+	local current_count = tonumber(redis.call('hget', item_key, 'count'))
+	local item_id = redis.call('hget', item_key, 'item_id')
+	ledger(user_key, item_id, item_unique_id, -delete_count, current_count - delete_count)
+
+	if delete_count == current_count then
+		-- 删除数量等于现有数量，删除整个道具
+		if for_trade and is_unique_instance(item_id, item_unique_id) then
+			save_escrow(item_key, item_id, item_unique_id)
+		end
+		redis.call('del', item_key)
+		redis.call('srem', user_items_set_key, item_unique_id)
+		redis.call('zrem', user_key .. 'expiry', item_unique_id)
+	else
+		-- 删除数量小于现有数量，减少数量
+		local new_count = current_count - delete_count
+		redis.call('hset', item_key, 'count', new_count)
+	end
+end
+
+local result_json = cjson.encode({success = true})
+redis.call('set', idempotent_key, result_json, 'EX', 604800)
+return result_json
+
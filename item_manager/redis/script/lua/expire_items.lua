@@ -0,0 +1,8 @@
+-- version: 3
+-- 过期扫描：移除用户已到期的限时道具和超过领取截止时间的邮件，返回被移除的道具和邮件。
+-- 修改背包的脚本执行前也通过同一段逻辑移除调用方的到期项
+-- KEYS[1] 用户道具前缀，ARGV[1] 当前时间戳，ARGV[2] 流水公共字段JSON，ARGV[3] 本次最多处理的实例数
+-- include: expire_due
+
+local expired = expire_due(KEYS[1], tonumber(ARGV[1]), cjson.decode(ARGV[2]), tonumber(ARGV[3]))
+return cjson.encode({success = true, results = expired.results, mails = expired.mails, more = expired.more})
@@ -0,0 +1,7 @@
+-- version: 1
+-- 续期后台任务的Redis锁：仍由调用方持有时重置过期时间，返回1；锁已过期或被其他实例持有时返回0
+-- KEYS[1] 锁key，ARGV[1] 持有者token，ARGV[2] 过期时间（毫秒）
+if redis.call('get', KEYS[1]) == ARGV[1] then
+	return redis.call('pexpire', KEYS[1], ARGV[2])
+end
+return 0
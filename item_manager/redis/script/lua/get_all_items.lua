@@ -0,0 +1,27 @@
+-- version: 2
+-- 获取用户的所有道具，已过期未移除的道具不返回
+-- KEYS[1] 用户道具前缀，ARGV[1] 当前时间戳
+local user_key = KEYS[1]
+local user_items_set_key = user_key .. 'items'
+local results = {}
+local now = tonumber(ARGV[1])
+
+local item_ids = redis.call('smembers', user_items_set_key)
+for i, item_id in ipairs(item_ids) do
+	local item_key = user_key .. item_id
+	local exists = redis.call('exists', item_key)
+	if exists == 1 then
+		local item_data = redis.call('hgetall', item_key)
+		local item = {}
+		for j = 1, #item_data, 2 do
+			item[item_data[j]] = item_data[j+1]
+		end
+		local expire_at = tonumber(item.expire_at or 0)
+		if expire_at == 0 or expire_at > now then
+			table.insert(results, item)
+		end
+	end
+end
+
+return cjson.encode({success = true, results = results})
+
@@ -0,0 +1,68 @@
+-- 移除用户已到期的限时道具：按过期索引取出到期的实例，确认实例上的过期时间后删除并记录流水。
+-- 过期索引中mail:<邮件id>为邮箱中的道具，超过领取截止时间后从邮箱移除并记录邮箱流水。
+-- limit为nil时处理全部到期项；返回 {results = 移除的道具, mails = 移除的邮件, more = 是否还有未处理的到期项}
+local function expire_due(user_key, now, expire_meta, limit)
+	local expiry_key = user_key .. 'expiry'
+	local mailbox_key = user_key .. 'mailbox'
+
+	local function expire_ledger(item_id, item_unique_id, delta, mailbox)
+		redis.call('xadd', user_key .. 'ledger', '*',
+			'item_id', item_id,
+			'item_unique_id', item_unique_id,
+			'delta', delta,
+			'balance', 0,
+			'reason', expire_meta.reason,
+			'source', expire_meta.source,
+			'idempotent_id', expire_meta.idempotent_id,
+			'trace_id', expire_meta.trace_id,
+			'mailbox', mailbox)
+	end
+
+	local due
+	if limit then
+		due = redis.call('zrangebyscore', expiry_key, '-inf', now, 'LIMIT', 0, limit)
+	else
+		due = redis.call('zrangebyscore', expiry_key, '-inf', now)
+	end
+
+	local results = {}
+	local mails = {}
+	for i, item_unique_id in ipairs(due) do
+		local item_key = user_key .. item_unique_id
+		local expire_at = tonumber(redis.call('hget', item_key, 'expire_at') or 0)
+
+		if string.sub(item_unique_id, 1, 5) == 'mail:' then
+			local mail_id = string.sub(item_unique_id, 6)
+			local raw = redis.call('hget', mailbox_key, mail_id)
+			redis.call('zrem', expiry_key, item_unique_id)
+			if raw then
+				local mail = cjson.decode(raw)
+				redis.call('hdel', mailbox_key, mail_id)
+				expire_ledger(mail.item_id, mail.item_unique_id, -mail.count, 1)
+				table.insert(mails, mail)
+			end
+		elseif redis.call('exists', item_key) == 0 or expire_at == 0 then
+			-- 实例已删除，清理索引
+			redis.call('zrem', expiry_key, item_unique_id)
+		elseif expire_at > now then
+			-- 索引与实例不一致时以实例为准
+			redis.call('zadd', expiry_key, expire_at, item_unique_id)
+		else
+			local item = {
+				item_id = tonumber(redis.call('hget', item_key, 'item_id')),
+				item_unique_id = item_unique_id,
+				item_type = tonumber(redis.call('hget', item_key, 'item_type')),
+				properties = redis.call('hget', item_key, 'properties'),
+				count = tonumber(redis.call('hget', item_key, 'count')),
+				expire_at = expire_at
+			}
+			redis.call('del', item_key)
+			redis.call('srem', user_key .. 'items', item_unique_id)
+			redis.call('zrem', expiry_key, item_unique_id)
+			expire_ledger(item.item_id, item_unique_id, -item.count, 0)
+			table.insert(results, item)
+		end
+	end
+
+	return {results = results, mails = mails, more = limit ~= nil and #due == limit}
+end
@@ -0,0 +1,114 @@
+-- version: 3
+-- 按原唯一id与属性写回道具（拍卖撤单、成交交付唯一道具实例、转移道具的转入和退还），与添加道具使用相同的
+-- 背包容量和堆叠上限。写回模式：
+--   restore      超出时整单拒绝且不缓存，由调用方腾出空间后使用同一幂等id重试
+--   transfer_in  失败结果也缓存，同一次转移只会有一个转入结果，据此决定完成还是退还
+--   refund       退还转出方扣除的道具，不检查容量和堆叠上限（道具原本就在该用户背包中）
+-- 写回前先移除该用户已到期的道具，过期实例不占用格子；限时道具按原唯一id写回，只与过期时间相同的实例叠加
+-- KEYS[1] 用户道具前缀，KEYS[2] 幂等键
+-- ARGV[1] 道具列表JSON，ARGV[2] 背包分类配置JSON，ARGV[3] 流水公共字段JSON，ARGV[4] 写回模式，
+-- ARGV[5] 当前时间戳，ARGV[6] 过期移除流水的公共字段JSON
+-- include: expire_due
+local user_key = KEYS[1]
+local idempotent_key = KEYS[2]
+local item_data = cjson.decode(ARGV[1])
+local bags = cjson.decode(ARGV[2])
+local ledger_meta = cjson.decode(ARGV[3])
+local mode = ARGV[4]
+
+local cached_result = redis.call('get', idempotent_key)
+if cached_result then
+	return cached_result
+end
+
+local expired = expire_due(user_key, tonumber(ARGV[5]), cjson.decode(ARGV[6]))
+
+local user_items_set_key = user_key .. 'items'
+
+local function fail(error_msg, cache)
+	local result = {success = false, error = error_msg}
+	if cache or mode == 'transfer_in' then
+		redis.call('set', idempotent_key, cjson.encode(result), 'EX', 604800)
+	end
+	result.expired = expired
+	return cjson.encode(result)
+end
+
+-- 唯一道具实例不能重复存在，该结果重试也不会改变，缓存
+for i, item in ipairs(item_data) do
+	if item.is_unique == 1 and redis.call('exists', user_key .. item.item_unique_id) == 1 then
+		return fail('item already exists', true)
+	end
+end
+
+-- 统计各分类已占用的格子数
+local used = {}
+for i, item_id in ipairs(redis.call('smembers', user_items_set_key)) do
+	local category = redis.call('hget', user_key .. item_id, 'category')
+	if category then
+		used[category] = (used[category] or 0) + 1
+	end
+end
+
+-- 第一阶段：校验容量和堆叠上限，不修改任何数据
+local planned = {}
+for i, item in ipairs(item_data) do
+	local item_key = user_key .. item.item_unique_id
+	local bag = bags[item.category]
+	local current = planned[item.item_unique_id]
+	if current == nil and redis.call('exists', item_key) == 1 then
+		current = tonumber(redis.call('hget', item_key, 'count'))
+	end
+	if current == nil then
+		if mode ~= 'refund' and bag.capacity > 0 and (used[item.category] or 0) >= bag.capacity then
+			return fail('bag full')
+		end
+		used[item.category] = (used[item.category] or 0) + 1
+		current = 0
+	end
+	if mode ~= 'refund' and item.max_stack > 0 and current + item.count > item.max_stack then
+		return fail('stack limit exceeded')
+	end
+	planned[item.item_unique_id] = current + item.count
+end
+
+-- 第二阶段：写回道具，非唯一道具叠加数量，每个道具记一条流水
+local results = {}
+for i, item in ipairs(item_data) do
+	local item_key = user_key .. item.item_unique_id
+	local balance = item.count
+	if redis.call('exists', item_key) == 1 then
+		balance = redis.call('hincrby', item_key, 'count', item.count)
+	else
+		redis.call('hset', item_key,
+			'item_id', item.item_id,
+			'item_unique_id', item.item_unique_id,
+			'item_type', item.item_type,
+			'properties', item.properties,
+			'category', item.category,
+			'count', item.count)
+		redis.call('sadd', user_items_set_key, item.item_unique_id)
+		-- 限时道具保留原过期时间
+		if item.expire_at > 0 then
+			redis.call('hset', item_key, 'expire_at', item.expire_at)
+			redis.call('zadd', user_key .. 'expiry', item.expire_at, item.item_unique_id)
+		end
+	end
+	redis.call('xadd', user_key .. 'ledger', '*',
+		'item_id', item.item_id, 'item_unique_id', item.item_unique_id, 'delta', item.count, 'balance', balance,
+		'reason', ledger_meta.reason, 'source', ledger_meta.source,
+		'idempotent_id', ledger_meta.idempotent_id, 'trace_id', ledger_meta.trace_id)
+	table.insert(results, {
+		item_id = item.item_id,
+		item_unique_id = item.item_unique_id,
+		item_type = tonumber(redis.call('hget', item_key, 'item_type')),
+		properties = redis.call('hget', item_key, 'properties'),
+		count = balance,
+		expire_at = tonumber(redis.call('hget', item_key, 'expire_at') or 0)
+	})
+end
+
+local result = {success = true, results = results}
+redis.call('set', idempotent_key, cjson.encode(result), 'EX', 604800)
+result.expired = expired
+return cjson.encode(result)
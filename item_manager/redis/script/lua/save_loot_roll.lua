@@ -0,0 +1,28 @@
+-- version: 1
+-- 保存一次掉落抽取的结果并更新保底计数：抽取在服务内按读取到的计数完成，
+-- 计数在此期间被其他请求修改时拒绝保存，由服务重新读取后再抽取。结果按幂等键缓存，重试时直接返回
+-- KEYS[1] 用户保底计数hash，KEYS[2] 抽取结果幂等键
+-- ARGV[1] 抽取结果JSON，ARGV[2] 抽取前的计数JSON，ARGV[3] 抽取后的计数JSON
+local pity_key = KEYS[1]
+local roll_key = KEYS[2]
+
+local cached_result = redis.call('get', roll_key)
+if cached_result then
+	return cached_result
+end
+
+local before = cjson.decode(ARGV[2])
+for table_id, count in pairs(before) do
+	if tonumber(redis.call('hget', pity_key, table_id) or 0) ~= count then
+		return cjson.encode({success = false, error = 'pity changed'})
+	end
+end
+
+local after = cjson.decode(ARGV[3])
+for table_id, count in pairs(after) do
+	redis.call('hset', pity_key, table_id, count)
+end
+
+local result_json = cjson.encode({success = true, rewards = cjson.decode(ARGV[1])})
+redis.call('set', roll_key, result_json, 'EX', 604800)
+return result_json
@@ -0,0 +1,97 @@
+-- version: 6
+-- 转移道具第一步：在转出方的hash tag内扣除道具，并把扣出的道具实例记入转出方的待转入记录（transfer_out，field为幂等键）。
+-- 第二步由服务在转入方的hash tag内通过restore_items写入，失败时退还转出方，最后由complete_transfer清除记录并缓存最终结果。
+-- 每一步都只访问一个用户的key，可以在Redis Cluster上执行；进程在两步之间退出时由恢复协程按待转入记录继续。
+-- 扣除前先移除转出方已到期的道具，限时道具保留过期时间（唯一id中的过期分桶不变）；实例属性中标记绑定的道具不能转移
+-- KEYS[1] 转出用户道具前缀，KEYS[2] 幂等键
+-- ARGV[1] 转移列表JSON（唯一id不重复），ARGV[2] 转入用户id，ARGV[3] 流水公共字段JSON，ARGV[4] 当前时间戳，
+-- ARGV[5] 过期移除流水的公共字段JSON
+-- include: expire_due
+local from_key = KEYS[1]
+local idempotent_key = KEYS[2]
+local transfer_data = cjson.decode(ARGV[1])
+local ledger_meta = cjson.decode(ARGV[3])
+local now = tonumber(ARGV[4])
+
+-- 已扣除未完成时缓存的是待转入记录（pending = true），完成后是最终结果
+local cached_result = redis.call('get', idempotent_key)
+if cached_result then
+	return cached_result
+end
+
+local expired = expire_due(from_key, now, cjson.decode(ARGV[5]))
+
+local function fail(error_msg)
+	-- 失败结果不缓存，条件满足后可使用同一幂等id重试
+	return cjson.encode({success = false, error = error_msg, expired = expired})
+end
+
+-- 实例属性JSON，缺失或不是对象时按空属性处理
+local function item_properties(item_key)
+	local ok, properties = pcall(cjson.decode, redis.call('hget', item_key, 'properties') or '{}')
+	if ok and type(properties) == 'table' then
+		return properties
+	end
+	return {}
+end
+
+-- 第一阶段：检查转出数量，不修改任何数据
+for i, transfer in ipairs(transfer_data) do
+	local item_key = from_key .. transfer.item_unique_id
+	if redis.call('exists', item_key) == 0 then
+		return fail('item not found')
+	end
+	if item_properties(item_key).bindable == true then
+		return fail('item bound')
+	end
+	if transfer.count > tonumber(redis.call('hget', item_key, 'count')) then
+		return fail('transfer count exceeds available count')
+	end
+end
+
+-- 第二阶段：扣除道具并记录待转入的实例
+local items = {}
+for i, transfer in ipairs(transfer_data) do
+	local item_key = from_key .. transfer.item_unique_id
+	local fields = redis.call('hmget', item_key, 'item_id', 'item_type', 'properties', 'expire_at')
+	local remaining = redis.call('hincrby', item_key, 'count', -transfer.count)
+	redis.call('xadd', from_key .. 'ledger', '*',
+		'item_id', fields[1],
+		'item_unique_id', transfer.item_unique_id,
+		'delta', -transfer.count,
+		'balance', remaining,
+		'reason', ledger_meta.reason,
+		'source', ledger_meta.source,
+		'idempotent_id', ledger_meta.idempotent_id,
+		'trace_id', ledger_meta.trace_id)
+	if remaining == 0 then
+		redis.call('del', item_key)
+		redis.call('srem', from_key .. 'items', transfer.item_unique_id)
+		redis.call('zrem', from_key .. 'expiry', transfer.item_unique_id)
+	end
+
+	table.insert(items, {
+		item_id = tonumber(fields[1]),
+		item_unique_id = transfer.item_unique_id,
+		item_type = tonumber(fields[2]),
+		properties = fields[3],
+		category = transfer.category,
+		max_stack = transfer.max_stack,
+		is_unique = transfer.is_unique,
+		count = transfer.count,
+		expire_at = tonumber(fields[4] or 0)
+	})
+end
+
+local pending = {
+	success = true,
+	pending = true,
+	to_user_id = ARGV[2],
+	items = items,
+	meta = ARGV[3]
+}
+local pending_json = cjson.encode(pending)
+redis.call('hset', from_key .. 'transfer_out', idempotent_key, pending_json)
+redis.call('set', idempotent_key, pending_json, 'EX', 604800)
+pending.expired = expired
+return cjson.encode(pending)
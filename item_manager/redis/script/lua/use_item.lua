@@ -0,0 +1,65 @@
+-- version: 3
+-- 使用道具时消耗一个道具实例：检查数量后扣除并记录流水，成功结果（含道具id）按幂等键缓存，
+-- 重试时即使道具已被消耗完也能从缓存取回道具id以补发效果。消耗前先移除调用方已到期的道具。
+-- 解锁类道具（皮肤）传入解锁集合和成员，已解锁时不消耗，否则消耗的同时写入集合，并发使用只有一次成功
+-- KEYS[1] 用户道具前缀，KEYS[2] 幂等键
+-- ARGV[1] 道具唯一id，ARGV[2] 使用数量，ARGV[3] 流水公共字段JSON，ARGV[4] 当前时间戳，ARGV[5] 过期移除流水的公共字段JSON
+-- ARGV[6] 解锁集合在用户道具前缀下的后缀（为空表示不是解锁类道具），ARGV[7] 解锁的成员
+-- include: expire_due
+local user_key = KEYS[1]
+local idempotent_key = KEYS[2]
+local item_unique_id = ARGV[1]
+local count = tonumber(ARGV[2])
+local ledger_meta = cjson.decode(ARGV[3])
+local now = tonumber(ARGV[4])
+local unlock_suffix = ARGV[6] or ''
+local unlock_member = ARGV[7]
+
+local cached_result = redis.call('get', idempotent_key)
+if cached_result then
+	return cached_result
+end
+
+local expired = expire_due(user_key, now, cjson.decode(ARGV[5]))
+
+local item_key = user_key .. item_unique_id
+if redis.call('exists', item_key) == 0 then
+	-- 失败结果不缓存，条件满足后可使用同一幂等id重试
+	return cjson.encode({success = false, error = 'item not found', expired = expired})
+end
+local current = tonumber(redis.call('hget', item_key, 'count'))
+if count > current then
+	return cjson.encode({success = false, error = 'use count exceeds available count', expired = expired})
+end
+
+if unlock_suffix ~= '' and redis.call('sismember', user_key .. unlock_suffix, unlock_member) == 1 then
+	return cjson.encode({success = false, error = 'already unlocked', expired = expired})
+end
+
+local item_id = tonumber(redis.call('hget', item_key, 'item_id'))
+local remaining = current - count
+redis.call('xadd', user_key .. 'ledger', '*',
+	'item_id', item_id,
+	'item_unique_id', item_unique_id,
+	'delta', -count,
+	'balance', remaining,
+	'reason', ledger_meta.reason,
+	'source', ledger_meta.source,
+	'idempotent_id', ledger_meta.idempotent_id,
+	'trace_id', ledger_meta.trace_id)
+if remaining == 0 then
+	redis.call('del', item_key)
+	redis.call('srem', user_key .. 'items', item_unique_id)
+	redis.call('zrem', user_key .. 'expiry', item_unique_id)
+else
+	redis.call('hset', item_key, 'count', remaining)
+end
+
+if unlock_suffix ~= '' then
+	redis.call('sadd', user_key .. unlock_suffix, unlock_member)
+end
+
+local result = {success = true, item_id = item_id, item_unique_id = item_unique_id, count = count, remaining = remaining}
+redis.call('set', idempotent_key, cjson.encode(result), 'EX', 604800)
+result.expired = expired
+return cjson.encode(result)
@@ -0,0 +1,183 @@
+package script
+
+import (
+	"context"
+	"crypto/sha1"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/redis/go-redis/v9"
+)
+
+// 已注册的脚本名称，对应 lua 目录下的同名文件
+const (
+	AddItem          = "add_item"
+	DeleteItem       = "delete_item"
+	GetAllItems      = "get_all_items"
+	TransferItems    = "transfer_items"
+	ExpireItems      = "expire_items"
+	UseItem          = "use_item"
+	SaveLootRoll     = "save_loot_roll"
+	RestoreItems     = "restore_items"
+	CompleteTransfer = "complete_transfer"
+	ClaimMailbox     = "claim_mailbox"
+	ClaimEscrow      = "claim_escrow"
+	ExtendLock       = "extend_lock"
+)
+
+//go:embed lua/*.lua lua/lib/*.lua
+var luaFiles embed.FS
+
+// Script 一个带版本号的Lua脚本，版本号写在脚本首行的 "-- version: N" 注释中。
+// 脚本中单独一行的 "-- include: name" 在加载时替换为 lib 目录下同名文件的内容，多个脚本共用的逻辑放在 lib 中
+type Script struct {
+	Name    string
+	Version int
+	Source  string
+	Sha     string
+}
+
+// Registry 脚本注册表：启动时通过 SCRIPT LOAD 预加载，调用时使用 EVALSHA，
+// 脚本缓存被清空（NOSCRIPT）时回退到 EVAL 并重新缓存
+type Registry struct {
+	scripts map[string]*Script
+}
+
+var registry struct {
+	sync.Once
+	r *Registry
+}
+
+// GetRegistry 返回由内嵌脚本构建的注册表
+func GetRegistry() *Registry {
+	registry.Do(func() {
+		sub, err := fs.Sub(luaFiles, "lua")
+		if err != nil {
+			panic("lua scripts not embedded:" + err.Error())
+		}
+		r, err := NewRegistry(sub)
+		if err != nil {
+			panic("lua scripts invalid:" + err.Error())
+		}
+		registry.r = r
+	})
+	return registry.r
+}
+
+// Load 启动时将内嵌脚本加载到Redis
+func Load(ctx context.Context, rdb redis.Scripter) error {
+	return GetRegistry().Load(ctx, rdb)
+}
+
+// Run 通过EVALSHA执行内嵌脚本
+func Run(ctx context.Context, rdb redis.Scripter, name string, keys []string, args ...interface{}) *redis.Cmd {
+	return GetRegistry().Run(ctx, rdb, name, keys, args...)
+}
+
+// NewRegistry 读取目录下所有 .lua 文件，文件名（去掉扩展名）即脚本名称，SHA按展开include后的源码计算
+func NewRegistry(fsys fs.FS) (*Registry, error) {
+	files, err := fs.Glob(fsys, "*.lua")
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Registry{scripts: make(map[string]*Script, len(files))}
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		name := strings.TrimSuffix(path.Base(file), ".lua")
+		version, err := parseVersion(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		source, err := expandIncludes(fsys, string(data))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		sum := sha1.Sum([]byte(source))
+		r.scripts[name] = &Script{
+			Name:    name,
+			Version: version,
+			Source:  source,
+			Sha:     hex.EncodeToString(sum[:]),
+		}
+	}
+	return r, nil
+}
+
+// 展开 "-- include: name" 行，lib 中的文件不再嵌套include
+func expandIncludes(fsys fs.FS, source string) (string, error) {
+	lines := strings.Split(source, "\n")
+	for i, line := range lines {
+		name, ok := strings.CutPrefix(strings.TrimSpace(line), "-- include:")
+		if !ok {
+			continue
+		}
+		lib, err := fs.ReadFile(fsys, path.Join("lib", strings.TrimSpace(name)+".lua"))
+		if err != nil {
+			return "", fmt.Errorf("include %s: %w", strings.TrimSpace(name), err)
+		}
+		lines[i] = strings.TrimRight(string(lib), "\n")
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// 解析首行的版本号注释
+func parseVersion(source string) (int, error) {
+	firstLine, _, _ := strings.Cut(source, "\n")
+	val, ok := strings.CutPrefix(strings.TrimSpace(firstLine), "-- version:")
+	if !ok {
+		return 0, fmt.Errorf("missing version header")
+	}
+	version, err := strconv.Atoi(strings.TrimSpace(val))
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("invalid version %q", strings.TrimSpace(val))
+	}
+	return version, nil
+}
+
+// Get 按名称获取脚本
+func (r *Registry) Get(name string) *Script {
+	return r.scripts[name]
+}
+
+// Load 将所有脚本加载到Redis脚本缓存，并校验返回的SHA与本地计算一致
+func (r *Registry) Load(ctx context.Context, rdb redis.Scripter) error {
+	for _, s := range r.scripts {
+		sha, err := rdb.ScriptLoad(ctx, s.Source).Result()
+		if err != nil {
+			return fmt.Errorf("load script %s: %w", s.Name, err)
+		}
+		if sha != s.Sha {
+			return fmt.Errorf("load script %s: sha mismatch %s != %s", s.Name, sha, s.Sha)
+		}
+		klog.CtxInfof(ctx, "[ITEM-SCRIPT-LOAD] Script loaded: name=%s, version=%d, sha=%s", s.Name, s.Version, s.Sha)
+	}
+	return nil
+}
+
+// Run 使用EVALSHA执行脚本，脚本未缓存时回退到EVAL
+func (r *Registry) Run(ctx context.Context, rdb redis.Scripter, name string, keys []string, args ...interface{}) *redis.Cmd {
+	s := r.scripts[name]
+	if s == nil {
+		cmd := redis.NewCmd(ctx)
+		cmd.SetErr(fmt.Errorf("script %s not registered", name))
+		return cmd
+	}
+
+	cmd := rdb.EvalSha(ctx, s.Sha, keys, args...)
+	if err := cmd.Err(); err != nil && redis.HasErrorPrefix(err, "NOSCRIPT") {
+		klog.CtxWarnf(ctx, "[ITEM-SCRIPT-NOSCRIPT] Script not cached, fallback to EVAL: name=%s, version=%d", s.Name, s.Version)
+		return rdb.Eval(ctx, s.Source, keys, args...)
+	}
+	return cmd
+}
@@ -0,0 +1,531 @@
+package script
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	testUserKey = "item:user:{u1}:"
+	testMeta    = `{"reason":"test","source":"test","idempotent_id":"id","trace_id":""}`
+
+	testExpireMeta = `{"reason":"expired","source":"item_expiry","idempotent_id":"","trace_id":""}`
+	testBags       = `{"material":{"category":"material","capacity":2,"overflow":"reject"},"equipment":{"category":"equipment","capacity":1,"overflow":"mail"}}`
+
+	testMailboxRetention = 86400
+)
+
+func setupMiniRedis(t *testing.T) *redis.Client {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return rdb
+}
+
+func runJSON(t *testing.T, rdb *redis.Client, name string, keys []string, args ...interface{}) map[string]interface{} {
+	val, err := Run(context.Background(), rdb, name, keys, args...).Text()
+	if err != nil {
+		t.Fatalf("run %s failed: %v", name, err)
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(val), &result); err != nil {
+		t.Fatalf("unmarshal %s result failed: %v, result: %s", name, err, val)
+	}
+	return result
+}
+
+// TestLoadAndFallback 测试启动加载和NOSCRIPT回退
+func TestLoadAndFallback(t *testing.T) {
+	ctx := context.Background()
+	rdb := setupMiniRedis(t)
+
+	for name, version := range map[string]int{AddItem: 6, DeleteItem: 6, GetAllItems: 2, TransferItems: 6, ExpireItems: 3, UseItem: 3, SaveLootRoll: 1, RestoreItems: 3, CompleteTransfer: 1, ClaimMailbox: 2, ClaimEscrow: 1, ExtendLock: 1} {
+		if s := GetRegistry().Get(name); s == nil || s.Version != version {
+			t.Fatalf("script %s not registered with version %d", name, version)
+		}
+	}
+
+	if err := Load(ctx, rdb); err != nil {
+		t.Fatalf("load scripts failed: %v", err)
+	}
+	exists, err := rdb.ScriptExists(ctx, GetRegistry().Get(GetAllItems).Sha).Result()
+	if err != nil || !exists[0] {
+		t.Fatalf("script not cached after load: %v, %v", exists, err)
+	}
+
+	rdb.ScriptFlush(ctx)
+	result := runJSON(t, rdb, GetAllItems, []string{testUserKey}, 0)
+	if result["success"] != true {
+		t.Errorf("expected success after fallback, got %v", result)
+	}
+}
+
+// TestAddDeleteItems 测试添加、扣除和查询道具脚本
+func TestAddDeleteItems(t *testing.T) {
+	ctx := context.Background()
+	rdb := setupMiniRedis(t)
+
+	items := `[{"item_id":1001,"item_unique_id":"1001","item_type":1001,"properties":"{}","category":"material","max_stack":0,"count":5}]`
+	result := runJSON(t, rdb, AddItem, []string{testUserKey, "idempotent:{u1}:add1"}, items, testBags, 0, testMeta, testMailboxRetention, testExpireMeta)
+	if result["success"] != true {
+		t.Fatalf("add item failed: %v", result)
+	}
+	runJSON(t, rdb, AddItem, []string{testUserKey, "idempotent:{u1}:add2"}, items, testBags, 0, testMeta, testMailboxRetention, testExpireMeta)
+	// 重复的幂等请求直接返回缓存结果
+	runJSON(t, rdb, AddItem, []string{testUserKey, "idempotent:{u1}:add2"}, items, testBags, 0, testMeta, testMailboxRetention, testExpireMeta)
+	if count := rdb.HGet(ctx, testUserKey+"1001", "count").Val(); count != "10" {
+		t.Errorf("expected count 10, got %s", count)
+	}
+
+	result = runJSON(t, rdb, DeleteItem, []string{testUserKey, "idempotent:{u1}:del1"}, `[{"item_unique_id":"1001","count":11}]`, testMeta, 0)
+	if result["success"] != false || result["error"] != "delete count exceeds available count" {
+		t.Errorf("expected exceeds error, got %v", result)
+	}
+	result = runJSON(t, rdb, DeleteItem, []string{testUserKey, "idempotent:{u1}:del2"}, `[{"item_unique_id":"1001","count":10}]`, testMeta, 0)
+	if result["success"] != true {
+		t.Fatalf("delete item failed: %v", result)
+	}
+	if rdb.Exists(ctx, testUserKey+"1001").Val() != 0 || rdb.SIsMember(ctx, testUserKey+"items", "1001").Val() {
+		t.Errorf("item should be removed after deleting all")
+	}
+
+	result = runJSON(t, rdb, GetAllItems, []string{testUserKey}, 0)
+	if results, ok := result["results"].([]interface{}); ok && len(results) != 0 {
+		t.Errorf("expected no items, got %v", results)
+	}
+}
+
+// TestAddItemLimits 测试背包容量、堆叠上限与溢出转入邮箱
+func TestAddItemLimits(t *testing.T) {
+	ctx := context.Background()
+	rdb := setupMiniRedis(t)
+	add := func(id string, items string) map[string]interface{} {
+		return runJSON(t, rdb, AddItem, []string{testUserKey, "idempotent:{u1}:" + id}, items, testBags, 1700000000, `{"reason":"test","source":"test","idempotent_id":"`+id+`","trace_id":""}`, testMailboxRetention, testExpireMeta)
+	}
+
+	// 堆叠上限为10，分类reject：超出上限整单拒绝且不写入任何道具
+	result := add("stack1", `[{"item_id":1,"item_unique_id":"1","item_type":2,"properties":"{}","category":"material","max_stack":10,"count":6},`+
+		`{"item_id":1,"item_unique_id":"1","item_type":2,"properties":"{}","category":"material","max_stack":10,"count":5}]`)
+	if result["success"] != false || result["error"] != "stack limit exceeded" {
+		t.Fatalf("expected stack limit exceeded, got %v", result)
+	}
+	if rdb.Exists(ctx, testUserKey+"1").Val() != 0 {
+		t.Fatalf("rejected add should not write items")
+	}
+	// 拒绝结果不缓存，同一幂等id可以重试
+	if rdb.Exists(ctx, "idempotent:{u1}:stack1").Val() != 0 {
+		t.Fatalf("rejected result should not be cached")
+	}
+
+	// 容量为2：第三个格子被拒绝
+	for i, id := range []string{"1", "2"} {
+		result = add("cap"+id, `[{"item_id":`+id+`,"item_unique_id":"`+id+`","item_type":2,"properties":"{}","category":"material","max_stack":10,"count":1}]`)
+		if result["success"] != true {
+			t.Fatalf("add %d failed: %v", i, result)
+		}
+	}
+	result = add("cap3", `[{"item_id":3,"item_unique_id":"3","item_type":2,"properties":"{}","category":"material","max_stack":10,"count":1}]`)
+	if result["success"] != false || result["error"] != "bag full" {
+		t.Fatalf("expected bag full, got %v", result)
+	}
+	// 已有格子的道具仍可叠加
+	result = add("cap4", `[{"item_id":1,"item_unique_id":"1","item_type":2,"properties":"{}","category":"material","max_stack":10,"count":9}]`)
+	if result["success"] != true {
+		t.Fatalf("stack onto existing item failed: %v", result)
+	}
+
+	// 分类mail：容量为1，第二件唯一道具整件转入邮箱
+	result = add("mail1", `[{"item_id":9,"item_unique_id":"u1","item_type":1,"properties":"{}","category":"equipment","max_stack":0,"count":1},`+
+		`{"item_id":9,"item_unique_id":"u2","item_type":1,"properties":"{}","category":"equipment","max_stack":0,"count":1}]`)
+	if result["success"] != true {
+		t.Fatalf("add with mail overflow failed: %v", result)
+	}
+	if mailbox, _ := result["mailbox"].([]interface{}); len(mailbox) != 1 {
+		t.Fatalf("expected 1 mailbox entry, got %v", result["mailbox"])
+	}
+	if rdb.Exists(ctx, testUserKey+"u2").Val() != 0 {
+		t.Errorf("overflowed item should not be in bag")
+	}
+	mails := rdb.HGetAll(ctx, testUserKey+"mailbox").Val()
+	if len(mails) != 1 || mails["1"] == "" {
+		t.Fatalf("expected 1 mail, got %v", mails)
+	}
+	var mail map[string]interface{}
+	if err := json.Unmarshal([]byte(mails["1"]), &mail); err != nil || mail["item_unique_id"] != "u2" || mail["count"] != float64(1) ||
+		mail["deadline"] != float64(1700000000+testMailboxRetention) {
+		t.Errorf("unexpected mail: %s, %v", mails["1"], err)
+	}
+	if score := rdb.ZScore(ctx, testUserKey+"expiry", "mail:1").Val(); score != float64(1700000000+testMailboxRetention) {
+		t.Errorf("mail should be indexed by deadline, got %v", score)
+	}
+}
+
+// TestClaimMailbox 测试领取邮箱道具：背包已满时留在邮箱，腾出格子后领取并记录背包和邮箱流水，超过领取截止时间由过期扫描移除
+func TestClaimMailbox(t *testing.T) {
+	ctx := context.Background()
+	rdb := setupMiniRedis(t)
+	const now = 1700000000
+	claim := func(id string, mailIds string) map[string]interface{} {
+		return runJSON(t, rdb, ClaimMailbox, []string{testUserKey, "idempotent:{u1}:" + id}, mailIds, testBags, now, testMeta, testExpireMeta)
+	}
+	// 装备容量为1：u2、u3依次转入邮箱（邮件1、2）
+	result := runJSON(t, rdb, AddItem, []string{testUserKey, "idempotent:{u1}:add"}, `[{"item_id":9,"item_unique_id":"u1","item_type":1,"properties":"{}","category":"equipment","max_stack":0,"count":1},`+
+		`{"item_id":9,"item_unique_id":"u2","item_type":1,"properties":"{}","category":"equipment","max_stack":0,"count":1},`+
+		`{"item_id":9,"item_unique_id":"u3","item_type":1,"properties":"{}","category":"equipment","max_stack":0,"count":1}]`, testBags, now, testMeta, testMailboxRetention, testExpireMeta)
+	if mailbox, _ := result["mailbox"].([]interface{}); len(mailbox) != 2 {
+		t.Fatalf("expected 2 mails, got %v", result)
+	}
+
+	result = claim("c1", "[]")
+	if mails, _ := result["mails"].([]interface{}); result["success"] != true || len(mails) != 2 {
+		t.Fatalf("bag full claim should keep mails, got %v", result)
+	}
+
+	runJSON(t, rdb, DeleteItem, []string{testUserKey, "idempotent:{u1}:del"}, `[{"item_unique_id":"u1","count":1}]`, testMeta, 0)
+	result = claim("c2", "[]")
+	results, _ := result["results"].([]interface{})
+	mails, _ := result["mails"].([]interface{})
+	if len(results) != 1 || results[0].(map[string]interface{})["item_unique_id"] != "u2" || len(mails) != 1 {
+		t.Fatalf("expected u2 claimed and u3 left, got %v", result)
+	}
+	if rdb.HExists(ctx, testUserKey+"mailbox", "1").Val() || rdb.ZScore(ctx, testUserKey+"expiry", "mail:1").Err() == nil {
+		t.Errorf("claimed mail should be removed from mailbox and expiry index")
+	}
+	ledger := rdb.XRevRangeN(ctx, testUserKey+"ledger", "+", "-", 2).Val()
+	if ledger[0].Values["mailbox"] != "1" || ledger[0].Values["delta"] != "-1" || ledger[1].Values["mailbox"] != "0" || ledger[1].Values["delta"] != "1" {
+		t.Errorf("expected bag and mailbox ledger entries, got %v", ledger)
+	}
+	// 重复的幂等请求返回缓存结果
+	if again := claim("c2", "[]"); len(again["results"].([]interface{})) != 1 {
+		t.Errorf("expected cached claim result, got %v", again)
+	}
+
+	// 超过领取截止时间，过期扫描移除邮件并记录邮箱流水
+	result = runJSON(t, rdb, ExpireItems, []string{testUserKey}, now+testMailboxRetention, testMeta, 10)
+	if expired, _ := result["mails"].([]interface{}); len(expired) != 1 {
+		t.Fatalf("expected 1 expired mail, got %v", result)
+	}
+	if rdb.HLen(ctx, testUserKey+"mailbox").Val() != 0 {
+		t.Errorf("expired mail should be removed")
+	}
+	if last := rdb.XRevRangeN(ctx, testUserKey+"ledger", "+", "-", 1).Val()[0]; last.Values["mailbox"] != "1" || last.Values["delta"] != "-1" {
+		t.Errorf("unexpected expire ledger entry: %v", last)
+	}
+}
+
+// TestBoundItems 测试绑定的道具不能用于交易托管或转移，不可交易的道具不能用于交易托管，普通扣除不受影响
+func TestBoundItems(t *testing.T) {
+	rdb := setupMiniRedis(t)
+	runJSON(t, rdb, AddItem, []string{testUserKey, "idempotent:{u1}:add"}, `[{"item_id":5,"item_unique_id":"b1","item_type":1,"properties":"{\"bindable\":true,\"tradable\":true}","category":"equipment","max_stack":0,"count":1},`+
+		`{"item_id":6,"item_unique_id":"6","item_type":2,"properties":"{\"bindable\":false,\"tradable\":false}","category":"material","max_stack":0,"count":5}]`, testBags, 0, testMeta, testMailboxRetention, testExpireMeta)
+
+	result := runJSON(t, rdb, DeleteItem, []string{testUserKey, "idempotent:{u1}:d1"}, `[{"item_unique_id":"b1","count":1}]`, testMeta, 0, 1)
+	if result["success"] != false || result["error"] != "item bound" {
+		t.Errorf("expected item bound, got %v", result)
+	}
+	result = runJSON(t, rdb, DeleteItem, []string{testUserKey, "idempotent:{u1}:d2"}, `[{"item_unique_id":"6","count":1}]`, testMeta, 0, 1)
+	if result["success"] != false || result["error"] != "item not tradable" {
+		t.Errorf("expected item not tradable, got %v", result)
+	}
+	result = runJSON(t, rdb, TransferItems, []string{testUserKey, "idempotent:{u1}:t1"}, `[{"item_unique_id":"b1","count":1,"is_unique":1,"category":"equipment","max_stack":0}]`, "u2", testMeta, 0, testExpireMeta)
+	if result["success"] != false || result["error"] != "item bound" {
+		t.Errorf("expected item bound, got %v", result)
+	}
+	result = runJSON(t, rdb, DeleteItem, []string{testUserKey, "idempotent:{u1}:d3"}, `[{"item_unique_id":"b1","count":1}]`, testMeta, 0, 0)
+	if result["success"] != true {
+		t.Errorf("plain delete of bound item should succeed, got %v", result)
+	}
+}
+
+// TestTransferItems 测试分两步的道具转移：转出方扣除并记录待转入，转入方写入或退还，最后完成并缓存结果
+func TestTransferItems(t *testing.T) {
+	ctx := context.Background()
+	rdb := setupMiniRedis(t)
+	const toUserKey = "item:user:{u2}:"
+	add := func(userKey string, id string, items string) {
+		result := runJSON(t, rdb, AddItem, []string{userKey, "idempotent:{u}:" + id}, items, testBags, 0, testMeta, testMailboxRetention, testExpireMeta)
+		if result["success"] != true {
+			t.Fatalf("add item failed: %v", result)
+		}
+	}
+	debit := func(id string, items string) (map[string]interface{}, string) {
+		val, err := Run(ctx, rdb, TransferItems, []string{testUserKey, "idempotent:{u1}:" + id}, items, "u2", testMeta, 0, testExpireMeta).Text()
+		if err != nil {
+			t.Fatalf("run transfer failed: %v", err)
+		}
+		var result map[string]interface{}
+		json.Unmarshal([]byte(val), &result)
+		return result, val
+	}
+	add(testUserKey, "a1", `[{"item_id":1,"item_unique_id":"1","item_type":2,"properties":"{}","category":"material","max_stack":10,"count":8},`+
+		`{"item_id":9,"item_unique_id":"u1","item_type":1,"properties":"{\"a\":1}","category":"equipment","max_stack":0,"count":1}]`)
+	add(toUserKey, "a2", `[{"item_id":1,"item_unique_id":"1","item_type":2,"properties":"{}","category":"material","max_stack":10,"count":5}]`)
+
+	result, _ := debit("t0", `[{"item_unique_id":"1","count":9,"is_unique":0,"category":"material","max_stack":10}]`)
+	if result["success"] != false || result["error"] != "transfer count exceeds available count" {
+		t.Fatalf("expected exceeds error, got %v", result)
+	}
+
+	// 第一步：扣除转出方并记录待转入，重试返回同一待转入记录
+	result, pending := debit("t1", `[{"item_unique_id":"u1","count":1,"is_unique":1,"category":"equipment","max_stack":0},`+
+		`{"item_unique_id":"1","count":6,"is_unique":0,"category":"material","max_stack":10}]`)
+	if result["success"] != true || result["pending"] != true || result["to_user_id"] != "u2" {
+		t.Fatalf("debit failed: %v", result)
+	}
+	if rdb.Exists(ctx, testUserKey+"u1").Val() != 0 || rdb.HGet(ctx, testUserKey+"1", "count").Val() != "2" {
+		t.Fatalf("items should be debited from sender")
+	}
+	// 返回结果比待转入记录多出脚本内移除的到期道具
+	recorded := rdb.HGet(ctx, testUserKey+"transfer_out", "idempotent:{u1}:t1").Val()
+	if recorded == "" || recorded != rdb.Get(ctx, "idempotent:{u1}:t1").Val() {
+		t.Fatalf("pending transfer should be recorded: %s", recorded)
+	}
+	pending = recorded
+	if _, again := debit("t1", `[{"item_unique_id":"1","count":1,"is_unique":0,"category":"material","max_stack":10}]`); again != pending ||
+		rdb.HGet(ctx, testUserKey+"1", "count").Val() != "2" {
+		t.Fatalf("retry should return pending record without debiting again")
+	}
+
+	// 第二步：转入方堆叠上限不足，失败结果被缓存，退还转出方
+	items, _ := json.Marshal(result["items"])
+	credit := runJSON(t, rdb, RestoreItems, []string{toUserKey, "idempotent:{u2}:transfer:u1:t1"}, string(items), testBags, testMeta, "transfer_in", 0, testExpireMeta)
+	if credit["success"] != false || credit["error"] != "stack limit exceeded" || rdb.Exists(ctx, "idempotent:{u2}:transfer:u1:t1").Val() != 1 {
+		t.Fatalf("expected cached stack limit exceeded, got %v", credit)
+	}
+	refund := runJSON(t, rdb, RestoreItems, []string{testUserKey, "idempotent:{u1}:t1:refund"}, string(items), testBags, testMeta, "refund", 0, testExpireMeta)
+	if refund["success"] != true || rdb.HGet(ctx, testUserKey+"u1", "properties").Val() != `{"a":1}` || rdb.HGet(ctx, testUserKey+"1", "count").Val() != "8" {
+		t.Fatalf("refund failed: %v", refund)
+	}
+
+	// 最后一步：清除待转入记录并缓存最终结果，之后第一步直接返回最终结果
+	final := runJSON(t, rdb, CompleteTransfer, []string{testUserKey, "idempotent:{u1}:t1"}, `{"success":false,"error":"stack limit exceeded"}`)
+	if final["success"] != false || rdb.HExists(ctx, testUserKey+"transfer_out", "idempotent:{u1}:t1").Val() {
+		t.Fatalf("complete transfer failed: %v", final)
+	}
+	if result, _ := debit("t1", `[]`); result["pending"] != nil || result["error"] != "stack limit exceeded" {
+		t.Errorf("expected final result on retry, got %v", result)
+	}
+	// 已完成的转移再次完成时保留首次的结果
+	if final := runJSON(t, rdb, CompleteTransfer, []string{testUserKey, "idempotent:{u1}:t1"}, `{"success":true}`); final["success"] != false {
+		t.Errorf("completed transfer should keep its result, got %v", final)
+	}
+
+	// 转入成功：转出方和转入方各记录流水
+	result, _ = debit("t2", `[{"item_unique_id":"1","count":5,"is_unique":0,"category":"material","max_stack":10}]`)
+	items, _ = json.Marshal(result["items"])
+	credit = runJSON(t, rdb, RestoreItems, []string{toUserKey, "idempotent:{u2}:transfer:u1:t2"}, string(items), testBags, testMeta, "transfer_in", 0, testExpireMeta)
+	if credit["success"] != true || rdb.HGet(ctx, toUserKey+"1", "count").Val() != "10" {
+		t.Fatalf("credit failed: %v", credit)
+	}
+	if entries := rdb.XRange(ctx, toUserKey+"ledger", "-", "+").Val(); len(entries) != 2 ||
+		entries[1].Values["delta"] != "5" || entries[1].Values["balance"] != "10" || entries[1].Values["item_id"] != "1" {
+		t.Errorf("unexpected receiver ledger: %v", entries)
+	}
+	if entries := rdb.XRange(ctx, testUserKey+"ledger", "-", "+").Val(); entries[len(entries)-1].Values["delta"] != "-5" ||
+		entries[len(entries)-1].Values["balance"] != "3" {
+		t.Errorf("unexpected sender ledger: %v", entries)
+	}
+}
+
+// TestExpireItems 测试限时道具按过期时间分桶、查询隐藏、按道具id扣除的顺序，以及脚本内和扫描时的过期移除
+func TestExpireItems(t *testing.T) {
+	ctx := context.Background()
+	rdb := setupMiniRedis(t)
+	const now = 1700000000
+	const bags = `{"material":{"category":"material","capacity":0,"overflow":"reject"}}`
+	timed := func(itemId string, count int, expireAt string) string {
+		return `{"item_id":` + itemId + `,"item_unique_id":"` + itemId + `@` + expireAt + `","item_type":2,"properties":"{}","category":"material","max_stack":0,"count":` +
+			strconv.Itoa(count) + `,"expire_at":` + expireAt + `}`
+	}
+	add := func(id string, at int64, items string) map[string]interface{} {
+		result := runJSON(t, rdb, AddItem, []string{testUserKey, "idempotent:{u1}:" + id}, items, bags, at, testMeta, testMailboxRetention, testExpireMeta)
+		if result["success"] != true {
+			t.Fatalf("add item failed: %v", result)
+		}
+		return result
+	}
+	add("e1", now, "["+timed("1", 2, "1700000100")+","+timed("2", 3, "1700000050")+"]")
+	// 过期时间不同的同种道具各自一个实例，叠加不改变已有实例的过期时间
+	add("e2", now, "["+timed("2", 1, "1700000200")+","+timed("2", 1, "1700000050")+"]")
+	if count := rdb.HGet(ctx, testUserKey+"2@1700000050", "count").Val(); count != "4" || rdb.ZScore(ctx, testUserKey+"expiry", "2@1700000050").Val() != 1700000050 {
+		t.Fatalf("expected bucket 2@1700000050 with 4 items, got %s", count)
+	}
+
+	// 按道具id扣除时先扣最早过期的分桶
+	result := runJSON(t, rdb, DeleteItem, []string{testUserKey, "idempotent:{u1}:d1"}, `[{"item_id":2,"count":5}]`, testMeta, now)
+	if result["success"] != true || rdb.Exists(ctx, testUserKey+"2@1700000050", testUserKey+"2@1700000200").Val() != 0 {
+		t.Fatalf("delete by item id failed: %v", result)
+	}
+	add("e3", now, "["+timed("2", 2, "1700000200")+"]")
+	result = runJSON(t, rdb, DeleteItem, []string{testUserKey, "idempotent:{u1}:d2"}, `[{"item_id":2,"count":3}]`, testMeta, now)
+	if result["success"] != false || result["error"] != "delete count exceeds available count" {
+		t.Fatalf("expected exceeds error, got %v", result)
+	}
+
+	// 到期但未移除的道具对查询和扣除不可见
+	result = runJSON(t, rdb, GetAllItems, []string{testUserKey}, now+150)
+	if results, _ := result["results"].([]interface{}); len(results) != 1 {
+		t.Fatalf("expected 1 visible item, got %v", result["results"])
+	}
+	result = runJSON(t, rdb, DeleteItem, []string{testUserKey, "idempotent:{u1}:d3"}, `[{"item_unique_id":"1@1700000100","count":1}]`, testMeta, now+150)
+	if result["success"] != false || result["error"] != "item not found" {
+		t.Fatalf("expected item not found for expired item, got %v", result)
+	}
+
+	// 添加道具前在脚本内移除到期道具，结果中返回被移除的道具，缓存的结果不包含
+	result = add("e4", now+150, `[{"item_id":3,"item_unique_id":"3","item_type":2,"properties":"{}","category":"material","max_stack":0,"count":1}]`)
+	expired, _ := result["expired"].(map[string]interface{})
+	if results, _ := expired["results"].([]interface{}); len(results) != 1 || rdb.Exists(ctx, testUserKey+"1@1700000100").Val() != 0 {
+		t.Fatalf("expected 1 item expired in add_item, got %v", result["expired"])
+	}
+	if cached := add("e4", now+150, "[]"); cached["expired"] != nil {
+		t.Errorf("expired items should not be cached: %v", cached)
+	}
+
+	// 批量大小为1时分批移除，more标记是否还有到期实例
+	add("e5", now, "["+timed("4", 1, "1700000250")+"]")
+	result = runJSON(t, rdb, ExpireItems, []string{testUserKey}, now+300, testExpireMeta, 1)
+	if results, _ := result["results"].([]interface{}); len(results) != 1 || result["more"] != true {
+		t.Fatalf("expected 1 expired item with more, got %v", result)
+	}
+	result = runJSON(t, rdb, ExpireItems, []string{testUserKey}, now+300, testExpireMeta, 1)
+	if results, _ := result["results"].([]interface{}); len(results) != 1 {
+		t.Fatalf("expected 1 expired item, got %v", result)
+	}
+	if rdb.Exists(ctx, testUserKey+"expiry").Val() != 0 || rdb.SCard(ctx, testUserKey+"items").Val() != 1 {
+		t.Errorf("expired items should be removed")
+	}
+	expiredEntries := 0
+	for _, entry := range rdb.XRange(ctx, testUserKey+"ledger", "-", "+").Val() {
+		if entry.Values["reason"] == "expired" {
+			expiredEntries++
+		}
+	}
+	if expiredEntries != 3 {
+		t.Errorf("expected 3 expiry ledger entries, got %d", expiredEntries)
+	}
+}
+
+// TestUseItem 测试使用道具的消耗与幂等缓存
+func TestUseItem(t *testing.T) {
+	ctx := context.Background()
+	rdb := setupMiniRedis(t)
+	items := `[{"item_id":11,"item_unique_id":"11","item_type":5,"properties":"{}","category":"material","max_stack":0,"count":2}]`
+	runJSON(t, rdb, AddItem, []string{testUserKey, "idempotent:{u1}:add"}, items, testBags, 0, testMeta, testMailboxRetention, testExpireMeta)
+	use := func(id string, count int) map[string]interface{} {
+		return runJSON(t, rdb, UseItem, []string{testUserKey, "idempotent:{u1}:" + id}, "11", count, testMeta, 0, testExpireMeta)
+	}
+
+	result := use("u1", 3)
+	if result["success"] != false || result["error"] != "use count exceeds available count" {
+		t.Fatalf("expected exceeds error, got %v", result)
+	}
+	if rdb.Exists(ctx, "idempotent:{u1}:u1").Val() != 0 {
+		t.Fatalf("failed use should not be cached")
+	}
+
+	result = use("u2", 2)
+	if result["success"] != true || result["item_id"] != float64(11) || result["count"] != float64(2) {
+		t.Fatalf("use item failed: %v", result)
+	}
+	if rdb.Exists(ctx, testUserKey+"11").Val() != 0 || rdb.SIsMember(ctx, testUserKey+"items", "11").Val() {
+		t.Errorf("item should be removed after using all")
+	}
+	// 道具已消耗完，重试仍返回缓存的道具id和数量
+	result = use("u2", 2)
+	if result["success"] != true || result["item_id"] != float64(11) || result["count"] != float64(2) {
+		t.Errorf("expected cached result on retry, got %v", result)
+	}
+	if n := rdb.XLen(ctx, testUserKey+"ledger").Val(); n != 2 {
+		t.Errorf("expected 2 ledger entries, got %d", n)
+	}
+
+	// 解锁类道具：消耗的同时写入解锁集合，已解锁时不消耗
+	skins := `[{"item_id":12,"item_unique_id":"12","item_type":4,"properties":"{}","category":"material","max_stack":0,"count":2}]`
+	runJSON(t, rdb, AddItem, []string{testUserKey, "idempotent:{u1}:add_skin"}, skins, testBags, 0, testMeta, testMailboxRetention, testExpireMeta)
+	unlock := func(id string) map[string]interface{} {
+		return runJSON(t, rdb, UseItem, []string{testUserKey, "idempotent:{u1}:" + id}, "12", 1, testMeta, 0, testExpireMeta, "skins", "301")
+	}
+	if result = unlock("s1"); result["success"] != true {
+		t.Fatalf("unlock failed: %v", result)
+	}
+	if !rdb.SIsMember(ctx, testUserKey+"skins", "301").Val() {
+		t.Errorf("skin should be unlocked with the consume")
+	}
+	if result = unlock("s2"); result["success"] != false || result["error"] != "already unlocked" {
+		t.Errorf("expected already unlocked, got %v", result)
+	}
+	if count := rdb.HGet(ctx, testUserKey+"12", "count").Val(); count != "1" {
+		t.Errorf("already unlocked voucher should not be consumed, count %s", count)
+	}
+	// 首次使用的重试返回缓存结果
+	if result = unlock("s1"); result["success"] != true {
+		t.Errorf("expected cached result on retry, got %v", result)
+	}
+}
+
+// TestSaveLootRoll 测试保存抽取结果时的保底计数校验与结果缓存
+func TestSaveLootRoll(t *testing.T) {
+	ctx := context.Background()
+	rdb := setupMiniRedis(t)
+	pityKey := testUserKey + "pity"
+	rewards := `[{"item_id":2,"count":100}]`
+	save := func(id string, before string, after string) map[string]interface{} {
+		return runJSON(t, rdb, SaveLootRoll, []string{pityKey, "idempotent:{u1}:" + id}, rewards, before, after)
+	}
+
+	rdb.HSet(ctx, pityKey, "box", 3)
+	result := save("r1", `{"box":2}`, `{"box":3}`)
+	if result["success"] != false || result["error"] != "pity changed" {
+		t.Fatalf("expected pity changed, got %v", result)
+	}
+	if rdb.Exists(ctx, "idempotent:{u1}:r1").Val() != 0 {
+		t.Fatalf("rejected roll should not be cached")
+	}
+
+	result = save("r1", `{"box":3}`, `{"box":4}`)
+	if result["success"] != true || len(result["rewards"].([]interface{})) != 1 {
+		t.Fatalf("save loot roll failed: %v", result)
+	}
+	// 重试返回缓存结果，不再更新计数
+	result = save("r1", `{"box":4}`, `{"box":5}`)
+	if result["success"] != true || rdb.HGet(ctx, pityKey, "box").Val() != "4" {
+		t.Errorf("expected cached result without pity update, got %v, pity %s", result, rdb.HGet(ctx, pityKey, "box").Val())
+	}
+}
+
+// TestRestoreItems 测试按原唯一id写回道具时的容量、堆叠上限与重复实例校验
+func TestRestoreItems(t *testing.T) {
+	ctx := context.Background()
+	rdb := setupMiniRedis(t)
+	restore := func(id string, items string) map[string]interface{} {
+		return runJSON(t, rdb, RestoreItems, []string{testUserKey, "idempotent:{u1}:" + id}, items, testBags, testMeta, "restore", 0, testExpireMeta)
+	}
+	unique := func(uid string) string {
+		return `[{"item_id":9,"item_unique_id":"` + uid + `","item_type":1,"properties":"{\"lv\":3}","category":"equipment","max_stack":0,"count":1,"is_unique":1,"expire_at":0}]`
+	}
+
+	result := restore("r1", unique("u1"))
+	if result["success"] != true || rdb.HGet(ctx, testUserKey+"u1", "properties").Val() != `{"lv":3}` {
+		t.Fatalf("restore item failed: %v", result)
+	}
+	// 装备分类容量为1，再写回一件被拒绝且不缓存
+	result = restore("r2", unique("u2"))
+	if result["success"] != false || result["error"] != "bag full" || rdb.Exists(ctx, "idempotent:{u1}:r2").Val() != 0 {
+		t.Fatalf("expected uncached bag full, got %v", result)
+	}
+	result = restore("r3", unique("u1"))
+	if result["success"] != false || result["error"] != "item already exists" {
+		t.Fatalf("expected item already exists, got %v", result)
+	}
+
+	result = restore("r4", `[{"item_id":1,"item_unique_id":"1","item_type":2,"properties":"{}","category":"material","max_stack":10,"count":11,"is_unique":0,"expire_at":0}]`)
+	if result["success"] != false || result["error"] != "stack limit exceeded" || rdb.Exists(ctx, testUserKey+"1").Val() != 0 {
+		t.Fatalf("expected stack limit exceeded, got %v", result)
+	}
+}
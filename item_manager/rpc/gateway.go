@@ -0,0 +1,32 @@
+package rpc
+
+import (
+	common_config "item_manager/config"
+	"item_manager/etcd"
+	"item_manager/kitex_gen/gateway_service/gatewayservice"
+	"sync"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/kitex-contrib/obs-opentelemetry/tracing"
+)
+
+var (
+	GatewayClient gatewayservice.Client
+	onceGateway   sync.Once
+)
+
+// InitGateWayClient 初始化网关客户端，用于向用户推送道具过期通知
+func InitGateWayClient() (err error) {
+	onceGateway.Do(func() {
+		GatewayClient, err = gatewayservice.NewClient(
+			common_config.Get("gateway.service_name").(string),
+			client.WithResolver(etcd.GetEtcdResolver()),
+			client.WithSuite(tracing.NewClientSuite()),
+		)
+		if err != nil {
+			klog.Error("[ITEM-RPC-GATEWAY-INIT] Failed to initialize gateway client: ", err)
+		}
+	})
+	return err
+}
@@ -0,0 +1,32 @@
+package rpc
+
+import (
+	common_config "item_manager/config"
+	"item_manager/etcd"
+	"item_manager/kitex_gen/homepage_service/homepageservice"
+	"sync"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/kitex-contrib/obs-opentelemetry/tracing"
+)
+
+var (
+	HomepageClient homepageservice.Client
+	onceHomepage   sync.Once
+)
+
+// InitHomepageClient 初始化主页服务客户端，用于使用经验道具时增加角色经验
+func InitHomepageClient() (err error) {
+	onceHomepage.Do(func() {
+		HomepageClient, err = homepageservice.NewClient(
+			common_config.Get("homepage.service_name").(string),
+			client.WithResolver(etcd.GetEtcdResolver()),
+			client.WithSuite(tracing.NewClientSuite()),
+		)
+		if err != nil {
+			klog.Error("[ITEM-RPC-HOMEPAGE-INIT] Failed to initialize homepage client: ", err)
+		}
+	})
+	return err
+}
@@ -84,6 +84,17 @@ const (
 	ErrorCode_ITEM_JSON_ERROR            ErrorCode = 1204 // JSON解析错误
 	ErrorCode_ITEM_LUA_SCRIPT_ERROR      ErrorCode = 1205 // Lua脚本执行错误
 	ErrorCode_ITEM_REDIS_OPERATION_ERROR ErrorCode = 1206 // Redis操作错误
+	ErrorCode_ITEM_ALREADY_EXISTS        ErrorCode = 1207 // 道具实例已存在
+	ErrorCode_ITEM_BAG_FULL              ErrorCode = 1208 // 背包该分类容量已满
+	ErrorCode_ITEM_STACK_LIMIT           ErrorCode = 1209 // 超过道具堆叠上限
+	ErrorCode_ITEM_NOT_TRADABLE          ErrorCode = 1210 // 道具不可交易
+	ErrorCode_ITEM_TRANSFER_FAILED       ErrorCode = 1211 // 转移道具失败
+	ErrorCode_ITEM_NOT_USABLE            ErrorCode = 1212 // 道具不可使用
+	ErrorCode_ITEM_USE_FAILED            ErrorCode = 1213 // 道具已消耗但效果执行失败，使用相同幂等id重试
+	ErrorCode_ITEM_LOOT_TABLE_NOT_FOUND  ErrorCode = 1214 // 掉落表不存在
+	ErrorCode_ITEM_BOUND                 ErrorCode = 1215 // 道具已绑定，不能交易或转移
+	ErrorCode_ITEM_ADMIN_DENIED          ErrorCode = 1216 // 运维操作人无权限
+	ErrorCode_ITEM_ESCROW_MISMATCH       ErrorCode = 1217 // 写回的道具实例没有对应的托管记录或已被写回
 	// 拍卖服务相关错误
 	ErrorCode_AUCTION_PARAM_ERROR              ErrorCode = 1300 // 参数错误
 	ErrorCode_AUCTION_REDIS_ERROR              ErrorCode = 1301 // Redis操作错误
@@ -92,6 +103,23 @@ const (
 	ErrorCode_AUCTION_IDEMPOTENT_DUPLICATE     ErrorCode = 1304 // 幂等请求重复
 	ErrorCode_AUCTION_ORDER_ID_GENERATE_FAILED ErrorCode = 1305 // 订单ID生成失败
 	ErrorCode_AUCTION_PROCESSING               ErrorCode = 1306 // 正在处理中
+	ErrorCode_AUCTION_ESCROW_FAILED            ErrorCode = 1307 // 托管道具或货币失败
+	ErrorCode_AUCTION_MARKET_UNAVAILABLE       ErrorCode = 1308 // 道具撮合单元暂不可用（归属迁移中）
+	ErrorCode_AUCTION_PRICE_OUT_OF_BAND        ErrorCode = 1309 // 价格超出道具允许的价格区间
+	ErrorCode_AUCTION_PRICE_TICK_INVALID       ErrorCode = 1310 // 价格不是道具最小价格变动单位的整数倍
+	ErrorCode_AUCTION_QUANTITY_TOO_SMALL       ErrorCode = 1311 // 数量低于道具最小交易数量
+	ErrorCode_AUCTION_ORDER_LIMIT_EXCEEDED     ErrorCode = 1312 // 用户挂单数量超过上限
+	ErrorCode_AUCTION_TRADING_HALTED           ErrorCode = 1313 // 道具交易已被运维暂停
+	ErrorCode_AUCTION_ADMIN_DENIED             ErrorCode = 1314 // 运维操作人无权限
+	ErrorCode_AUCTION_ORDER_CHANGED            ErrorCode = 1315 // 订单在修改过程中已成交或变化，需重新查询后重试
+	ErrorCode_AUCTION_LISTING_NOT_FOUND        ErrorCode = 1316 // 唯一道具挂单不存在或已结束
+	ErrorCode_AUCTION_ITEM_NOT_UNIQUE          ErrorCode = 1317 // 道具不是唯一道具实例，不能按实例挂单
+	ErrorCode_AUCTION_OFFER_TOO_LOW            ErrorCode = 1318 // 出价低于当前最低可接受价格
+	ErrorCode_AUCTION_AUCTION_HAS_BIDS         ErrorCode = 1319 // 限时拍卖已有出价，不能取消
+	ErrorCode_AUCTION_MARKET_BUSY              ErrorCode = 1320 // 道具撮合队列已满，稍后重试
+	ErrorCode_AUCTION_TIMEOUT                  ErrorCode = 1321 // 请求在撮合单元处理完成前超时或被取消
+	ErrorCode_AUCTION_ITEM_NOT_TRADABLE        ErrorCode = 1322 // 道具不可交易或已绑定，不能挂单
+	ErrorCode_AUCTION_ITEM_MOVED               ErrorCode = 1323 // 道具撮合单元已迁移到其他实例，稍后重试
 	// 排行榜服务相关错误
 	ErrorCode_RANKING_INVALID_TYPE         ErrorCode = 1400 // 无效的排行榜类型
 	ErrorCode_RANKING_INVALID_SCORE        ErrorCode = 1401 // 无效的分数值
@@ -156,6 +184,17 @@ var (
 		1204: "ITEM_JSON_ERROR",
 		1205: "ITEM_LUA_SCRIPT_ERROR",
 		1206: "ITEM_REDIS_OPERATION_ERROR",
+		1207: "ITEM_ALREADY_EXISTS",
+		1208: "ITEM_BAG_FULL",
+		1209: "ITEM_STACK_LIMIT",
+		1210: "ITEM_NOT_TRADABLE",
+		1211: "ITEM_TRANSFER_FAILED",
+		1212: "ITEM_NOT_USABLE",
+		1213: "ITEM_USE_FAILED",
+		1214: "ITEM_LOOT_TABLE_NOT_FOUND",
+		1215: "ITEM_BOUND",
+		1216: "ITEM_ADMIN_DENIED",
+		1217: "ITEM_ESCROW_MISMATCH",
 		1300: "AUCTION_PARAM_ERROR",
 		1301: "AUCTION_REDIS_ERROR",
 		1302: "AUCTION_ORDER_NOT_FOUND",
@@ -163,6 +202,23 @@ var (
 		1304: "AUCTION_IDEMPOTENT_DUPLICATE",
 		1305: "AUCTION_ORDER_ID_GENERATE_FAILED",
 		1306: "AUCTION_PROCESSING",
+		1307: "AUCTION_ESCROW_FAILED",
+		1308: "AUCTION_MARKET_UNAVAILABLE",
+		1309: "AUCTION_PRICE_OUT_OF_BAND",
+		1310: "AUCTION_PRICE_TICK_INVALID",
+		1311: "AUCTION_QUANTITY_TOO_SMALL",
+		1312: "AUCTION_ORDER_LIMIT_EXCEEDED",
+		1313: "AUCTION_TRADING_HALTED",
+		1314: "AUCTION_ADMIN_DENIED",
+		1315: "AUCTION_ORDER_CHANGED",
+		1316: "AUCTION_LISTING_NOT_FOUND",
+		1317: "AUCTION_ITEM_NOT_UNIQUE",
+		1318: "AUCTION_OFFER_TOO_LOW",
+		1319: "AUCTION_AUCTION_HAS_BIDS",
+		1320: "AUCTION_MARKET_BUSY",
+		1321: "AUCTION_TIMEOUT",
+		1322: "AUCTION_ITEM_NOT_TRADABLE",
+		1323: "AUCTION_ITEM_MOVED",
 		1400: "RANKING_INVALID_TYPE",
 		1401: "RANKING_INVALID_SCORE",
 		1402: "RANKING_INVALID_USER_ID",
@@ -223,6 +279,17 @@ var (
 		"ITEM_JSON_ERROR":                  1204,
 		"ITEM_LUA_SCRIPT_ERROR":            1205,
 		"ITEM_REDIS_OPERATION_ERROR":       1206,
+		"ITEM_ALREADY_EXISTS":              1207,
+		"ITEM_BAG_FULL":                    1208,
+		"ITEM_STACK_LIMIT":                 1209,
+		"ITEM_NOT_TRADABLE":                1210,
+		"ITEM_TRANSFER_FAILED":             1211,
+		"ITEM_NOT_USABLE":                  1212,
+		"ITEM_USE_FAILED":                  1213,
+		"ITEM_LOOT_TABLE_NOT_FOUND":        1214,
+		"ITEM_BOUND":                       1215,
+		"ITEM_ADMIN_DENIED":                1216,
+		"ITEM_ESCROW_MISMATCH":             1217,
 		"AUCTION_PARAM_ERROR":              1300,
 		"AUCTION_REDIS_ERROR":              1301,
 		"AUCTION_ORDER_NOT_FOUND":          1302,
@@ -230,6 +297,23 @@ var (
 		"AUCTION_IDEMPOTENT_DUPLICATE":     1304,
 		"AUCTION_ORDER_ID_GENERATE_FAILED": 1305,
 		"AUCTION_PROCESSING":               1306,
+		"AUCTION_ESCROW_FAILED":            1307,
+		"AUCTION_MARKET_UNAVAILABLE":       1308,
+		"AUCTION_PRICE_OUT_OF_BAND":        1309,
+		"AUCTION_PRICE_TICK_INVALID":       1310,
+		"AUCTION_QUANTITY_TOO_SMALL":       1311,
+		"AUCTION_ORDER_LIMIT_EXCEEDED":     1312,
+		"AUCTION_TRADING_HALTED":           1313,
+		"AUCTION_ADMIN_DENIED":             1314,
+		"AUCTION_ORDER_CHANGED":            1315,
+		"AUCTION_LISTING_NOT_FOUND":        1316,
+		"AUCTION_ITEM_NOT_UNIQUE":          1317,
+		"AUCTION_OFFER_TOO_LOW":            1318,
+		"AUCTION_AUCTION_HAS_BIDS":         1319,
+		"AUCTION_MARKET_BUSY":              1320,
+		"AUCTION_TIMEOUT":                  1321,
+		"AUCTION_ITEM_NOT_TRADABLE":        1322,
+		"AUCTION_ITEM_MOVED":               1323,
 		"RANKING_INVALID_TYPE":             1400,
 		"RANKING_INVALID_SCORE":            1401,
 		"RANKING_INVALID_USER_ID":          1402,
@@ -274,7 +358,7 @@ var File_proto_common_proto protoreflect.FileDescriptor
 
 var file_proto_common_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2a, 0x9c, 0x0e, 0x0a,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2a, 0x9c, 0x14, 0x0a,
 	0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b,
 	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17,
 	0x0a, 0x13, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x48,
@@ -357,19 +441,67 @@ var file_proto_common_proto_rawDesc = []byte{
 	0x49, 0x50, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xb5, 0x09, 0x12, 0x1f, 0x0a, 0x1a,
 	0x49, 0x54, 0x45, 0x4d, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
 	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xb6, 0x09, 0x12, 0x18, 0x0a,
-	0x13, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x10, 0x94, 0x0a, 0x12, 0x18, 0x0a, 0x13, 0x41, 0x55, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x95,
-	0x0a, 0x12, 0x1c, 0x0a, 0x17, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44,
-	0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x96, 0x0a, 0x12,
-	0x1b, 0x0a, 0x16, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f,
-	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x97, 0x0a, 0x12, 0x21, 0x0a, 0x1c,
-	0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45,
-	0x4e, 0x54, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x98, 0x0a, 0x12,
-	0x25, 0x0a, 0x20, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
-	0x5f, 0x49, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x10, 0x99, 0x0a, 0x12, 0x17, 0x0a, 0x12, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x9a, 0x0a, 0x12,
+	0x13, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58,
+	0x49, 0x53, 0x54, 0x53, 0x10, 0xb7, 0x09, 0x12, 0x12, 0x0a, 0x0d, 0x49, 0x54, 0x45, 0x4d, 0x5f,
+	0x42, 0x41, 0x47, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0xb8, 0x09, 0x12, 0x15, 0x0a, 0x10, 0x49,
+	0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x43, 0x4b, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10,
+	0xb9, 0x09, 0x12, 0x16, 0x0a, 0x11, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x54,
+	0x52, 0x41, 0x44, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xba, 0x09, 0x12, 0x19, 0x0a, 0x14, 0x49, 0x54,
+	0x45, 0x4d, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0xbb, 0x09, 0x12, 0x14, 0x0a, 0x0f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x55, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x10, 0xbc, 0x09, 0x12, 0x14, 0x0a, 0x0f, 0x49,
+	0x54, 0x45, 0x4d, 0x5f, 0x55, 0x53, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0xbd,
+	0x09, 0x12, 0x1e, 0x0a, 0x19, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x4c, 0x4f, 0x4f, 0x54, 0x5f, 0x54,
+	0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xbe,
+	0x09, 0x12, 0x0f, 0x0a, 0x0a, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0xbf, 0x09, 0x12, 0x16, 0x0a, 0x11, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e,
+	0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0xc0, 0x09, 0x12, 0x19, 0x0a, 0x14, 0x49, 0x54,
+	0x45, 0x4d, 0x5f, 0x45, 0x53, 0x43, 0x52, 0x4f, 0x57, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54,
+	0x43, 0x48, 0x10, 0xc1, 0x09, 0x12, 0x18, 0x0a, 0x13, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x94, 0x0a, 0x12,
+	0x18, 0x0a, 0x13, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x44, 0x49, 0x53,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x95, 0x0a, 0x12, 0x1c, 0x0a, 0x17, 0x41, 0x55, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x96, 0x0a, 0x12, 0x1b, 0x0a, 0x16, 0x41, 0x55, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x97, 0x0a, 0x12, 0x21, 0x0a, 0x1c, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x49, 0x44, 0x45, 0x4d, 0x50, 0x4f, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49,
+	0x43, 0x41, 0x54, 0x45, 0x10, 0x98, 0x0a, 0x12, 0x25, 0x0a, 0x20, 0x41, 0x55, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x44, 0x5f, 0x47, 0x45, 0x4e, 0x45,
+	0x52, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x99, 0x0a, 0x12, 0x17,
+	0x0a, 0x12, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53,
+	0x53, 0x49, 0x4e, 0x47, 0x10, 0x9a, 0x0a, 0x12, 0x1a, 0x0a, 0x15, 0x41, 0x55, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x45, 0x53, 0x43, 0x52, 0x4f, 0x57, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x9b, 0x0a, 0x12, 0x1f, 0x0a, 0x1a, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0x9c, 0x0a, 0x12, 0x1e, 0x0a, 0x19, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x42, 0x41, 0x4e,
+	0x44, 0x10, 0x9d, 0x0a, 0x12, 0x1f, 0x0a, 0x1a, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x52, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x49, 0x43, 0x4b, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x10, 0x9e, 0x0a, 0x12, 0x1f, 0x0a, 0x1a, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x4d,
+	0x41, 0x4c, 0x4c, 0x10, 0x9f, 0x0a, 0x12, 0x21, 0x0a, 0x1c, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58,
+	0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0xa0, 0x0a, 0x12, 0x1b, 0x0a, 0x16, 0x41, 0x55, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x48, 0x41, 0x4c,
+	0x54, 0x45, 0x44, 0x10, 0xa1, 0x0a, 0x12, 0x19, 0x0a, 0x14, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0xa2,
+	0x0a, 0x12, 0x1a, 0x0a, 0x15, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0xa3, 0x0a, 0x12, 0x1e, 0x0a,
+	0x19, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xa4, 0x0a, 0x12, 0x1c, 0x0a,
+	0x17, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0xa5, 0x0a, 0x12, 0x1a, 0x0a, 0x15, 0x41,
+	0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x46, 0x46, 0x45, 0x52, 0x5f, 0x54, 0x4f, 0x4f,
+	0x5f, 0x4c, 0x4f, 0x57, 0x10, 0xa6, 0x0a, 0x12, 0x1d, 0x0a, 0x18, 0x41, 0x55, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x41, 0x53, 0x5f, 0x42,
+	0x49, 0x44, 0x53, 0x10, 0xa7, 0x0a, 0x12, 0x18, 0x0a, 0x13, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa8, 0x0a,
+	0x12, 0x14, 0x0a, 0x0f, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x4f, 0x55, 0x54, 0x10, 0xa9, 0x0a, 0x12, 0x1e, 0x0a, 0x19, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x44, 0x41,
+	0x42, 0x4c, 0x45, 0x10, 0xaa, 0x0a, 0x12, 0x17, 0x0a, 0x12, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0xab, 0x0a, 0x12,
 	0x19, 0x0a, 0x14, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
 	0x49, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0xf8, 0x0a, 0x12, 0x1a, 0x0a, 0x15, 0x52, 0x41,
 	0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x43,
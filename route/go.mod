@@ -3,6 +3,7 @@ module route_module
 go 1.25.0
 
 require (
+	github.com/bytedance/gopkg v0.1.3
 	github.com/cloudwego/kitex v0.15.1
 	github.com/gin-gonic/gin v1.11.0
 	github.com/golang/protobuf v1.5.4
@@ -15,7 +16,6 @@ require (
 )
 
 require (
-	github.com/bytedance/gopkg v0.1.3 // indirect
 	github.com/bytedance/sonic v1.14.1 // indirect
 	github.com/bytedance/sonic/loader v0.3.0 // indirect
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
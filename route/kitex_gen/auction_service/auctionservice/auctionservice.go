@@ -51,6 +51,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"amend_sell": kitex.NewMethodInfo(
+		amendSellHandler,
+		newAmendSellArgs,
+		newAmendSellResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"amend_buy": kitex.NewMethodInfo(
+		amendBuyHandler,
+		newAmendBuyArgs,
+		newAmendBuyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"get_my_sells": kitex.NewMethodInfo(
 		getMySellsHandler,
 		newGetMySellsArgs,
@@ -72,6 +86,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"search_market": kitex.NewMethodInfo(
+		searchMarketHandler,
+		newSearchMarketArgs,
+		newSearchMarketResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"get_transaction_history": kitex.NewMethodInfo(
 		getTransactionHistoryHandler,
 		newGetTransactionHistoryArgs,
@@ -86,6 +107,125 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"get_auction_statement": kitex.NewMethodInfo(
+		getAuctionStatementHandler,
+		newGetAuctionStatementArgs,
+		newGetAuctionStatementResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"export_auction_statement": kitex.NewMethodInfo(
+		exportAuctionStatementHandler,
+		newExportAuctionStatementArgs,
+		newExportAuctionStatementResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"get_item_kline": kitex.NewMethodInfo(
+		getItemKlineHandler,
+		newGetItemKlineArgs,
+		newGetItemKlineResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"subscribe_item": kitex.NewMethodInfo(
+		subscribeItemHandler,
+		newSubscribeItemArgs,
+		newSubscribeItemResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"unsubscribe_item": kitex.NewMethodInfo(
+		unsubscribeItemHandler,
+		newUnsubscribeItemArgs,
+		newUnsubscribeItemResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"list_unique_item": kitex.NewMethodInfo(
+		listUniqueItemHandler,
+		newListUniqueItemArgs,
+		newListUniqueItemResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"cancel_unique_listing": kitex.NewMethodInfo(
+		cancelUniqueListingHandler,
+		newCancelUniqueListingArgs,
+		newCancelUniqueListingResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"buy_unique_item": kitex.NewMethodInfo(
+		buyUniqueItemHandler,
+		newBuyUniqueItemArgs,
+		newBuyUniqueItemResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"offer_unique_item": kitex.NewMethodInfo(
+		offerUniqueItemHandler,
+		newOfferUniqueItemArgs,
+		newOfferUniqueItemResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"accept_unique_offer": kitex.NewMethodInfo(
+		acceptUniqueOfferHandler,
+		newAcceptUniqueOfferArgs,
+		newAcceptUniqueOfferResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"search_unique_listings": kitex.NewMethodInfo(
+		searchUniqueListingsHandler,
+		newSearchUniqueListingsArgs,
+		newSearchUniqueListingsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"get_my_unique_listings": kitex.NewMethodInfo(
+		getMyUniqueListingsHandler,
+		newGetMyUniqueListingsArgs,
+		newGetMyUniqueListingsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"create_timed_auction": kitex.NewMethodInfo(
+		createTimedAuctionHandler,
+		newCreateTimedAuctionArgs,
+		newCreateTimedAuctionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"bid_timed_auction": kitex.NewMethodInfo(
+		bidTimedAuctionHandler,
+		newBidTimedAuctionArgs,
+		newBidTimedAuctionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"cancel_timed_auction": kitex.NewMethodInfo(
+		cancelTimedAuctionHandler,
+		newCancelTimedAuctionArgs,
+		newCancelTimedAuctionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"get_timed_auction": kitex.NewMethodInfo(
+		getTimedAuctionHandler,
+		newGetTimedAuctionArgs,
+		newGetTimedAuctionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"get_timed_auctions": kitex.NewMethodInfo(
+		getTimedAuctionsHandler,
+		newGetTimedAuctionsArgs,
+		newGetTimedAuctionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 }
 
 var (
@@ -707,6 +847,228 @@ func (p *CancelBuyResult) GetResult() interface{} {
 	return p.Success
 }
 
+func amendSellHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.AmendSellReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).AmendSell(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AmendSellArgs:
+		success, err := handler.(auction_service.AuctionService).AmendSell(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AmendSellResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAmendSellArgs() interface{} {
+	return &AmendSellArgs{}
+}
+
+func newAmendSellResult() interface{} {
+	return &AmendSellResult{}
+}
+
+type AmendSellArgs struct {
+	Req *auction.AmendSellReq
+}
+
+func (p *AmendSellArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AmendSellArgs) Unmarshal(in []byte) error {
+	msg := new(auction.AmendSellReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AmendSellArgs_Req_DEFAULT *auction.AmendSellReq
+
+func (p *AmendSellArgs) GetReq() *auction.AmendSellReq {
+	if !p.IsSetReq() {
+		return AmendSellArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AmendSellArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AmendSellArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AmendSellResult struct {
+	Success *auction.AmendSellRsp
+}
+
+var AmendSellResult_Success_DEFAULT *auction.AmendSellRsp
+
+func (p *AmendSellResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AmendSellResult) Unmarshal(in []byte) error {
+	msg := new(auction.AmendSellRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AmendSellResult) GetSuccess() *auction.AmendSellRsp {
+	if !p.IsSetSuccess() {
+		return AmendSellResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AmendSellResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.AmendSellRsp)
+}
+
+func (p *AmendSellResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AmendSellResult) GetResult() interface{} {
+	return p.Success
+}
+
+func amendBuyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.AmendBuyReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).AmendBuy(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AmendBuyArgs:
+		success, err := handler.(auction_service.AuctionService).AmendBuy(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AmendBuyResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAmendBuyArgs() interface{} {
+	return &AmendBuyArgs{}
+}
+
+func newAmendBuyResult() interface{} {
+	return &AmendBuyResult{}
+}
+
+type AmendBuyArgs struct {
+	Req *auction.AmendBuyReq
+}
+
+func (p *AmendBuyArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AmendBuyArgs) Unmarshal(in []byte) error {
+	msg := new(auction.AmendBuyReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AmendBuyArgs_Req_DEFAULT *auction.AmendBuyReq
+
+func (p *AmendBuyArgs) GetReq() *auction.AmendBuyReq {
+	if !p.IsSetReq() {
+		return AmendBuyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AmendBuyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AmendBuyArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AmendBuyResult struct {
+	Success *auction.AmendBuyRsp
+}
+
+var AmendBuyResult_Success_DEFAULT *auction.AmendBuyRsp
+
+func (p *AmendBuyResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AmendBuyResult) Unmarshal(in []byte) error {
+	msg := new(auction.AmendBuyRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AmendBuyResult) GetSuccess() *auction.AmendBuyRsp {
+	if !p.IsSetSuccess() {
+		return AmendBuyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AmendBuyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.AmendBuyRsp)
+}
+
+func (p *AmendBuyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AmendBuyResult) GetResult() interface{} {
+	return p.Success
+}
+
 func getMySellsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
@@ -1040,52 +1402,52 @@ func (p *GetItemAuctionInfoResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getTransactionHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func searchMarketHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(auction.GetTransactionHistoryReq)
+		req := new(auction.SearchMarketReq)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(auction_service.AuctionService).GetTransactionHistory(ctx, req)
+		resp, err := handler.(auction_service.AuctionService).SearchMarket(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetTransactionHistoryArgs:
-		success, err := handler.(auction_service.AuctionService).GetTransactionHistory(ctx, s.Req)
+	case *SearchMarketArgs:
+		success, err := handler.(auction_service.AuctionService).SearchMarket(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetTransactionHistoryResult)
+		realResult := result.(*SearchMarketResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetTransactionHistoryArgs() interface{} {
-	return &GetTransactionHistoryArgs{}
+func newSearchMarketArgs() interface{} {
+	return &SearchMarketArgs{}
 }
 
-func newGetTransactionHistoryResult() interface{} {
-	return &GetTransactionHistoryResult{}
+func newSearchMarketResult() interface{} {
+	return &SearchMarketResult{}
 }
 
-type GetTransactionHistoryArgs struct {
-	Req *auction.GetTransactionHistoryReq
+type SearchMarketArgs struct {
+	Req *auction.SearchMarketReq
 }
 
-func (p *GetTransactionHistoryArgs) Marshal(out []byte) ([]byte, error) {
+func (p *SearchMarketArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetTransactionHistoryArgs) Unmarshal(in []byte) error {
-	msg := new(auction.GetTransactionHistoryReq)
+func (p *SearchMarketArgs) Unmarshal(in []byte) error {
+	msg := new(auction.SearchMarketReq)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1093,38 +1455,38 @@ func (p *GetTransactionHistoryArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetTransactionHistoryArgs_Req_DEFAULT *auction.GetTransactionHistoryReq
+var SearchMarketArgs_Req_DEFAULT *auction.SearchMarketReq
 
-func (p *GetTransactionHistoryArgs) GetReq() *auction.GetTransactionHistoryReq {
+func (p *SearchMarketArgs) GetReq() *auction.SearchMarketReq {
 	if !p.IsSetReq() {
-		return GetTransactionHistoryArgs_Req_DEFAULT
+		return SearchMarketArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetTransactionHistoryArgs) IsSetReq() bool {
+func (p *SearchMarketArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetTransactionHistoryArgs) GetFirstArgument() interface{} {
+func (p *SearchMarketArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetTransactionHistoryResult struct {
-	Success *auction.GetTransactionHistoryRsp
+type SearchMarketResult struct {
+	Success *auction.SearchMarketRsp
 }
 
-var GetTransactionHistoryResult_Success_DEFAULT *auction.GetTransactionHistoryRsp
+var SearchMarketResult_Success_DEFAULT *auction.SearchMarketRsp
 
-func (p *GetTransactionHistoryResult) Marshal(out []byte) ([]byte, error) {
+func (p *SearchMarketResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetTransactionHistoryResult) Unmarshal(in []byte) error {
-	msg := new(auction.GetTransactionHistoryRsp)
+func (p *SearchMarketResult) Unmarshal(in []byte) error {
+	msg := new(auction.SearchMarketRsp)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1132,34 +1494,145 @@ func (p *GetTransactionHistoryResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetTransactionHistoryResult) GetSuccess() *auction.GetTransactionHistoryRsp {
+func (p *SearchMarketResult) GetSuccess() *auction.SearchMarketRsp {
 	if !p.IsSetSuccess() {
-		return GetTransactionHistoryResult_Success_DEFAULT
+		return SearchMarketResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetTransactionHistoryResult) SetSuccess(x interface{}) {
-	p.Success = x.(*auction.GetTransactionHistoryRsp)
+func (p *SearchMarketResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.SearchMarketRsp)
 }
 
-func (p *GetTransactionHistoryResult) IsSetSuccess() bool {
+func (p *SearchMarketResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetTransactionHistoryResult) GetResult() interface{} {
+func (p *SearchMarketResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getTransactionsByTimeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getTransactionHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(auction.GetTransactionsByTimeReq)
+		req := new(auction.GetTransactionHistoryReq)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(auction_service.AuctionService).GetTransactionsByTime(ctx, req)
+		resp, err := handler.(auction_service.AuctionService).GetTransactionHistory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetTransactionHistoryArgs:
+		success, err := handler.(auction_service.AuctionService).GetTransactionHistory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetTransactionHistoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetTransactionHistoryArgs() interface{} {
+	return &GetTransactionHistoryArgs{}
+}
+
+func newGetTransactionHistoryResult() interface{} {
+	return &GetTransactionHistoryResult{}
+}
+
+type GetTransactionHistoryArgs struct {
+	Req *auction.GetTransactionHistoryReq
+}
+
+func (p *GetTransactionHistoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetTransactionHistoryArgs) Unmarshal(in []byte) error {
+	msg := new(auction.GetTransactionHistoryReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetTransactionHistoryArgs_Req_DEFAULT *auction.GetTransactionHistoryReq
+
+func (p *GetTransactionHistoryArgs) GetReq() *auction.GetTransactionHistoryReq {
+	if !p.IsSetReq() {
+		return GetTransactionHistoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetTransactionHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetTransactionHistoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetTransactionHistoryResult struct {
+	Success *auction.GetTransactionHistoryRsp
+}
+
+var GetTransactionHistoryResult_Success_DEFAULT *auction.GetTransactionHistoryRsp
+
+func (p *GetTransactionHistoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetTransactionHistoryResult) Unmarshal(in []byte) error {
+	msg := new(auction.GetTransactionHistoryRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetTransactionHistoryResult) GetSuccess() *auction.GetTransactionHistoryRsp {
+	if !p.IsSetSuccess() {
+		return GetTransactionHistoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetTransactionHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.GetTransactionHistoryRsp)
+}
+
+func (p *GetTransactionHistoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetTransactionHistoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getTransactionsByTimeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.GetTransactionsByTimeReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).GetTransactionsByTime(ctx, req)
 		if err != nil {
 			return err
 		}
@@ -1169,34 +1642,1810 @@ func getTransactionsByTimeHandler(ctx context.Context, handler interface{}, arg,
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetTransactionsByTimeResult)
+		realResult := result.(*GetTransactionsByTimeResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetTransactionsByTimeArgs() interface{} {
+	return &GetTransactionsByTimeArgs{}
+}
+
+func newGetTransactionsByTimeResult() interface{} {
+	return &GetTransactionsByTimeResult{}
+}
+
+type GetTransactionsByTimeArgs struct {
+	Req *auction.GetTransactionsByTimeReq
+}
+
+func (p *GetTransactionsByTimeArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetTransactionsByTimeArgs) Unmarshal(in []byte) error {
+	msg := new(auction.GetTransactionsByTimeReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetTransactionsByTimeArgs_Req_DEFAULT *auction.GetTransactionsByTimeReq
+
+func (p *GetTransactionsByTimeArgs) GetReq() *auction.GetTransactionsByTimeReq {
+	if !p.IsSetReq() {
+		return GetTransactionsByTimeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetTransactionsByTimeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetTransactionsByTimeArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetTransactionsByTimeResult struct {
+	Success *auction.GetTransactionsByTimeRsp
+}
+
+var GetTransactionsByTimeResult_Success_DEFAULT *auction.GetTransactionsByTimeRsp
+
+func (p *GetTransactionsByTimeResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetTransactionsByTimeResult) Unmarshal(in []byte) error {
+	msg := new(auction.GetTransactionsByTimeRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetTransactionsByTimeResult) GetSuccess() *auction.GetTransactionsByTimeRsp {
+	if !p.IsSetSuccess() {
+		return GetTransactionsByTimeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetTransactionsByTimeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.GetTransactionsByTimeRsp)
+}
+
+func (p *GetTransactionsByTimeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetTransactionsByTimeResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getAuctionStatementHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.GetAuctionStatementReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).GetAuctionStatement(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetAuctionStatementArgs:
+		success, err := handler.(auction_service.AuctionService).GetAuctionStatement(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetAuctionStatementResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetAuctionStatementArgs() interface{} {
+	return &GetAuctionStatementArgs{}
+}
+
+func newGetAuctionStatementResult() interface{} {
+	return &GetAuctionStatementResult{}
+}
+
+type GetAuctionStatementArgs struct {
+	Req *auction.GetAuctionStatementReq
+}
+
+func (p *GetAuctionStatementArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetAuctionStatementArgs) Unmarshal(in []byte) error {
+	msg := new(auction.GetAuctionStatementReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetAuctionStatementArgs_Req_DEFAULT *auction.GetAuctionStatementReq
+
+func (p *GetAuctionStatementArgs) GetReq() *auction.GetAuctionStatementReq {
+	if !p.IsSetReq() {
+		return GetAuctionStatementArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetAuctionStatementArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetAuctionStatementArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetAuctionStatementResult struct {
+	Success *auction.GetAuctionStatementRsp
+}
+
+var GetAuctionStatementResult_Success_DEFAULT *auction.GetAuctionStatementRsp
+
+func (p *GetAuctionStatementResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetAuctionStatementResult) Unmarshal(in []byte) error {
+	msg := new(auction.GetAuctionStatementRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetAuctionStatementResult) GetSuccess() *auction.GetAuctionStatementRsp {
+	if !p.IsSetSuccess() {
+		return GetAuctionStatementResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetAuctionStatementResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.GetAuctionStatementRsp)
+}
+
+func (p *GetAuctionStatementResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetAuctionStatementResult) GetResult() interface{} {
+	return p.Success
+}
+
+func exportAuctionStatementHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.ExportAuctionStatementReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).ExportAuctionStatement(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ExportAuctionStatementArgs:
+		success, err := handler.(auction_service.AuctionService).ExportAuctionStatement(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ExportAuctionStatementResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newExportAuctionStatementArgs() interface{} {
+	return &ExportAuctionStatementArgs{}
+}
+
+func newExportAuctionStatementResult() interface{} {
+	return &ExportAuctionStatementResult{}
+}
+
+type ExportAuctionStatementArgs struct {
+	Req *auction.ExportAuctionStatementReq
+}
+
+func (p *ExportAuctionStatementArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ExportAuctionStatementArgs) Unmarshal(in []byte) error {
+	msg := new(auction.ExportAuctionStatementReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ExportAuctionStatementArgs_Req_DEFAULT *auction.ExportAuctionStatementReq
+
+func (p *ExportAuctionStatementArgs) GetReq() *auction.ExportAuctionStatementReq {
+	if !p.IsSetReq() {
+		return ExportAuctionStatementArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ExportAuctionStatementArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ExportAuctionStatementArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ExportAuctionStatementResult struct {
+	Success *auction.ExportAuctionStatementRsp
+}
+
+var ExportAuctionStatementResult_Success_DEFAULT *auction.ExportAuctionStatementRsp
+
+func (p *ExportAuctionStatementResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ExportAuctionStatementResult) Unmarshal(in []byte) error {
+	msg := new(auction.ExportAuctionStatementRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ExportAuctionStatementResult) GetSuccess() *auction.ExportAuctionStatementRsp {
+	if !p.IsSetSuccess() {
+		return ExportAuctionStatementResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ExportAuctionStatementResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.ExportAuctionStatementRsp)
+}
+
+func (p *ExportAuctionStatementResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ExportAuctionStatementResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getItemKlineHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.GetItemKlineReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).GetItemKline(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetItemKlineArgs:
+		success, err := handler.(auction_service.AuctionService).GetItemKline(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetItemKlineResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetItemKlineArgs() interface{} {
+	return &GetItemKlineArgs{}
+}
+
+func newGetItemKlineResult() interface{} {
+	return &GetItemKlineResult{}
+}
+
+type GetItemKlineArgs struct {
+	Req *auction.GetItemKlineReq
+}
+
+func (p *GetItemKlineArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetItemKlineArgs) Unmarshal(in []byte) error {
+	msg := new(auction.GetItemKlineReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetItemKlineArgs_Req_DEFAULT *auction.GetItemKlineReq
+
+func (p *GetItemKlineArgs) GetReq() *auction.GetItemKlineReq {
+	if !p.IsSetReq() {
+		return GetItemKlineArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetItemKlineArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetItemKlineArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetItemKlineResult struct {
+	Success *auction.GetItemKlineRsp
+}
+
+var GetItemKlineResult_Success_DEFAULT *auction.GetItemKlineRsp
+
+func (p *GetItemKlineResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetItemKlineResult) Unmarshal(in []byte) error {
+	msg := new(auction.GetItemKlineRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetItemKlineResult) GetSuccess() *auction.GetItemKlineRsp {
+	if !p.IsSetSuccess() {
+		return GetItemKlineResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetItemKlineResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.GetItemKlineRsp)
+}
+
+func (p *GetItemKlineResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetItemKlineResult) GetResult() interface{} {
+	return p.Success
+}
+
+func subscribeItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.SubscribeItemReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).SubscribeItem(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SubscribeItemArgs:
+		success, err := handler.(auction_service.AuctionService).SubscribeItem(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SubscribeItemResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSubscribeItemArgs() interface{} {
+	return &SubscribeItemArgs{}
+}
+
+func newSubscribeItemResult() interface{} {
+	return &SubscribeItemResult{}
+}
+
+type SubscribeItemArgs struct {
+	Req *auction.SubscribeItemReq
+}
+
+func (p *SubscribeItemArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SubscribeItemArgs) Unmarshal(in []byte) error {
+	msg := new(auction.SubscribeItemReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SubscribeItemArgs_Req_DEFAULT *auction.SubscribeItemReq
+
+func (p *SubscribeItemArgs) GetReq() *auction.SubscribeItemReq {
+	if !p.IsSetReq() {
+		return SubscribeItemArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SubscribeItemArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SubscribeItemArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SubscribeItemResult struct {
+	Success *auction.SubscribeItemRsp
+}
+
+var SubscribeItemResult_Success_DEFAULT *auction.SubscribeItemRsp
+
+func (p *SubscribeItemResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SubscribeItemResult) Unmarshal(in []byte) error {
+	msg := new(auction.SubscribeItemRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SubscribeItemResult) GetSuccess() *auction.SubscribeItemRsp {
+	if !p.IsSetSuccess() {
+		return SubscribeItemResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SubscribeItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.SubscribeItemRsp)
+}
+
+func (p *SubscribeItemResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SubscribeItemResult) GetResult() interface{} {
+	return p.Success
+}
+
+func unsubscribeItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.UnsubscribeItemReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).UnsubscribeItem(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UnsubscribeItemArgs:
+		success, err := handler.(auction_service.AuctionService).UnsubscribeItem(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UnsubscribeItemResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUnsubscribeItemArgs() interface{} {
+	return &UnsubscribeItemArgs{}
+}
+
+func newUnsubscribeItemResult() interface{} {
+	return &UnsubscribeItemResult{}
+}
+
+type UnsubscribeItemArgs struct {
+	Req *auction.UnsubscribeItemReq
+}
+
+func (p *UnsubscribeItemArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UnsubscribeItemArgs) Unmarshal(in []byte) error {
+	msg := new(auction.UnsubscribeItemReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UnsubscribeItemArgs_Req_DEFAULT *auction.UnsubscribeItemReq
+
+func (p *UnsubscribeItemArgs) GetReq() *auction.UnsubscribeItemReq {
+	if !p.IsSetReq() {
+		return UnsubscribeItemArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UnsubscribeItemArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UnsubscribeItemArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UnsubscribeItemResult struct {
+	Success *auction.UnsubscribeItemRsp
+}
+
+var UnsubscribeItemResult_Success_DEFAULT *auction.UnsubscribeItemRsp
+
+func (p *UnsubscribeItemResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UnsubscribeItemResult) Unmarshal(in []byte) error {
+	msg := new(auction.UnsubscribeItemRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UnsubscribeItemResult) GetSuccess() *auction.UnsubscribeItemRsp {
+	if !p.IsSetSuccess() {
+		return UnsubscribeItemResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UnsubscribeItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.UnsubscribeItemRsp)
+}
+
+func (p *UnsubscribeItemResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UnsubscribeItemResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listUniqueItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.ListUniqueItemReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).ListUniqueItem(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListUniqueItemArgs:
+		success, err := handler.(auction_service.AuctionService).ListUniqueItem(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListUniqueItemResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListUniqueItemArgs() interface{} {
+	return &ListUniqueItemArgs{}
+}
+
+func newListUniqueItemResult() interface{} {
+	return &ListUniqueItemResult{}
+}
+
+type ListUniqueItemArgs struct {
+	Req *auction.ListUniqueItemReq
+}
+
+func (p *ListUniqueItemArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListUniqueItemArgs) Unmarshal(in []byte) error {
+	msg := new(auction.ListUniqueItemReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListUniqueItemArgs_Req_DEFAULT *auction.ListUniqueItemReq
+
+func (p *ListUniqueItemArgs) GetReq() *auction.ListUniqueItemReq {
+	if !p.IsSetReq() {
+		return ListUniqueItemArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListUniqueItemArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListUniqueItemArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListUniqueItemResult struct {
+	Success *auction.ListUniqueItemRsp
+}
+
+var ListUniqueItemResult_Success_DEFAULT *auction.ListUniqueItemRsp
+
+func (p *ListUniqueItemResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListUniqueItemResult) Unmarshal(in []byte) error {
+	msg := new(auction.ListUniqueItemRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListUniqueItemResult) GetSuccess() *auction.ListUniqueItemRsp {
+	if !p.IsSetSuccess() {
+		return ListUniqueItemResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListUniqueItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.ListUniqueItemRsp)
+}
+
+func (p *ListUniqueItemResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListUniqueItemResult) GetResult() interface{} {
+	return p.Success
+}
+
+func cancelUniqueListingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.CancelUniqueListingReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).CancelUniqueListing(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CancelUniqueListingArgs:
+		success, err := handler.(auction_service.AuctionService).CancelUniqueListing(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CancelUniqueListingResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCancelUniqueListingArgs() interface{} {
+	return &CancelUniqueListingArgs{}
+}
+
+func newCancelUniqueListingResult() interface{} {
+	return &CancelUniqueListingResult{}
+}
+
+type CancelUniqueListingArgs struct {
+	Req *auction.CancelUniqueListingReq
+}
+
+func (p *CancelUniqueListingArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CancelUniqueListingArgs) Unmarshal(in []byte) error {
+	msg := new(auction.CancelUniqueListingReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CancelUniqueListingArgs_Req_DEFAULT *auction.CancelUniqueListingReq
+
+func (p *CancelUniqueListingArgs) GetReq() *auction.CancelUniqueListingReq {
+	if !p.IsSetReq() {
+		return CancelUniqueListingArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CancelUniqueListingArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CancelUniqueListingArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CancelUniqueListingResult struct {
+	Success *auction.CancelUniqueListingRsp
+}
+
+var CancelUniqueListingResult_Success_DEFAULT *auction.CancelUniqueListingRsp
+
+func (p *CancelUniqueListingResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CancelUniqueListingResult) Unmarshal(in []byte) error {
+	msg := new(auction.CancelUniqueListingRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CancelUniqueListingResult) GetSuccess() *auction.CancelUniqueListingRsp {
+	if !p.IsSetSuccess() {
+		return CancelUniqueListingResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CancelUniqueListingResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.CancelUniqueListingRsp)
+}
+
+func (p *CancelUniqueListingResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CancelUniqueListingResult) GetResult() interface{} {
+	return p.Success
+}
+
+func buyUniqueItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.BuyUniqueItemReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).BuyUniqueItem(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *BuyUniqueItemArgs:
+		success, err := handler.(auction_service.AuctionService).BuyUniqueItem(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*BuyUniqueItemResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newBuyUniqueItemArgs() interface{} {
+	return &BuyUniqueItemArgs{}
+}
+
+func newBuyUniqueItemResult() interface{} {
+	return &BuyUniqueItemResult{}
+}
+
+type BuyUniqueItemArgs struct {
+	Req *auction.BuyUniqueItemReq
+}
+
+func (p *BuyUniqueItemArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *BuyUniqueItemArgs) Unmarshal(in []byte) error {
+	msg := new(auction.BuyUniqueItemReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var BuyUniqueItemArgs_Req_DEFAULT *auction.BuyUniqueItemReq
+
+func (p *BuyUniqueItemArgs) GetReq() *auction.BuyUniqueItemReq {
+	if !p.IsSetReq() {
+		return BuyUniqueItemArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *BuyUniqueItemArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *BuyUniqueItemArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type BuyUniqueItemResult struct {
+	Success *auction.BuyUniqueItemRsp
+}
+
+var BuyUniqueItemResult_Success_DEFAULT *auction.BuyUniqueItemRsp
+
+func (p *BuyUniqueItemResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *BuyUniqueItemResult) Unmarshal(in []byte) error {
+	msg := new(auction.BuyUniqueItemRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *BuyUniqueItemResult) GetSuccess() *auction.BuyUniqueItemRsp {
+	if !p.IsSetSuccess() {
+		return BuyUniqueItemResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *BuyUniqueItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.BuyUniqueItemRsp)
+}
+
+func (p *BuyUniqueItemResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *BuyUniqueItemResult) GetResult() interface{} {
+	return p.Success
+}
+
+func offerUniqueItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.OfferUniqueItemReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).OfferUniqueItem(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *OfferUniqueItemArgs:
+		success, err := handler.(auction_service.AuctionService).OfferUniqueItem(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*OfferUniqueItemResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newOfferUniqueItemArgs() interface{} {
+	return &OfferUniqueItemArgs{}
+}
+
+func newOfferUniqueItemResult() interface{} {
+	return &OfferUniqueItemResult{}
+}
+
+type OfferUniqueItemArgs struct {
+	Req *auction.OfferUniqueItemReq
+}
+
+func (p *OfferUniqueItemArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *OfferUniqueItemArgs) Unmarshal(in []byte) error {
+	msg := new(auction.OfferUniqueItemReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var OfferUniqueItemArgs_Req_DEFAULT *auction.OfferUniqueItemReq
+
+func (p *OfferUniqueItemArgs) GetReq() *auction.OfferUniqueItemReq {
+	if !p.IsSetReq() {
+		return OfferUniqueItemArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *OfferUniqueItemArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OfferUniqueItemArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type OfferUniqueItemResult struct {
+	Success *auction.OfferUniqueItemRsp
+}
+
+var OfferUniqueItemResult_Success_DEFAULT *auction.OfferUniqueItemRsp
+
+func (p *OfferUniqueItemResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *OfferUniqueItemResult) Unmarshal(in []byte) error {
+	msg := new(auction.OfferUniqueItemRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *OfferUniqueItemResult) GetSuccess() *auction.OfferUniqueItemRsp {
+	if !p.IsSetSuccess() {
+		return OfferUniqueItemResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *OfferUniqueItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.OfferUniqueItemRsp)
+}
+
+func (p *OfferUniqueItemResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OfferUniqueItemResult) GetResult() interface{} {
+	return p.Success
+}
+
+func acceptUniqueOfferHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.AcceptUniqueOfferReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).AcceptUniqueOffer(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AcceptUniqueOfferArgs:
+		success, err := handler.(auction_service.AuctionService).AcceptUniqueOffer(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AcceptUniqueOfferResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAcceptUniqueOfferArgs() interface{} {
+	return &AcceptUniqueOfferArgs{}
+}
+
+func newAcceptUniqueOfferResult() interface{} {
+	return &AcceptUniqueOfferResult{}
+}
+
+type AcceptUniqueOfferArgs struct {
+	Req *auction.AcceptUniqueOfferReq
+}
+
+func (p *AcceptUniqueOfferArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AcceptUniqueOfferArgs) Unmarshal(in []byte) error {
+	msg := new(auction.AcceptUniqueOfferReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AcceptUniqueOfferArgs_Req_DEFAULT *auction.AcceptUniqueOfferReq
+
+func (p *AcceptUniqueOfferArgs) GetReq() *auction.AcceptUniqueOfferReq {
+	if !p.IsSetReq() {
+		return AcceptUniqueOfferArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AcceptUniqueOfferArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AcceptUniqueOfferArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AcceptUniqueOfferResult struct {
+	Success *auction.AcceptUniqueOfferRsp
+}
+
+var AcceptUniqueOfferResult_Success_DEFAULT *auction.AcceptUniqueOfferRsp
+
+func (p *AcceptUniqueOfferResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AcceptUniqueOfferResult) Unmarshal(in []byte) error {
+	msg := new(auction.AcceptUniqueOfferRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AcceptUniqueOfferResult) GetSuccess() *auction.AcceptUniqueOfferRsp {
+	if !p.IsSetSuccess() {
+		return AcceptUniqueOfferResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AcceptUniqueOfferResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.AcceptUniqueOfferRsp)
+}
+
+func (p *AcceptUniqueOfferResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AcceptUniqueOfferResult) GetResult() interface{} {
+	return p.Success
+}
+
+func searchUniqueListingsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.SearchUniqueListingsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).SearchUniqueListings(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SearchUniqueListingsArgs:
+		success, err := handler.(auction_service.AuctionService).SearchUniqueListings(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SearchUniqueListingsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSearchUniqueListingsArgs() interface{} {
+	return &SearchUniqueListingsArgs{}
+}
+
+func newSearchUniqueListingsResult() interface{} {
+	return &SearchUniqueListingsResult{}
+}
+
+type SearchUniqueListingsArgs struct {
+	Req *auction.SearchUniqueListingsReq
+}
+
+func (p *SearchUniqueListingsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SearchUniqueListingsArgs) Unmarshal(in []byte) error {
+	msg := new(auction.SearchUniqueListingsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SearchUniqueListingsArgs_Req_DEFAULT *auction.SearchUniqueListingsReq
+
+func (p *SearchUniqueListingsArgs) GetReq() *auction.SearchUniqueListingsReq {
+	if !p.IsSetReq() {
+		return SearchUniqueListingsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SearchUniqueListingsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SearchUniqueListingsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SearchUniqueListingsResult struct {
+	Success *auction.SearchUniqueListingsRsp
+}
+
+var SearchUniqueListingsResult_Success_DEFAULT *auction.SearchUniqueListingsRsp
+
+func (p *SearchUniqueListingsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SearchUniqueListingsResult) Unmarshal(in []byte) error {
+	msg := new(auction.SearchUniqueListingsRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SearchUniqueListingsResult) GetSuccess() *auction.SearchUniqueListingsRsp {
+	if !p.IsSetSuccess() {
+		return SearchUniqueListingsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SearchUniqueListingsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.SearchUniqueListingsRsp)
+}
+
+func (p *SearchUniqueListingsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SearchUniqueListingsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getMyUniqueListingsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.GetMyUniqueListingsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).GetMyUniqueListings(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetMyUniqueListingsArgs:
+		success, err := handler.(auction_service.AuctionService).GetMyUniqueListings(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetMyUniqueListingsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetMyUniqueListingsArgs() interface{} {
+	return &GetMyUniqueListingsArgs{}
+}
+
+func newGetMyUniqueListingsResult() interface{} {
+	return &GetMyUniqueListingsResult{}
+}
+
+type GetMyUniqueListingsArgs struct {
+	Req *auction.GetMyUniqueListingsReq
+}
+
+func (p *GetMyUniqueListingsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetMyUniqueListingsArgs) Unmarshal(in []byte) error {
+	msg := new(auction.GetMyUniqueListingsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetMyUniqueListingsArgs_Req_DEFAULT *auction.GetMyUniqueListingsReq
+
+func (p *GetMyUniqueListingsArgs) GetReq() *auction.GetMyUniqueListingsReq {
+	if !p.IsSetReq() {
+		return GetMyUniqueListingsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetMyUniqueListingsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetMyUniqueListingsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetMyUniqueListingsResult struct {
+	Success *auction.GetMyUniqueListingsRsp
+}
+
+var GetMyUniqueListingsResult_Success_DEFAULT *auction.GetMyUniqueListingsRsp
+
+func (p *GetMyUniqueListingsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetMyUniqueListingsResult) Unmarshal(in []byte) error {
+	msg := new(auction.GetMyUniqueListingsRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetMyUniqueListingsResult) GetSuccess() *auction.GetMyUniqueListingsRsp {
+	if !p.IsSetSuccess() {
+		return GetMyUniqueListingsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetMyUniqueListingsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.GetMyUniqueListingsRsp)
+}
+
+func (p *GetMyUniqueListingsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetMyUniqueListingsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createTimedAuctionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.CreateTimedAuctionReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).CreateTimedAuction(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateTimedAuctionArgs:
+		success, err := handler.(auction_service.AuctionService).CreateTimedAuction(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateTimedAuctionResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateTimedAuctionArgs() interface{} {
+	return &CreateTimedAuctionArgs{}
+}
+
+func newCreateTimedAuctionResult() interface{} {
+	return &CreateTimedAuctionResult{}
+}
+
+type CreateTimedAuctionArgs struct {
+	Req *auction.CreateTimedAuctionReq
+}
+
+func (p *CreateTimedAuctionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateTimedAuctionArgs) Unmarshal(in []byte) error {
+	msg := new(auction.CreateTimedAuctionReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateTimedAuctionArgs_Req_DEFAULT *auction.CreateTimedAuctionReq
+
+func (p *CreateTimedAuctionArgs) GetReq() *auction.CreateTimedAuctionReq {
+	if !p.IsSetReq() {
+		return CreateTimedAuctionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateTimedAuctionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateTimedAuctionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateTimedAuctionResult struct {
+	Success *auction.CreateTimedAuctionRsp
+}
+
+var CreateTimedAuctionResult_Success_DEFAULT *auction.CreateTimedAuctionRsp
+
+func (p *CreateTimedAuctionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateTimedAuctionResult) Unmarshal(in []byte) error {
+	msg := new(auction.CreateTimedAuctionRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateTimedAuctionResult) GetSuccess() *auction.CreateTimedAuctionRsp {
+	if !p.IsSetSuccess() {
+		return CreateTimedAuctionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateTimedAuctionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.CreateTimedAuctionRsp)
+}
+
+func (p *CreateTimedAuctionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateTimedAuctionResult) GetResult() interface{} {
+	return p.Success
+}
+
+func bidTimedAuctionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.BidTimedAuctionReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).BidTimedAuction(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *BidTimedAuctionArgs:
+		success, err := handler.(auction_service.AuctionService).BidTimedAuction(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*BidTimedAuctionResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newBidTimedAuctionArgs() interface{} {
+	return &BidTimedAuctionArgs{}
+}
+
+func newBidTimedAuctionResult() interface{} {
+	return &BidTimedAuctionResult{}
+}
+
+type BidTimedAuctionArgs struct {
+	Req *auction.BidTimedAuctionReq
+}
+
+func (p *BidTimedAuctionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *BidTimedAuctionArgs) Unmarshal(in []byte) error {
+	msg := new(auction.BidTimedAuctionReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var BidTimedAuctionArgs_Req_DEFAULT *auction.BidTimedAuctionReq
+
+func (p *BidTimedAuctionArgs) GetReq() *auction.BidTimedAuctionReq {
+	if !p.IsSetReq() {
+		return BidTimedAuctionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *BidTimedAuctionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *BidTimedAuctionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type BidTimedAuctionResult struct {
+	Success *auction.BidTimedAuctionRsp
+}
+
+var BidTimedAuctionResult_Success_DEFAULT *auction.BidTimedAuctionRsp
+
+func (p *BidTimedAuctionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *BidTimedAuctionResult) Unmarshal(in []byte) error {
+	msg := new(auction.BidTimedAuctionRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *BidTimedAuctionResult) GetSuccess() *auction.BidTimedAuctionRsp {
+	if !p.IsSetSuccess() {
+		return BidTimedAuctionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *BidTimedAuctionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.BidTimedAuctionRsp)
+}
+
+func (p *BidTimedAuctionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *BidTimedAuctionResult) GetResult() interface{} {
+	return p.Success
+}
+
+func cancelTimedAuctionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.CancelTimedAuctionReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).CancelTimedAuction(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CancelTimedAuctionArgs:
+		success, err := handler.(auction_service.AuctionService).CancelTimedAuction(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CancelTimedAuctionResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCancelTimedAuctionArgs() interface{} {
+	return &CancelTimedAuctionArgs{}
+}
+
+func newCancelTimedAuctionResult() interface{} {
+	return &CancelTimedAuctionResult{}
+}
+
+type CancelTimedAuctionArgs struct {
+	Req *auction.CancelTimedAuctionReq
+}
+
+func (p *CancelTimedAuctionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CancelTimedAuctionArgs) Unmarshal(in []byte) error {
+	msg := new(auction.CancelTimedAuctionReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CancelTimedAuctionArgs_Req_DEFAULT *auction.CancelTimedAuctionReq
+
+func (p *CancelTimedAuctionArgs) GetReq() *auction.CancelTimedAuctionReq {
+	if !p.IsSetReq() {
+		return CancelTimedAuctionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CancelTimedAuctionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CancelTimedAuctionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CancelTimedAuctionResult struct {
+	Success *auction.CancelTimedAuctionRsp
+}
+
+var CancelTimedAuctionResult_Success_DEFAULT *auction.CancelTimedAuctionRsp
+
+func (p *CancelTimedAuctionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CancelTimedAuctionResult) Unmarshal(in []byte) error {
+	msg := new(auction.CancelTimedAuctionRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CancelTimedAuctionResult) GetSuccess() *auction.CancelTimedAuctionRsp {
+	if !p.IsSetSuccess() {
+		return CancelTimedAuctionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CancelTimedAuctionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.CancelTimedAuctionRsp)
+}
+
+func (p *CancelTimedAuctionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CancelTimedAuctionResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getTimedAuctionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.GetTimedAuctionReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).GetTimedAuction(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetTimedAuctionArgs:
+		success, err := handler.(auction_service.AuctionService).GetTimedAuction(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetTimedAuctionResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetTransactionsByTimeArgs() interface{} {
-	return &GetTransactionsByTimeArgs{}
+func newGetTimedAuctionArgs() interface{} {
+	return &GetTimedAuctionArgs{}
 }
 
-func newGetTransactionsByTimeResult() interface{} {
-	return &GetTransactionsByTimeResult{}
+func newGetTimedAuctionResult() interface{} {
+	return &GetTimedAuctionResult{}
 }
 
-type GetTransactionsByTimeArgs struct {
-	Req *auction.GetTransactionsByTimeReq
+type GetTimedAuctionArgs struct {
+	Req *auction.GetTimedAuctionReq
 }
 
-func (p *GetTransactionsByTimeArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetTimedAuctionArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetTransactionsByTimeArgs) Unmarshal(in []byte) error {
-	msg := new(auction.GetTransactionsByTimeReq)
+func (p *GetTimedAuctionArgs) Unmarshal(in []byte) error {
+	msg := new(auction.GetTimedAuctionReq)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1204,38 +3453,38 @@ func (p *GetTransactionsByTimeArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetTransactionsByTimeArgs_Req_DEFAULT *auction.GetTransactionsByTimeReq
+var GetTimedAuctionArgs_Req_DEFAULT *auction.GetTimedAuctionReq
 
-func (p *GetTransactionsByTimeArgs) GetReq() *auction.GetTransactionsByTimeReq {
+func (p *GetTimedAuctionArgs) GetReq() *auction.GetTimedAuctionReq {
 	if !p.IsSetReq() {
-		return GetTransactionsByTimeArgs_Req_DEFAULT
+		return GetTimedAuctionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetTransactionsByTimeArgs) IsSetReq() bool {
+func (p *GetTimedAuctionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetTransactionsByTimeArgs) GetFirstArgument() interface{} {
+func (p *GetTimedAuctionArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetTransactionsByTimeResult struct {
-	Success *auction.GetTransactionsByTimeRsp
+type GetTimedAuctionResult struct {
+	Success *auction.GetTimedAuctionRsp
 }
 
-var GetTransactionsByTimeResult_Success_DEFAULT *auction.GetTransactionsByTimeRsp
+var GetTimedAuctionResult_Success_DEFAULT *auction.GetTimedAuctionRsp
 
-func (p *GetTransactionsByTimeResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetTimedAuctionResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetTransactionsByTimeResult) Unmarshal(in []byte) error {
-	msg := new(auction.GetTransactionsByTimeRsp)
+func (p *GetTimedAuctionResult) Unmarshal(in []byte) error {
+	msg := new(auction.GetTimedAuctionRsp)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1243,22 +3492,133 @@ func (p *GetTransactionsByTimeResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetTransactionsByTimeResult) GetSuccess() *auction.GetTransactionsByTimeRsp {
+func (p *GetTimedAuctionResult) GetSuccess() *auction.GetTimedAuctionRsp {
 	if !p.IsSetSuccess() {
-		return GetTransactionsByTimeResult_Success_DEFAULT
+		return GetTimedAuctionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetTransactionsByTimeResult) SetSuccess(x interface{}) {
-	p.Success = x.(*auction.GetTransactionsByTimeRsp)
+func (p *GetTimedAuctionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.GetTimedAuctionRsp)
 }
 
-func (p *GetTransactionsByTimeResult) IsSetSuccess() bool {
+func (p *GetTimedAuctionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetTransactionsByTimeResult) GetResult() interface{} {
+func (p *GetTimedAuctionResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getTimedAuctionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(auction.GetTimedAuctionsReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(auction_service.AuctionService).GetTimedAuctions(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetTimedAuctionsArgs:
+		success, err := handler.(auction_service.AuctionService).GetTimedAuctions(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetTimedAuctionsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetTimedAuctionsArgs() interface{} {
+	return &GetTimedAuctionsArgs{}
+}
+
+func newGetTimedAuctionsResult() interface{} {
+	return &GetTimedAuctionsResult{}
+}
+
+type GetTimedAuctionsArgs struct {
+	Req *auction.GetTimedAuctionsReq
+}
+
+func (p *GetTimedAuctionsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetTimedAuctionsArgs) Unmarshal(in []byte) error {
+	msg := new(auction.GetTimedAuctionsReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetTimedAuctionsArgs_Req_DEFAULT *auction.GetTimedAuctionsReq
+
+func (p *GetTimedAuctionsArgs) GetReq() *auction.GetTimedAuctionsReq {
+	if !p.IsSetReq() {
+		return GetTimedAuctionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetTimedAuctionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetTimedAuctionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetTimedAuctionsResult struct {
+	Success *auction.GetTimedAuctionsRsp
+}
+
+var GetTimedAuctionsResult_Success_DEFAULT *auction.GetTimedAuctionsRsp
+
+func (p *GetTimedAuctionsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetTimedAuctionsResult) Unmarshal(in []byte) error {
+	msg := new(auction.GetTimedAuctionsRsp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetTimedAuctionsResult) GetSuccess() *auction.GetTimedAuctionsRsp {
+	if !p.IsSetSuccess() {
+		return GetTimedAuctionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetTimedAuctionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*auction.GetTimedAuctionsRsp)
+}
+
+func (p *GetTimedAuctionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetTimedAuctionsResult) GetResult() interface{} {
 	return p.Success
 }
 
@@ -1322,6 +3682,26 @@ func (p *kClient) CancelBuy(ctx context.Context, Req *auction.CancelBuyReq) (r *
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) AmendSell(ctx context.Context, Req *auction.AmendSellReq) (r *auction.AmendSellRsp, err error) {
+	var _args AmendSellArgs
+	_args.Req = Req
+	var _result AmendSellResult
+	if err = p.c.Call(ctx, "amend_sell", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AmendBuy(ctx context.Context, Req *auction.AmendBuyReq) (r *auction.AmendBuyRsp, err error) {
+	var _args AmendBuyArgs
+	_args.Req = Req
+	var _result AmendBuyResult
+	if err = p.c.Call(ctx, "amend_buy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) GetMySells(ctx context.Context, Req *auction.GetMySellsReq) (r *auction.GetMySellsRsp, err error) {
 	var _args GetMySellsArgs
 	_args.Req = Req
@@ -1352,6 +3732,16 @@ func (p *kClient) GetItemAuctionInfo(ctx context.Context, Req *auction.GetItemAu
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) SearchMarket(ctx context.Context, Req *auction.SearchMarketReq) (r *auction.SearchMarketRsp, err error) {
+	var _args SearchMarketArgs
+	_args.Req = Req
+	var _result SearchMarketResult
+	if err = p.c.Call(ctx, "search_market", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) GetTransactionHistory(ctx context.Context, Req *auction.GetTransactionHistoryReq) (r *auction.GetTransactionHistoryRsp, err error) {
 	var _args GetTransactionHistoryArgs
 	_args.Req = Req
@@ -1371,3 +3761,173 @@ func (p *kClient) GetTransactionsByTime(ctx context.Context, Req *auction.GetTra
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) GetAuctionStatement(ctx context.Context, Req *auction.GetAuctionStatementReq) (r *auction.GetAuctionStatementRsp, err error) {
+	var _args GetAuctionStatementArgs
+	_args.Req = Req
+	var _result GetAuctionStatementResult
+	if err = p.c.Call(ctx, "get_auction_statement", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportAuctionStatement(ctx context.Context, Req *auction.ExportAuctionStatementReq) (r *auction.ExportAuctionStatementRsp, err error) {
+	var _args ExportAuctionStatementArgs
+	_args.Req = Req
+	var _result ExportAuctionStatementResult
+	if err = p.c.Call(ctx, "export_auction_statement", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetItemKline(ctx context.Context, Req *auction.GetItemKlineReq) (r *auction.GetItemKlineRsp, err error) {
+	var _args GetItemKlineArgs
+	_args.Req = Req
+	var _result GetItemKlineResult
+	if err = p.c.Call(ctx, "get_item_kline", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SubscribeItem(ctx context.Context, Req *auction.SubscribeItemReq) (r *auction.SubscribeItemRsp, err error) {
+	var _args SubscribeItemArgs
+	_args.Req = Req
+	var _result SubscribeItemResult
+	if err = p.c.Call(ctx, "subscribe_item", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UnsubscribeItem(ctx context.Context, Req *auction.UnsubscribeItemReq) (r *auction.UnsubscribeItemRsp, err error) {
+	var _args UnsubscribeItemArgs
+	_args.Req = Req
+	var _result UnsubscribeItemResult
+	if err = p.c.Call(ctx, "unsubscribe_item", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListUniqueItem(ctx context.Context, Req *auction.ListUniqueItemReq) (r *auction.ListUniqueItemRsp, err error) {
+	var _args ListUniqueItemArgs
+	_args.Req = Req
+	var _result ListUniqueItemResult
+	if err = p.c.Call(ctx, "list_unique_item", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelUniqueListing(ctx context.Context, Req *auction.CancelUniqueListingReq) (r *auction.CancelUniqueListingRsp, err error) {
+	var _args CancelUniqueListingArgs
+	_args.Req = Req
+	var _result CancelUniqueListingResult
+	if err = p.c.Call(ctx, "cancel_unique_listing", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BuyUniqueItem(ctx context.Context, Req *auction.BuyUniqueItemReq) (r *auction.BuyUniqueItemRsp, err error) {
+	var _args BuyUniqueItemArgs
+	_args.Req = Req
+	var _result BuyUniqueItemResult
+	if err = p.c.Call(ctx, "buy_unique_item", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) OfferUniqueItem(ctx context.Context, Req *auction.OfferUniqueItemReq) (r *auction.OfferUniqueItemRsp, err error) {
+	var _args OfferUniqueItemArgs
+	_args.Req = Req
+	var _result OfferUniqueItemResult
+	if err = p.c.Call(ctx, "offer_unique_item", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AcceptUniqueOffer(ctx context.Context, Req *auction.AcceptUniqueOfferReq) (r *auction.AcceptUniqueOfferRsp, err error) {
+	var _args AcceptUniqueOfferArgs
+	_args.Req = Req
+	var _result AcceptUniqueOfferResult
+	if err = p.c.Call(ctx, "accept_unique_offer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SearchUniqueListings(ctx context.Context, Req *auction.SearchUniqueListingsReq) (r *auction.SearchUniqueListingsRsp, err error) {
+	var _args SearchUniqueListingsArgs
+	_args.Req = Req
+	var _result SearchUniqueListingsResult
+	if err = p.c.Call(ctx, "search_unique_listings", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetMyUniqueListings(ctx context.Context, Req *auction.GetMyUniqueListingsReq) (r *auction.GetMyUniqueListingsRsp, err error) {
+	var _args GetMyUniqueListingsArgs
+	_args.Req = Req
+	var _result GetMyUniqueListingsResult
+	if err = p.c.Call(ctx, "get_my_unique_listings", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateTimedAuction(ctx context.Context, Req *auction.CreateTimedAuctionReq) (r *auction.CreateTimedAuctionRsp, err error) {
+	var _args CreateTimedAuctionArgs
+	_args.Req = Req
+	var _result CreateTimedAuctionResult
+	if err = p.c.Call(ctx, "create_timed_auction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BidTimedAuction(ctx context.Context, Req *auction.BidTimedAuctionReq) (r *auction.BidTimedAuctionRsp, err error) {
+	var _args BidTimedAuctionArgs
+	_args.Req = Req
+	var _result BidTimedAuctionResult
+	if err = p.c.Call(ctx, "bid_timed_auction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelTimedAuction(ctx context.Context, Req *auction.CancelTimedAuctionReq) (r *auction.CancelTimedAuctionRsp, err error) {
+	var _args CancelTimedAuctionArgs
+	_args.Req = Req
+	var _result CancelTimedAuctionResult
+	if err = p.c.Call(ctx, "cancel_timed_auction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetTimedAuction(ctx context.Context, Req *auction.GetTimedAuctionReq) (r *auction.GetTimedAuctionRsp, err error) {
+	var _args GetTimedAuctionArgs
+	_args.Req = Req
+	var _result GetTimedAuctionResult
+	if err = p.c.Call(ctx, "get_timed_auction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetTimedAuctions(ctx context.Context, Req *auction.GetTimedAuctionsReq) (r *auction.GetTimedAuctionsRsp, err error) {
+	var _args GetTimedAuctionsArgs
+	_args.Req = Req
+	var _result GetTimedAuctionsResult
+	if err = p.c.Call(ctx, "get_timed_auctions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -16,11 +16,31 @@ type Client interface {
 	Buy(ctx context.Context, Req *auction.BuyReq, callOptions ...callopt.Option) (r *auction.BuyRsp, err error)
 	CancelSell(ctx context.Context, Req *auction.CancelSellReq, callOptions ...callopt.Option) (r *auction.CancelSellRsp, err error)
 	CancelBuy(ctx context.Context, Req *auction.CancelBuyReq, callOptions ...callopt.Option) (r *auction.CancelBuyRsp, err error)
+	AmendSell(ctx context.Context, Req *auction.AmendSellReq, callOptions ...callopt.Option) (r *auction.AmendSellRsp, err error)
+	AmendBuy(ctx context.Context, Req *auction.AmendBuyReq, callOptions ...callopt.Option) (r *auction.AmendBuyRsp, err error)
 	GetMySells(ctx context.Context, Req *auction.GetMySellsReq, callOptions ...callopt.Option) (r *auction.GetMySellsRsp, err error)
 	GetMyBuys(ctx context.Context, Req *auction.GetMyBuysReq, callOptions ...callopt.Option) (r *auction.GetMyBuysRsp, err error)
 	GetItemAuctionInfo(ctx context.Context, Req *auction.GetItemAuctionInfoReq, callOptions ...callopt.Option) (r *auction.GetItemAuctionInfoRsp, err error)
+	SearchMarket(ctx context.Context, Req *auction.SearchMarketReq, callOptions ...callopt.Option) (r *auction.SearchMarketRsp, err error)
 	GetTransactionHistory(ctx context.Context, Req *auction.GetTransactionHistoryReq, callOptions ...callopt.Option) (r *auction.GetTransactionHistoryRsp, err error)
 	GetTransactionsByTime(ctx context.Context, Req *auction.GetTransactionsByTimeReq, callOptions ...callopt.Option) (r *auction.GetTransactionsByTimeRsp, err error)
+	GetAuctionStatement(ctx context.Context, Req *auction.GetAuctionStatementReq, callOptions ...callopt.Option) (r *auction.GetAuctionStatementRsp, err error)
+	ExportAuctionStatement(ctx context.Context, Req *auction.ExportAuctionStatementReq, callOptions ...callopt.Option) (r *auction.ExportAuctionStatementRsp, err error)
+	GetItemKline(ctx context.Context, Req *auction.GetItemKlineReq, callOptions ...callopt.Option) (r *auction.GetItemKlineRsp, err error)
+	SubscribeItem(ctx context.Context, Req *auction.SubscribeItemReq, callOptions ...callopt.Option) (r *auction.SubscribeItemRsp, err error)
+	UnsubscribeItem(ctx context.Context, Req *auction.UnsubscribeItemReq, callOptions ...callopt.Option) (r *auction.UnsubscribeItemRsp, err error)
+	ListUniqueItem(ctx context.Context, Req *auction.ListUniqueItemReq, callOptions ...callopt.Option) (r *auction.ListUniqueItemRsp, err error)
+	CancelUniqueListing(ctx context.Context, Req *auction.CancelUniqueListingReq, callOptions ...callopt.Option) (r *auction.CancelUniqueListingRsp, err error)
+	BuyUniqueItem(ctx context.Context, Req *auction.BuyUniqueItemReq, callOptions ...callopt.Option) (r *auction.BuyUniqueItemRsp, err error)
+	OfferUniqueItem(ctx context.Context, Req *auction.OfferUniqueItemReq, callOptions ...callopt.Option) (r *auction.OfferUniqueItemRsp, err error)
+	AcceptUniqueOffer(ctx context.Context, Req *auction.AcceptUniqueOfferReq, callOptions ...callopt.Option) (r *auction.AcceptUniqueOfferRsp, err error)
+	SearchUniqueListings(ctx context.Context, Req *auction.SearchUniqueListingsReq, callOptions ...callopt.Option) (r *auction.SearchUniqueListingsRsp, err error)
+	GetMyUniqueListings(ctx context.Context, Req *auction.GetMyUniqueListingsReq, callOptions ...callopt.Option) (r *auction.GetMyUniqueListingsRsp, err error)
+	CreateTimedAuction(ctx context.Context, Req *auction.CreateTimedAuctionReq, callOptions ...callopt.Option) (r *auction.CreateTimedAuctionRsp, err error)
+	BidTimedAuction(ctx context.Context, Req *auction.BidTimedAuctionReq, callOptions ...callopt.Option) (r *auction.BidTimedAuctionRsp, err error)
+	CancelTimedAuction(ctx context.Context, Req *auction.CancelTimedAuctionReq, callOptions ...callopt.Option) (r *auction.CancelTimedAuctionRsp, err error)
+	GetTimedAuction(ctx context.Context, Req *auction.GetTimedAuctionReq, callOptions ...callopt.Option) (r *auction.GetTimedAuctionRsp, err error)
+	GetTimedAuctions(ctx context.Context, Req *auction.GetTimedAuctionsReq, callOptions ...callopt.Option) (r *auction.GetTimedAuctionsRsp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -77,6 +97,16 @@ func (p *kAuctionServiceClient) CancelBuy(ctx context.Context, Req *auction.Canc
 	return p.kClient.CancelBuy(ctx, Req)
 }
 
+func (p *kAuctionServiceClient) AmendSell(ctx context.Context, Req *auction.AmendSellReq, callOptions ...callopt.Option) (r *auction.AmendSellRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AmendSell(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) AmendBuy(ctx context.Context, Req *auction.AmendBuyReq, callOptions ...callopt.Option) (r *auction.AmendBuyRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AmendBuy(ctx, Req)
+}
+
 func (p *kAuctionServiceClient) GetMySells(ctx context.Context, Req *auction.GetMySellsReq, callOptions ...callopt.Option) (r *auction.GetMySellsRsp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetMySells(ctx, Req)
@@ -92,6 +122,11 @@ func (p *kAuctionServiceClient) GetItemAuctionInfo(ctx context.Context, Req *auc
 	return p.kClient.GetItemAuctionInfo(ctx, Req)
 }
 
+func (p *kAuctionServiceClient) SearchMarket(ctx context.Context, Req *auction.SearchMarketReq, callOptions ...callopt.Option) (r *auction.SearchMarketRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SearchMarket(ctx, Req)
+}
+
 func (p *kAuctionServiceClient) GetTransactionHistory(ctx context.Context, Req *auction.GetTransactionHistoryReq, callOptions ...callopt.Option) (r *auction.GetTransactionHistoryRsp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetTransactionHistory(ctx, Req)
@@ -101,3 +136,88 @@ func (p *kAuctionServiceClient) GetTransactionsByTime(ctx context.Context, Req *
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetTransactionsByTime(ctx, Req)
 }
+
+func (p *kAuctionServiceClient) GetAuctionStatement(ctx context.Context, Req *auction.GetAuctionStatementReq, callOptions ...callopt.Option) (r *auction.GetAuctionStatementRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetAuctionStatement(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) ExportAuctionStatement(ctx context.Context, Req *auction.ExportAuctionStatementReq, callOptions ...callopt.Option) (r *auction.ExportAuctionStatementRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportAuctionStatement(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) GetItemKline(ctx context.Context, Req *auction.GetItemKlineReq, callOptions ...callopt.Option) (r *auction.GetItemKlineRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetItemKline(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) SubscribeItem(ctx context.Context, Req *auction.SubscribeItemReq, callOptions ...callopt.Option) (r *auction.SubscribeItemRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubscribeItem(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) UnsubscribeItem(ctx context.Context, Req *auction.UnsubscribeItemReq, callOptions ...callopt.Option) (r *auction.UnsubscribeItemRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UnsubscribeItem(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) ListUniqueItem(ctx context.Context, Req *auction.ListUniqueItemReq, callOptions ...callopt.Option) (r *auction.ListUniqueItemRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListUniqueItem(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) CancelUniqueListing(ctx context.Context, Req *auction.CancelUniqueListingReq, callOptions ...callopt.Option) (r *auction.CancelUniqueListingRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelUniqueListing(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) BuyUniqueItem(ctx context.Context, Req *auction.BuyUniqueItemReq, callOptions ...callopt.Option) (r *auction.BuyUniqueItemRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BuyUniqueItem(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) OfferUniqueItem(ctx context.Context, Req *auction.OfferUniqueItemReq, callOptions ...callopt.Option) (r *auction.OfferUniqueItemRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.OfferUniqueItem(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) AcceptUniqueOffer(ctx context.Context, Req *auction.AcceptUniqueOfferReq, callOptions ...callopt.Option) (r *auction.AcceptUniqueOfferRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AcceptUniqueOffer(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) SearchUniqueListings(ctx context.Context, Req *auction.SearchUniqueListingsReq, callOptions ...callopt.Option) (r *auction.SearchUniqueListingsRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SearchUniqueListings(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) GetMyUniqueListings(ctx context.Context, Req *auction.GetMyUniqueListingsReq, callOptions ...callopt.Option) (r *auction.GetMyUniqueListingsRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetMyUniqueListings(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) CreateTimedAuction(ctx context.Context, Req *auction.CreateTimedAuctionReq, callOptions ...callopt.Option) (r *auction.CreateTimedAuctionRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateTimedAuction(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) BidTimedAuction(ctx context.Context, Req *auction.BidTimedAuctionReq, callOptions ...callopt.Option) (r *auction.BidTimedAuctionRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BidTimedAuction(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) CancelTimedAuction(ctx context.Context, Req *auction.CancelTimedAuctionReq, callOptions ...callopt.Option) (r *auction.CancelTimedAuctionRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelTimedAuction(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) GetTimedAuction(ctx context.Context, Req *auction.GetTimedAuctionReq, callOptions ...callopt.Option) (r *auction.GetTimedAuctionRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetTimedAuction(ctx, Req)
+}
+
+func (p *kAuctionServiceClient) GetTimedAuctions(ctx context.Context, Req *auction.GetTimedAuctionsReq, callOptions ...callopt.Option) (r *auction.GetTimedAuctionsRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetTimedAuctions(ctx, Req)
+}
@@ -15,6 +15,12 @@ type Client interface {
 	DeleteItem(ctx context.Context, Req *item.DeleteItemReq, callOptions ...callopt.Option) (r *item.DeleteItemRsp, err error)
 	GetAllItems(ctx context.Context, Req *item.GetAllItemsReq, callOptions ...callopt.Option) (r *item.GetAllItemsRsp, err error)
 	GetItem(ctx context.Context, Req *item.GetItemReq, callOptions ...callopt.Option) (r *item.GetItemRsp, err error)
+	DeleteItemById(ctx context.Context, Req *item.DeleteItemByIdReq, callOptions ...callopt.Option) (r *item.DeleteItemByIdRsp, err error)
+	GetItemLedger(ctx context.Context, Req *item.GetItemLedgerReq, callOptions ...callopt.Option) (r *item.GetItemLedgerRsp, err error)
+	UseItem(ctx context.Context, Req *item.UseItemReq, callOptions ...callopt.Option) (r *item.UseItemRsp, err error)
+	GetMailbox(ctx context.Context, Req *item.GetMailboxReq, callOptions ...callopt.Option) (r *item.GetMailboxRsp, err error)
+	ClaimMailbox(ctx context.Context, Req *item.ClaimMailboxReq, callOptions ...callopt.Option) (r *item.ClaimMailboxRsp, err error)
+	GetSkins(ctx context.Context, Req *item.GetSkinsReq, callOptions ...callopt.Option) (r *item.GetSkinsRsp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -65,3 +71,33 @@ func (p *kItemServiceClient) GetItem(ctx context.Context, Req *item.GetItemReq,
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetItem(ctx, Req)
 }
+
+func (p *kItemServiceClient) DeleteItemById(ctx context.Context, Req *item.DeleteItemByIdReq, callOptions ...callopt.Option) (r *item.DeleteItemByIdRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteItemById(ctx, Req)
+}
+
+func (p *kItemServiceClient) GetItemLedger(ctx context.Context, Req *item.GetItemLedgerReq, callOptions ...callopt.Option) (r *item.GetItemLedgerRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetItemLedger(ctx, Req)
+}
+
+func (p *kItemServiceClient) UseItem(ctx context.Context, Req *item.UseItemReq, callOptions ...callopt.Option) (r *item.UseItemRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UseItem(ctx, Req)
+}
+
+func (p *kItemServiceClient) GetMailbox(ctx context.Context, Req *item.GetMailboxReq, callOptions ...callopt.Option) (r *item.GetMailboxRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetMailbox(ctx, Req)
+}
+
+func (p *kItemServiceClient) ClaimMailbox(ctx context.Context, Req *item.ClaimMailboxReq, callOptions ...callopt.Option) (r *item.ClaimMailboxRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ClaimMailbox(ctx, Req)
+}
+
+func (p *kItemServiceClient) GetSkins(ctx context.Context, Req *item.GetSkinsReq, callOptions ...callopt.Option) (r *item.GetSkinsRsp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSkins(ctx, Req)
+}
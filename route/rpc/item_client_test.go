@@ -0,0 +1,52 @@
+package rpc
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"route_module/kitex_gen/item"
+	"route_module/kitex_gen/item_service/itemservice"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/golang/protobuf/proto"
+	any1 "github.com/golang/protobuf/ptypes/any"
+)
+
+// 发奖、写回道具实例和转移道具只在道具内部服务中提供，路由生成的道具客户端上不存在这些方法
+func Test_CallRPC_ItemInternalMethods(t *testing.T) {
+	itemClient, err := itemservice.NewClient("item", client.WithHostPorts("127.0.0.1:0"))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	// 对照：公开方法能被路由找到
+	for _, method := range []string{"GetItem", "GetSkins"} {
+		if !reflect.ValueOf(itemClient).MethodByName(method).IsValid() {
+			t.Fatalf("%s should be callable through route", method)
+		}
+	}
+
+	tests := []struct {
+		rpcName string
+		req     proto.Message
+	}{
+		{rpcName: "GrantRewards", req: &item.GrantRewardsReq{TableId: "daily_login"}},
+		{rpcName: "RestoreItems", req: &item.RestoreItemsReq{ItemInfoList: []*item.ItemInfo{{ItemId: 1001, ItemUniqueId: "forged", Count: 1}}}},
+		{rpcName: "TransferItems", req: &item.TransferItemsReq{ToUserId: "attacker"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rpcName, func(t *testing.T) {
+			bytes, _ := proto.Marshal(tt.req)
+			got, got2 := callRPC(context.Background(), itemClient, tt.rpcName, &any1.Any{Value: bytes})
+			if got == nil || !strings.Contains(got.Error(), "unknown rpc method") {
+				t.Errorf("callRPC(%s) error = %v, want unknown rpc method", tt.rpcName, got)
+			}
+			if got2 != nil {
+				t.Errorf("callRPC(%s) got2 = %v, want nil", tt.rpcName, got2)
+			}
+		})
+	}
+}